@@ -15,6 +15,7 @@ import (
 	v505 "github.com/neutron-org/neutron/v5/app/upgrades/v5.0.5"
 	v510 "github.com/neutron-org/neutron/v5/app/upgrades/v5.1.0"
 	v513 "github.com/neutron-org/neutron/v5/app/upgrades/v5.1.3"
+	v520 "github.com/neutron-org/neutron/v5/app/upgrades/v5.2.0"
 	dynamicfeestypes "github.com/neutron-org/neutron/v5/x/dynamicfees/types"
 
 	"github.com/skip-mev/feemarket/x/feemarket"
@@ -100,6 +101,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
@@ -202,6 +204,9 @@ import (
 	"github.com/neutron-org/neutron/v5/x/dex"
 	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
 	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+	"github.com/neutron-org/neutron/v5/x/incentives"
+	incentiveskeeper "github.com/neutron-org/neutron/v5/x/incentives/keeper"
+	incentivestypes "github.com/neutron-org/neutron/v5/x/incentives/types"
 
 	globalfeekeeper "github.com/neutron-org/neutron/v5/x/globalfee/keeper"
 	gmpmiddleware "github.com/neutron-org/neutron/v5/x/gmp"
@@ -238,6 +243,7 @@ var (
 		v505.Upgrade,
 		v510.Upgrade,
 		v513.Upgrade,
+		v520.Upgrade,
 	}
 
 	// DefaultNodeHome default home directories for the application daemon
@@ -290,6 +296,7 @@ var (
 		globalfee.AppModule{},
 		feemarket.AppModuleBasic{},
 		dex.AppModuleBasic{},
+		incentives.AppModuleBasic{},
 		oracle.AppModuleBasic{},
 		marketmap.AppModuleBasic{},
 		dynamicfees.AppModuleBasic{},
@@ -311,6 +318,7 @@ var (
 		tokenfactorytypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
 		crontypes.ModuleName:                          nil,
 		dextypes.ModuleName:                           {authtypes.Minter, authtypes.Burner},
+		incentivestypes.ModuleName:                    nil,
 		oracletypes.ModuleName:                        nil,
 		marketmaptypes.ModuleName:                     nil,
 		feemarkettypes.FeeCollectorName:               nil,
@@ -382,6 +390,7 @@ type App struct {
 	CronKeeper          cronkeeper.Keeper
 	PFMKeeper           *pfmkeeper.Keeper
 	DexKeeper           dexkeeper.Keeper
+	IncentivesKeeper    incentiveskeeper.Keeper
 	GlobalFeeKeeper     globalfeekeeper.Keeper
 
 	PFMModule packetforward.AppModule
@@ -499,6 +508,7 @@ func New(
 		feeburnertypes.StoreKey, adminmoduletypes.StoreKey, ccvconsumertypes.StoreKey, tokenfactorytypes.StoreKey, pfmtypes.StoreKey,
 		crontypes.StoreKey, ibcratelimittypes.ModuleName, ibchookstypes.StoreKey, consensusparamtypes.StoreKey, crisistypes.StoreKey, dextypes.StoreKey, auctiontypes.StoreKey,
 		oracletypes.StoreKey, marketmaptypes.StoreKey, feemarkettypes.StoreKey, dynamicfeestypes.StoreKey, globalfeetypes.StoreKey,
+		incentivestypes.StoreKey,
 	)
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, dextypes.TStoreKey)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, feetypes.MemStoreKey)
@@ -728,8 +738,24 @@ func New(
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 
+	app.DexKeeper.SetHooks(
+		dextypes.NewMultiDexHooks(
+			dexkeeper.NewWasmHooks(app.DexKeeper, &app.WasmKeeper),
+		))
+	app.DexKeeper.SetContractKeeper(&app.WasmKeeper)
+
 	dexModule := dex.NewAppModule(appCodec, app.DexKeeper, app.BankKeeper)
 
+	app.IncentivesKeeper = *incentiveskeeper.NewKeeper(
+		appCodec,
+		keys[incentivestypes.StoreKey],
+		keys[incentivestypes.MemStoreKey],
+		app.BankKeeper,
+		app.DexKeeper,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
+	)
+	incentivesModule := incentives.NewAppModule(appCodec, app.IncentivesKeeper)
+
 	wasmDir := filepath.Join(homePath, "wasm")
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
 	if err != nil {
@@ -934,6 +960,7 @@ func New(
 		feemarket.NewAppModule(appCodec, *app.FeeMarkerKeeper),
 		dynamicfees.NewAppModule(appCodec, *app.DynamicFeesKeeper),
 		dexModule,
+		incentivesModule,
 		marketmapModule,
 		oracleModule,
 		auction.NewAppModule(appCodec, app.AuctionKeeper),
@@ -1021,6 +1048,7 @@ func New(
 		globalfee.ModuleName,
 		feemarkettypes.ModuleName,
 		dextypes.ModuleName,
+		incentivestypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
 
@@ -1063,6 +1091,7 @@ func New(
 		oracletypes.ModuleName,
 		marketmaptypes.ModuleName,
 		dextypes.ModuleName,
+		incentivestypes.ModuleName,
 		dynamicfeestypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
@@ -1078,7 +1107,7 @@ func New(
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	app.sm = module.NewSimulationManager(
-		auth.NewAppModule(appCodec, app.AccountKeeper, nil, app.GetSubspace(authtypes.ModuleName)),
+		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper, false),
@@ -1691,7 +1720,7 @@ func (app *App) WireICS20PreWasmKeeper(
 		&wasmHooks,
 	)
 
-	ibcratelimitKeeper := ibcratelimitkeeper.NewKeeper(appCodec, app.keys[ibcratelimittypes.ModuleName], authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String())
+	ibcratelimitKeeper := ibcratelimitkeeper.NewKeeper(appCodec, app.keys[ibcratelimittypes.ModuleName], app.BankKeeper, authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String())
 	// ChannelKeeper wrapper for rate limiting SendPacket(). The wasmKeeper needs to be added after it's created
 	rateLimitingICS4Wrapper := ibcratelimit.NewICS4Middleware(
 		app.HooksICS4Wrapper,
@@ -1719,7 +1748,9 @@ func (app *App) WireICS20PreWasmKeeper(
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 
-	app.PFMKeeper.SetTransferKeeper(app.TransferKeeper.Keeper)
+	// forwarded transfers go through the ICS-20 keeper so that tokenfactory hooks receive them
+	app.PFMKeeper.SetTransferKeeper(app.TransferKeeper.ICS20Keeper())
+	app.RateLimitingICS4Wrapper.TransferKeeper = app.TransferKeeper.Keeper
 
 	// Packet Forward Middleware
 	// Initialize packet forward middleware router
@@ -28,6 +28,7 @@ import (
 	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
 	feeburnertypes "github.com/neutron-org/neutron/v5/x/feeburner/types"
 	feerefundertypes "github.com/neutron-org/neutron/v5/x/feerefunder/types"
+	incentivestypes "github.com/neutron-org/neutron/v5/x/incentives/types"
 	interchainqueriestypes "github.com/neutron-org/neutron/v5/x/interchainqueries/types"
 	interchaintxstypes "github.com/neutron-org/neutron/v5/x/interchaintxs/types"
 	tokenfactorytypes "github.com/neutron-org/neutron/v5/x/tokenfactory/types"
@@ -71,6 +72,9 @@ func isSdkMessageWhitelisted(msg sdk.Msg) bool {
 		*ibcclienttypes.MsgRecoverClient,
 		*ibcclienttypes.MsgIBCSoftwareUpgrade,
 		*tokenfactorytypes.MsgUpdateParams,
+		*tokenfactorytypes.MsgForceUnfreeze,
+		*tokenfactorytypes.MsgSetDenomRegistryEntry,
+		*tokenfactorytypes.MsgRemoveDenomRegistryEntry,
 		*interchainqueriestypes.MsgUpdateParams,
 		*interchaintxstypes.MsgUpdateParams,
 		*feeburnertypes.MsgUpdateParams,
@@ -80,6 +84,11 @@ func isSdkMessageWhitelisted(msg sdk.Msg) bool {
 		*crontypes.MsgRemoveSchedule,
 		*contractmanagertypes.MsgUpdateParams,
 		*dextypes.MsgUpdateParams,
+		*dextypes.MsgSetPairTradingStatus,
+		*dextypes.MsgSetDenomTradingStatus,
+		*dextypes.MsgSetPairFeeTiers,
+		*dextypes.MsgSetTraderFeeTier,
+		*incentivestypes.MsgUpdateParams,
 		*banktypes.MsgUpdateParams,
 		*crisistypes.MsgUpdateParams,
 		*minttypes.MsgUpdateParams,
@@ -94,7 +103,11 @@ func isSdkMessageWhitelisted(msg sdk.Msg) bool {
 		*dynamicfeestypes.MsgUpdateParams,
 		*ibctransfertypes.MsgUpdateParams,
 		*globalfeetypes.MsgUpdateParams,
-		*ibcratelimittypes.MsgUpdateParams:
+		*ibcratelimittypes.MsgUpdateParams,
+		*ibcratelimittypes.MsgSetRateLimit,
+		*ibcratelimittypes.MsgRemoveRateLimit,
+		*ibcratelimittypes.MsgResetRateLimitQuota,
+		*ibcratelimittypes.MsgSetChannelQuotas:
 		return true
 	}
 	return false
@@ -0,0 +1,202 @@
+package app_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	consumertypes "github.com/cosmos/interchain-security/v5/x/ccv/consumer/types"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/app"
+	globalfeetypes "github.com/neutron-org/neutron/v5/x/globalfee/types"
+)
+
+// simDenoms are the denoms every simulation account is funded with so that the dex has pairs to trade
+var simDenoms = []string{sdk.DefaultBondDenom, "untrn", "uibcatom", "uibcusdc"}
+
+// skippedSimOperations are modules whose operations cannot be executed on neutron. Slashing messages are rejected by
+// the consumer ante handler and its operations rely on a staking keeper. The others pay random fees in any denom held
+// by an account, including dex pool shares, which the global fee module rejects.
+var skippedSimOperations = map[string]bool{
+	slashingtypes.ModuleName: true,
+	authz.ModuleName:         true,
+	banktypes.ModuleName:     true,
+	feegrant.ModuleName:      true,
+	wasmtypes.ModuleName:     true,
+}
+
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+// TestFullAppSimulation runs randomized operations of all modules against a fresh chain. It is skipped unless the
+// -Enabled flag is set. Since the consumer ante handler only accepts IBC messages until the CCV channel to the
+// provider is established, it must be built with the skip_ccv_msg_filter tag:
+//
+//	go test ./app -run TestFullAppSimulation -tags skip_ccv_msg_filter -Enabled=true -NumBlocks=100 -BlockSize=50 -Commit=true -Period=5
+func TestFullAppSimulation(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = "neutron-sim"
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(
+		config,
+		"leveldb-app-sim",
+		"Simulation",
+		simcli.FlagVerboseValue,
+		simcli.FlagEnabledValue,
+	)
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	neutronApp := app.New(
+		logger,
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		dir,
+		simcli.FlagPeriodValue,
+		app.MakeEncodingConfig(),
+		simtestutil.EmptyAppOptions{},
+		nil,
+		baseapp.SetChainID(config.ChainID),
+	)
+
+	simState := module.SimulationState{
+		AppParams: make(simtypes.AppParams),
+		Cdc:       neutronApp.AppCodec(),
+		TxConfig:  neutronApp.GetTxConfig(),
+	}
+	var operations []simtypes.WeightedOperation
+	for _, simModule := range neutronApp.SimulationManager().Modules {
+		if named, ok := simModule.(module.HasName); ok && skippedSimOperations[named.Name()] {
+			continue
+		}
+		operations = append(operations, simModule.WeightedOperations(simState)...)
+	}
+
+	_, _, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		neutronApp.BaseApp,
+		simAppStateFn(neutronApp.AppCodec(), neutronApp.SimulationManager()),
+		simtypes.RandomAccounts,
+		operations,
+		neutronApp.BlockedAddrs(),
+		config,
+		neutronApp.AppCodec(),
+	)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+}
+
+// simAppStateFn builds a randomized genesis for a consumer chain. The generic simulation genesis cannot be used since
+// neutron has no staking module: the validator set is provided by the consumer module instead.
+func simAppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simtypes.AppStateFn {
+	return func(r *rand.Rand, accs []simtypes.Account, config simtypes.Config,
+	) (appState json.RawMessage, simAccs []simtypes.Account, chainID string, genesisTimestamp time.Time) {
+		genesisTimestamp = simtypes.RandTimestamp(r)
+		genesisState := app.NewDefaultGenesisState(cdc)
+
+		simState := &module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			GenState:     genesisState,
+			Accounts:     accs,
+			InitialStake: sdk.DefaultPowerReduction,
+			NumBonded:    1,
+			BondDenom:    sdk.DefaultBondDenom,
+			GenTimestamp: genesisTimestamp,
+		}
+		simManager.GenerateGenesisStates(simState)
+
+		// fund every account with all of the sim denoms
+		balances := make([]banktypes.Balance, 0, len(accs))
+		supply := sdk.NewCoins()
+		for _, acc := range accs {
+			coins := sdk.NewCoins()
+			for _, denom := range simDenoms {
+				coins = coins.Add(sdk.NewCoin(denom, math.NewInt(r.Int63n(1_000_000_000_000)+1_000_000)))
+			}
+			balances = append(balances, banktypes.Balance{Address: acc.Address.String(), Coins: coins})
+			supply = supply.Add(coins...)
+		}
+		bankGenesis := banktypes.NewGenesisState(banktypes.DefaultParams(), balances, supply, nil, nil)
+		genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenesis)
+
+		// fees are random in simulations, so the fee market is disabled and fees are accepted in all sim denoms
+		feemarketGenesis := feemarkettypes.DefaultGenesisState()
+		feemarketGenesis.Params.Enabled = false
+		genesisState[feemarkettypes.ModuleName] = cdc.MustMarshalJSON(feemarketGenesis)
+
+		globalfeeGenesis := globalfeetypes.DefaultGenesisState()
+		// zero coins have to be set directly since DecCoins arithmetic drops them
+		minGasPrices := make(sdk.DecCoins, len(simDenoms))
+		for i, denom := range simDenoms {
+			minGasPrices[i] = sdk.NewDecCoin(denom, math.ZeroInt())
+		}
+		globalfeeGenesis.Params.MinimumGasPrices = minGasPrices.Sort()
+		genesisState[globalfeetypes.ModuleName] = cdc.MustMarshalJSON(globalfeeGenesis)
+
+		var consumerGenesis consumertypes.GenesisState
+		cdc.MustUnmarshalJSON(genesisState[consumertypes.ModuleName], &consumerGenesis)
+		consumerGenesis.Params.Enabled = true
+		consumerGenesis.Provider.InitialValSet = simValidatorUpdates(r)
+		genesisState[consumertypes.ModuleName] = cdc.MustMarshalJSON(&consumerGenesis)
+
+		appState, err := json.Marshal(genesisState)
+		if err != nil {
+			panic(err)
+		}
+
+		return appState, accs, config.ChainID, genesisTimestamp
+	}
+}
+
+func simValidatorUpdates(r *rand.Rand) []abci.ValidatorUpdate {
+	numValidators := simtypes.RandIntBetween(r, 1, 5)
+	updates := make([]abci.ValidatorUpdate, numValidators)
+	for i := range updates {
+		seed := make([]byte, 32)
+		r.Read(seed)
+		pubKey, err := cryptocodec.ToCmtProtoPublicKey(ed25519.GenPrivKeyFromSecret(seed).PubKey())
+		if err != nil {
+			panic(err)
+		}
+		updates[i] = abci.ValidatorUpdate{PubKey: pubKey, Power: int64(simtypes.RandIntBetween(r, 1, 100))}
+	}
+
+	return updates
+}
@@ -0,0 +1,21 @@
+package v520
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/neutron-org/neutron/v5/app/upgrades"
+	incentivestypes "github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name.
+	UpgradeName = "v5.2.0"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{incentivestypes.StoreKey},
+	},
+}
@@ -0,0 +1,75 @@
+package v520_test
+
+import (
+	"testing"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	v520 "github.com/neutron-org/neutron/v5/app/upgrades/v5.2.0"
+	"github.com/neutron-org/neutron/v5/testutil"
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+	incentivestypes "github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+type UpgradeTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (suite *UpgradeTestSuite) SetupTest() {
+	suite.IBCConnectionTestSuite.SetupTest()
+}
+
+func (suite *UpgradeTestSuite) TestUpgrade() {
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext().WithChainID("neutron-1")
+	t := suite.T()
+
+	upgrade := upgradetypes.Plan{
+		Name:   v520.UpgradeName,
+		Info:   "some text here",
+		Height: 100,
+	}
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgrade))
+	require.Contains(t, v520.Upgrade.StoreUpgrades.Added, incentivestypes.StoreKey)
+}
+
+func (suite *UpgradeTestSuite) TestUpgradeDexParams() {
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext().WithChainID("neutron-1")
+	t := suite.T()
+
+	// Params stored by dex v5 don't have the new fields
+	vm, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	vm[dextypes.ModuleName] = 5
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, vm))
+	require.NoError(t, app.DexKeeper.SetParams(ctx, dextypes.Params{
+		FeeTiers:              dextypes.DefaultFeeTiers,
+		MaxJitsPerBlock:       dextypes.DefaultMaxJITsPerBlock,
+		GoodTilPurgeAllowance: dextypes.DefaultGoodTilPurgeAllowance,
+	}))
+
+	upgrade := upgradetypes.Plan{
+		Name:   v520.UpgradeName,
+		Info:   "some text here",
+		Height: 100,
+	}
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgrade))
+
+	params := app.DexKeeper.GetParams(ctx)
+	require.Equal(t, dextypes.DefaultHookGasLimit, params.HookGasLimit)
+	require.Equal(t, dextypes.DefaultFlashSwapFee, params.FlashSwapFee)
+	require.Equal(t, dextypes.DefaultMaxFlashSwapDepth, params.MaxFlashSwapDepth)
+	require.Equal(t, dextypes.DefaultCandleIntervals, params.CandleIntervals)
+	require.Equal(t, dextypes.DefaultCandleRetention, params.CandleRetention)
+	require.Equal(t, dextypes.DefaultTraderVolumeWindow, params.TraderVolumeWindow)
+	require.Equal(t, dextypes.DefaultTWAPSlicesPerBlock, params.TwapSlicesPerBlock)
+	require.Equal(t, dextypes.DefaultAutoSettlesPerBlock, params.AutoSettlesPerBlock)
+	require.NoError(t, params.Validate())
+}
@@ -10,6 +10,7 @@ import (
 	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
 	feetypes "github.com/neutron-org/neutron/v5/x/feerefunder/types"
 	icqtypes "github.com/neutron-org/neutron/v5/x/interchainqueries/types"
+	tokenfactorytypes "github.com/neutron-org/neutron/v5/x/tokenfactory/types"
 	transferwrappertypes "github.com/neutron-org/neutron/v5/x/transfer/types"
 )
 
@@ -37,6 +38,15 @@ type NeutronMsg struct {
 	CreateDenom *CreateDenom `json:"create_denom,omitempty"`
 	/// Contracts can change the admin of a denom that they are the admin of.
 	ChangeAdmin *ChangeAdmin `json:"change_admin,omitempty"`
+	/// Contracts can propose handing a factory denom they are the admin of over to a new admin,
+	/// who has to accept it after an optional timelock and before an optional expiry.
+	ProposeAdmin *ProposeAdmin `json:"propose_admin,omitempty"`
+	/// Contracts can accept the admin transfer of a factory denom proposed to them.
+	AcceptAdmin *AcceptAdmin `json:"accept_admin,omitempty"`
+	/// Contracts can cancel the admin transfer they proposed for a factory denom.
+	CancelAdminTransfer *CancelAdminTransfer `json:"cancel_admin_transfer,omitempty"`
+	/// Contracts can permanently leave a factory denom they are the admin of without admin.
+	RenounceAdmin *RenounceAdmin `json:"renounce_admin,omitempty"`
 	/// Contracts can mint native tokens for an existing factory denom
 	/// that they are the admin of.
 	MintTokens *MintTokens `json:"mint_tokens,omitempty"`
@@ -48,11 +58,20 @@ type NeutronMsg struct {
 	///	that they are the admin of.
 	///	Currently, the set before hook call should be performed from address that must be the admin contract.
 	SetBeforeSendHook *SetBeforeSendHook `json:"set_before_send_hook,omitempty"`
+	/// Contracts can replace the ordered list of before send hooks of a factory denom that they are the admin of.
+	SetBeforeSendHooks *SetBeforeSendHooks `json:"set_before_send_hooks,omitempty"`
 	/// Force transferring of a specific denom is only allowed for the creator of the denom registered during CreateDenom.
 	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
 	/// Setting of metadata for a specific denom is only allowed for the admin of the denom.
 	/// It allows the overwriting of the denom metadata in the bank module.
 	SetDenomMetadata *SetDenomMetadata `json:"set_denom_metadata,omitempty"`
+	/// Contracts can cap the supply and mint rate of a denom that they are the admin of.
+	/// Looser limits only take effect after the mint limits timelock.
+	SetMintLimits *SetMintLimits `json:"set_mint_limits,omitempty"`
+	/// Contracts with the minter role of a denom can mint it into a vesting schedule of a recipient.
+	MintVested *MintVested `json:"mint_vested,omitempty"`
+	/// Contracts can claim the tokens vested so far in the vesting schedules they are the recipient of.
+	ClaimVested *ClaimVested `json:"claim_vested,omitempty"`
 
 	// Cron types
 	AddSchedule    *AddSchedule    `json:"add_schedule,omitempty"`
@@ -149,18 +168,42 @@ type ProposalExecuteMessage struct {
 // Subdenom can be of length at most 44 characters, in [0-9a-zA-Z./]
 // The (creating contract address, subdenom) pair must be unique.
 // The created denom's admin is the creating contract address,
-// but this admin can be changed using the ChangeAdmin binding.
+// but this admin can be handed over using the ProposeAdmin and AcceptAdmin bindings.
 type CreateDenom struct {
 	Subdenom string `json:"subdenom"`
 }
 
-// ChangeAdmin changes the admin for a factory denom.
-// If the NewAdminAddress is empty, the denom has no admin.
+// ChangeAdmin proposes handing a factory denom over to NewAdminAddress, which can accept it right away with
+// AcceptAdmin. It is a ProposeAdmin without timelock nor expiry.
 type ChangeAdmin struct {
 	Denom           string `json:"denom"`
 	NewAdminAddress string `json:"new_admin_address"`
 }
 
+// ProposeAdmin proposes handing a factory denom over to NewAdminAddress. The new admin can accept after Timelock
+// seconds, and until Expiry more seconds have passed unless Expiry is zero.
+type ProposeAdmin struct {
+	Denom           string `json:"denom"`
+	NewAdminAddress string `json:"new_admin_address"`
+	Timelock        uint64 `json:"timelock,omitempty"`
+	Expiry          uint64 `json:"expiry,omitempty"`
+}
+
+// AcceptAdmin accepts the admin transfer of a factory denom proposed to the contract.
+type AcceptAdmin struct {
+	Denom string `json:"denom"`
+}
+
+// CancelAdminTransfer cancels the proposed admin transfer of a factory denom.
+type CancelAdminTransfer struct {
+	Denom string `json:"denom"`
+}
+
+// RenounceAdmin permanently leaves a factory denom without admin.
+type RenounceAdmin struct {
+	Denom string `json:"denom"`
+}
+
 type MintTokens struct {
 	Denom         string   `json:"denom"`
 	Amount        math.Int `json:"amount"`
@@ -181,11 +224,49 @@ type SetBeforeSendHook struct {
 	ContractAddr string `json:"contract_addr"`
 }
 
+// SetBeforeSendHooks replaces the before send hooks of a factory denom, called in the given order. Empty removes all hooks.
+type SetBeforeSendHooks struct {
+	Denom string                             `json:"denom"`
+	Hooks []tokenfactorytypes.BeforeSendHook `json:"hooks"`
+}
+
 // SetDenomMetadata is sets the denom's bank metadata
 type SetDenomMetadata struct {
 	banktypes.Metadata
 }
 
+// SetMintLimits sets the max supply and mint rate limit of a factory denom. Zero disables a limit.
+type SetMintLimits struct {
+	Denom         string   `json:"denom"`
+	MaxSupply     math.Int `json:"max_supply"`
+	MintRateLimit math.Int `json:"mint_rate_limit"`
+	MintWindow    uint64   `json:"mint_window"`
+}
+
+// MintVested mints tokens of a factory denom into a vesting schedule of the recipient.
+// The schedule vests continuously between StartTime and EndTime if Periods is empty, and periodically otherwise.
+type MintVested struct {
+	Denom     string                            `json:"denom"`
+	Amount    math.Int                          `json:"amount"`
+	Recipient string                            `json:"recipient"`
+	StartTime int64                             `json:"start_time"`
+	EndTime   int64                             `json:"end_time,omitempty"`
+	Periods   []tokenfactorytypes.VestingPeriod `json:"periods,omitempty"`
+}
+
+type MintVestedResponse struct {
+	ScheduleId uint64 `json:"schedule_id"`
+}
+
+// ClaimVested claims the tokens vested so far in a vesting schedule of the contract.
+type ClaimVested struct {
+	ScheduleId uint64 `json:"schedule_id"`
+}
+
+type ClaimVestedResponse struct {
+	Amount sdk.Coin `json:"amount"`
+}
+
 // ForceTransfer forces transferring of a specific denom is only allowed for the creator of the denom registered during CreateDenom.
 type ForceTransfer struct {
 	Denom               string   `json:"denom"`
@@ -235,7 +316,18 @@ type Dex struct {
 	PlaceLimitOrder          *MsgPlaceLimitOrder                   `json:"place_limit_order"`
 	WithdrawFilledLimitOrder *dextypes.MsgWithdrawFilledLimitOrder `json:"withdraw_filled_limit_order"`
 	CancelLimitOrder         *dextypes.MsgCancelLimitOrder         `json:"cancel_limit_order"`
+	BatchCancelLimitOrders   *dextypes.MsgBatchCancelLimitOrders   `json:"batch_cancel_limit_orders"`
+	ReplaceLimitOrder        *MsgReplaceLimitOrder                 `json:"replace_limit_order"`
 	MultiHopSwap             *dextypes.MsgMultiHopSwap             `json:"multi_hop_swap"`
+	SubscribeHooks           *dextypes.MsgSubscribeHooks           `json:"subscribe_hooks"`
+	UnsubscribeHooks         *dextypes.MsgUnsubscribeHooks         `json:"unsubscribe_hooks"`
+	DepositRange             *MsgDepositRange                      `json:"deposit_range"`
+	WithdrawRangePosition    *dextypes.MsgWithdrawRangePosition    `json:"withdraw_range_position"`
+	RebalanceRangePosition   *MsgRebalanceRangePosition            `json:"rebalance_range_position"`
+	FlashSwap                *dextypes.MsgFlashSwap                `json:"flash_swap"`
+	PlaceTWAPOrder           *dextypes.MsgPlaceTWAPOrder           `json:"place_twap_order"`
+	WithdrawTWAPOrder        *dextypes.MsgWithdrawTWAPOrder        `json:"withdraw_twap_order"`
+	PurgeExpiredOrders       *dextypes.MsgPurgeExpiredOrders       `json:"purge_expired_orders"`
 }
 
 // MsgPlaceLimitOrder is a copy dextypes.MsgPlaceLimitOrder with altered ExpirationTime field,
@@ -252,6 +344,43 @@ type MsgPlaceLimitOrder struct {
 	// expirationTime is only valid iff orderType == GOOD_TIL_TIME.
 	ExpirationTime *uint64   `json:"expiration_time,omitempty"`
 	MaxAmountOut   *math.Int `json:"max_amount_out"`
+	AutoSettle     bool      `json:"auto_settle,omitempty"`
 	// Accepts standard decimals and decimals with scientific notation (ie. 1234.23E-7)
 	LimitSellPrice string `json:"limit_sell_price,omitempty"`
 }
+
+// MsgReplaceLimitOrder is a copy dextypes.MsgReplaceLimitOrder with altered ExpirationTime and LimitSellPrice fields
+type MsgReplaceLimitOrder struct {
+	Creator    string    `json:"creator,omitempty"`
+	TrancheKey string    `json:"tranche_key,omitempty"`
+	AmountIn   *math.Int `json:"amount_in,omitempty"`
+	// expirationTime is only valid iff the replaced order is GOOD_TIL_TIME.
+	ExpirationTime *uint64 `json:"expiration_time,omitempty"`
+	// Accepts standard decimals and decimals with scientific notation (ie. 1234.23E-7)
+	LimitSellPrice string `json:"limit_sell_price,omitempty"`
+}
+
+// MsgDepositRange is a copy dextypes.MsgDepositRange with DistributionShape passed as a string
+type MsgDepositRange struct {
+	Receiver           string                   `json:"receiver,omitempty"`
+	TokenA             string                   `json:"token_a,omitempty"`
+	TokenB             string                   `json:"token_b,omitempty"`
+	AmountA            math.Int                 `json:"amount_a"`
+	AmountB            math.Int                 `json:"amount_b"`
+	LowerTickIndexAToB int64                    `json:"lower_tick_index_a_to_b,omitempty"`
+	UpperTickIndexAToB int64                    `json:"upper_tick_index_a_to_b,omitempty"`
+	TickSpacing        uint64                   `json:"tick_spacing,omitempty"`
+	Fee                uint64                   `json:"fee,omitempty"`
+	DistributionShape  string                   `json:"distribution_shape,omitempty"`
+	Options            *dextypes.DepositOptions `json:"options,omitempty"`
+}
+
+// MsgRebalanceRangePosition is a copy dextypes.MsgRebalanceRangePosition with DistributionShape passed as a string
+type MsgRebalanceRangePosition struct {
+	PositionID        uint64                   `json:"position_id,omitempty"`
+	LowerTickIndex    int64                    `json:"lower_tick_index,omitempty"`
+	UpperTickIndex    int64                    `json:"upper_tick_index,omitempty"`
+	TickSpacing       uint64                   `json:"tick_spacing,omitempty"`
+	DistributionShape string                   `json:"distribution_shape,omitempty"`
+	Options           *dextypes.DepositOptions `json:"options,omitempty"`
+}
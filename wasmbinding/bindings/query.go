@@ -10,9 +10,11 @@ import (
 	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
 
 	feerefundertypes "github.com/neutron-org/neutron/v5/x/feerefunder/types"
+	tokenfactorytypes "github.com/neutron-org/neutron/v5/x/tokenfactory/types"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
 
@@ -43,6 +45,12 @@ type NeutronQuery struct {
 	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
 	// Returns the before send hook if it was set before
 	BeforeSendHook *BeforeSendHook `json:"before_send_hook,omitempty"`
+	// Returns a vesting schedule and the amounts vested and claimable from it
+	VestingSchedule *VestingSchedule `json:"vesting_schedule,omitempty"`
+	// Returns the vesting schedules of a recipient
+	VestingSchedules *VestingSchedules `json:"vesting_schedules,omitempty"`
+	// Returns the bank metadata and the governance curated registry entry of any denom
+	DenomMetadata *DenomMetadata `json:"denom_metadata,omitempty"`
 	// Contractmanager queries
 	// Query all failures for address
 	Failures *Failures `json:"failures,omitempty"`
@@ -214,11 +222,55 @@ type BeforeSendHook struct {
 }
 
 type BeforeSendHookResponse struct {
+	// Address of the first hook of the denom
 	ContractAddr string `json:"contract_addr"`
+	// All the hooks of the denom in the order they are called
+	Hooks []tokenfactorytypes.BeforeSendHook `json:"hooks"`
+}
+
+type VestingSchedule struct {
+	Id uint64 `json:"id"`
+}
+
+type VestingScheduleResponse struct {
+	VestingSchedule tokenfactorytypes.VestingScheduleStatus `json:"vesting_schedule"`
+}
+
+type VestingSchedules struct {
+	Recipient  string             `json:"recipient"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+type VestingSchedulesResponse struct {
+	VestingSchedules []tokenfactorytypes.VestingScheduleStatus `json:"vesting_schedules"`
+	Pagination       *query.PageResponse                       `json:"pagination,omitempty"`
+}
+
+type DenomMetadata struct {
+	Denom string `json:"denom"`
+}
+
+type DenomMetadataResponse struct {
+	// Bank metadata of the denom, if any
+	Metadata *banktypes.Metadata `json:"metadata,omitempty"`
+	// Registry entry of the denom curated by governance, if any
+	RegistryEntry *tokenfactorytypes.DenomRegistryEntry `json:"registry_entry,omitempty"`
 }
 
 type DenomAdminResponse struct {
 	Admin string `json:"admin"`
+	// Limits enforced on every mint of the denom, if any
+	MintLimits *tokenfactorytypes.MintLimits `json:"mint_limits,omitempty"`
+	// Looser limits that replace MintLimits at PendingMintLimitsEffectiveTime (unix seconds)
+	PendingMintLimits              *tokenfactorytypes.MintLimits `json:"pending_mint_limits,omitempty"`
+	PendingMintLimitsEffectiveTime int64                         `json:"pending_mint_limits_effective_time,omitempty"`
+	// Roles granted by the admin over the denom
+	Roles []tokenfactorytypes.RoleAssignment `json:"roles,omitempty"`
+	// Remaining allowances of the minters that have one
+	MinterAllowances       []tokenfactorytypes.MinterAllowance `json:"minter_allowances,omitempty"`
+	ForceTransferRenounced bool                                `json:"force_transfer_renounced,omitempty"`
+	// Admin transfer waiting for the acceptance of the new admin, if any
+	PendingAdminTransfer *tokenfactorytypes.PendingAdminTransfer `json:"pending_admin_transfer,omitempty"`
 }
 
 type FullDenomResponse struct {
@@ -263,6 +315,28 @@ type DexQuery struct {
 	EstimateMultiHopSwap *dextypes.QueryEstimateMultiHopSwapRequest `json:"estimate_multi_hop_swap"`
 	// Queries the simulated result of a PlaceLimit order
 	EstimatePlaceLimitOrder *QueryEstimatePlaceLimitOrderRequest `json:"estimate_place_limit_order"`
+	// Simulates MsgDeposit
+	SimulateDeposit *dextypes.QuerySimulateDepositRequest `json:"simulate_deposit"`
+	// Simulates MsgWithdrawal
+	SimulateWithdrawal *dextypes.QuerySimulateWithdrawalRequest `json:"simulate_withdrawal"`
+	// Simulates MsgPlaceLimitOrder
+	SimulatePlaceLimitOrder *QuerySimulatePlaceLimitOrderRequest `json:"simulate_place_limit_order"`
+	// Simulates MsgCancelLimitOrder
+	SimulateCancelLimitOrder *dextypes.QuerySimulateCancelLimitOrderRequest `json:"simulate_cancel_limit_order"`
+	// Simulates MsgWithdrawFilledLimitOrder
+	SimulateWithdrawFilledLimitOrder *dextypes.QuerySimulateWithdrawFilledLimitOrderRequest `json:"simulate_withdraw_filled_limit_order"`
+	// Simulates MsgMultiHopSwap
+	SimulateMultiHopSwap *dextypes.QuerySimulateMultiHopSwapRequest `json:"simulate_multi_hop_swap"`
+	// Queries the OHLCV candles of a pair for an interval
+	CandleAll *dextypes.QueryAllCandleRequest `json:"candle_all"`
+	// Queries a TWAP order and the results of its slices
+	TWAPOrder *dextypes.QueryGetTWAPOrderRequest `json:"twap_order"`
+	// Queries the results of the slices of a TWAP order, including completed and withdrawn ones
+	TWAPOrderFills *dextypes.QueryTWAPOrderFillsRequest `json:"twap_order_fills"`
+	// Queries the TWAP orders created by an address
+	UserTWAPOrders *dextypes.QueryUserTWAPOrdersRequest `json:"user_twap_orders"`
+	// Queries the value of the pool shares and limit orders of an address
+	UserPositionValue *dextypes.QueryUserPositionValueRequest `json:"user_position_value"`
 	// Queries a pool by pair, tick and fee
 	Pool *dextypes.QueryPoolRequest `json:"pool"`
 	// Queries a pool by ID
@@ -287,3 +361,9 @@ type QueryEstimatePlaceLimitOrderRequest struct {
 	ExpirationTime *uint64   `json:"expiration_time,omitempty"`
 	MaxAmountOut   *math.Int `json:"max_amount_out"`
 }
+
+// QuerySimulatePlaceLimitOrderRequest is a copy dextypes.QuerySimulatePlaceLimitOrderRequest with the binding of
+// MsgPlaceLimitOrder, so the expiration time is passed as unixtime and the limit sell price as a string
+type QuerySimulatePlaceLimitOrderRequest struct {
+	Msg *MsgPlaceLimitOrder `json:"msg"`
+}
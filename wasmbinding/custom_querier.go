@@ -142,6 +142,42 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.VestingSchedule != nil:
+			res, err := qp.GetVestingSchedule(ctx, contractQuery.VestingSchedule.Id)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to get vesting schedule")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to JSON marshal VestingScheduleResponse response")
+			}
+
+			return bz, nil
+
+		case contractQuery.VestingSchedules != nil:
+			res, err := qp.GetVestingSchedules(ctx, contractQuery.VestingSchedules.Recipient, contractQuery.VestingSchedules.Pagination)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to get vesting schedules")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to JSON marshal VestingSchedulesResponse response")
+			}
+
+			return bz, nil
+
+		case contractQuery.DenomMetadata != nil:
+			res := qp.GetDenomMetadata(ctx, contractQuery.DenomMetadata.Denom)
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to JSON marshal DenomMetadataResponse response")
+			}
+
+			return bz, nil
+
 		case contractQuery.Failures != nil:
 			res, err := qp.GetFailures(ctx, contractQuery.Failures.Address, contractQuery.Failures.Pagination)
 			if err != nil {
@@ -152,9 +152,24 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 	if contractMsg.SetBeforeSendHook != nil {
 		return m.setBeforeSendHook(ctx, contractAddr, contractMsg.SetBeforeSendHook)
 	}
+	if contractMsg.SetBeforeSendHooks != nil {
+		return m.setBeforeSendHooks(ctx, contractAddr, contractMsg.SetBeforeSendHooks)
+	}
 	if contractMsg.ChangeAdmin != nil {
 		return m.changeAdmin(ctx, contractAddr, contractMsg.ChangeAdmin)
 	}
+	if contractMsg.ProposeAdmin != nil {
+		return m.proposeAdmin(ctx, contractAddr, contractMsg.ProposeAdmin)
+	}
+	if contractMsg.AcceptAdmin != nil {
+		return m.acceptAdmin(ctx, contractAddr, contractMsg.AcceptAdmin)
+	}
+	if contractMsg.CancelAdminTransfer != nil {
+		return m.cancelAdminTransfer(ctx, contractAddr, contractMsg.CancelAdminTransfer)
+	}
+	if contractMsg.RenounceAdmin != nil {
+		return m.renounceAdmin(ctx, contractAddr, contractMsg.RenounceAdmin)
+	}
 	if contractMsg.BurnTokens != nil {
 		return m.burnTokens(ctx, contractAddr, contractMsg.BurnTokens)
 	}
@@ -164,6 +179,15 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 	if contractMsg.SetDenomMetadata != nil {
 		return m.setDenomMetadata(ctx, contractAddr, contractMsg.SetDenomMetadata)
 	}
+	if contractMsg.SetMintLimits != nil {
+		return m.setMintLimits(ctx, contractAddr, contractMsg.SetMintLimits)
+	}
+	if contractMsg.MintVested != nil {
+		return m.mintVested(ctx, contractAddr, contractMsg.MintVested)
+	}
+	if contractMsg.ClaimVested != nil {
+		return m.claimVested(ctx, contractAddr, contractMsg.ClaimVested)
+	}
 
 	if contractMsg.AddSchedule != nil {
 		return m.addSchedule(ctx, contractAddr, contractMsg.AddSchedule)
@@ -231,33 +255,31 @@ func (m *CustomMessenger) dispatchDexMsg(ctx sdk.Context, contractAddr sdk.AccAd
 		dex.Withdrawal.Creator = contractAddr.String()
 		return handleDexMsg(ctx, dex.Withdrawal, m.DexMsgServer.Withdrawal)
 	case dex.PlaceLimitOrder != nil:
-		msg := dextypes.MsgPlaceLimitOrder{
-			Creator:  contractAddr.String(),
-			Receiver: dex.PlaceLimitOrder.Receiver,
-			TokenIn:  dex.PlaceLimitOrder.TokenIn,
-			TokenOut: dex.PlaceLimitOrder.TokenOut,
-			//nolint: staticcheck // TODO: remove in next release
-			TickIndexInToOut: dex.PlaceLimitOrder.TickIndexInToOut,
-			AmountIn:         dex.PlaceLimitOrder.AmountIn,
-			MaxAmountOut:     dex.PlaceLimitOrder.MaxAmountOut,
+		msg, err := placeLimitOrderMsg(contractAddr.String(), dex.PlaceLimitOrder)
+		if err != nil {
+			return nil, nil, err
 		}
-		orderTypeInt, ok := dextypes.LimitOrderType_value[dex.PlaceLimitOrder.OrderType]
-		if !ok {
-			return nil, nil, errors.Wrap(dextypes.ErrInvalidOrderType,
-				fmt.Sprintf(
-					"got \"%s\", expected one of %s",
-					dex.PlaceLimitOrder.OrderType,
-					strings.Join(maps.Keys(dextypes.LimitOrderType_value), ", ")),
-			)
+
+		return handleDexMsg(ctx, msg, m.DexMsgServer.PlaceLimitOrder)
+	case dex.CancelLimitOrder != nil:
+		dex.CancelLimitOrder.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.CancelLimitOrder, m.DexMsgServer.CancelLimitOrder)
+	case dex.BatchCancelLimitOrders != nil:
+		dex.BatchCancelLimitOrders.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.BatchCancelLimitOrders, m.DexMsgServer.BatchCancelLimitOrders)
+	case dex.ReplaceLimitOrder != nil:
+		msg := dextypes.MsgReplaceLimitOrder{
+			Creator:    contractAddr.String(),
+			TrancheKey: dex.ReplaceLimitOrder.TrancheKey,
+			AmountIn:   dex.ReplaceLimitOrder.AmountIn,
 		}
-		msg.OrderType = dextypes.LimitOrderType(orderTypeInt)
 
-		if dex.PlaceLimitOrder.ExpirationTime != nil {
-			t := time.Unix(int64(*(dex.PlaceLimitOrder.ExpirationTime)), 0) //nolint:gosec
+		if dex.ReplaceLimitOrder.ExpirationTime != nil {
+			t := time.Unix(int64(*(dex.ReplaceLimitOrder.ExpirationTime)), 0) //nolint:gosec
 			msg.ExpirationTime = &t
 		}
 
-		if limitPriceStr := dex.PlaceLimitOrder.LimitSellPrice; limitPriceStr != "" {
+		if limitPriceStr := dex.ReplaceLimitOrder.LimitSellPrice; limitPriceStr != "" {
 			limitPriceDec, err := dexutils.ParsePrecDecScientificNotation(limitPriceStr)
 			if err != nil {
 				return nil, nil, errors.Wrapf(err, "cannot parse string %s for limit price", limitPriceStr)
@@ -265,21 +287,133 @@ func (m *CustomMessenger) dispatchDexMsg(ctx sdk.Context, contractAddr sdk.AccAd
 			msg.LimitSellPrice = &limitPriceDec
 		}
 
-		return handleDexMsg(ctx, &msg, m.DexMsgServer.PlaceLimitOrder)
-	case dex.CancelLimitOrder != nil:
-		dex.CancelLimitOrder.Creator = contractAddr.String()
-		return handleDexMsg(ctx, dex.CancelLimitOrder, m.DexMsgServer.CancelLimitOrder)
+		return handleDexMsg(ctx, &msg, m.DexMsgServer.ReplaceLimitOrder)
 	case dex.WithdrawFilledLimitOrder != nil:
 		dex.WithdrawFilledLimitOrder.Creator = contractAddr.String()
 		return handleDexMsg(ctx, dex.WithdrawFilledLimitOrder, m.DexMsgServer.WithdrawFilledLimitOrder)
 	case dex.MultiHopSwap != nil:
 		dex.MultiHopSwap.Creator = contractAddr.String()
 		return handleDexMsg(ctx, dex.MultiHopSwap, m.DexMsgServer.MultiHopSwap)
+	case dex.SubscribeHooks != nil:
+		dex.SubscribeHooks.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.SubscribeHooks, m.DexMsgServer.SubscribeHooks)
+	case dex.UnsubscribeHooks != nil:
+		dex.UnsubscribeHooks.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.UnsubscribeHooks, m.DexMsgServer.UnsubscribeHooks)
+	case dex.DepositRange != nil:
+		shape, err := parseDistributionShape(dex.DepositRange.DistributionShape)
+		if err != nil {
+			return nil, nil, err
+		}
+		msg := dextypes.MsgDepositRange{
+			Creator:            contractAddr.String(),
+			Receiver:           dex.DepositRange.Receiver,
+			TokenA:             dex.DepositRange.TokenA,
+			TokenB:             dex.DepositRange.TokenB,
+			AmountA:            dex.DepositRange.AmountA,
+			AmountB:            dex.DepositRange.AmountB,
+			LowerTickIndexAToB: dex.DepositRange.LowerTickIndexAToB,
+			UpperTickIndexAToB: dex.DepositRange.UpperTickIndexAToB,
+			TickSpacing:        dex.DepositRange.TickSpacing,
+			Fee:                dex.DepositRange.Fee,
+			DistributionShape:  shape,
+			Options:            dex.DepositRange.Options,
+		}
+		return handleDexMsg(ctx, &msg, m.DexMsgServer.DepositRange)
+	case dex.WithdrawRangePosition != nil:
+		dex.WithdrawRangePosition.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.WithdrawRangePosition, m.DexMsgServer.WithdrawRangePosition)
+	case dex.RebalanceRangePosition != nil:
+		shape, err := parseDistributionShape(dex.RebalanceRangePosition.DistributionShape)
+		if err != nil {
+			return nil, nil, err
+		}
+		msg := dextypes.MsgRebalanceRangePosition{
+			Creator:           contractAddr.String(),
+			PositionId:        dex.RebalanceRangePosition.PositionID,
+			LowerTickIndex:    dex.RebalanceRangePosition.LowerTickIndex,
+			UpperTickIndex:    dex.RebalanceRangePosition.UpperTickIndex,
+			TickSpacing:       dex.RebalanceRangePosition.TickSpacing,
+			DistributionShape: shape,
+			Options:           dex.RebalanceRangePosition.Options,
+		}
+		return handleDexMsg(ctx, &msg, m.DexMsgServer.RebalanceRangePosition)
+	case dex.FlashSwap != nil:
+		dex.FlashSwap.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.FlashSwap, m.DexMsgServer.FlashSwap)
+	case dex.PlaceTWAPOrder != nil:
+		dex.PlaceTWAPOrder.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.PlaceTWAPOrder, m.DexMsgServer.PlaceTWAPOrder)
+	case dex.WithdrawTWAPOrder != nil:
+		dex.WithdrawTWAPOrder.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.WithdrawTWAPOrder, m.DexMsgServer.WithdrawTWAPOrder)
+	case dex.PurgeExpiredOrders != nil:
+		dex.PurgeExpiredOrders.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.PurgeExpiredOrders, m.DexMsgServer.PurgeExpiredOrders)
 	}
 
 	return nil, nil, sdkerrors.ErrUnknownRequest
 }
 
+// placeLimitOrderMsg converts the binding of MsgPlaceLimitOrder into dextypes.MsgPlaceLimitOrder
+func placeLimitOrderMsg(creator string, placeLimitOrder *bindings.MsgPlaceLimitOrder) (*dextypes.MsgPlaceLimitOrder, error) {
+	msg := dextypes.MsgPlaceLimitOrder{
+		Creator:  creator,
+		Receiver: placeLimitOrder.Receiver,
+		TokenIn:  placeLimitOrder.TokenIn,
+		TokenOut: placeLimitOrder.TokenOut,
+		//nolint: staticcheck // TODO: remove in next release
+		TickIndexInToOut: placeLimitOrder.TickIndexInToOut,
+		AmountIn:         placeLimitOrder.AmountIn,
+		MaxAmountOut:     placeLimitOrder.MaxAmountOut,
+		AutoSettle:       placeLimitOrder.AutoSettle,
+	}
+	orderTypeInt, ok := dextypes.LimitOrderType_value[placeLimitOrder.OrderType]
+	if !ok {
+		return nil, errors.Wrap(dextypes.ErrInvalidOrderType,
+			fmt.Sprintf(
+				"got \"%s\", expected one of %s",
+				placeLimitOrder.OrderType,
+				strings.Join(maps.Keys(dextypes.LimitOrderType_value), ", ")),
+		)
+	}
+	msg.OrderType = dextypes.LimitOrderType(orderTypeInt)
+
+	if placeLimitOrder.ExpirationTime != nil {
+		t := time.Unix(int64(*(placeLimitOrder.ExpirationTime)), 0) //nolint:gosec
+		msg.ExpirationTime = &t
+	}
+
+	if limitPriceStr := placeLimitOrder.LimitSellPrice; limitPriceStr != "" {
+		limitPriceDec, err := dexutils.ParsePrecDecScientificNotation(limitPriceStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot parse string %s for limit price", limitPriceStr)
+		}
+		msg.LimitSellPrice = &limitPriceDec
+	}
+
+	return &msg, nil
+}
+
+// parseDistributionShape converts a shape name to a DistributionShape; an empty name means UNIFORM
+func parseDistributionShape(name string) (dextypes.DistributionShape, error) {
+	if name == "" {
+		return dextypes.DistributionShape_UNIFORM, nil
+	}
+
+	shape, ok := dextypes.DistributionShape_value[name]
+	if !ok {
+		return 0, errors.Wrap(dextypes.ErrInvalidRangePosition,
+			fmt.Sprintf(
+				"got \"%s\" distribution shape, expected one of %s",
+				name,
+				strings.Join(maps.Keys(dextypes.DistributionShape_value), ", ")),
+		)
+	}
+
+	return dextypes.DistributionShape(shape), nil
+}
+
 func (m *CustomMessenger) ibcTransfer(ctx sdk.Context, contractAddr sdk.AccAddress, ibcTransferMsg transferwrappertypes.MsgTransfer) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
 	ibcTransferMsg.Sender = contractAddr.String()
 
@@ -674,6 +808,105 @@ func PerformSetDenomMetadata(f *tokenfactorykeeper.Keeper, ctx sdk.Context, cont
 	return nil
 }
 
+// setMintLimits sets the mint limits of a specified denom.
+func (m *CustomMessenger) setMintLimits(ctx sdk.Context, contractAddr sdk.AccAddress, setMintLimits *bindings.SetMintLimits) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformSetMintLimits(m.TokenFactory, ctx, contractAddr, setMintLimits)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "perform set mint limits")
+	}
+	return nil, nil, nil, nil
+}
+
+// PerformSetMintLimits is used with setMintLimits to set the mint limits of a tokenfactory denom; validates the msgSetMintLimits.
+func PerformSetMintLimits(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setMintLimits *bindings.SetMintLimits) error {
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+
+	msgSetMintLimits := tokenfactorytypes.NewMsgSetMintLimits(contractAddr.String(), setMintLimits.Denom, tokenfactorytypes.MintLimits{
+		MaxSupply:     setMintLimits.MaxSupply,
+		MintRateLimit: setMintLimits.MintRateLimit,
+		MintWindow:    setMintLimits.MintWindow,
+	})
+
+	_, err := msgServer.SetMintLimits(ctx, msgSetMintLimits)
+	if err != nil {
+		return errors.Wrap(err, "setting mint limits")
+	}
+	return nil
+}
+
+// mintVested mints tokens of a specified denom into a vesting schedule.
+func (m *CustomMessenger) mintVested(ctx sdk.Context, contractAddr sdk.AccAddress, mintVested *bindings.MintVested) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	response, err := PerformMintVested(m.TokenFactory, ctx, contractAddr, mintVested)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "perform mint vested")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal MintVestedResponse response to JSON",
+			"from_address", contractAddr.String(),
+			"msg", response,
+			"error", err,
+		)
+		return nil, nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	return nil, [][]byte{data}, nil, nil
+}
+
+// PerformMintVested is used with mintVested to mint tokens into a vesting schedule; validates the msgMintVested.
+func PerformMintVested(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, mintVested *bindings.MintVested) (*bindings.MintVestedResponse, error) {
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+
+	msgMintVested := tokenfactorytypes.NewMsgMintVested(
+		contractAddr.String(),
+		sdk.Coin{Denom: mintVested.Denom, Amount: mintVested.Amount},
+		mintVested.Recipient,
+		mintVested.StartTime,
+		mintVested.EndTime,
+		mintVested.Periods,
+	)
+
+	res, err := msgServer.MintVested(ctx, msgMintVested)
+	if err != nil {
+		return nil, errors.Wrap(err, "minting vested coins from message")
+	}
+	return &bindings.MintVestedResponse{ScheduleId: res.ScheduleId}, nil
+}
+
+// claimVested claims the vested tokens of a vesting schedule.
+func (m *CustomMessenger) claimVested(ctx sdk.Context, contractAddr sdk.AccAddress, claimVested *bindings.ClaimVested) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	response, err := PerformClaimVested(m.TokenFactory, ctx, contractAddr, claimVested)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "perform claim vested")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal ClaimVestedResponse response to JSON",
+			"from_address", contractAddr.String(),
+			"msg", response,
+			"error", err,
+		)
+		return nil, nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	return nil, [][]byte{data}, nil, nil
+}
+
+// PerformClaimVested is used with claimVested to claim the vested tokens of a vesting schedule; validates the msgClaimVested.
+func PerformClaimVested(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, claimVested *bindings.ClaimVested) (*bindings.ClaimVestedResponse, error) {
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+
+	msgClaimVested := tokenfactorytypes.NewMsgClaimVested(contractAddr.String(), claimVested.ScheduleId)
+
+	res, err := msgServer.ClaimVested(ctx, msgClaimVested)
+	if err != nil {
+		return nil, errors.Wrap(err, "claiming vested coins from message")
+	}
+	return &bindings.ClaimVestedResponse{Amount: res.Amount}, nil
+}
+
 // mintTokens mints tokens of a specified denom to an address.
 func (m *CustomMessenger) mintTokens(ctx sdk.Context, contractAddr sdk.AccAddress, mint *bindings.MintTokens) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
 	err := PerformMint(m.TokenFactory, m.Bank, ctx, contractAddr, mint)
@@ -692,6 +925,15 @@ func (m *CustomMessenger) setBeforeSendHook(ctx sdk.Context, contractAddr sdk.Ac
 	return nil, nil, nil, nil
 }
 
+// setBeforeSendHooks sets the before send hooks for a specified denom.
+func (m *CustomMessenger) setBeforeSendHooks(ctx sdk.Context, contractAddr sdk.AccAddress, set *bindings.SetBeforeSendHooks) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformSetBeforeSendHooks(m.TokenFactory, ctx, contractAddr, set)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to perform set before send hooks")
+	}
+	return nil, nil, nil, nil
+}
+
 // PerformMint used with mintTokens to validate the mint message and mint through token factory.
 func PerformMint(f *tokenfactorykeeper.Keeper, _ *bankkeeper.BaseKeeper, ctx sdk.Context, contractAddr sdk.AccAddress, mint *bindings.MintTokens) error {
 	rcpt, err := parseAddress(mint.MintToAddress)
@@ -725,6 +967,19 @@ func PerformSetBeforeSendHook(f *tokenfactorykeeper.Keeper, ctx sdk.Context, con
 	return nil
 }
 
+func PerformSetBeforeSendHooks(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, set *bindings.SetBeforeSendHooks) error {
+	sdkMsg := tokenfactorytypes.NewMsgSetBeforeSendHooks(contractAddr.String(), set.Denom, set.Hooks)
+
+	// SetBeforeSendHooks through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetBeforeSendHooks(ctx, sdkMsg)
+	if err != nil {
+		return errors.Wrap(err, "set before send hooks from message")
+	}
+
+	return nil
+}
+
 // changeAdmin changes the admin.
 func (m *CustomMessenger) changeAdmin(ctx sdk.Context, contractAddr sdk.AccAddress, changeAdmin *bindings.ChangeAdmin) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
 	err := ChangeAdmin(m.TokenFactory, ctx, contractAddr, changeAdmin)
@@ -735,23 +990,75 @@ func (m *CustomMessenger) changeAdmin(ctx sdk.Context, contractAddr sdk.AccAddre
 	return nil, nil, nil, nil
 }
 
-// ChangeAdmin is used with changeAdmin to validate changeAdmin messages and to dispatch.
+// ChangeAdmin is used with changeAdmin to validate changeAdmin messages and to dispatch. The admin is not changed
+// directly, the transfer is proposed without timelock and the new admin has to accept it.
 func ChangeAdmin(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, changeAdmin *bindings.ChangeAdmin) error {
-	newAdminAddr, err := parseAddress(changeAdmin.NewAdminAddress)
+	return PerformProposeAdmin(f, ctx, contractAddr, &bindings.ProposeAdmin{
+		Denom:           changeAdmin.Denom,
+		NewAdminAddress: changeAdmin.NewAdminAddress,
+	})
+}
+
+// proposeAdmin proposes an admin transfer.
+func (m *CustomMessenger) proposeAdmin(ctx sdk.Context, contractAddr sdk.AccAddress, proposeAdmin *bindings.ProposeAdmin) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformProposeAdmin(m.TokenFactory, ctx, contractAddr, proposeAdmin)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to propose admin")
+	}
+
+	return nil, nil, nil, nil
+}
+
+// PerformProposeAdmin is used with proposeAdmin to validate proposeAdmin messages and to dispatch.
+func PerformProposeAdmin(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, proposeAdmin *bindings.ProposeAdmin) error {
+	newAdminAddr, err := parseAddress(proposeAdmin.NewAdminAddress)
 	if err != nil {
 		return err
 	}
 
-	changeAdminMsg := tokenfactorytypes.NewMsgChangeAdmin(contractAddr.String(), changeAdmin.Denom, newAdminAddr.String())
+	proposeAdminMsg := tokenfactorytypes.NewMsgProposeAdmin(contractAddr.String(), proposeAdmin.Denom, newAdminAddr.String(), proposeAdmin.Timelock, proposeAdmin.Expiry)
 
 	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
-	_, err = msgServer.ChangeAdmin(ctx, changeAdminMsg)
+	_, err = msgServer.ProposeAdmin(ctx, proposeAdminMsg)
 	if err != nil {
-		return errors.Wrap(err, "failed changing admin from message")
+		return errors.Wrap(err, "failed proposing admin from message")
 	}
 	return nil
 }
 
+// acceptAdmin accepts an admin transfer proposed to the contract.
+func (m *CustomMessenger) acceptAdmin(ctx sdk.Context, contractAddr sdk.AccAddress, acceptAdmin *bindings.AcceptAdmin) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*m.TokenFactory)
+	_, err := msgServer.AcceptAdmin(ctx, tokenfactorytypes.NewMsgAcceptAdmin(contractAddr.String(), acceptAdmin.Denom))
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to accept admin")
+	}
+
+	return nil, nil, nil, nil
+}
+
+// cancelAdminTransfer cancels an admin transfer proposed by the contract.
+func (m *CustomMessenger) cancelAdminTransfer(ctx sdk.Context, contractAddr sdk.AccAddress, cancelAdminTransfer *bindings.CancelAdminTransfer) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*m.TokenFactory)
+	_, err := msgServer.CancelAdminTransfer(ctx, tokenfactorytypes.NewMsgCancelAdminTransfer(contractAddr.String(), cancelAdminTransfer.Denom))
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to cancel admin transfer")
+	}
+
+	return nil, nil, nil, nil
+}
+
+// renounceAdmin leaves a denom of the contract without admin.
+func (m *CustomMessenger) renounceAdmin(ctx sdk.Context, contractAddr sdk.AccAddress, renounceAdmin *bindings.RenounceAdmin) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*m.TokenFactory)
+	_, err := msgServer.RenounceAdmin(ctx, tokenfactorytypes.NewMsgRenounceAdmin(contractAddr.String(), renounceAdmin.Denom))
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to renounce admin")
+	}
+
+	return nil, nil, nil, nil
+}
+
 // burnTokens burns tokens.
 func (m *CustomMessenger) burnTokens(ctx sdk.Context, contractAddr sdk.AccAddress, burn *bindings.BurnTokens) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
 	err := PerformBurn(m.TokenFactory, ctx, contractAddr, burn)
@@ -13,6 +13,7 @@ import (
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
@@ -25,6 +26,7 @@ import (
 	"github.com/neutron-org/neutron/v5/wasmbinding/bindings"
 	"github.com/neutron-org/neutron/v5/x/interchainqueries/types"
 	icatypes "github.com/neutron-org/neutron/v5/x/interchaintxs/types"
+	tokenfactorytypes "github.com/neutron-org/neutron/v5/x/tokenfactory/types"
 )
 
 func (qp *QueryPlugin) GetInterchainQueryResult(ctx sdk.Context, queryID uint64) (*bindings.QueryRegisteredQueryResultResponse, error) {
@@ -108,14 +110,67 @@ func (qp QueryPlugin) GetDenomAdmin(ctx sdk.Context, denom string) (*bindings.De
 		return nil, errors.Wrapf(err, "failed to get admin for denom: %s", denom)
 	}
 
-	return &bindings.DenomAdminResponse{Admin: metadata.Admin}, nil
+	return &bindings.DenomAdminResponse{
+		Admin:                          metadata.Admin,
+		MintLimits:                     metadata.MintLimits,
+		PendingMintLimits:              metadata.PendingMintLimits,
+		PendingMintLimitsEffectiveTime: metadata.PendingMintLimitsEffectiveTime,
+		Roles:                          metadata.Roles,
+		MinterAllowances:               metadata.MinterAllowances,
+		ForceTransferRenounced:         metadata.ForceTransferRenounced,
+		PendingAdminTransfer:           metadata.PendingAdminTransfer,
+	}, nil
 }
 
 // GetBeforeSendHook is a query to get denom before send hook.
 func (qp QueryPlugin) GetBeforeSendHook(ctx sdk.Context, denom string) (*bindings.BeforeSendHookResponse, error) {
-	contractAddr := qp.tokenFactoryKeeper.GetBeforeSendHook(ctx, denom)
+	hooks := qp.tokenFactoryKeeper.GetBeforeSendHooks(ctx, denom)
 
-	return &bindings.BeforeSendHookResponse{ContractAddr: contractAddr}, nil
+	res := &bindings.BeforeSendHookResponse{Hooks: hooks}
+	if len(hooks) > 0 {
+		res.ContractAddr = hooks[0].ContractAddr
+	}
+
+	return res, nil
+}
+
+// GetVestingSchedule is a query to get a tokenfactory vesting schedule.
+func (qp QueryPlugin) GetVestingSchedule(ctx sdk.Context, id uint64) (*bindings.VestingScheduleResponse, error) {
+	res, err := qp.tokenFactoryKeeper.VestingSchedule(ctx, &tokenfactorytypes.QueryVestingScheduleRequest{Id: id})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get vesting schedule: %d", id)
+	}
+
+	return &bindings.VestingScheduleResponse{VestingSchedule: res.VestingSchedule}, nil
+}
+
+// GetVestingSchedules is a query to get the tokenfactory vesting schedules of a recipient.
+func (qp QueryPlugin) GetVestingSchedules(ctx sdk.Context, recipient string, pagination *sdkquery.PageRequest) (*bindings.VestingSchedulesResponse, error) {
+	res, err := qp.tokenFactoryKeeper.VestingSchedules(ctx, &tokenfactorytypes.QueryVestingSchedulesRequest{
+		Recipient:  recipient,
+		Pagination: pagination,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get vesting schedules for recipient: %s", recipient)
+	}
+
+	return &bindings.VestingSchedulesResponse{
+		VestingSchedules: res.VestingSchedules,
+		Pagination:       res.Pagination,
+	}, nil
+}
+
+// GetDenomMetadata is a query to get the bank metadata and registry entry of a denom.
+func (qp QueryPlugin) GetDenomMetadata(ctx sdk.Context, denom string) *bindings.DenomMetadataResponse {
+	res := &bindings.DenomMetadataResponse{}
+	if metadata, found := qp.tokenFactoryKeeper.GetDenomMetadata(ctx, denom); found {
+		res.Metadata = &metadata
+	}
+	if entry, found := qp.tokenFactoryKeeper.GetDenomRegistryEntry(ctx, denom); found {
+		res.RegistryEntry = &entry
+	}
+
+	return res
 }
 
 func (qp *QueryPlugin) GetTotalBurnedNeutronsAmount(ctx sdk.Context, _ *bindings.QueryTotalBurnedNeutronsAmountRequest) (*bindings.QueryTotalBurnedNeutronsAmountResponse, error) {
@@ -169,6 +224,35 @@ func (qp *QueryPlugin) DexQuery(ctx sdk.Context, query bindings.DexQuery) (data
 			q.ExpirationTime = &t
 		}
 		data, err = dexQuery(ctx, &q, qp.dexKeeper.EstimatePlaceLimitOrder)
+	case query.SimulateDeposit != nil:
+		data, err = dexQuery(ctx, query.SimulateDeposit, qp.dexKeeper.SimulateDeposit)
+	case query.SimulateWithdrawal != nil:
+		data, err = dexQuery(ctx, query.SimulateWithdrawal, qp.dexKeeper.SimulateWithdrawal)
+	case query.SimulatePlaceLimitOrder != nil:
+		if query.SimulatePlaceLimitOrder.Msg == nil {
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "msg is required for simulate_place_limit_order")
+		}
+		msg, msgErr := placeLimitOrderMsg(query.SimulatePlaceLimitOrder.Msg.Creator, query.SimulatePlaceLimitOrder.Msg)
+		if msgErr != nil {
+			return nil, msgErr
+		}
+		data, err = dexQuery(ctx, &dextypes.QuerySimulatePlaceLimitOrderRequest{Msg: msg}, qp.dexKeeper.SimulatePlaceLimitOrder)
+	case query.SimulateCancelLimitOrder != nil:
+		data, err = dexQuery(ctx, query.SimulateCancelLimitOrder, qp.dexKeeper.SimulateCancelLimitOrder)
+	case query.SimulateWithdrawFilledLimitOrder != nil:
+		data, err = dexQuery(ctx, query.SimulateWithdrawFilledLimitOrder, qp.dexKeeper.SimulateWithdrawFilledLimitOrder)
+	case query.SimulateMultiHopSwap != nil:
+		data, err = dexQuery(ctx, query.SimulateMultiHopSwap, qp.dexKeeper.SimulateMultiHopSwap)
+	case query.CandleAll != nil:
+		data, err = dexQuery(ctx, query.CandleAll, qp.dexKeeper.CandleAll)
+	case query.TWAPOrder != nil:
+		data, err = dexQuery(ctx, query.TWAPOrder, qp.dexKeeper.TWAPOrder)
+	case query.TWAPOrderFills != nil:
+		data, err = dexQuery(ctx, query.TWAPOrderFills, qp.dexKeeper.TWAPOrderFills)
+	case query.UserTWAPOrders != nil:
+		data, err = dexQuery(ctx, query.UserTWAPOrders, qp.dexKeeper.UserTWAPOrders)
+	case query.UserPositionValue != nil:
+		data, err = dexQuery(ctx, query.UserPositionValue, qp.dexKeeper.UserPositionValue)
 	case query.InactiveLimitOrderTranche != nil:
 		data, err = dexQuery(ctx, query.InactiveLimitOrderTranche, qp.dexKeeper.InactiveLimitOrderTranche)
 	case query.InactiveLimitOrderTrancheAll != nil:
@@ -85,6 +85,7 @@ func (suite *CustomMessengerTestSuite) SetupTest() {
 		0,
 		FeeCollectorAddress,
 		tokenfactorytypes.DefaultWhitelistedHooks,
+		tokenfactorytypes.DefaultMintLimitsTimelock,
 	))
 	suite.Require().NoError(err)
 
@@ -468,6 +469,40 @@ func (suite *CustomMessengerTestSuite) TestForceTransferMsg() {
 	require.Equal(suite.T(), sunDenom, coinReceiver.Denom)
 }
 
+func (suite *CustomMessengerTestSuite) TestChangeAdminMsg() {
+	newAdmin := keeper.RandomAccountAddress(suite.T())
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	coinsAmnt := sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(int64(10_000_000))))
+	err := suite.neutron.BankKeeper.SendCoins(suite.ctx, senderAddress, suite.contractAddress, coinsAmnt)
+	suite.NoError(err)
+
+	fullMsg := bindings.NeutronMsg{
+		CreateDenom: &bindings.CreateDenom{
+			Subdenom: "SUN",
+		},
+	}
+	_, err = suite.executeNeutronMsg(suite.contractAddress, fullMsg)
+	suite.NoError(err)
+
+	sunDenom := fmt.Sprintf("factory/%s/%s", suite.contractAddress.String(), fullMsg.CreateDenom.Subdenom)
+
+	fullMsg = bindings.NeutronMsg{
+		ChangeAdmin: &bindings.ChangeAdmin{
+			Denom:           sunDenom,
+			NewAdminAddress: newAdmin.String(),
+		},
+	}
+	_, err = suite.executeNeutronMsg(suite.contractAddress, fullMsg)
+	suite.NoError(err)
+
+	// the admin only proposes the transfer, which the new admin has to accept
+	metadata, err := suite.neutron.TokenFactoryKeeper.GetAuthorityMetadata(suite.ctx, sunDenom)
+	suite.NoError(err)
+	suite.Equal(suite.contractAddress.String(), metadata.Admin)
+	suite.Equal(newAdmin.String(), metadata.PendingAdminTransfer.NewAdmin)
+}
+
 func (suite *CustomMessengerTestSuite) TestUpdateInterchainQuery() {
 	// reuse register interchain query test to get query registered
 	suite.TestRegisterInterchainQuery()
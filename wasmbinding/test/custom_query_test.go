@@ -4,11 +4,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/neutron-org/neutron/v5/app/params"
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
 	feerefundertypes "github.com/neutron-org/neutron/v5/x/feerefunder/types"
 	tokenfactorytypes "github.com/neutron-org/neutron/v5/x/tokenfactory/types"
 
@@ -21,6 +26,7 @@ import (
 
 	"github.com/neutron-org/neutron/v5/app"
 	"github.com/neutron-org/neutron/v5/testutil"
+	"github.com/neutron-org/neutron/v5/wasmbinding"
 	"github.com/neutron-org/neutron/v5/wasmbinding/bindings"
 	icqtypes "github.com/neutron-org/neutron/v5/x/interchainqueries/types"
 	ictxtypes "github.com/neutron-org/neutron/v5/x/interchaintxs/types"
@@ -256,6 +262,7 @@ func (suite *CustomQuerierTestSuite) TestDenomAdmin() {
 		0,
 		FeeCollectorAddress,
 		tokenfactorytypes.DefaultWhitelistedHooks,
+		tokenfactorytypes.DefaultMintLimitsTimelock,
 	))
 	suite.Require().NoError(err)
 
@@ -284,6 +291,229 @@ func (suite *CustomQuerierTestSuite) TestDenomAdmin() {
 	suite.Require().Equal(contractAddress.String(), resp.Admin)
 }
 
+func (suite *CustomQuerierTestSuite) TestDenomMetadata() {
+	var (
+		neutron = suite.GetNeutronZoneApp(suite.ChainA)
+		ctx     = suite.ChainA.GetContext()
+		denom   = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	)
+
+	query := bindings.NeutronQuery{
+		DenomMetadata: &bindings.DenomMetadata{
+			Denom: denom,
+		},
+	}
+	resp := bindings.DenomMetadataResponse{}
+	err := suite.queryDexCustom(ctx, query, &resp)
+	suite.Require().NoError(err)
+	suite.Require().Nil(resp.Metadata)
+	suite.Require().Nil(resp.RegistryEntry)
+
+	entry := tokenfactorytypes.DenomRegistryEntry{
+		Denom:    denom,
+		Display:  "atom",
+		Decimals: 6,
+		Symbol:   "ATOM",
+		Verified: true,
+	}
+	_, err = neutron.TokenFactoryKeeper.SetDenomRegistryEntry(ctx, &tokenfactorytypes.MsgSetDenomRegistryEntry{
+		Authority: neutron.TokenFactoryKeeper.GetAuthority(),
+		Entry:     entry,
+	})
+	suite.Require().NoError(err)
+
+	resp = bindings.DenomMetadataResponse{}
+	err = suite.queryDexCustom(ctx, query, &resp)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&entry, resp.RegistryEntry)
+}
+
+func (suite *CustomQuerierTestSuite) TestDexSimulateDeposit() {
+	var (
+		ctx   = suite.ChainA.GetContext()
+		owner = keeper.RandomAccountAddress(suite.T())
+	)
+
+	query := bindings.NeutronQuery{
+		Dex: &bindings.DexQuery{
+			SimulateDeposit: &dextypes.QuerySimulateDepositRequest{
+				Msg: &dextypes.MsgDeposit{
+					Creator:         owner.String(),
+					Receiver:        owner.String(),
+					TokenA:          "TokenA",
+					TokenB:          "TokenB",
+					AmountsA:        []math.Int{math.NewInt(10)},
+					AmountsB:        []math.Int{math.ZeroInt()},
+					TickIndexesAToB: []int64{0},
+					Fees:            []uint64{1},
+					Options:         []*dextypes.DepositOptions{{}},
+				},
+			},
+		},
+	}
+	resp := dextypes.QuerySimulateDepositResponse{}
+	err := suite.queryDexCustom(ctx, query, &resp)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]math.Int{math.NewInt(10)}, resp.Resp.Reserve0Deposited)
+	suite.Require().Equal([]math.Int{math.ZeroInt()}, resp.Resp.Reserve1Deposited)
+	suite.Require().Equal(sdk.NewInt64Coin(dextypes.NewPoolDenom(0), 10), resp.Resp.SharesIssued[0])
+}
+
+func (suite *CustomQuerierTestSuite) TestDexSimulatePlaceLimitOrder() {
+	var (
+		ctx   = suite.ChainA.GetContext().WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+		owner = keeper.RandomAccountAddress(suite.T())
+	)
+
+	expirationTime := uint64(ctx.BlockTime().Add(time.Hour).Unix()) //nolint:gosec
+	query := bindings.NeutronQuery{
+		Dex: &bindings.DexQuery{
+			SimulatePlaceLimitOrder: &bindings.QuerySimulatePlaceLimitOrderRequest{
+				Msg: &bindings.MsgPlaceLimitOrder{
+					Creator:        owner.String(),
+					Receiver:       owner.String(),
+					TokenIn:        "TokenA",
+					TokenOut:       "TokenB",
+					AmountIn:       math.NewInt(10),
+					OrderType:      "GOOD_TIL_TIME",
+					ExpirationTime: &expirationTime,
+					LimitSellPrice: "1.5",
+				},
+			},
+		},
+	}
+	resp := dextypes.QuerySimulatePlaceLimitOrderResponse{}
+	err := suite.queryDexCustom(ctx, query, &resp)
+	suite.Require().NoError(err)
+
+	suite.Require().NotEmpty(resp.Resp.TrancheKey)
+	suite.Require().Equal(sdk.NewInt64Coin("TokenA", 10), resp.Resp.CoinIn)
+	suite.Require().True(resp.Resp.TakerCoinOut.IsZero())
+
+	// an unknown order type is rejected
+	query.Dex.SimulatePlaceLimitOrder.Msg.OrderType = "UNKNOWN"
+	err = suite.queryDexCustom(ctx, query, &resp)
+	suite.Require().ErrorIs(err, dextypes.ErrInvalidOrderType)
+}
+
+func (suite *CustomQuerierTestSuite) TestDexSimulateLimitOrderLifecycle() {
+	var (
+		neutron   = suite.GetNeutronZoneApp(suite.ChainA)
+		ctx       = suite.ChainA.GetContext().WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+		owner     = keeper.RandomAccountAddress(suite.T())
+		maker     = keeper.RandomAccountAddress(suite.T())
+		msgServer = dexkeeper.NewMsgServerImpl(neutron.DexKeeper)
+	)
+
+	// maker places a limit order selling 100 TokenA
+	suite.FundAcc(maker, sdk.NewCoins(sdk.NewInt64Coin("TokenA", 100)))
+	placeResp, err := msgServer.PlaceLimitOrder(ctx, &dextypes.MsgPlaceLimitOrder{
+		Creator:          maker.String(),
+		Receiver:         maker.String(),
+		TokenIn:          "TokenA",
+		TokenOut:         "TokenB",
+		TickIndexInToOut: 0,
+		AmountIn:         math.NewInt(100),
+		OrderType:        dextypes.LimitOrderType_GOOD_TIL_CANCELLED,
+	})
+	suite.Require().NoError(err)
+
+	// a swap through the limit order is simulated
+	swapQuery := bindings.NeutronQuery{
+		Dex: &bindings.DexQuery{
+			SimulateMultiHopSwap: &dextypes.QuerySimulateMultiHopSwapRequest{
+				Msg: &dextypes.MsgMultiHopSwap{
+					Creator:        owner.String(),
+					Receiver:       owner.String(),
+					Routes:         []*dextypes.MultiHopRoute{{Hops: []string{"TokenB", "TokenA"}}},
+					AmountIn:       math.NewInt(50),
+					ExitLimitPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+				},
+			},
+		},
+	}
+	swapResp := dextypes.QuerySimulateMultiHopSwapResponse{}
+	err = suite.queryDexCustom(ctx, swapQuery, &swapResp)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("TokenA", 50), swapResp.Resp.CoinOut)
+
+	// the simulation does not change the state, so there is nothing to withdraw from the order
+	withdrawQuery := bindings.NeutronQuery{
+		Dex: &bindings.DexQuery{
+			SimulateWithdrawFilledLimitOrder: &dextypes.QuerySimulateWithdrawFilledLimitOrderRequest{
+				Msg: &dextypes.MsgWithdrawFilledLimitOrder{
+					Creator:    maker.String(),
+					TrancheKey: placeResp.TrancheKey,
+				},
+			},
+		},
+	}
+	withdrawResp := dextypes.QuerySimulateWithdrawFilledLimitOrderResponse{}
+	err = suite.queryDexCustom(ctx, withdrawQuery, &withdrawResp)
+	suite.Require().ErrorIs(err, dextypes.ErrWithdrawEmptyLimitOrder)
+
+	cancelQuery := bindings.NeutronQuery{
+		Dex: &bindings.DexQuery{
+			SimulateCancelLimitOrder: &dextypes.QuerySimulateCancelLimitOrderRequest{
+				Msg: &dextypes.MsgCancelLimitOrder{
+					Creator:    maker.String(),
+					TrancheKey: placeResp.TrancheKey,
+				},
+			},
+		},
+	}
+	cancelResp := dextypes.QuerySimulateCancelLimitOrderResponse{}
+	err = suite.queryDexCustom(ctx, cancelQuery, &cancelResp)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("TokenA", 100), cancelResp.Resp.MakerCoinOut)
+}
+
+func (suite *CustomQuerierTestSuite) TestDexSimulateWithdrawal() {
+	var (
+		neutron   = suite.GetNeutronZoneApp(suite.ChainA)
+		ctx       = suite.ChainA.GetContext().WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+		lp        = keeper.RandomAccountAddress(suite.T())
+		msgServer = dexkeeper.NewMsgServerImpl(neutron.DexKeeper)
+	)
+
+	suite.FundAcc(lp, sdk.NewCoins(sdk.NewInt64Coin("TokenA", 100)))
+	_, err := msgServer.Deposit(ctx, &dextypes.MsgDeposit{
+		Creator:         lp.String(),
+		Receiver:        lp.String(),
+		TokenA:          "TokenA",
+		TokenB:          "TokenB",
+		AmountsA:        []math.Int{math.NewInt(100)},
+		AmountsB:        []math.Int{math.ZeroInt()},
+		TickIndexesAToB: []int64{0},
+		Fees:            []uint64{1},
+		Options:         []*dextypes.DepositOptions{{}},
+	})
+	suite.Require().NoError(err)
+
+	query := bindings.NeutronQuery{
+		Dex: &bindings.DexQuery{
+			SimulateWithdrawal: &dextypes.QuerySimulateWithdrawalRequest{
+				Msg: &dextypes.MsgWithdrawal{
+					Creator:         lp.String(),
+					Receiver:        lp.String(),
+					TokenA:          "TokenA",
+					TokenB:          "TokenB",
+					SharesToRemove:  []math.Int{math.NewInt(40)},
+					TickIndexesAToB: []int64{0},
+					Fees:            []uint64{1},
+				},
+			},
+		},
+	}
+	resp := dextypes.QuerySimulateWithdrawalResponse{}
+	err = suite.queryDexCustom(ctx, query, &resp)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(math.NewInt(40), resp.Resp.Reserve0Withdrawn)
+	suite.Require().Equal(math.ZeroInt(), resp.Resp.Reserve1Withdrawn)
+}
+
 type ChainRequest struct {
 	Reflect wasmvmtypes.QueryRequest `json:"reflect"`
 }
@@ -292,6 +522,34 @@ type ChainResponse struct {
 	Data []byte `json:"data"`
 }
 
+func (suite *CustomQuerierTestSuite) TestDexCandleAll() {
+	var (
+		ctx    = suite.ChainA.GetContext()
+		pairID = &dextypes.PairID{Token0: "TokenA", Token1: "TokenB"}
+	)
+
+	dexKeeper := suite.GetNeutronZoneApp(suite.ChainA).DexKeeper
+	dexKeeper.RecordBlockTrades(ctx, []dextypes.SwapRecord{
+		{TradePairId: dextypes.NewTradePairIDFromTaker(pairID, "TokenA"), AmountIn: math.NewInt(10), AmountOut: math.NewInt(20)},
+	})
+	dexKeeper.UpdateCandles(ctx)
+
+	query := bindings.NeutronQuery{
+		Dex: &bindings.DexQuery{
+			CandleAll: &dextypes.QueryAllCandleRequest{PairId: "TokenA<>TokenB", Interval: 3600},
+		},
+	}
+	resp := dextypes.QueryAllCandleResponse{}
+	err := suite.queryDexCustom(ctx, query, &resp)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(resp.Candles, 1)
+	suite.Require().Equal(uint64(1), resp.Candles[0].TradeCount)
+	suite.Require().Equal(math.NewInt(10), resp.Candles[0].Volume0)
+	suite.Require().Equal(math.NewInt(20), resp.Candles[0].Volume1)
+	suite.Require().Equal(ctx.BlockTime().Unix()-ctx.BlockTime().Unix()%3600, resp.Candles[0].OpenTime)
+}
+
 func (suite *CustomQuerierTestSuite) queryCustom(ctx sdk.Context, contract sdk.AccAddress, request, response interface{}) error {
 	msgBz, err := json.Marshal(request)
 	suite.Require().NoError(err)
@@ -319,6 +577,33 @@ func (suite *CustomQuerierTestSuite) queryCustom(ctx sdk.Context, contract sdk.A
 	return json.Unmarshal(resp.Data, response)
 }
 
+// queryDexCustom passes the request through the custom querier of the wasm bindings as a contract query would. The
+// reflect contract in testdata can not be used here since its query type predates the dex and denom metadata queries.
+func (suite *CustomQuerierTestSuite) queryDexCustom(ctx sdk.Context, request, response interface{}) error {
+	neutron := suite.GetNeutronZoneApp(suite.ChainA)
+	queryPlugin := wasmbinding.NewQueryPlugin(
+		&neutron.InterchainTxsKeeper,
+		&neutron.InterchainQueriesKeeper,
+		neutron.FeeBurnerKeeper,
+		neutron.FeeKeeper,
+		neutron.TokenFactoryKeeper,
+		&neutron.ContractManagerKeeper,
+		&neutron.DexKeeper,
+		neutron.OracleKeeper,
+		neutron.MarketMapKeeper,
+	)
+
+	requestBz, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	data, err := wasmbinding.CustomQuerier(queryPlugin)(ctx, requestBz)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, response)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(CustomQuerierTestSuite))
 }
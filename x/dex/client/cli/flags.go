@@ -7,6 +7,10 @@ const (
 	FlagIncludePoolData = "include-pool-data"
 	FlagCalcWithdraw    = "calc-withdraw"
 	FlagPrice           = "price"
+	FlagPairID          = "pair-id"
+	FlagTokenIn         = "token-in"
+	FlagAmountIn        = "amount-in"
+	FlagExpirationTime  = "expiration-time"
 )
 
 func FlagSetMaxAmountOut() *flag.FlagSet {
@@ -21,6 +25,20 @@ func FlagSetPrice() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetBatchCancelByPair() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagPairID, "", "Cancel all limit orders for a pair (ie. tokenA<>tokenB)")
+	fs.String(FlagTokenIn, "", "Only cancel limit orders selling this token")
+	return fs
+}
+
+func FlagSetReplaceLimitOrder() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagAmountIn, "", "Amount for the new limit order")
+	fs.String(FlagExpirationTime, "", "Expiration time for the new limit order ('01/02/2006 15:04:05')")
+	return fs
+}
+
 func FlagSetIncludePoolData() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.Bool(FlagIncludePoolData, false, "Include pool data with response")
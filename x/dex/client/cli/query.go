@@ -40,6 +40,16 @@ func GetQueryCmd(_ string) *cobra.Command {
 
 	cmd.AddCommand(CmdListPoolMetadata())
 	cmd.AddCommand(CmdShowPoolMetadata())
+	cmd.AddCommand(CmdShowPairTradingStatus())
+	cmd.AddCommand(CmdShowPairFeeTiers())
+	cmd.AddCommand(CmdShowTraderFees())
+	cmd.AddCommand(CmdShowRangePosition())
+	cmd.AddCommand(CmdListUserRangePositions())
+	cmd.AddCommand(CmdShowTWAPOrder())
+	cmd.AddCommand(CmdShowTWAPOrderFills())
+	cmd.AddCommand(CmdListUserTWAPOrders())
+	cmd.AddCommand(CmdShowUserPositionValue())
+	cmd.AddCommand(CmdListCandles())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
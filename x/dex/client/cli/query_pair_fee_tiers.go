@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdShowPairFeeTiers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "show-pair-fee-tiers [pair-id]",
+		Short:   "shows the fee tiers and volatility fee of a pair",
+		Example: "show-pair-fee-tiers tokenA<>tokenB",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPairFeeTiersRequest{
+				PairId: args[0],
+			}
+
+			res, err := queryClient.PairFeeTiers(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
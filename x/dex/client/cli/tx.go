@@ -28,7 +28,16 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdPlaceLimitOrder())
 	cmd.AddCommand(CmdWithdrawFilledLimitOrder())
 	cmd.AddCommand(CmdCancelLimitOrder())
+	cmd.AddCommand(CmdBatchCancelLimitOrders())
+	cmd.AddCommand(CmdReplaceLimitOrder())
 	cmd.AddCommand(CmdMultiHopSwap())
+	cmd.AddCommand(CmdDepositRange())
+	cmd.AddCommand(CmdWithdrawRangePosition())
+	cmd.AddCommand(CmdRebalanceRangePosition())
+	cmd.AddCommand(CmdClaimMakerRebates())
+	cmd.AddCommand(CmdPlaceTWAPOrder())
+	cmd.AddCommand(CmdWithdrawTWAPOrder())
+	cmd.AddCommand(CmdPurgeExpiredOrders())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdBatchCancelLimitOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "batch-cancel-limit-orders ?[tranche-keys...] ?(--pair-id) ?(--token-in)",
+		Short:   "Broadcast message BatchCancelLimitOrders",
+		Example: "batch-cancel-limit-orders TRANCHEKEY123 TRANCHEKEY456 --from alice\nbatch-cancel-limit-orders --pair-id tokenA<>tokenB --token-in tokenA --from alice",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			pairID, err := cmd.Flags().GetString(FlagPairID)
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := cmd.Flags().GetString(FlagTokenIn)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBatchCancelLimitOrders{
+				Creator:     clientCtx.GetFromAddress().String(),
+				TrancheKeys: args,
+				PairId:      pairID,
+				TokenIn:     tokenIn,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetBatchCancelByPair())
+
+	return cmd
+}
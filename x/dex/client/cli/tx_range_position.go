@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdDepositRange() *cobra.Command {
+	cmd := &cobra.Command{
+		//nolint:lll
+		Use:     "deposit-range [receiver] [token-a] [token-b] [amount-a] [amount-b] [lower-tick-index] [upper-tick-index] [tick-spacing] [fee] [distribution-shape]",
+		Short:   "Broadcast message DepositRange",
+		Example: "deposit-range alice tokenA tokenB 1000 1000 [-100] 100 10 1 GAUSSIAN --from alice",
+		Args:    cobra.ExactArgs(10),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			amountA, ok := math.NewIntFromString(args[3])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for amount-a")
+			}
+
+			amountB, ok := math.NewIntFromString(args[4])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for amount-b")
+			}
+
+			lowerTickIndex, err := strconv.ParseInt(trimBrackets(args[5]), 10, 64)
+			if err != nil {
+				return err
+			}
+
+			upperTickIndex, err := strconv.ParseInt(trimBrackets(args[6]), 10, 64)
+			if err != nil {
+				return err
+			}
+
+			tickSpacing, err := strconv.ParseUint(args[7], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fee, err := strconv.ParseUint(args[8], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			shape, ok := types.DistributionShape_value[args[9]]
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidRangePosition, "invalid distribution shape %s", args[9])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositRange(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+				amountA,
+				amountB,
+				lowerTickIndex,
+				upperTickIndex,
+				tickSpacing,
+				fee,
+				types.DistributionShape(shape),
+				&types.DepositOptions{},
+			)
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWithdrawRangePosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-range-position [receiver] [position-id]",
+		Short:   "Broadcast message WithdrawRangePosition",
+		Example: "withdraw-range-position alice 0 --from alice",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			positionID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawRangePosition(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				positionID,
+			)
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRebalanceRangePosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rebalance-range-position [position-id] [lower-tick-index] [upper-tick-index] [tick-spacing] [distribution-shape]",
+		Short:   "Broadcast message RebalanceRangePosition",
+		Example: "rebalance-range-position 0 [-50] 150 10 UNIFORM --from alice",
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			positionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			lowerTickIndex, err := strconv.ParseInt(trimBrackets(args[1]), 10, 64)
+			if err != nil {
+				return err
+			}
+
+			upperTickIndex, err := strconv.ParseInt(trimBrackets(args[2]), 10, 64)
+			if err != nil {
+				return err
+			}
+
+			tickSpacing, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			shape, ok := types.DistributionShape_value[args[4]]
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidRangePosition, "invalid distribution shape %s", args[4])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRebalanceRangePosition(
+				clientCtx.GetFromAddress().String(),
+				positionID,
+				lowerTickIndex,
+				upperTickIndex,
+				tickSpacing,
+				types.DistributionShape(shape),
+				&types.DepositOptions{},
+			)
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// trimBrackets allows negative numbers to be passed as [-N] so they are not parsed as flags
+func trimBrackets(arg string) string {
+	if strings.HasPrefix(arg, "[") && strings.HasSuffix(arg, "]") {
+		arg = strings.TrimPrefix(arg, "[")
+		arg = strings.TrimSuffix(arg, "]")
+	}
+
+	return arg
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdReplaceLimitOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "replace-limit-order [tranche-key] ?(--amount-in) ?(--price) ?(--expiration-time)",
+		Short:   "Broadcast message ReplaceLimitOrder",
+		Example: "replace-limit-order TRANCHEKEY123 --price 1.05 --amount-in 50 --from alice",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			amountInArg, err := cmd.Flags().GetString(FlagAmountIn)
+			if err != nil {
+				return err
+			}
+
+			var amountInIntP *math.Int
+			if amountInArg != "" {
+				amountInInt, ok := math.NewIntFromString(amountInArg)
+				if !ok {
+					return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for amount-in")
+				}
+				amountInIntP = &amountInInt
+			}
+
+			priceArg, err := cmd.Flags().GetString(FlagPrice)
+			if err != nil {
+				return err
+			}
+
+			var priceDecP *math_utils.PrecDec
+			if priceArg != "" {
+				priceDec, err := math_utils.NewPrecDecFromStr(priceArg)
+				if err != nil {
+					return err
+				}
+				priceDecP = &priceDec
+			}
+
+			expirationTimeArg, err := cmd.Flags().GetString(FlagExpirationTime)
+			if err != nil {
+				return err
+			}
+
+			var goodTil *time.Time
+			if expirationTimeArg != "" {
+				const timeFormat = "01/02/2006 15:04:05"
+				tm, err := time.Parse(timeFormat, expirationTimeArg)
+				if err != nil {
+					return sdkerrors.Wrapf(types.ErrInvalidTimeString, "%s", err.Error())
+				}
+				goodTil = &tm
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReplaceLimitOrder(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				amountInIntP,
+				priceDecP,
+				goodTil,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetPrice())
+	cmd.Flags().AddFlagSet(FlagSetReplaceLimitOrder())
+
+	return cmd
+}
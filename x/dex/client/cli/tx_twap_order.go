@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"strconv"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdPlaceTWAPOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "place-twap-order [token-in] [token-out] [total-in] [slices] [interval] [max-price]",
+		Short:   "Broadcast message PlaceTWAPOrder",
+		Example: "place-twap-order tokenA tokenB 1000000 10 60 1.05 --from alice",
+		Args:    cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			totalIn, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for total-in")
+			}
+
+			slices, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			maxPrice, err := math_utils.NewPrecDecFromStr(args[5])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceTWAPOrder(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				totalIn,
+				slices,
+				interval,
+				maxPrice,
+			)
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWithdrawTWAPOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-twap-order [order-id]",
+		Short:   "Broadcast message WithdrawTWAPOrder",
+		Example: "withdraw-twap-order 0 --from alice",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTWAPOrder(clientCtx.GetFromAddress().String(), orderID)
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -44,6 +44,70 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set poolMetadata count
 	k.SetPoolCount(ctx, genState.PoolCount)
+
+	// Set all the trading statuses
+	for _, elem := range genState.PairTradingStatusList {
+		k.SetPairTradingStatus(ctx, elem.PairId, elem.Status)
+	}
+	for _, elem := range genState.DenomTradingStatusList {
+		k.SetDenomTradingStatus(ctx, elem.Denom, elem.Status)
+	}
+	// Set all the hook subscriptions
+	for _, elem := range genState.HookSubscriptionList {
+		k.SetHookSubscription(ctx, elem.PairId, elem.ContractAddress)
+	}
+	// Set all the range positions
+	for _, elem := range genState.RangePositionList {
+		k.SetRangePosition(ctx, elem)
+	}
+	k.SetRangePositionCount(ctx, genState.RangePositionCount)
+	// Set all the pair fee tiers
+	for _, elem := range genState.PairFeeTiersList {
+		k.SetPairFeeTiers(ctx, elem.PairId, elem.FeeTiers)
+	}
+	// Set all the candles
+	for _, elem := range genState.CandleList {
+		k.SetCandle(ctx, elem)
+	}
+	// Set all the trader fee tier assignments
+	for _, elem := range genState.TraderFeeTierAssignmentList {
+		k.SetTraderFeeTierAssignment(ctx, elem.Address, elem.Tier)
+	}
+	// Set all the trader volumes
+	for _, elem := range genState.TraderVolumeList {
+		k.SetTraderVolume(ctx, elem)
+	}
+	// Set all the tranche rebate pools
+	for _, elem := range genState.TrancheRebatePoolList {
+		k.SetTrancheRebatePool(ctx, elem)
+	}
+	// Set all the maker rebates
+	for _, elem := range genState.MakerRebatesList {
+		k.SetMakerRebates(ctx, elem)
+	}
+	// Set all the auto-settle orders
+	for _, elem := range genState.AutoSettleOrderList {
+		k.SetAutoSettleOrder(ctx, elem)
+	}
+	for _, trancheKey := range genState.AutoSettleQueue {
+		k.QueueAutoSettleTranche(ctx, trancheKey)
+	}
+	// Set all the TWAP orders and their fills
+	for _, elem := range genState.TwapOrderList {
+		k.SetTWAPOrder(ctx, elem)
+	}
+	k.SetTWAPOrderCount(ctx, genState.TwapOrderCount)
+	for _, elem := range genState.TwapOrderFillList {
+		k.SetTWAPOrderFill(ctx, elem)
+	}
+	// Set the cost basis of all pool share positions
+	for _, elem := range genState.PoolDepositBasisList {
+		k.SetPoolDepositBasis(ctx, elem)
+	}
+	// Set all the expiration deposits of GOOD_TIL_TIME limit orders
+	for _, elem := range genState.ExpirationDepositList {
+		k.SetExpirationDeposit(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	err := k.SetParams(ctx, genState.Params)
 	if err != nil {
@@ -60,6 +124,24 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.InactiveLimitOrderTrancheList = k.GetAllInactiveLimitOrderTranche(ctx)
 	genesis.PoolMetadataList = k.GetAllPoolMetadata(ctx)
 	genesis.PoolCount = k.GetPoolCount(ctx)
+	genesis.PairTradingStatusList = k.GetAllPairTradingStatus(ctx)
+	genesis.DenomTradingStatusList = k.GetAllDenomTradingStatus(ctx)
+	genesis.HookSubscriptionList = k.GetAllHookSubscription(ctx)
+	genesis.RangePositionList = k.GetAllRangePosition(ctx)
+	genesis.RangePositionCount = k.GetRangePositionCount(ctx)
+	genesis.CandleList = k.GetAllCandle(ctx)
+	genesis.PairFeeTiersList = k.GetAllPairFeeTiers(ctx)
+	genesis.TraderFeeTierAssignmentList = k.GetAllTraderFeeTierAssignment(ctx)
+	genesis.TraderVolumeList = k.GetAllTraderVolume(ctx)
+	genesis.TrancheRebatePoolList = k.GetAllTrancheRebatePool(ctx)
+	genesis.MakerRebatesList = k.GetAllMakerRebates(ctx)
+	genesis.AutoSettleOrderList = k.GetAllAutoSettleOrder(ctx)
+	genesis.AutoSettleQueue = k.GetAllQueuedAutoSettleTranches(ctx)
+	genesis.TwapOrderList = k.GetAllTWAPOrder(ctx)
+	genesis.TwapOrderCount = k.GetTWAPOrderCount(ctx)
+	genesis.TwapOrderFillList = k.GetAllTWAPOrderFill(ctx)
+	genesis.PoolDepositBasisList = k.GetAllPoolDepositBasis(ctx)
+	genesis.ExpirationDepositList = k.GetAllExpirationDeposit(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
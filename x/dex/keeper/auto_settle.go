@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetAutoSettleOrder sets an AutoSettleOrder in the store
+func (k Keeper) SetAutoSettleOrder(ctx sdk.Context, order types.AutoSettleOrder) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&order)
+	store.Set(types.AutoSettleOrderKey(order.TrancheKey, order.Address), b)
+}
+
+// HasAutoSettleOrder returns whether the limit order of address in the tranche is auto-settled
+func (k Keeper) HasAutoSettleOrder(ctx sdk.Context, trancheKey, address string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.AutoSettleOrderKey(trancheKey, address))
+}
+
+// RemoveAutoSettleOrder removes an AutoSettleOrder from the store
+func (k Keeper) RemoveAutoSettleOrder(ctx sdk.Context, trancheKey, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoSettleOrderKey(trancheKey, address))
+}
+
+// GetAutoSettleOrdersForTranche returns all AutoSettleOrders of a tranche
+func (k Keeper) GetAutoSettleOrdersForTranche(ctx sdk.Context, trancheKey string) (list []types.AutoSettleOrder) {
+	return k.getAutoSettleOrdersForTranche(ctx, trancheKey, 0)
+}
+
+// getAutoSettleOrdersForTranche returns the first limit AutoSettleOrders of a tranche, or all of them if limit is 0
+func (k Keeper) getAutoSettleOrdersForTranche(ctx sdk.Context, trancheKey string, limit uint64) (list []types.AutoSettleOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoSettleOrderTranchePrefix(trancheKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && (limit == 0 || uint64(len(list)) < limit); iterator.Next() {
+		var val types.AutoSettleOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllAutoSettleOrder returns all AutoSettleOrders
+func (k Keeper) GetAllAutoSettleOrder(ctx sdk.Context) (list []types.AutoSettleOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoSettleOrderKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AutoSettleOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RegisterAutoSettleOrder marks the limit order of the receiver in the tranche as auto-settled. Orders that were
+// entirely filled as a taker leave nothing in the tranche and are not registered.
+func (k Keeper) RegisterAutoSettleOrder(ctx sdk.Context, trancheKey string, receiverAddr sdk.AccAddress) {
+	if _, found := k.GetLimitOrderTrancheUser(ctx, receiverAddr.String(), trancheKey); !found {
+		return
+	}
+
+	k.SetAutoSettleOrder(ctx, types.AutoSettleOrder{
+		TrancheKey: trancheKey,
+		Address:    receiverAddr.String(),
+	})
+}
+
+// QueueAutoSettleTranche schedules the auto-settled orders of a tranche that was fully filled or expired to be settled
+// at the end of the block
+func (k Keeper) QueueAutoSettleTranche(ctx sdk.Context, trancheKey string) {
+	if len(k.getAutoSettleOrdersForTranche(ctx, trancheKey, 1)) == 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoSettleQueueKeyPrefix))
+	store.Set([]byte(trancheKey), []byte(trancheKey))
+}
+
+// GetAllQueuedAutoSettleTranches returns the keys of all tranches whose auto-settled orders are waiting to be settled
+func (k Keeper) GetAllQueuedAutoSettleTranches(ctx sdk.Context) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoSettleQueueKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Value()))
+	}
+
+	return
+}
+
+// SettleAutoSettleOrders withdraws the auto-settled orders of queued tranches on behalf of their owners, at most
+// Params.AutoSettlesPerBlock of them. The orders that do not fit stay queued for the next blocks. Orders that cannot be
+// withdrawn within AutoSettleGasLimit are dropped and left to be withdrawn manually.
+func (k Keeper) SettleAutoSettleOrders(ctx sdk.Context) {
+	remaining := k.GetParams(ctx).AutoSettlesPerBlock
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoSettleQueueKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	// Every queued tranche has at least one order unless they have all been withdrawn manually
+	var trancheKeys []string
+	for ; iterator.Valid() && uint64(len(trancheKeys)) < remaining; iterator.Next() {
+		trancheKeys = append(trancheKeys, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, trancheKey := range trancheKeys {
+		orders := k.getAutoSettleOrdersForTranche(ctx, trancheKey, remaining)
+		for _, order := range orders {
+			k.RemoveAutoSettleOrder(ctx, order.TrancheKey, order.Address)
+			k.settleAutoSettleOrder(ctx, order)
+		}
+
+		remaining -= uint64(len(orders))
+		if remaining == 0 && len(k.getAutoSettleOrdersForTranche(ctx, trancheKey, 1)) > 0 {
+			// The rest of the tranche is settled in the next blocks
+			return
+		}
+		store.Delete([]byte(trancheKey))
+		if remaining == 0 {
+			return
+		}
+	}
+}
+
+func (k Keeper) settleAutoSettleOrder(ctx sdk.Context, order types.AutoSettleOrder) {
+	ownerAddr := sdk.MustAccAddressFromBech32(order.Address)
+	if _, found := k.GetLimitOrderTrancheUser(ctx, order.Address, order.TrancheKey); !found {
+		// The order has already been withdrawn
+		return
+	}
+
+	// Settling runs in EndBlock with an infinite gas meter and may call token hooks, so it is given a fixed gas budget
+	var takerCoinOut, makerCoinOut sdk.Coin
+	err := runWithGasLimit(ctx, types.AutoSettleGasLimit, func(ctx sdk.Context) (err error) {
+		takerCoinOut, makerCoinOut, err = k.WithdrawFilledLimitOrderCore(ctx, order.TrancheKey, ownerAddr)
+		return err
+	})
+	if err != nil {
+		k.Logger(ctx).Error(
+			"Failed to auto-settle limit order",
+			"err", err,
+			"address", order.Address,
+			"tranche_key", order.TrancheKey,
+		)
+		return
+	}
+
+	if k.contractKeeper == nil || !k.contractKeeper.HasContractInfo(ctx, ownerAddr) {
+		return
+	}
+
+	msgBz, err := json.Marshal(types.AutoSettleSudoMsg{
+		LimitOrderSettled: &types.LimitOrderSettledSudoMsg{
+			TrancheKey:   order.TrancheKey,
+			TakerCoinOut: CWCoinFromSDKCoin(takerCoinOut),
+			MakerCoinOut: CWCoinFromSDKCoin(makerCoinOut),
+		},
+	})
+	if err != nil {
+		// should never happen
+		k.Logger(ctx).Error("failed to marshal auto-settle sudo msg", "err", err)
+		return
+	}
+
+	// The proceeds stay with the contract even if the notification fails
+	if err := sudoWithGasLimit(ctx, k.contractKeeper, ownerAddr, msgBz, k.GetParams(ctx).HookGasLimit); err != nil {
+		k.Logger(ctx).Error(
+			"Auto-settle sudo call failed",
+			"err", err,
+			"contract", order.Address,
+			"tranche_key", order.TrancheKey,
+		)
+	}
+}
@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) aliceAutoSettleLimitSells(
+	selling string,
+	tick, amountIn int,
+	orderType types.LimitOrderType,
+	goodTil *time.Time,
+) string {
+	tradePairID := types.NewTradePairIDFromTaker(defaultPairID, selling)
+	resp, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.alice.String(),
+		Receiver:         s.alice.String(),
+		TokenIn:          tradePairID.TakerDenom,
+		TokenOut:         tradePairID.MakerDenom,
+		TickIndexInToOut: tradePairID.TickIndexTakerToMaker(int64(tick)),
+		AmountIn:         sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple),
+		OrderType:        orderType,
+		ExpirationTime:   goodTil,
+		AutoSettle:       true,
+	})
+	s.NoError(err)
+
+	return resp.TrancheKey
+}
+
+func (s *DexTestSuite) TestAutoSettleFilledOrder() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(20, 0)
+
+	// GIVEN alice places an auto-settled limit order of 10 B at tick 0
+	trancheKey := s.aliceAutoSettleLimitSells("TokenB", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED, nil)
+	s.True(s.App.DexKeeper.HasAutoSettleOrder(s.Ctx, trancheKey, s.alice.String()))
+
+	// WHEN bob partially fills it
+	s.bobLimitSells("TokenA", 10, 5, types.LimitOrderType_FILL_OR_KILL)
+	s.App.DexKeeper.SettleAutoSettleOrders(s.Ctx)
+
+	// THEN nothing is settled yet
+	s.assertAliceBalances(0, 0)
+
+	// WHEN bob fills the rest of it
+	s.bobLimitSells("TokenA", 10, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.App.DexKeeper.SettleAutoSettleOrders(s.Ctx)
+
+	// THEN alice receives the proceeds without withdrawing
+	s.assertAliceBalances(10, 0)
+	_, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), trancheKey)
+	s.False(found)
+	s.False(s.App.DexKeeper.HasAutoSettleOrder(s.Ctx, trancheKey, s.alice.String()))
+}
+
+func (s *DexTestSuite) TestAutoSettleExpiredOrder() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(5, 0)
+
+	// GIVEN alice places an auto-settled expiring limit order of 10 B that is half filled
+	goodTil := s.Ctx.BlockTime().Add(time.Hour)
+	trancheKey := s.aliceAutoSettleLimitSells("TokenB", 0, 10, types.LimitOrderType_GOOD_TIL_TIME, &goodTil)
+	s.bobLimitSells("TokenA", 10, 5, types.LimitOrderType_FILL_OR_KILL)
+
+	// WHEN the order expires
+	s.App.DexKeeper.PurgeExpiredLimitOrders(s.Ctx, goodTil)
+	s.App.DexKeeper.SettleAutoSettleOrders(s.Ctx)
+
+	// THEN alice receives both the filled and the unfilled amounts
+	s.assertAliceBalances(5, 5)
+	_, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), trancheKey)
+	s.False(found)
+}
+
+func (s *DexTestSuite) TestAutoSettleCanceledOrder() {
+	s.fundAliceBalances(0, 10)
+
+	// GIVEN alice places an auto-settled limit order
+	trancheKey := s.aliceAutoSettleLimitSells("TokenB", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED, nil)
+
+	// WHEN she cancels it
+	s.aliceCancelsLimitSell(trancheKey)
+
+	// THEN it is no longer auto-settled
+	s.False(s.App.DexKeeper.HasAutoSettleOrder(s.Ctx, trancheKey, s.alice.String()))
+	s.assertAliceBalances(0, 10)
+}
+
+func (s *DexTestSuite) TestAutoSettleReplacedOrder() {
+	s.fundAliceBalances(0, 10)
+
+	// GIVEN alice places an auto-settled limit order
+	trancheKey := s.aliceAutoSettleLimitSells("TokenB", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED, nil)
+
+	// WHEN she replaces it
+	resp, err := s.msgServer.ReplaceLimitOrder(s.Ctx, &types.MsgReplaceLimitOrder{
+		Creator:    s.alice.String(),
+		TrancheKey: trancheKey,
+	})
+	s.NoError(err)
+
+	// THEN the new order is auto-settled
+	s.False(s.App.DexKeeper.HasAutoSettleOrder(s.Ctx, trancheKey, s.alice.String()))
+	s.True(s.App.DexKeeper.HasAutoSettleOrder(s.Ctx, resp.TrancheKey, s.alice.String()))
+}
+
+func (s *DexTestSuite) TestAutoSettleTakerOnlyFails() {
+	s.fundAliceBalances(0, 10)
+
+	_, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.alice.String(),
+		Receiver:         s.alice.String(),
+		TokenIn:          "TokenB",
+		TokenOut:         "TokenA",
+		TickIndexInToOut: 0,
+		AmountIn:         sdkmath.NewInt(10),
+		OrderType:        types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+		AutoSettle:       true,
+	})
+	s.ErrorIs(err, types.ErrAutoSettleTakerOnly)
+}
+
+func (s *DexTestSuite) TestRunWithGasLimitOutOfGas() {
+	order := types.AutoSettleOrder{TrancheKey: "trancheKey", Address: s.alice.String()}
+	gasBefore := s.Ctx.GasMeter().GasConsumed()
+
+	// WHEN the execution writes state and then runs out of gas
+	err := dexkeeper.RunWithGasLimit(s.Ctx, 10_000, func(ctx sdk.Context) error {
+		s.App.DexKeeper.SetAutoSettleOrder(ctx, order)
+		ctx.GasMeter().ConsumeGas(20_000, "test")
+		return nil
+	})
+
+	// THEN it errors instead of panicking, its state is dropped and the limit is charged to the parent ctx
+	s.ErrorIs(err, types.ErrOutOfGas)
+	s.Equal(gasBefore+10_000, s.Ctx.GasMeter().GasConsumed())
+	s.False(s.App.DexKeeper.HasAutoSettleOrder(s.Ctx, order.TrancheKey, order.Address))
+}
+
+func (s *DexTestSuite) TestAutoSettleOrdersPerBlock() {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.AutoSettlesPerBlock = 1
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+	s.fundAliceBalances(0, 10)
+	s.fundCarolBalances(0, 10)
+	s.fundBobBalances(20, 0)
+
+	// GIVEN alice and carol place auto-settled limit orders in the same tranche and bob fills it
+	trancheKey := s.aliceAutoSettleLimitSells("TokenB", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED, nil)
+	_, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.carol.String(),
+		Receiver:         s.carol.String(),
+		TokenIn:          "TokenB",
+		TokenOut:         "TokenA",
+		TickIndexInToOut: 0,
+		AmountIn:         sdkmath.NewInt(10).Mul(denomMultiple),
+		OrderType:        types.LimitOrderType_GOOD_TIL_CANCELLED,
+		AutoSettle:       true,
+	})
+	s.NoError(err)
+	s.bobLimitSells("TokenA", 10, 20, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN the orders are settled at the end of the block
+	s.App.DexKeeper.SettleAutoSettleOrders(s.Ctx)
+
+	// THEN only one of them is settled and the tranche stays queued
+	s.Equal([]string{trancheKey}, s.App.DexKeeper.GetAllQueuedAutoSettleTranches(s.Ctx))
+	s.Len(s.App.DexKeeper.GetAutoSettleOrdersForTranche(s.Ctx, trancheKey), 1)
+
+	// WHEN the orders are settled at the end of the next block
+	s.App.DexKeeper.SettleAutoSettleOrders(s.Ctx)
+
+	// THEN both alice and carol received their proceeds
+	s.assertAliceBalances(10, 0)
+	s.assertCarolBalances(10, 0)
+	s.Empty(s.App.DexKeeper.GetAllQueuedAutoSettleTranches(s.Ctx))
+}
+
+func (s *DexTestSuite) TestAutoSettleDisabledFails() {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.AutoSettlesPerBlock = 0
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+	s.fundAliceBalances(0, 10)
+
+	_, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.alice.String(),
+		Receiver:         s.alice.String(),
+		TokenIn:          "TokenB",
+		TokenOut:         "TokenA",
+		TickIndexInToOut: 0,
+		AmountIn:         sdkmath.NewInt(10).Mul(denomMultiple),
+		OrderType:        types.LimitOrderType_GOOD_TIL_CANCELLED,
+		AutoSettle:       true,
+	})
+	s.ErrorIs(err, types.ErrAutoSettleDisabled)
+}
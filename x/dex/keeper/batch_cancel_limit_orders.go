@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// BatchCancelLimitOrdersCore handles the logic for MsgBatchCancelLimitOrders. When skipEmpty is true limit orders
+// that have nothing left to cancel or withdraw are ignored instead of failing the entire batch.
+func (k Keeper) BatchCancelLimitOrdersCore(
+	goCtx context.Context,
+	trancheKeys []string,
+	skipEmpty bool,
+	callerAddr sdk.AccAddress,
+) (canceledTrancheKeys []string, makerCoinsOut, takerCoinsOut sdk.Coins, err error) {
+	canceledTrancheKeys = make([]string, 0, len(trancheKeys))
+	makerCoinsOut = sdk.NewCoins()
+	takerCoinsOut = sdk.NewCoins()
+
+	for _, trancheKey := range trancheKeys {
+		makerCoinOut, takerCoinOut, err := k.CancelLimitOrderCore(goCtx, trancheKey, callerAddr)
+		if skipEmpty && errors.Is(err, types.ErrCancelEmptyLimitOrder) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		canceledTrancheKeys = append(canceledTrancheKeys, trancheKey)
+		makerCoinsOut = makerCoinsOut.Add(makerCoinOut)
+		takerCoinsOut = takerCoinsOut.Add(takerCoinOut)
+	}
+
+	return canceledTrancheKeys, makerCoinsOut, takerCoinsOut, nil
+}
+
+// GetLimitOrderTrancheKeysForPair returns the tranche keys of all the limit orders owned by address for the given pair.
+// If tokenIn is not empty only limit orders selling tokenIn are returned.
+func (k Keeper) GetLimitOrderTrancheKeysForPair(
+	ctx sdk.Context,
+	address sdk.AccAddress,
+	pairID *types.PairID,
+	tokenIn string,
+) (trancheKeys []string) {
+	for _, trancheUser := range k.GetAllLimitOrderTrancheUserForAddress(ctx, address) {
+		tradePairID := trancheUser.TradePairId
+		if *tradePairID.MustPairID() != *pairID {
+			continue
+		}
+		if tokenIn != "" && tradePairID.MakerDenom != tokenIn {
+			continue
+		}
+		trancheKeys = append(trancheKeys, trancheUser.TrancheKey)
+	}
+
+	return trancheKeys
+}
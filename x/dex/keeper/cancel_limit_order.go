@@ -18,6 +18,9 @@ func (k Keeper) CancelLimitOrderCore(
 ) (makerCoinOut, takerCoinOut sdk.Coin, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// The tranche user is looked up before the cancellation since it may be removed by it
+	trancheUser, _ := k.GetLimitOrderTrancheUser(ctx, callerAddr.String(), trancheKey)
+
 	makerCoinOut, takerCoinOut, err = k.ExecuteCancelLimitOrder(ctx, trancheKey, callerAddr)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
@@ -34,6 +37,23 @@ func (k Keeper) CancelLimitOrderCore(
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	if err := k.SettleMakerRebate(ctx, trancheUser, takerCoinOut.Amount); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	k.RemoveAutoSettleOrder(ctx, trancheKey, callerAddr.String())
+
+	if trancheUser.OrderType.IsGoodTil() {
+		trancheRef := types.LimitOrderTrancheKey{
+			TradePairId:           trancheUser.TradePairId,
+			TickIndexTakerToMaker: trancheUser.TickIndexTakerToMaker,
+			TrancheKey:            trancheKey,
+		}.KeyMarshal()
+		if err := k.RefundExpirationDeposit(ctx, trancheRef); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
 	makerDenom := makerCoinOut.Denom
 	takerDenom := takerCoinOut.Denom
 	// This will never panic since PairID has already been successfully constructed during tranche creation
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// Swaps are collected in the transient store as messages execute successfully and are aggregated into candles once
+// per block in EndBlock. Only the most recent CandleRetention candles are kept for each pair and interval.
+
+// SetCandle set a specific candle in the store
+func (k Keeper) SetCandle(ctx sdk.Context, candle types.Candle) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&candle)
+	store.Set(types.CandleKey(candle.PairId, candle.Interval, candle.OpenTime), b)
+}
+
+// GetCandle returns the candle of a pair for the interval starting at openTime
+func (k Keeper) GetCandle(ctx sdk.Context, pairID *types.PairID, interval uint64, openTime int64) (val types.Candle, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.CandleKey(pairID, interval, openTime))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllCandle returns all candles
+func (k Keeper) GetAllCandle(ctx sdk.Context) (list []types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Candle
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// PruneCandles removes all candles of a pair and interval that opened before minOpenTime
+func (k Keeper) PruneCandles(ctx sdk.Context, pairID *types.PairID, interval uint64, minOpenTime int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(pairID, interval))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Candle
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		// Denoms may contain "/" so the prefix can also match pairs with a longer token1
+		if *val.PairId != *pairID || val.Interval != interval {
+			continue
+		}
+		if val.OpenTime >= minOpenTime {
+			break
+		}
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RecordBlockTrades saves swaps to be aggregated into candles at the end of the block
+func (k Keeper) RecordBlockTrades(ctx sdk.Context, swaps []types.SwapRecord) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.BlockTradeKeyPrefix))
+	for _, swap := range swaps {
+		if swap.AmountIn.IsZero() || swap.AmountOut.IsZero() {
+			continue
+		}
+		b := k.cdc.MustMarshal(&swap)
+		store.Set(k.nextHookRecordID(ctx), b)
+	}
+}
+
+func (k Keeper) popBlockTrades(ctx sdk.Context) (list []types.SwapRecord) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.BlockTradeKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SwapRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return list
+}
+
+// UpdateCandles aggregates the swaps of the current block into the candles of every configured interval and prunes
+// candles that fell out of the retention window
+func (k Keeper) UpdateCandles(ctx sdk.Context) {
+	trades := k.popBlockTrades(ctx)
+	if len(trades) == 0 {
+		return
+	}
+
+	params := k.GetParams(ctx)
+
+	// Candles are updated in memory and written once, in the order they were first touched
+	candles := make(map[string]*types.Candle)
+	var candleKeys []string
+
+	blockTime := ctx.BlockTime().Unix()
+	for _, trade := range trades {
+		pairID := trade.TradePairId.MustPairID()
+		price, volume0, volume1 := tradePriceAndVolumes(pairID, trade)
+
+		for _, interval := range params.CandleIntervals {
+			openTime := types.CandleOpenTime(blockTime, interval)
+			key := string(types.CandleKey(pairID, interval, openTime))
+
+			if candle, ok := candles[key]; ok {
+				candle.AddTrade(price, volume0, volume1)
+				continue
+			}
+
+			candle, found := k.GetCandle(ctx, pairID, interval, openTime)
+			if found {
+				candle.AddTrade(price, volume0, volume1)
+			} else {
+				candle = types.NewCandle(pairID, interval, openTime, price, volume0, volume1)
+			}
+			candles[key] = &candle
+			candleKeys = append(candleKeys, key)
+		}
+	}
+
+	for _, key := range candleKeys {
+		candle := candles[key]
+		k.SetCandle(ctx, *candle)
+
+		retentionWindow := int64(params.CandleRetention-1) * int64(candle.Interval) //nolint:gosec
+		k.PruneCandles(ctx, candle.PairId, candle.Interval, candle.OpenTime-retentionWindow)
+	}
+}
+
+// tradePriceAndVolumes returns the price of a swap in token1 per token0 and the amounts of token0 and token1 traded
+func tradePriceAndVolumes(pairID *types.PairID, trade types.SwapRecord) (price math_utils.PrecDec, volume0, volume1 math.Int) {
+	if trade.TradePairId.TakerDenom == pairID.Token0 {
+		volume0, volume1 = trade.AmountIn, trade.AmountOut
+	} else {
+		volume0, volume1 = trade.AmountOut, trade.AmountIn
+	}
+	price = math_utils.NewPrecDecFromInt(volume1).Quo(math_utils.NewPrecDecFromInt(volume0))
+
+	return price, volume0, volume1
+}
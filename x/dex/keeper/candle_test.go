@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+var candleBlockTime = time.Unix(86_400*100+3_630, 0)
+
+func (s *DexTestSuite) TestCandlesAggregateBlockTrades() {
+	s.Ctx = s.Ctx.WithBlockTime(candleBlockTime)
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+	s.aliceLimitSells("TokenA", -1, 10)
+
+	// GIVEN bob buys TokenA twice in a block
+	s.bobLimitSells("TokenB", -10, 2, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.bobLimitSells("TokenB", -10, 3, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN the block ends
+	s.App.DexKeeper.UpdateCandles(s.Ctx)
+
+	// THEN both trades are aggregated into a candle for every interval
+	pairID := &types.PairID{Token0: "TokenA", Token1: "TokenB"}
+	tokenABought := s.App.BankKeeper.GetBalance(s.Ctx, s.bob, "TokenA").Amount
+	for _, interval := range types.DefaultCandleIntervals {
+		openTime := types.CandleOpenTime(candleBlockTime.Unix(), interval)
+		candle, found := s.App.DexKeeper.GetCandle(s.Ctx, pairID, interval, openTime)
+		s.True(found)
+		s.Equal(uint64(2), candle.TradeCount)
+		s.Equal(math.NewInt(5_000_000), candle.Volume1)
+		s.Equal(tokenABought, candle.Volume0)
+		s.True(candle.Low.LTE(candle.Open) && candle.Open.LTE(candle.High))
+		s.True(candle.Low.LTE(candle.Close) && candle.Close.LTE(candle.High))
+		s.True(candle.Close.GT(math_utils.OnePrecDec()))
+	}
+	s.Equal(int64(86_400*100+3_600), types.CandleOpenTime(candleBlockTime.Unix(), 3600))
+}
+
+func (s *DexTestSuite) TestCandlesRecordedWithoutHooks() {
+	s.App.DexKeeper.ReplaceHooks(nil)
+	s.msgServer = dexkeeper.NewMsgServerImpl(s.App.DexKeeper)
+	s.Ctx = s.Ctx.WithBlockTime(candleBlockTime)
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+	s.aliceLimitSells("TokenA", -1, 10)
+
+	// GIVEN no hooks are set WHEN bob trades
+	s.bobLimitSells("TokenB", -10, 2, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.App.DexKeeper.UpdateCandles(s.Ctx)
+
+	// THEN the trade is still aggregated into a candle
+	pairID := &types.PairID{Token0: "TokenA", Token1: "TokenB"}
+	candle, found := s.App.DexKeeper.GetCandle(s.Ctx, pairID, 60, types.CandleOpenTime(candleBlockTime.Unix(), 60))
+	s.True(found)
+	s.Equal(uint64(1), candle.TradeCount)
+}
+
+func (s *DexTestSuite) TestCandlesUpdatedAcrossBlocks() {
+	pairID := &types.PairID{Token0: "TokenA", Token1: "TokenB"}
+	tradePairID := types.NewTradePairIDFromTaker(pairID, "TokenA")
+	s.Ctx = s.Ctx.WithBlockTime(candleBlockTime)
+
+	// GIVEN a trade at a price of 2
+	s.App.DexKeeper.RecordBlockTrades(s.Ctx, []types.SwapRecord{
+		{TradePairId: tradePairID, AmountIn: math.NewInt(10), AmountOut: math.NewInt(20)},
+	})
+	s.App.DexKeeper.UpdateCandles(s.Ctx)
+
+	// WHEN a later block in the same minute trades at 4 and 1
+	s.Ctx = s.Ctx.WithBlockTime(candleBlockTime.Add(10 * time.Second))
+	s.App.DexKeeper.RecordBlockTrades(s.Ctx, []types.SwapRecord{
+		{TradePairId: tradePairID.Reversed(), AmountIn: math.NewInt(40), AmountOut: math.NewInt(10)},
+		{TradePairId: tradePairID, AmountIn: math.NewInt(10), AmountOut: math.NewInt(10)},
+	})
+	s.App.DexKeeper.UpdateCandles(s.Ctx)
+
+	// THEN the candle covers all three trades
+	candle, found := s.App.DexKeeper.GetCandle(s.Ctx, pairID, 60, types.CandleOpenTime(candleBlockTime.Unix(), 60))
+	s.True(found)
+	s.Equal(math_utils.NewPrecDec(2), candle.Open)
+	s.Equal(math_utils.NewPrecDec(4), candle.High)
+	s.Equal(math_utils.NewPrecDec(1), candle.Low)
+	s.Equal(math_utils.NewPrecDec(1), candle.Close)
+	s.Equal(math.NewInt(30), candle.Volume0)
+	s.Equal(math.NewInt(70), candle.Volume1)
+	s.Equal(uint64(3), candle.TradeCount)
+}
+
+func (s *DexTestSuite) TestCandlesPruned() {
+	pairID := &types.PairID{Token0: "TokenA", Token1: "TokenB"}
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.CandleIntervals = []uint64{60}
+	params.CandleRetention = 2
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	// GIVEN candles for the two previous minutes
+	openTime := types.CandleOpenTime(candleBlockTime.Unix(), 60)
+	for _, t := range []int64{openTime - 120, openTime - 60} {
+		s.App.DexKeeper.SetCandle(s.Ctx, types.NewCandle(pairID, 60, t, math_utils.OnePrecDec(), math.OneInt(), math.OneInt()))
+	}
+
+	// WHEN a trade happens in the current minute
+	s.Ctx = s.Ctx.WithBlockTime(candleBlockTime)
+	s.App.DexKeeper.RecordBlockTrades(s.Ctx, []types.SwapRecord{
+		{TradePairId: types.NewTradePairIDFromTaker(pairID, "TokenA"), AmountIn: math.NewInt(10), AmountOut: math.NewInt(10)},
+	})
+	s.App.DexKeeper.UpdateCandles(s.Ctx)
+
+	// THEN only the most recent two candles are kept
+	resp, err := s.App.DexKeeper.CandleAll(s.Ctx, &types.QueryAllCandleRequest{PairId: "TokenA<>TokenB", Interval: 60})
+	s.NoError(err)
+	s.Len(resp.Candles, 2)
+	s.Equal(openTime-60, resp.Candles[0].OpenTime)
+	s.Equal(openTime, resp.Candles[1].OpenTime)
+}
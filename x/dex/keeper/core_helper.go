@@ -62,12 +62,17 @@ func (k Keeper) GetCurrLiq(ctx sdk.Context, tradePairID *types.TradePairID) *typ
 	return nil
 }
 
-func (k Keeper) GetValidFees(ctx sdk.Context) []uint64 {
+// GetValidFees returns the fee tiers set for the pair, falling back to the fee tiers of the params
+func (k Keeper) GetValidFees(ctx sdk.Context, pairID *types.PairID) []uint64 {
+	if feeTiers, found := k.GetPairFeeTiers(ctx, pairID); found {
+		return feeTiers
+	}
+
 	return k.GetParams(ctx).FeeTiers
 }
 
-func (k Keeper) ValidateFee(ctx sdk.Context, fee uint64) error {
-	validFees := k.GetValidFees(ctx)
+func (k Keeper) ValidateFee(ctx sdk.Context, pairID *types.PairID, fee uint64) error {
+	validFees := k.GetValidFees(ctx, pairID)
 	if !slices.Contains(validFees, fee) {
 		return sdkerrors.Wrapf(types.ErrInvalidFee, "%d", validFees)
 	}
@@ -57,6 +57,13 @@ func (k Keeper) DepositCore(
 		return nil, nil, nil, nil, err
 	}
 
+	if k.hooks != nil && !sharesIssued.IsZero() {
+		err := k.hooks.AfterDeposit(ctx, callerAddr, receiverAddr, pairID, totalAmountReserve0, totalAmountReserve1, sharesIssued)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+	}
+
 	return amounts0Deposited, amounts1Deposited, sharesIssued, failedDeposits, nil
 }
 
@@ -90,6 +97,10 @@ func (k Keeper) ExecuteDeposit(
 		amounts1Deposited[i] = math.ZeroInt()
 	}
 
+	if err := k.AssertCanAddLiquidity(ctx, pairID); err != nil {
+		return nil, nil, math.ZeroInt(), math.ZeroInt(), nil, nil, nil, err
+	}
+
 	for i, amount0 := range amounts0 {
 		amount1 := amounts1[i]
 		tickIndex := tickIndices[i]
@@ -100,7 +111,7 @@ func (k Keeper) ExecuteDeposit(
 		}
 		autoswap := !option.DisableAutoswap
 
-		if err := k.ValidateFee(ctx, fee); err != nil {
+		if err := k.ValidateFee(ctx, pairID, fee); err != nil {
 			return nil, nil, math.ZeroInt(), math.ZeroInt(), nil, nil, nil, err
 		}
 
@@ -140,6 +151,7 @@ func (k Keeper) ExecuteDeposit(
 		}
 
 		sharesIssued = append(sharesIssued, outShares)
+		k.RecordPoolDeposit(ctx, receiverAddr, pool, inAmount0, inAmount1, outShares.Amount)
 
 		amounts0Deposited[i] = inAmount0
 		amounts1Deposited[i] = inAmount1
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// ReplaceHooks overrides the hooks set on the keeper so tests can install mocks
+func (k *Keeper) ReplaceHooks(dh types.DexHooks) {
+	k.hooks = dh
+}
+
+// ReplaceContractKeeper overrides the contract keeper set on the keeper so tests can install mocks
+func (k *Keeper) ReplaceContractKeeper(ck types.ContractKeeper) {
+	k.contractKeeper = ck
+}
+
+// RunWithGasLimit exposes runWithGasLimit to tests
+var RunWithGasLimit = runWithGasLimit
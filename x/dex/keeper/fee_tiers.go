@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+	"github.com/neutron-org/neutron/v5/x/dex/utils"
+)
+
+// SetPairFeeTiers overrides the fee tiers of a pair. Setting an empty list removes the override.
+func (k Keeper) SetPairFeeTiers(ctx sdk.Context, pairID *types.PairID, feeTiers []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PairFeeTiersKeyPrefix))
+	key := types.PairFeeTiersKey(pairID)
+	if len(feeTiers) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	b := k.cdc.MustMarshal(&types.PairFeeTiers{PairId: pairID, FeeTiers: feeTiers})
+	store.Set(key, b)
+}
+
+// GetPairFeeTiers returns the fee tiers set for a pair, if any
+func (k Keeper) GetPairFeeTiers(ctx sdk.Context, pairID *types.PairID) (feeTiers []uint64, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PairFeeTiersKeyPrefix))
+	b := store.Get(types.PairFeeTiersKey(pairID))
+	if b == nil {
+		return nil, false
+	}
+
+	var val types.PairFeeTiers
+	k.cdc.MustUnmarshal(b, &val)
+	return val.FeeTiers, true
+}
+
+// GetAllPairFeeTiers returns the fee tiers of all pairs that override the params
+func (k Keeper) GetAllPairFeeTiers(ctx sdk.Context) (list []types.PairFeeTiers) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PairFeeTiersKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PairFeeTiers
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetVolatilityFee returns the fee in basis points charged on top of the pool fee for swaps against the pools of a
+// pair. It grows with the price range of the pair over the current and previous candles of the volatility fee
+// interval, up to MaxVolatilityFee.
+func (k Keeper) GetVolatilityFee(ctx sdk.Context, pairID *types.PairID) uint64 {
+	params := k.GetParams(ctx)
+	interval := params.VolatilityFeeInterval
+	if interval == 0 || params.VolatilityFeeRate == 0 || params.MaxVolatilityFee == 0 {
+		return 0
+	}
+
+	openTime := types.CandleOpenTime(ctx.BlockTime().Unix(), interval)
+	var high, low math_utils.PrecDec
+	found := false
+	for _, t := range []int64{openTime - int64(interval), openTime} { //nolint:gosec
+		candle, ok := k.GetCandle(ctx, pairID, interval, t)
+		if !ok {
+			continue
+		}
+		if !found || candle.High.GT(high) {
+			high = candle.High
+		}
+		if !found || candle.Low.LT(low) {
+			low = candle.Low
+		}
+		found = true
+	}
+	if !found {
+		return 0
+	}
+
+	highTick, errHigh := types.CalcTickIndexFromPrice(high)
+	lowTick, errLow := types.CalcTickIndexFromPrice(low)
+	if errHigh != nil || errLow != nil {
+		return params.MaxVolatilityFee
+	}
+
+	fee := utils.Abs(highTick-lowTick) * params.VolatilityFeeRate / 100
+	if fee > params.MaxVolatilityFee {
+		return params.MaxVolatilityFee
+	}
+
+	return fee
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) setPairFeeTiers(tokenA, tokenB string, feeTiers []uint64) {
+	_, err := s.msgServer.SetPairFeeTiers(s.Ctx, types.NewMsgSetPairFeeTiers(
+		s.App.DexKeeper.GetAuthority(),
+		tokenA,
+		tokenB,
+		feeTiers,
+	))
+	s.NoError(err)
+}
+
+func (s *DexTestSuite) TestPairFeeTiers() {
+	s.fundAliceBalances(100, 100)
+	s.FundAcc(s.alice, sdk.NewCoins(sdk.NewInt64Coin("TokenC", 100_000_000)))
+	pairAC := types.PairID{Token0: "TokenA", Token1: "TokenC"}
+
+	// GIVEN fee 7 is not a global fee tier
+	s.assertAliceDepositFails(types.ErrInvalidFee, NewDeposit(10, 0, 0, 7))
+
+	// WHEN the fee tiers of TokenA<>TokenB are set to 7 and 30
+	s.setPairFeeTiers("TokenA", "TokenB", []uint64{7, 30})
+
+	// THEN only those fees can be used for the pair
+	s.aliceDeposits(NewDeposit(10, 0, 0, 7))
+	s.assertAliceDepositFails(types.ErrInvalidFee, NewDeposit(10, 0, 0, 1))
+
+	// AND other pairs still use the global fee tiers
+	_, err := s.deposits(s.alice, []*Deposit{NewDeposit(10, 0, 0, 1)}, pairAC)
+	s.NoError(err)
+	_, err = s.deposits(s.alice, []*Deposit{NewDeposit(10, 0, 0, 7)}, pairAC)
+	s.ErrorIs(err, types.ErrInvalidFee)
+
+	resp, err := s.App.DexKeeper.PairFeeTiers(s.Ctx, &types.QueryPairFeeTiersRequest{PairId: "TokenA<>TokenB"})
+	s.NoError(err)
+	s.Equal([]uint64{7, 30}, resp.FeeTiers)
+	s.True(resp.IsPairOverride)
+
+	// WHEN the override is removed THEN the pair falls back to the global fee tiers
+	s.setPairFeeTiers("TokenA", "TokenB", nil)
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+	resp, err = s.App.DexKeeper.PairFeeTiers(s.Ctx, &types.QueryPairFeeTiersRequest{PairId: "TokenA<>TokenB"})
+	s.NoError(err)
+	s.Equal(types.DefaultFeeTiers, resp.FeeTiers)
+	s.False(resp.IsPairOverride)
+}
+
+func (s *DexTestSuite) TestSetPairFeeTiersUnauthorized() {
+	_, err := s.msgServer.SetPairFeeTiers(s.Ctx, types.NewMsgSetPairFeeTiers(
+		s.alice.String(),
+		"TokenA",
+		"TokenB",
+		[]uint64{7},
+	))
+	s.ErrorContains(err, "invalid authority")
+
+	_, err = s.msgServer.SetPairFeeTiers(s.Ctx, types.NewMsgSetPairFeeTiers(
+		s.App.DexKeeper.GetAuthority(),
+		"TokenA",
+		"TokenB",
+		[]uint64{7, 7},
+	))
+	s.ErrorIs(err, types.ErrInvalidFee)
+}
+
+func (s *DexTestSuite) setVolatilityFeeParams(rate, maxFee uint64) {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.VolatilityFeeInterval = 60
+	params.VolatilityFeeRate = rate
+	params.MaxVolatilityFee = maxFee
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+}
+
+func (s *DexTestSuite) setVolatileCandle(pairID *types.PairID) {
+	// The price ranged over 20 ticks during the previous minute
+	openTime := types.CandleOpenTime(s.Ctx.BlockTime().Unix(), 60) - 60
+	candle := types.NewCandle(pairID, 60, openTime, types.MustCalcPrice(10), math.OneInt(), math.OneInt())
+	candle.AddTrade(types.MustCalcPrice(30), math.OneInt(), math.OneInt())
+	s.App.DexKeeper.SetCandle(s.Ctx, candle)
+}
+
+func (s *DexTestSuite) TestVolatilityFee() {
+	pairID := &types.PairID{Token0: "TokenA", Token1: "TokenB"}
+	s.setVolatileCandle(pairID)
+
+	// GIVEN no volatility fee rate THEN there is no fee
+	s.Equal(uint64(0), s.App.DexKeeper.GetVolatilityFee(s.Ctx, pairID))
+
+	// WHEN the rate is 100% of the range THEN the fee is 20 bps
+	s.setVolatilityFeeParams(100, 50)
+	s.Equal(uint64(20), s.App.DexKeeper.GetVolatilityFee(s.Ctx, pairID))
+
+	// WHEN the rate is 1000% of the range THEN the fee is capped at 50 bps
+	s.setVolatilityFeeParams(1000, 50)
+	s.Equal(uint64(50), s.App.DexKeeper.GetVolatilityFee(s.Ctx, pairID))
+
+	// AND pairs without candles have no fee
+	s.Equal(uint64(0), s.App.DexKeeper.GetVolatilityFee(s.Ctx, &types.PairID{Token0: "TokenA", Token1: "TokenC"}))
+}
+
+func (s *DexTestSuite) TestVolatilityFeePaidToPool() {
+	pairID := &types.PairID{Token0: "TokenA", Token1: "TokenB"}
+	tradePairID := types.NewTradePairIDFromTaker(pairID, "TokenA")
+	s.fundAliceBalances(0, 10)
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+
+	// GIVEN the output of a swap without a volatility fee
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, coinOutNoFee, _, err := s.App.DexKeeper.Swap(cacheCtx, tradePairID, math.NewInt(1_000_000), nil, nil, 0)
+	s.NoError(err)
+
+	// WHEN the same swap is made with a 20 bps volatility fee
+	s.setVolatileCandle(pairID)
+	s.setVolatilityFeeParams(100, 50)
+	coinIn, coinOut, _, err := s.App.DexKeeper.Swap(s.Ctx, tradePairID, math.NewInt(1_000_000), nil, nil, 0)
+	s.NoError(err)
+
+	// THEN the full input is used, the output is reduced by the fee and the fee stays in the pool
+	s.Equal(math.NewInt(1_000_000), coinIn.Amount)
+	expectedOut := math_utils.NewPrecDecFromInt(coinOutNoFee.Amount).MulInt64(10_000).QuoInt64(10_020).TruncateInt()
+	s.True(coinOut.Amount.Sub(expectedOut).Abs().LTE(math.OneInt()))
+	pool, found := s.App.DexKeeper.GetPool(s.Ctx, pairID, 0, 1)
+	s.True(found)
+	s.Equal(math.NewInt(1_000_000), pool.LowerTick0.ReservesMakerDenom)
+
+	resp, err := s.App.DexKeeper.PairFeeTiers(s.Ctx, &types.QueryPairFeeTiersRequest{PairId: "TokenA<>TokenB"})
+	s.NoError(err)
+	s.Equal(uint64(20), resp.VolatilityFee)
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// FlashSwapCore executes a multihop swap and sends its output to the calling contract before the input is paid.
+// The contract is then called back with a FlashSwapSudoMsg and has to hold amountIn plus the flash swap fee once the
+// call returns. Otherwise an error is returned and all state changes of the message are reverted.
+// The contract may execute further flash swaps from the callback up to Params.MaxFlashSwapDepth nested calls.
+func (k Keeper) FlashSwapCore(
+	goCtx context.Context,
+	amountIn math.Int,
+	routes []*types.MultiHopRoute,
+	exitLimitPrice math_utils.PrecDec,
+	pickBestRoute bool,
+	callerAddr sdk.AccAddress,
+	callbackData []byte,
+) (coinOut sdk.Coin, route []string, dust sdk.Coins, coinIn, fee sdk.Coin, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.contractKeeper == nil || !k.contractKeeper.HasContractInfo(ctx, callerAddr) {
+		return coinOut, route, dust, coinIn, fee, types.ErrFlashSwapCallerNotContract
+	}
+
+	params := k.GetParams(ctx)
+	depth := k.GetFlashSwapDepth(ctx)
+	if depth >= params.MaxFlashSwapDepth {
+		return coinOut, route, dust, coinIn, fee, types.ErrFlashSwapDepthExceeded.Wrapf("max depth is %d", params.MaxFlashSwapDepth)
+	}
+
+	bestRoute, initialInCoin, err := k.CalulateMultiHopSwap(ctx, amountIn, routes, exitLimitPrice, pickBestRoute, k.GetTakerFee(ctx, callerAddr))
+	if err != nil {
+		return coinOut, route, dust, coinIn, fee, err
+	}
+
+	bestRoute.write()
+
+	// Dispatch hooks before calling the contract, dex messages executed from the callback dispatch their own
+	if err := k.DispatchSwapHooks(ctx, callerAddr); err != nil {
+		return coinOut, route, dust, coinIn, fee, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		callerAddr,
+		bestRoute.dust.Add(bestRoute.coinOut),
+	)
+	if err != nil {
+		return coinOut, route, dust, coinIn, fee, err
+	}
+
+	fee = sdk.NewCoin(initialInCoin.Denom, CalcFlashSwapFee(amountIn, params.FlashSwapFee))
+	coinIn = initialInCoin.Add(fee)
+
+	msgBz, err := json.Marshal(types.FlashSwapSudoMsg{
+		FlashSwapCallback: &types.FlashSwapCallbackSudoMsg{
+			CoinOut:    CWCoinFromSDKCoin(bestRoute.coinOut),
+			Dust:       CWCoinsFromSDKCoins(bestRoute.dust),
+			Route:      bestRoute.route,
+			AmountOwed: CWCoinFromSDKCoin(coinIn),
+			Fee:        CWCoinFromSDKCoin(fee),
+			Data:       callbackData,
+		},
+	})
+	if err != nil {
+		return coinOut, route, dust, coinIn, fee, err
+	}
+
+	k.SetFlashSwapDepth(ctx, depth+1)
+	if _, err := k.contractKeeper.Sudo(ctx, callerAddr, msgBz); err != nil {
+		return coinOut, route, dust, coinIn, fee, err
+	}
+	k.SetFlashSwapDepth(ctx, depth)
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.ModuleName, sdk.Coins{initialInCoin})
+	if err != nil {
+		return coinOut, route, dust, coinIn, fee, types.ErrFlashSwapNotRepaid.Wrap(err.Error())
+	}
+
+	if fee.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, authtypes.FeeCollectorName, sdk.Coins{fee})
+		if err != nil {
+			return coinOut, route, dust, coinIn, fee, types.ErrFlashSwapNotRepaid.Wrap(err.Error())
+		}
+	}
+
+	ctx.EventManager().EmitEvent(types.CreateFlashSwapEvent(
+		callerAddr,
+		coinIn,
+		bestRoute.coinOut,
+		fee,
+		bestRoute.route,
+		bestRoute.dust,
+	))
+
+	return bestRoute.coinOut, bestRoute.route, bestRoute.dust, coinIn, fee, nil
+}
+
+// CalcFlashSwapFee returns the fee for a flash swap of amountIn, rounded up. feeBps is in basis points.
+func CalcFlashSwapFee(amountIn math.Int, feeBps uint64) math.Int {
+	denominator := math.NewIntFromUint64(types.MaxFlashSwapFee)
+	return amountIn.Mul(math.NewIntFromUint64(feeBps)).Add(denominator).SubRaw(1).Quo(denominator)
+}
+
+// GetFlashSwapDepth returns the number of flash swaps currently executing in the transaction
+func (k Keeper) GetFlashSwapDepth(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), []byte{})
+	bz := store.Get(types.KeyPrefix(types.FlashSwapDepthKey))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetFlashSwapDepth sets the number of flash swaps currently executing in the transaction. The depth is back to zero
+// once a flash swap completes; if it fails, the transient store changes are reverted together with the message.
+func (k Keeper) SetFlashSwapDepth(ctx sdk.Context, depth uint64) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, depth)
+	store.Set(types.KeyPrefix(types.FlashSwapDepthKey), bz)
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) setupFlashSwapContract() (*mockContractKeeper, sdk.AccAddress) {
+	contract := sdk.AccAddress([]byte("flash_contract"))
+	contractKeeper := &mockContractKeeper{}
+	s.App.DexKeeper.ReplaceContractKeeper(contractKeeper)
+	s.msgServer = dexkeeper.NewMsgServerImpl(s.App.DexKeeper)
+
+	return contractKeeper, contract
+}
+
+func (s *DexTestSuite) flashSwaps(ctx sdk.Context, contract sdk.AccAddress, amountIn int64) (*types.MsgFlashSwapResponse, error) {
+	return s.msgServer.FlashSwap(ctx, types.NewMsgFlashSwap(
+		contract.String(),
+		[][]string{{"TokenA", "TokenB"}},
+		math.NewInt(amountIn),
+		math_utils.MustNewPrecDecFromStr("0.9"),
+		false,
+		[]byte("data"),
+	))
+}
+
+func (s *DexTestSuite) TestFlashSwapRepaid() {
+	s.fundAliceBalances(0, 10)
+	s.aliceLimitSells("TokenB", 0, 10)
+	contractKeeper, contract := s.setupFlashSwapContract()
+
+	// GIVEN a contract that repays the flash swap from the callback
+	contractKeeper.sideFx = func(ctx sdk.Context) {
+		s.Equal(math.NewInt(5_000_000), s.App.BankKeeper.GetBalance(ctx, contract, "TokenB").Amount)
+		s.FundAcc(contract, sdk.NewCoins(sdk.NewInt64Coin("TokenA", 5_002_500)))
+	}
+
+	// WHEN it flash swaps 5 TokenA
+	resp, err := s.flashSwaps(s.Ctx, contract, 5_000_000)
+	s.NoError(err)
+
+	// THEN it keeps the output and pays the input plus a 5 bps fee
+	s.Equal(sdk.NewInt64Coin("TokenB", 5_000_000), resp.CoinOut)
+	s.Equal(sdk.NewInt64Coin("TokenA", 5_002_500), resp.CoinIn)
+	s.Equal(sdk.NewInt64Coin("TokenA", 2_500), resp.Fee)
+	s.Equal(math.ZeroInt(), s.App.BankKeeper.GetBalance(s.Ctx, contract, "TokenA").Amount)
+	s.Equal(math.NewInt(5_000_000), s.App.BankKeeper.GetBalance(s.Ctx, contract, "TokenB").Amount)
+	s.assertDexBalances(5, 5)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	s.Equal(math.NewInt(2_500), s.App.BankKeeper.GetBalance(s.Ctx, feeCollector, "TokenA").Amount)
+	s.Equal(uint64(0), s.App.DexKeeper.GetFlashSwapDepth(s.Ctx))
+
+	// AND the contract was called back with the amount owed
+	s.Len(contractKeeper.calls, 1)
+	var msg types.FlashSwapSudoMsg
+	s.NoError(json.Unmarshal(contractKeeper.calls[0], &msg))
+	s.Equal("5000000", msg.FlashSwapCallback.CoinOut.Amount)
+	s.Equal("5002500", msg.FlashSwapCallback.AmountOwed.Amount)
+	s.Equal("TokenA", msg.FlashSwapCallback.AmountOwed.Denom)
+	s.Equal([]string{"TokenA", "TokenB"}, msg.FlashSwapCallback.Route)
+	s.Equal([]byte("data"), msg.FlashSwapCallback.Data)
+}
+
+func (s *DexTestSuite) TestFlashSwapNotRepaidFails() {
+	s.fundAliceBalances(0, 10)
+	s.aliceLimitSells("TokenB", 0, 10)
+	contractKeeper, contract := s.setupFlashSwapContract()
+
+	// GIVEN a contract that only repays the input but not the fee
+	contractKeeper.sideFx = func(_ sdk.Context) {
+		s.FundAcc(contract, sdk.NewCoins(sdk.NewInt64Coin("TokenA", 5_000_000)))
+	}
+
+	// THEN the flash swap fails
+	_, err := s.flashSwaps(s.Ctx, contract, 5_000_000)
+	s.ErrorIs(err, types.ErrFlashSwapNotRepaid)
+}
+
+func (s *DexTestSuite) TestFlashSwapNotContractFails() {
+	s.fundAliceBalances(0, 10)
+	s.aliceLimitSells("TokenB", 0, 10)
+	contractKeeper, _ := s.setupFlashSwapContract()
+	contractKeeper.notContract = true
+
+	_, err := s.flashSwaps(s.Ctx, s.bob, 5_000_000)
+	s.ErrorIs(err, types.ErrFlashSwapCallerNotContract)
+	s.Empty(contractKeeper.calls)
+}
+
+func (s *DexTestSuite) TestFlashSwapMaxDepth() {
+	s.fundAliceBalances(0, 10)
+	s.aliceLimitSells("TokenB", 0, 10)
+	contractKeeper, contract := s.setupFlashSwapContract()
+
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.MaxFlashSwapDepth = 2
+	params.FlashSwapFee = 0
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	// GIVEN a contract that flash swaps again from every callback
+	var nestedErrs []error
+	contractKeeper.sideFx = func(ctx sdk.Context) {
+		_, err := s.flashSwaps(ctx, contract, 1_000_000)
+		nestedErrs = append(nestedErrs, err)
+		s.FundAcc(contract, sdk.NewCoins(sdk.NewInt64Coin("TokenA", 1_000_000)))
+	}
+
+	// WHEN it flash swaps
+	_, err := s.flashSwaps(s.Ctx, contract, 1_000_000)
+	s.NoError(err)
+
+	// THEN the second flash swap is nested and the third one exceeds the max depth
+	s.Len(contractKeeper.calls, 2)
+	s.Len(nestedErrs, 2)
+	s.ErrorIs(nestedErrs[0], types.ErrFlashSwapDepthExceeded)
+	s.NoError(nestedErrs[1])
+	s.Equal(uint64(0), s.App.DexKeeper.GetFlashSwapDepth(s.Ctx))
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) CandleAll(
+	goCtx context.Context,
+	req *types.QueryAllCandleRequest,
+) (*types.QueryAllCandleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, err
+	}
+
+	candleStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(pairID, req.Interval))
+
+	var candles []types.Candle
+	pageRes, err := query.FilteredPaginate(
+		candleStore,
+		req.Pagination,
+		func(_, value []byte, accum bool) (hit bool, err error) {
+			var candle types.Candle
+			if err := k.cdc.Unmarshal(value, &candle); err != nil {
+				return false, err
+			}
+			// Denoms may contain "/" so the prefix can also match pairs with a longer token1
+			if *candle.PairId != *pairID || candle.Interval != req.Interval {
+				return false, nil
+			}
+			if accum {
+				candles = append(candles, candle)
+			}
+
+			return true, nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllCandleResponse{Candles: candles, Pagination: pageRes}, nil
+}
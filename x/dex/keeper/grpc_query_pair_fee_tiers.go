@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) PairFeeTiers(
+	goCtx context.Context,
+	req *types.QueryPairFeeTiersRequest,
+) (*types.QueryPairFeeTiersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, isPairOverride := k.GetPairFeeTiers(ctx, pairID)
+
+	return &types.QueryPairFeeTiersResponse{
+		FeeTiers:       k.GetValidFees(ctx, pairID),
+		IsPairOverride: isPairOverride,
+		VolatilityFee:  k.GetVolatilityFee(ctx, pairID),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) PairTradingStatus(
+	goCtx context.Context,
+	req *types.QueryPairTradingStatusRequest,
+) (*types.QueryPairTradingStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPairTradingStatusResponse{
+		PairStatus:      k.GetPairTradingStatus(ctx, pairID),
+		Token0Status:    k.GetDenomTradingStatus(ctx, pairID.Token0),
+		Token1Status:    k.GetDenomTradingStatus(ctx, pairID.Token1),
+		EffectiveStatus: k.GetEffectiveTradingStatus(ctx, pairID),
+	}, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) UserPositionValue(
+	goCtx context.Context,
+	req *types.QueryUserPositionValueRequest,
+) (*types.QueryUserPositionValueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var poolPositions []types.PoolPositionValue
+	for _, record := range k.GetAllDepositsForAddress(ctx, addr) {
+		positionValue, found := k.GetPoolPositionValue(ctx, req.Address, record)
+		if found {
+			poolPositions = append(poolPositions, positionValue)
+		}
+	}
+
+	var limitOrderPositions []types.LimitOrderPositionValue
+	for _, trancheUser := range k.GetAllLimitOrderTrancheUserForAddress(ctx, addr) {
+		positionValue, found := k.GetLimitOrderPositionValue(ctx, trancheUser)
+		if found {
+			limitOrderPositions = append(limitOrderPositions, positionValue)
+		}
+	}
+
+	return &types.QueryUserPositionValueResponse{
+		PoolPositions:       poolPositions,
+		LimitOrderPositions: limitOrderPositions,
+	}, nil
+}
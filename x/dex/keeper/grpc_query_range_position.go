@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) RangePosition(
+	goCtx context.Context,
+	req *types.QueryGetRangePositionRequest,
+) (*types.QueryGetRangePositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetRangePosition(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRangePositionResponse{RangePosition: val}, nil
+}
+
+func (k Keeper) UserRangePositions(
+	goCtx context.Context,
+	req *types.QueryUserRangePositionsRequest,
+) (*types.QueryUserRangePositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var positions []types.RangePosition
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RangePositionOwnerPrefix(req.Address))
+
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(key, _ []byte) error {
+		position, found := k.GetRangePosition(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return types.ErrRangePositionNotFound
+		}
+
+		positions = append(positions, position)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUserRangePositionsResponse{RangePositions: positions, Pagination: pageRes}, nil
+}
@@ -29,6 +29,7 @@ func (k Keeper) SimulateMultiHopSwap(
 		msg.Routes,
 		msg.ExitLimitPrice,
 		msg.PickBestRoute,
+		k.GetTakerFee(cacheCtx, sdk.MustAccAddressFromBech32(msg.Creator)),
 	)
 	if err != nil {
 		return nil, err
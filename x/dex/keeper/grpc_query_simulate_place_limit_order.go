@@ -53,6 +53,7 @@ func (k Keeper) SimulatePlaceLimitOrder(
 		msg.MaxAmountOut,
 		msg.MinAverageSellPrice,
 		receiverAddr,
+		k.GetTakerFee(cacheCtx, sdk.MustAccAddressFromBech32(msg.Creator)),
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) TraderFees(
+	goCtx context.Context,
+	req *types.QueryTraderFeesRequest,
+) (*types.QueryTraderFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	tier, fees, isAssigned, _ := k.GetTraderFees(ctx, req.Address)
+
+	return &types.QueryTraderFeesResponse{
+		Tier:             tier,
+		TakerFee:         fees.TakerFee,
+		MakerRebate:      fees.MakerRebate,
+		Volume:           k.GetTraderVolume(ctx, req.Address),
+		IsAssigned:       isAssigned,
+		ClaimableRebates: k.GetMakerRebates(ctx, req.Address),
+	}, nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) TWAPOrder(
+	goCtx context.Context,
+	req *types.QueryGetTWAPOrderRequest,
+) (*types.QueryGetTWAPOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetTWAPOrder(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetTWAPOrderResponse{
+		TwapOrder: val,
+		Fills:     k.GetTWAPOrderFills(ctx, req.Id),
+	}, nil
+}
+
+func (k Keeper) TWAPOrderFills(
+	goCtx context.Context,
+	req *types.QueryTWAPOrderFillsRequest,
+) (*types.QueryTWAPOrderFillsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.OrderId >= k.GetTWAPOrderCount(ctx) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryTWAPOrderFillsResponse{
+		Fills: k.GetTWAPOrderFills(ctx, req.OrderId),
+	}, nil
+}
+
+func (k Keeper) UserTWAPOrders(
+	goCtx context.Context,
+	req *types.QueryUserTWAPOrdersRequest,
+) (*types.QueryUserTWAPOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var orders []types.TWAPOrder
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TWAPOrderCreatorPrefix(req.Address))
+
+	pageRes, err := query.Paginate(creatorStore, req.Pagination, func(key, _ []byte) error {
+		order, found := k.GetTWAPOrder(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return types.ErrTWAPOrderNotFound
+		}
+
+		orders = append(orders, order)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUserTWAPOrdersResponse{TwapOrders: orders, Pagination: pageRes}, nil
+}
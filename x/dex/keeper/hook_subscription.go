@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetHookSubscription subscribes a contract to dex hook sudo calls for a pair
+func (k Keeper) SetHookSubscription(ctx sdk.Context, pairID *types.PairID, contractAddr string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HookSubscriptionKeyPrefix))
+	b := k.cdc.MustMarshal(&types.HookSubscription{PairId: pairID, ContractAddress: contractAddr})
+	store.Set(types.HookSubscriptionKey(pairID, contractAddr), b)
+}
+
+// HasHookSubscription returns true if the contract is subscribed to dex hooks for the pair
+func (k Keeper) HasHookSubscription(ctx sdk.Context, pairID *types.PairID, contractAddr string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HookSubscriptionKeyPrefix))
+	return store.Has(types.HookSubscriptionKey(pairID, contractAddr))
+}
+
+// RemoveHookSubscription removes a contract's hook subscription for a pair
+func (k Keeper) RemoveHookSubscription(ctx sdk.Context, pairID *types.PairID, contractAddr string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HookSubscriptionKeyPrefix))
+	store.Delete(types.HookSubscriptionKey(pairID, contractAddr))
+}
+
+// GetHookSubscribers returns the addresses of all contracts subscribed to dex hooks for a pair
+func (k Keeper) GetHookSubscribers(ctx sdk.Context, pairID *types.PairID) (list []string) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefix(types.HookSubscriptionKeyPrefix), types.HookSubscriptionPairPrefix(pairID)...),
+	)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.HookSubscription
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		// Denoms may contain "/" so the prefix can also match pairs with a longer token1
+		if *val.PairId != *pairID {
+			continue
+		}
+		list = append(list, val.ContractAddress)
+	}
+
+	return
+}
+
+// GetAllHookSubscription returns all hook subscriptions
+func (k Keeper) GetAllHookSubscription(ctx sdk.Context) (list []types.HookSubscription) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HookSubscriptionKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.HookSubscription
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// Swaps and limit order fills happen deep inside liquidity iteration and, for multihop swaps, inside
+// branched contexts of which only the best route is written. Rather than calling hooks directly from Swap
+// they are recorded in the transient store and dispatched once the message has executed successfully.
+// Records written in a discarded branch (losing multihop routes, simulations) are discarded with it.
+
+// nextHookRecordID returns the next id for a transient hook record
+func (k Keeper) nextHookRecordID(ctx sdk.Context) []byte {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), []byte{})
+	byteKey := types.KeyPrefix(types.HookRecordCountKey)
+
+	var count uint64
+	if bz := store.Get(byteKey); bz != nil {
+		count = binary.BigEndian.Uint64(bz)
+	}
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count+1)
+	store.Set(byteKey, bz)
+
+	return bz
+}
+
+// RecordSwap saves a swap to be passed to DexHooks.AfterSwap
+func (k Keeper) RecordSwap(ctx sdk.Context, tradePairID *types.TradePairID, amountIn, amountOut math.Int) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.HookSwapRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&types.SwapRecord{
+		TradePairId: tradePairID,
+		AmountIn:    amountIn,
+		AmountOut:   amountOut,
+	})
+	store.Set(k.nextHookRecordID(ctx), b)
+}
+
+// RecordLimitOrderFill saves a limit order fill to be passed to DexHooks.AfterLimitOrderFilled
+func (k Keeper) RecordLimitOrderFill(ctx sdk.Context, trancheKey *types.LimitOrderTrancheKey, amountIn, amountOut math.Int) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.HookFillRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&types.LimitOrderFillRecord{
+		TrancheKey: trancheKey,
+		AmountIn:   amountIn,
+		AmountOut:  amountOut,
+	})
+	store.Set(k.nextHookRecordID(ctx), b)
+}
+
+// DispatchSwapHooks calls AfterLimitOrderFilled for every recorded limit order fill, followed by
+// AfterSwap for every recorded swap, and clears the records. The swaps are also kept for the block's candles,
+// whether or not hooks are set.
+func (k Keeper) DispatchSwapHooks(ctx sdk.Context, trader sdk.AccAddress) error {
+	fills := k.popLimitOrderFillRecords(ctx)
+	swaps := k.popSwapRecords(ctx)
+	k.RecordBlockTrades(ctx, swaps)
+	k.RecordTakerVolume(ctx, trader, swaps)
+
+	if k.hooks == nil {
+		return nil
+	}
+
+	for _, fill := range fills {
+		coinIn := sdk.NewCoin(fill.TrancheKey.TradePairId.TakerDenom, fill.AmountIn)
+		coinOut := sdk.NewCoin(fill.TrancheKey.TradePairId.MakerDenom, fill.AmountOut)
+		if err := k.hooks.AfterLimitOrderFilled(ctx, fill.TrancheKey, coinIn, coinOut); err != nil {
+			return err
+		}
+	}
+
+	for _, swap := range swaps {
+		coinIn := sdk.NewCoin(swap.TradePairId.TakerDenom, swap.AmountIn)
+		coinOut := sdk.NewCoin(swap.TradePairId.MakerDenom, swap.AmountOut)
+		if err := k.hooks.AfterSwap(ctx, trader, swap.TradePairId, coinIn, coinOut); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) popSwapRecords(ctx sdk.Context) (list []types.SwapRecord) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.HookSwapRecordKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SwapRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return list
+}
+
+func (k Keeper) popLimitOrderFillRecords(ctx sdk.Context) (list []types.LimitOrderFillRecord) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.HookFillRecordKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LimitOrderFillRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return list
+}
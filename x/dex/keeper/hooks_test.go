@@ -0,0 +1,344 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+type mockSwapCall struct {
+	trader      sdk.AccAddress
+	tradePairID types.TradePairID
+	coinIn      sdk.Coin
+	coinOut     sdk.Coin
+}
+
+type mockFillCall struct {
+	trancheKey string
+	coinIn     sdk.Coin
+	coinOut    sdk.Coin
+}
+
+type mockLiquidityCall struct {
+	pairID  types.PairID
+	amount0 math.Int
+	amount1 math.Int
+	shares  sdk.Coins
+}
+
+type mockDexHooks struct {
+	swaps     []mockSwapCall
+	fills     []mockFillCall
+	deposits  []mockLiquidityCall
+	withdraws []mockLiquidityCall
+	err       error
+}
+
+var _ types.DexHooks = &mockDexHooks{}
+
+func (h *mockDexHooks) AfterDeposit(
+	_ sdk.Context,
+	_, _ sdk.AccAddress,
+	pairID *types.PairID,
+	amount0, amount1 math.Int,
+	sharesIssued sdk.Coins,
+) error {
+	h.deposits = append(h.deposits, mockLiquidityCall{*pairID, amount0, amount1, sharesIssued})
+	return h.err
+}
+
+func (h *mockDexHooks) AfterWithdraw(
+	_ sdk.Context,
+	_, _ sdk.AccAddress,
+	pairID *types.PairID,
+	amount0, amount1 math.Int,
+	sharesBurned sdk.Coins,
+) error {
+	h.withdraws = append(h.withdraws, mockLiquidityCall{*pairID, amount0, amount1, sharesBurned})
+	return h.err
+}
+
+func (h *mockDexHooks) AfterSwap(_ sdk.Context, trader sdk.AccAddress, tradePairID *types.TradePairID, coinIn, coinOut sdk.Coin) error {
+	h.swaps = append(h.swaps, mockSwapCall{trader, *tradePairID, coinIn, coinOut})
+	return h.err
+}
+
+func (h *mockDexHooks) AfterLimitOrderFilled(_ sdk.Context, trancheKey *types.LimitOrderTrancheKey, coinIn, coinOut sdk.Coin) error {
+	h.fills = append(h.fills, mockFillCall{trancheKey.TrancheKey, coinIn, coinOut})
+	return h.err
+}
+
+func (s *DexTestSuite) setMockHooks() *mockDexHooks {
+	hooks := &mockDexHooks{}
+	s.App.DexKeeper.ReplaceHooks(hooks)
+	s.msgServer = dexkeeper.NewMsgServerImpl(s.App.DexKeeper)
+	return hooks
+}
+
+func (s *DexTestSuite) TestHooksAfterDepositAndWithdraw() {
+	s.fundAliceBalances(10, 10)
+	hooks := s.setMockHooks()
+
+	// WHEN alice deposits and withdraws
+	s.aliceDeposits(NewDeposit(10, 5, 0, 1))
+	s.aliceWithdraws(NewWithdrawal(15, 0, 1))
+
+	// THEN the hooks are called with the totals
+	pairID := types.PairID{Token0: "TokenA", Token1: "TokenB"}
+	s.Len(hooks.deposits, 1)
+	s.Equal(pairID, hooks.deposits[0].pairID)
+	s.Equal(math.NewInt(10_000_000), hooks.deposits[0].amount0)
+	s.Equal(math.NewInt(5_000_000), hooks.deposits[0].amount1)
+	s.Equal(math.NewInt(15_000_000), hooks.deposits[0].shares[0].Amount)
+
+	s.Len(hooks.withdraws, 1)
+	s.Equal(pairID, hooks.withdraws[0].pairID)
+	s.Equal(math.NewInt(10_000_000), hooks.withdraws[0].amount0)
+	s.Equal(math.NewInt(5_000_000), hooks.withdraws[0].amount1)
+	s.Equal(hooks.deposits[0].shares, hooks.withdraws[0].shares)
+}
+
+func (s *DexTestSuite) TestHooksAfterLimitOrderSwap() {
+	s.fundAliceBalances(20, 0)
+	s.fundBobBalances(0, 20)
+
+	// GIVEN two limit orders for TokenA
+	trancheKey0 := s.aliceLimitSells("TokenA", 0, 10)
+	trancheKey1 := s.aliceLimitSells("TokenA", 1, 10)
+	hooks := s.setMockHooks()
+
+	// WHEN bob swaps through both of them
+	s.bobLimitSells("TokenB", -5, 15, types.LimitOrderType_FILL_OR_KILL)
+
+	// THEN both fills and a single swap are reported
+	s.Len(hooks.fills, 2)
+	s.ElementsMatch([]string{trancheKey0, trancheKey1}, []string{hooks.fills[0].trancheKey, hooks.fills[1].trancheKey})
+	s.Equal("TokenB", hooks.fills[0].coinIn.Denom)
+	s.Equal("TokenA", hooks.fills[0].coinOut.Denom)
+	s.Equal(math.NewInt(15_000_000), hooks.fills[0].coinIn.Add(hooks.fills[1].coinIn).Amount)
+
+	s.Len(hooks.swaps, 1)
+	swap := hooks.swaps[0]
+	s.Equal(s.bob, swap.trader)
+	s.Equal(types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"}, swap.tradePairID)
+	s.Equal(hooks.fills[0].coinIn.Add(hooks.fills[1].coinIn), swap.coinIn)
+	s.Equal(hooks.fills[0].coinOut.Add(hooks.fills[1].coinOut), swap.coinOut)
+
+	// WHEN bob places a maker order that doesn't cross
+	hooks.swaps, hooks.fills = nil, nil
+	s.bobLimitSells("TokenB", 5, 1)
+
+	// THEN no hooks are called
+	s.Empty(hooks.swaps)
+	s.Empty(hooks.fills)
+}
+
+func (s *DexTestSuite) TestHooksMultiHopOnlyBestRoute() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN viable liquidity in pools but with a best route through E<>X
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 0, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, 0, 1),
+		NewPoolSetup("TokenC", "TokenX", 0, 1000, -1000, 1),
+		NewPoolSetup("TokenB", "TokenE", 0, 100, 0, 1),
+		NewPoolSetup("TokenE", "TokenX", 0, 1000, -3000, 1),
+	)
+	hooks := s.setMockHooks()
+
+	// WHEN alice multihopswaps with two routes
+	routes := [][]string{
+		{"TokenA", "TokenB", "TokenC", "TokenX"},
+		{"TokenA", "TokenB", "TokenE", "TokenX"},
+	}
+	s.aliceMultiHopSwaps(routes, 100, math_utils.MustNewPrecDecFromStr("0.9"), true)
+
+	// THEN only swaps on the executed route are reported
+	s.Len(hooks.swaps, 3)
+	s.Equal(types.TradePairID{MakerDenom: "TokenB", TakerDenom: "TokenA"}, hooks.swaps[0].tradePairID)
+	s.Equal(types.TradePairID{MakerDenom: "TokenE", TakerDenom: "TokenB"}, hooks.swaps[1].tradePairID)
+	s.Equal(types.TradePairID{MakerDenom: "TokenX", TakerDenom: "TokenE"}, hooks.swaps[2].tradePairID)
+	s.Equal(math.NewInt(134_943_366), hooks.swaps[2].coinOut.Amount)
+	s.Empty(hooks.fills)
+}
+
+func (s *DexTestSuite) TestHooksSimulateDoesNotDispatch() {
+	s.fundAliceBalances(10, 0)
+	s.aliceLimitSells("TokenA", 0, 10)
+	hooks := s.setMockHooks()
+
+	// WHEN a swap is simulated
+	_, err := s.App.DexKeeper.SimulatePlaceLimitOrder(s.Ctx, &types.QuerySimulatePlaceLimitOrderRequest{
+		Msg: &types.MsgPlaceLimitOrder{
+			Creator:          s.bob.String(),
+			Receiver:         s.bob.String(),
+			TokenIn:          "TokenB",
+			TokenOut:         "TokenA",
+			TickIndexInToOut: 5,
+			AmountIn:         math.NewInt(5_000_000),
+			OrderType:        types.LimitOrderType_FILL_OR_KILL,
+		},
+	})
+	s.NoError(err)
+
+	// AND a real swap happens afterwards
+	s.fundBobBalances(0, 5)
+	s.bobLimitSells("TokenB", -5, 5, types.LimitOrderType_FILL_OR_KILL)
+
+	// THEN only the real swap is reported
+	s.Len(hooks.swaps, 1)
+	s.Len(hooks.fills, 1)
+}
+
+func (s *DexTestSuite) TestHooksErrorFailsMsg() {
+	s.fundAliceBalances(10, 0)
+	hooks := s.setMockHooks()
+	hooks.err = errors.New("hook failed")
+
+	s.assertAliceDepositFails(hooks.err, NewDeposit(10, 0, 0, 1))
+}
+
+type mockContractKeeper struct {
+	calls       []json.RawMessage
+	gasUsed     uint64
+	err         error
+	sideFx      func(ctx sdk.Context)
+	notContract bool
+}
+
+func (m *mockContractKeeper) Sudo(ctx context.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	m.calls = append(m.calls, msg)
+	if m.sideFx != nil {
+		m.sideFx(sdkCtx)
+	}
+	sdkCtx.GasMeter().ConsumeGas(m.gasUsed, "mock contract")
+	return nil, m.err
+}
+
+func (m *mockContractKeeper) HasContractInfo(_ context.Context, _ sdk.AccAddress) bool {
+	return !m.notContract
+}
+
+func (s *DexTestSuite) setupWasmHooks(subscribe bool) (*mockContractKeeper, sdk.AccAddress) {
+	contract := sdk.AccAddress([]byte("hook_contract"))
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.WhitelistedHookSubscribers = []string{contract.String()}
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	contractKeeper := &mockContractKeeper{}
+	s.App.DexKeeper.ReplaceHooks(dexkeeper.NewWasmHooks(s.App.DexKeeper, contractKeeper))
+	s.msgServer = dexkeeper.NewMsgServerImpl(s.App.DexKeeper)
+
+	if subscribe {
+		_, err := s.msgServer.SubscribeHooks(s.Ctx, types.NewMsgSubscribeHooks(contract.String(), "TokenA", "TokenB"))
+		s.NoError(err)
+	}
+
+	return contractKeeper, contract
+}
+
+func (s *DexTestSuite) TestWasmHooksSudo() {
+	s.fundAliceBalances(10, 0)
+	contractKeeper, _ := s.setupWasmHooks(true)
+
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+
+	s.Len(contractKeeper.calls, 1)
+	var msg types.DexHookSudoMsg
+	s.NoError(json.Unmarshal(contractKeeper.calls[0], &msg))
+	s.NotNil(msg.AfterDeposit)
+	s.Equal("TokenA<>TokenB", msg.AfterDeposit.PairID)
+	s.Equal("10000000", msg.AfterDeposit.Amount0)
+	s.Equal(s.alice.String(), msg.AfterDeposit.Depositor)
+}
+
+func (s *DexTestSuite) TestWasmHooksNotSubscribed() {
+	s.fundAliceBalances(10, 10)
+	contractKeeper, _ := s.setupWasmHooks(false)
+
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	s.Empty(contractKeeper.calls)
+}
+
+func (s *DexTestSuite) TestWasmHooksRemovedFromWhitelist() {
+	s.fundAliceBalances(10, 0)
+	contractKeeper, _ := s.setupWasmHooks(true)
+
+	// WHEN the contract is removed from the whitelist
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.WhitelistedHookSubscribers = []string{}
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	// THEN it is no longer called
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+	s.Empty(contractKeeper.calls)
+}
+
+func (s *DexTestSuite) TestWasmHooksErrorIsDiscarded() {
+	s.fundAliceBalances(10, 0)
+	contractKeeper, _ := s.setupWasmHooks(true)
+	pairID := types.MustNewPairID("TokenC", "TokenD")
+
+	// GIVEN a contract that modifies state and then fails
+	contractKeeper.err = errors.New("contract failed")
+	contractKeeper.sideFx = func(ctx sdk.Context) {
+		s.App.DexKeeper.SetPairTradingStatus(ctx, pairID, types.TradingStatus_HALTED)
+	}
+
+	// WHEN alice deposits THEN the deposit succeeds
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+	s.assertAliceBalances(0, 0)
+
+	// AND the state changes made by the contract are reverted
+	s.Len(contractKeeper.calls, 1)
+	s.Equal(types.TradingStatus_ACTIVE, s.App.DexKeeper.GetPairTradingStatus(s.Ctx, pairID))
+}
+
+func (s *DexTestSuite) TestWasmHooksOutOfGasIsDiscarded() {
+	s.fundAliceBalances(10, 0)
+	contractKeeper, _ := s.setupWasmHooks(true)
+
+	// GIVEN a contract that uses more gas than the hook gas limit
+	contractKeeper.gasUsed = types.DefaultHookGasLimit + 1
+	gasBefore := s.Ctx.GasMeter().GasConsumed()
+
+	// WHEN alice deposits THEN the deposit succeeds
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+	s.assertAliceBalances(0, 0)
+
+	// AND the gas used by the contract is capped at the limit
+	gasUsed := s.Ctx.GasMeter().GasConsumed() - gasBefore
+	s.GreaterOrEqual(gasUsed, types.DefaultHookGasLimit)
+	s.Less(gasUsed, 2*types.DefaultHookGasLimit)
+}
+
+func (s *DexTestSuite) TestSubscribeHooksNotWhitelisted() {
+	s.setupWasmHooks(false)
+	other := sdk.AccAddress([]byte("other_contract"))
+
+	_, err := s.msgServer.SubscribeHooks(s.Ctx, types.NewMsgSubscribeHooks(other.String(), "TokenA", "TokenB"))
+	s.ErrorIs(err, types.ErrHookSubscriberNotWhitelisted)
+}
+
+func (s *DexTestSuite) TestUnsubscribeHooks() {
+	s.fundAliceBalances(10, 0)
+	contractKeeper, contract := s.setupWasmHooks(true)
+
+	_, err := s.msgServer.UnsubscribeHooks(s.Ctx, types.NewMsgUnsubscribeHooks(contract.String(), "TokenB", "TokenA"))
+	s.NoError(err)
+
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+	s.Empty(contractKeeper.calls)
+
+	_, err = s.msgServer.UnsubscribeHooks(s.Ctx, types.NewMsgUnsubscribeHooks(contract.String(), "TokenA", "TokenB"))
+	s.ErrorIs(err, types.ErrHookSubscriptionNotFound)
+}
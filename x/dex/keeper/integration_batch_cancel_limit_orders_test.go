@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) aliceBatchCancelsLimitSells(trancheKeys ...string) *types.MsgBatchCancelLimitOrdersResponse {
+	resp, err := s.msgServer.BatchCancelLimitOrders(s.Ctx, types.NewMsgBatchCancelLimitOrders(s.alice.String(), trancheKeys))
+	s.Assert().NoError(err)
+	return resp
+}
+
+func (s *DexTestSuite) aliceBatchCancelsLimitSellsForPair(pairID, tokenIn string) *types.MsgBatchCancelLimitOrdersResponse {
+	resp, err := s.msgServer.BatchCancelLimitOrders(
+		s.Ctx,
+		types.NewMsgBatchCancelLimitOrdersForPair(s.alice.String(), pairID, tokenIn),
+	)
+	s.Assert().NoError(err)
+	return resp
+}
+
+func (s *DexTestSuite) TestBatchCancelLimitOrdersByTrancheKeys() {
+	s.fundAliceBalances(50, 50)
+	// GIVEN alice has three limit orders
+	trancheKey0 := s.aliceLimitSells("TokenA", 0, 10)
+	trancheKey1 := s.aliceLimitSells("TokenA", 1, 10)
+	trancheKey2 := s.aliceLimitSells("TokenB", 5, 10)
+	s.assertAliceBalances(30, 40)
+
+	// WHEN alice cancels two of them
+	resp := s.aliceBatchCancelsLimitSells(trancheKey0, trancheKey2)
+
+	// THEN both are canceled and the remaining order is untouched
+	s.Equal([]string{trancheKey0, trancheKey2}, resp.TrancheKeys)
+	s.True(resp.TakerCoinsOut.IsZero())
+	s.Equal("10000000TokenA,10000000TokenB", resp.MakerCoinsOut.String())
+	s.assertAliceBalances(40, 50)
+	s.assertDexBalances(10, 0)
+
+	_, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), trancheKey0)
+	s.False(found)
+	_, found = s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), trancheKey1)
+	s.True(found)
+	_, found = s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), trancheKey2)
+	s.False(found)
+}
+
+func (s *DexTestSuite) TestBatchCancelLimitOrdersUnknownTrancheKeyFails() {
+	s.fundAliceBalances(50, 50)
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	_, err := s.msgServer.BatchCancelLimitOrders(
+		s.Ctx,
+		types.NewMsgBatchCancelLimitOrders(s.alice.String(), []string{trancheKey, "BADKEY"}),
+	)
+	s.ErrorIs(err, types.ErrValidLimitOrderTrancheNotFound)
+}
+
+func (s *DexTestSuite) TestBatchCancelLimitOrdersForPair() {
+	s.fundAliceBalances(50, 50)
+	s.fundBobBalances(50, 50)
+	// GIVEN alice has orders on both sides of the book and bob has an order
+	s.aliceLimitSells("TokenA", 0, 10)
+	s.aliceLimitSells("TokenA", 1, 10)
+	s.aliceLimitSells("TokenB", 5, 10)
+	bobTrancheKey := s.bobLimitSells("TokenA", 0, 10)
+
+	// WHEN alice cancels all of her orders for the pair
+	resp := s.aliceBatchCancelsLimitSellsForPair("TokenA<>TokenB", "")
+
+	// THEN all of alice's orders are canceled and bob's order is untouched
+	s.Len(resp.TrancheKeys, 3)
+	s.assertAliceBalances(50, 50)
+	s.assertDexBalances(10, 0)
+	s.Empty(s.App.DexKeeper.GetAllLimitOrderTrancheUserForAddress(s.Ctx, s.alice))
+	_, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.bob.String(), bobTrancheKey)
+	s.True(found)
+}
+
+func (s *DexTestSuite) TestBatchCancelLimitOrdersForPairOneSide() {
+	s.fundAliceBalances(50, 50)
+	// GIVEN alice has orders on both sides of the book
+	s.aliceLimitSells("TokenA", 0, 10)
+	s.aliceLimitSells("TokenA", 1, 10)
+	trancheKeyB := s.aliceLimitSells("TokenB", 5, 10)
+
+	// WHEN alice cancels only her TokenA orders
+	resp := s.aliceBatchCancelsLimitSellsForPair("TokenA<>TokenB", "TokenA")
+
+	// THEN only the TokenA orders are canceled
+	s.Len(resp.TrancheKeys, 2)
+	s.assertAliceBalances(50, 40)
+	s.assertDexBalances(0, 10)
+	trancheUsers := s.App.DexKeeper.GetAllLimitOrderTrancheUserForAddress(s.Ctx, s.alice)
+	s.Len(trancheUsers, 1)
+	s.Equal(trancheKeyB, trancheUsers[0].TrancheKey)
+}
+
+func (s *DexTestSuite) TestBatchCancelLimitOrdersForPairIgnoresWithdrawnOrders() {
+	s.fundAliceBalances(50, 50)
+	s.fundBobBalances(50, 50)
+	// GIVEN alice has one order that has been completely filled and withdrawn
+	// and a second order that is still open
+	filledTrancheKey := s.aliceLimitSells("TokenA", 0, 10)
+	openTrancheKey := s.aliceLimitSells("TokenB", 5, 10)
+	s.bobLimitSells("TokenB", -10, 10, types.LimitOrderType_FILL_OR_KILL)
+	s.aliceWithdrawsLimitSell(filledTrancheKey)
+
+	// WHEN alice cancels all of her orders for the pair
+	resp := s.aliceBatchCancelsLimitSellsForPair("TokenA<>TokenB", "")
+
+	// THEN only the open order is canceled
+	s.Equal([]string{openTrancheKey}, resp.TrancheKeys)
+	s.assertAliceBalances(40, 60)
+}
+
+func (s *DexTestSuite) TestBatchCancelLimitOrdersValidation() {
+	for _, tc := range []struct {
+		name string
+		msg  *types.MsgBatchCancelLimitOrders
+	}{
+		{
+			name: "nothing selected",
+			msg:  &types.MsgBatchCancelLimitOrders{Creator: s.alice.String()},
+		},
+		{
+			name: "tranche keys and pair",
+			msg: &types.MsgBatchCancelLimitOrders{
+				Creator:     s.alice.String(),
+				TrancheKeys: []string{"KEY"},
+				PairId:      "TokenA<>TokenB",
+			},
+		},
+		{
+			name: "duplicate tranche keys",
+			msg: &types.MsgBatchCancelLimitOrders{
+				Creator:     s.alice.String(),
+				TrancheKeys: []string{"KEY", "KEY"},
+			},
+		},
+		{
+			name: "token in not in pair",
+			msg: &types.MsgBatchCancelLimitOrders{
+				Creator: s.alice.String(),
+				PairId:  "TokenA<>TokenB",
+				TokenIn: "TokenC",
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.BatchCancelLimitOrders(s.Ctx, tc.msg)
+			s.ErrorIs(err, types.ErrInvalidBatchCancel)
+		})
+	}
+}
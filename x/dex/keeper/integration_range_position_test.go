@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) aliceDepositsRange(
+	amountA, amountB int64,
+	lowerTick, upperTick int64,
+	tickSpacing, fee uint64,
+	shape types.DistributionShape,
+) *types.MsgDepositRangeResponse {
+	resp, err := s.msgServer.DepositRange(s.Ctx, types.NewMsgDepositRange(
+		s.alice.String(),
+		s.alice.String(),
+		"TokenA",
+		"TokenB",
+		sdkmath.NewInt(amountA).Mul(denomMultiple),
+		sdkmath.NewInt(amountB).Mul(denomMultiple),
+		lowerTick,
+		upperTick,
+		tickSpacing,
+		fee,
+		shape,
+		&types.DepositOptions{},
+	))
+	s.Assert().NoError(err)
+	return resp
+}
+
+func (s *DexTestSuite) TestDepositRangeUniform() {
+	s.fundAliceBalances(10, 0)
+
+	// WHEN alice deposits 10 TokenA uniformly over 5 ticks
+	resp := s.aliceDepositsRange(10, 0, -20, 20, 10, 1, types.DistributionShape_UNIFORM)
+
+	// THEN every tick gets the same amount and a position holds the shares
+	s.Equal(uint64(0), resp.PositionId)
+	s.Equal(sdkmath.NewInt(10_000_000), resp.Reserve0Deposited)
+	s.Len(resp.SharesIssued, 5)
+	s.assertAliceBalances(0, 0)
+	s.assertDexBalances(10, 0)
+
+	for _, tick := range []int64{-20, -10, 0, 10, 20} {
+		liquidityA, _ := s.getLiquidityAtTick(tick, 1)
+		s.Equal(sdkmath.NewInt(2_000_000), liquidityA)
+	}
+
+	position, found := s.App.DexKeeper.GetRangePosition(s.Ctx, resp.PositionId)
+	s.True(found)
+	s.Equal(s.alice.String(), position.Owner)
+	s.Equal(resp.SharesIssued, position.Shares)
+
+	// the shares are held by the dex, not alice
+	for _, share := range position.Shares {
+		s.True(s.App.BankKeeper.GetBalance(s.Ctx, s.alice, share.Denom).IsZero())
+	}
+}
+
+func (s *DexTestSuite) TestDepositRangeGaussian() {
+	s.fundAliceBalances(16, 0)
+
+	// WHEN alice deposits 16 TokenA over 5 ticks with a gaussian shape
+	s.aliceDepositsRange(16, 0, -20, 20, 10, 1, types.DistributionShape_GAUSSIAN)
+
+	// THEN liquidity follows the 1-4-6-4-1 binomial weights
+	for i, tick := range []int64{-20, -10, 0, 10, 20} {
+		expected := []int64{1, 4, 6, 4, 1}[i]
+		liquidityA, _ := s.getLiquidityAtTick(tick, 1)
+		s.Equal(sdkmath.NewInt(expected).Mul(denomMultiple), liquidityA)
+	}
+}
+
+func (s *DexTestSuite) TestWithdrawRangePosition() {
+	s.fundAliceBalances(9, 0)
+	resp := s.aliceDepositsRange(9, 0, -20, 20, 10, 1, types.DistributionShape_LINEAR)
+	s.assertDexBalances(9, 0)
+
+	// WHEN alice withdraws the whole position
+	withdrawResp, err := s.msgServer.WithdrawRangePosition(
+		s.Ctx,
+		types.NewMsgWithdrawRangePosition(s.alice.String(), s.alice.String(), resp.PositionId),
+	)
+	s.NoError(err)
+
+	// THEN she gets all of her liquidity back and the position is gone
+	s.Equal(sdkmath.NewInt(9_000_000), withdrawResp.Reserve0Withdrawn)
+	s.Equal(resp.SharesIssued, withdrawResp.SharesBurned)
+	s.assertAliceBalances(9, 0)
+	s.assertDexBalances(0, 0)
+
+	_, found := s.App.DexKeeper.GetRangePosition(s.Ctx, resp.PositionId)
+	s.False(found)
+}
+
+func (s *DexTestSuite) TestWithdrawRangePositionNotOwnerFails() {
+	s.fundAliceBalances(10, 0)
+	resp := s.aliceDepositsRange(10, 0, -20, 20, 10, 1, types.DistributionShape_UNIFORM)
+
+	// WHEN bob tries to withdraw alice's position
+	_, err := s.msgServer.WithdrawRangePosition(
+		s.Ctx,
+		types.NewMsgWithdrawRangePosition(s.bob.String(), s.bob.String(), resp.PositionId),
+	)
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrNotRangePositionOwner)
+	s.assertDexBalances(10, 0)
+}
+
+func (s *DexTestSuite) TestRebalanceRangePosition() {
+	s.fundAliceBalances(10, 0)
+	resp := s.aliceDepositsRange(10, 0, -20, 20, 10, 1, types.DistributionShape_UNIFORM)
+
+	// WHEN alice moves the position to a narrower range
+	rebalanceResp, err := s.msgServer.RebalanceRangePosition(
+		s.Ctx,
+		types.NewMsgRebalanceRangePosition(
+			s.alice.String(),
+			resp.PositionId,
+			100,
+			110,
+			10,
+			types.DistributionShape_UNIFORM,
+			&types.DepositOptions{},
+		),
+	)
+	s.NoError(err)
+
+	// THEN the liquidity is moved and the position keeps its id
+	s.Equal(sdkmath.NewInt(10_000_000), rebalanceResp.Reserve0Deposited)
+	s.assertAliceBalances(0, 0)
+	s.assertDexBalances(10, 0)
+
+	liquidityA, _ := s.getLiquidityAtTick(0, 1)
+	s.True(liquidityA.IsZero())
+	liquidityA, _ = s.getLiquidityAtTick(100, 1)
+	s.Equal(sdkmath.NewInt(5_000_000), liquidityA)
+
+	position, found := s.App.DexKeeper.GetRangePosition(s.Ctx, resp.PositionId)
+	s.True(found)
+	s.Equal(int64(100), position.LowerTickIndex)
+	s.Equal(int64(110), position.UpperTickIndex)
+	s.Equal(rebalanceResp.SharesIssued, position.Shares)
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) aliceReplacesLimitSell(
+	trancheKey string,
+	amountIn *sdkmath.Int,
+	price *math_utils.PrecDec,
+	goodTil *time.Time,
+) *types.MsgReplaceLimitOrderResponse {
+	resp, err := s.msgServer.ReplaceLimitOrder(
+		s.Ctx,
+		types.NewMsgReplaceLimitOrder(s.alice.String(), trancheKey, amountIn, price, goodTil),
+	)
+	s.Assert().NoError(err)
+	return resp
+}
+
+func (s *DexTestSuite) TestReplaceLimitOrderNewAmount() {
+	s.fundAliceBalances(50, 50)
+	// GIVEN alice has a limit order for 10 TokenA
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN she replaces it with an order for 20 TokenA
+	amountIn := sdkmath.NewInt(20).Mul(denomMultiple)
+	resp := s.aliceReplacesLimitSell(trancheKey, &amountIn, nil, nil)
+
+	// THEN the original order is canceled and a new one is placed at the same price
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), resp.CanceledMakerCoinOut.Amount)
+	s.Equal(amountIn, resp.CoinIn.Amount)
+	s.assertAliceBalances(30, 50)
+	s.assertDexBalances(20, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 20)
+
+	trancheUser, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), resp.TrancheKey)
+	s.True(found)
+	s.Equal(types.LimitOrderType_GOOD_TIL_CANCELLED, trancheUser.OrderType)
+}
+
+func (s *DexTestSuite) TestReplaceLimitOrderNewPrice() {
+	s.fundAliceBalances(50, 50)
+	s.fundBobBalances(50, 50)
+	// GIVEN alice has a limit order for 10 TokenA
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN she replaces it with a new price
+	price := math_utils.MustNewPrecDecFromStr("2")
+	resp := s.aliceReplacesLimitSell(trancheKey, nil, &price, nil)
+
+	// THEN the full amount is re-placed at the new price
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), resp.CoinIn.Amount)
+	s.assertAliceBalances(40, 50)
+	s.assertDexBalances(10, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+
+	// AND an order placed at the same price joins the same tranche
+	bobResp, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:        s.bob.String(),
+		Receiver:       s.bob.String(),
+		TokenIn:        "TokenA",
+		TokenOut:       "TokenB",
+		AmountIn:       sdkmath.NewInt(10).Mul(denomMultiple),
+		OrderType:      types.LimitOrderType_GOOD_TIL_CANCELLED,
+		LimitSellPrice: &price,
+	})
+	s.NoError(err)
+	s.Equal(resp.TrancheKey, bobResp.TrancheKey)
+}
+
+func (s *DexTestSuite) TestReplaceLimitOrderKeepsExpiration() {
+	s.fundAliceBalances(50, 50)
+	// GIVEN alice has a GoodTil limit order
+	goodTil := s.Ctx.BlockTime().Add(time.Hour)
+	trancheKey := s.aliceLimitSellsGoodTil("TokenA", 0, 10, goodTil)
+
+	// WHEN she replaces it without a new expiration time
+	amountIn := sdkmath.NewInt(5).Mul(denomMultiple)
+	resp := s.aliceReplacesLimitSell(trancheKey, &amountIn, nil, nil)
+
+	// THEN the new order keeps the original expiration
+	trancheUser, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), resp.TrancheKey)
+	s.True(found)
+	s.Equal(types.LimitOrderType_GOOD_TIL_TIME, trancheUser.OrderType)
+	tranche, _, found := s.App.DexKeeper.FindLimitOrderTranche(s.Ctx, &types.LimitOrderTrancheKey{
+		TradePairId:           trancheUser.TradePairId,
+		TickIndexTakerToMaker: trancheUser.TickIndexTakerToMaker,
+		TrancheKey:            resp.TrancheKey,
+	})
+	s.True(found)
+	s.Equal(goodTil.Unix(), tranche.ExpirationTime.Unix())
+	s.assertAliceBalances(45, 50)
+}
+
+func (s *DexTestSuite) TestReplaceLimitOrderExpirationOnWrongOrderTypeFails() {
+	s.fundAliceBalances(50, 50)
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	goodTil := s.Ctx.BlockTime().Add(time.Hour)
+	_, err := s.msgServer.ReplaceLimitOrder(
+		s.Ctx,
+		types.NewMsgReplaceLimitOrder(s.alice.String(), trancheKey, nil, nil, &goodTil),
+	)
+	s.ErrorIs(err, types.ErrExpirationOnWrongOrderType)
+}
+
+func (s *DexTestSuite) TestReplaceLimitOrderNotFoundFails() {
+	_, err := s.msgServer.ReplaceLimitOrder(
+		s.Ctx,
+		types.NewMsgReplaceLimitOrder(s.alice.String(), "BADKEY", nil, nil, nil),
+	)
+	s.ErrorIs(err, types.ErrValidLimitOrderTrancheNotFound)
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// RegisterInvariants registers all dex invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pool-shares", PoolSharesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the dex module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return PoolSharesInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks that the dex module account holds enough of every denom to cover the reserves of all
+// pools, the maker and taker reserves of all active and inactive limit order tranches, the taker surcharges held for
+// makers and the unclaimed maker rebates. Rounding always favors the dex, so the balance may exceed the reserves by a
+// small amount of dust.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expectedBalance := sdk.NewCoins()
+
+		for _, tick := range k.GetAllTickLiquidity(ctx) {
+			switch liquidity := tick.Liquidity.(type) {
+			case *types.TickLiquidity_PoolReserves:
+				reserves := liquidity.PoolReserves
+				expectedBalance = expectedBalance.Add(
+					sdk.NewCoin(reserves.Key.TradePairId.MakerDenom, reserves.ReservesMakerDenom),
+				)
+			case *types.TickLiquidity_LimitOrderTranche:
+				expectedBalance = expectedBalance.Add(trancheReserves(liquidity.LimitOrderTranche)...)
+			}
+		}
+
+		for _, tranche := range k.GetAllInactiveLimitOrderTranche(ctx) {
+			expectedBalance = expectedBalance.Add(trancheReserves(tranche)...)
+		}
+
+		for _, pool := range k.GetAllTrancheRebatePool(ctx) {
+			expectedBalance = expectedBalance.Add(pool.Surcharges)
+		}
+
+		for _, rebates := range k.GetAllMakerRebates(ctx) {
+			expectedBalance = expectedBalance.Add(rebates.Rebates...)
+		}
+
+		for _, order := range k.GetAllTWAPOrder(ctx) {
+			expectedBalance = expectedBalance.Add(sdk.NewCoin(order.TokenIn, order.RemainingIn))
+		}
+
+		for _, deposit := range k.GetAllExpirationDeposit(ctx) {
+			expectedBalance = expectedBalance.Add(deposit.Deposit...)
+		}
+
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+		var msg string
+		broken := false
+		for _, coin := range expectedBalance {
+			balance := k.bankKeeper.GetBalance(ctx, moduleAddr, coin.Denom)
+			if balance.IsLT(coin) {
+				broken = true
+				msg += fmt.Sprintf("\tdex module balance of %s is %s but reserves are %s\n", coin.Denom, balance.Amount, coin.Amount)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-balance",
+			fmt.Sprintf("dex module balance does not cover reserves\n%s", msg),
+		), broken
+	}
+}
+
+// PoolSharesInvariant checks that a pool has outstanding shares if and only if it has reserves
+func PoolSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		for _, poolMetadata := range k.GetAllPoolMetadata(ctx) {
+			pool, found := k.GetPoolByID(ctx, poolMetadata.Id)
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("\tpool %d has metadata but no reserves\n", poolMetadata.Id)
+				continue
+			}
+
+			supply := k.bankKeeper.GetSupply(ctx, pool.GetPoolDenom()).Amount
+			isEmpty := pool.LowerTick0.ReservesMakerDenom.IsZero() && pool.UpperTick1.ReservesMakerDenom.IsZero()
+			if supply.IsZero() != isEmpty {
+				broken = true
+				msg += fmt.Sprintf(
+					"\tpool %d has share supply %s but reserves %s %s\n",
+					pool.Id,
+					supply,
+					pool.LowerTick0.ReservesMakerDenom,
+					pool.UpperTick1.ReservesMakerDenom,
+				)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "pool-shares",
+			fmt.Sprintf("pool share supply does not match pool reserves\n%s", msg),
+		), broken
+	}
+}
+
+func trancheReserves(tranche *types.LimitOrderTranche) sdk.Coins {
+	tradePairID := tranche.Key.TradePairId
+	return sdk.NewCoins(
+		sdk.NewCoin(tradePairID.MakerDenom, tranche.ReservesMakerDenom),
+		sdk.NewCoin(tradePairID.TakerDenom, tranche.ReservesTakerDenom),
+	)
+}
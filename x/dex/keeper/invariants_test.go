@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) TestInvariantsHold() {
+	s.fundAliceBalances(50, 50)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN a pool and a partially filled limit order
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+	s.aliceLimitSells("TokenA", -1, 10)
+	s.bobLimitSells("TokenB", -10, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN all invariants hold
+	msg, broken := dexkeeper.AllInvariants(s.App.DexKeeper)(s.Ctx)
+	s.False(broken, msg)
+}
+
+func (s *DexTestSuite) TestModuleBalanceInvariantBroken() {
+	s.fundAliceBalances(10, 0)
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+
+	// WHEN funds leave the dex without touching the reserves
+	err := s.App.BankKeeper.SendCoinsFromModuleToAccount(
+		s.Ctx,
+		types.ModuleName,
+		s.bob,
+		sdk.NewCoins(sdk.NewCoin("TokenA", denomMultiple)),
+	)
+	s.NoError(err)
+
+	// THEN the module balance invariant is broken
+	_, broken := dexkeeper.ModuleBalanceInvariant(s.App.DexKeeper)(s.Ctx)
+	s.True(broken)
+}
@@ -19,6 +19,9 @@ type (
 		tKey       storetypes.StoreKey
 		bankKeeper types.BankKeeper
 		authority  string
+		hooks      types.DexHooks
+		// contractKeeper is used to call back contracts executing flash swaps and receiving auto-settled limit orders
+		contractKeeper types.ContractKeeper
 	}
 )
 
@@ -47,3 +50,26 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
+
+// SetHooks sets the hooks called after dex liquidity events. It can only be called once.
+func (k *Keeper) SetHooks(dh types.DexHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set dex hooks twice")
+	}
+
+	k.hooks = dh
+
+	return k
+}
+
+// SetContractKeeper sets the keeper used to call back contracts executing flash swaps and receiving auto-settled
+// limit orders. It can only be called once.
+func (k *Keeper) SetContractKeeper(ck types.ContractKeeper) *Keeper {
+	if k.contractKeeper != nil {
+		panic("cannot set dex contract keeper twice")
+	}
+
+	k.contractKeeper = ck
+
+	return k
+}
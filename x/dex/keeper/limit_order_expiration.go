@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"time"
 
 	"cosmossdk.io/store/prefix"
@@ -136,25 +137,198 @@ func (k Keeper) PurgeExpiredLimitOrders(ctx sdk.Context, curTime time.Time) {
 			// This is ok since only GT limit orders pose a meaningful attack
 			// vector since there is no upper bound on how many GT limit orders can be
 			// canceled in a single block.
+			// Whatever is left over can be purged by anyone with MsgPurgeExpiredOrders.
 			ctx.EventManager().EmitEvent(types.GoodTilPurgeHitLimitEvent(gasConsumed))
 
 			return
 		}
-		var pairID types.TradePairID
-		if _, ok := archivedTranches[string(val.TrancheRef)]; !ok {
-			tranche, found := k.GetLimitOrderTrancheByKey(ctx, val.TrancheRef)
-			if found {
-				// Convert the tranche to an inactiveTranche
-				k.SetInactiveLimitOrderTranche(ctx, tranche)
-				k.RemoveLimitOrderTranche(ctx, tranche.Key)
-				archivedTranches[string(val.TrancheRef)] = true
-
-				pairID = *tranche.Key.TradePairId
-				ctx.EventManager().EmitEvent(types.CreateTickUpdateLimitOrderTranchePurge(tranche))
-			}
+
+		k.purgeLimitOrderExpiration(ctx, iterator.Key(), val, archivedTranches)
+
+		// Orders purged at the start of the block get their expiration deposit back. If that fails the deposit is
+		// kept and refunded when the order is withdrawn.
+		if err := k.RefundExpirationDeposit(ctx, val.TrancheRef); err != nil {
+			k.Logger(ctx).Error("failed to refund expiration deposit", "error", err)
+		}
+	}
+}
+
+// PurgeExpiredOrdersCore handles the logic for MsgPurgeExpiredOrders. It purges up to maxOrders expired limit orders
+// and pays their expiration deposits to the caller.
+func (k Keeper) PurgeExpiredOrdersCore(
+	goCtx context.Context,
+	maxOrders uint64,
+	callerAddr sdk.AccAddress,
+) (ordersPurged uint64, bounty sdk.Coins, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.LimitOrderExpirationKeyPrefix),
+	)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	archivedTranches := make(map[string]bool)
+	defer iterator.Close()
+	for ; iterator.Valid() && ordersPurged < maxOrders; iterator.Next() {
+		var val types.LimitOrderExpiration
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		if val.ExpirationTime.After(ctx.BlockTime()) {
+			break
+		}
+
+		k.purgeLimitOrderExpiration(ctx, iterator.Key(), val, archivedTranches)
+		if deposit, found := k.GetExpirationDeposit(ctx, val.TrancheRef); found {
+			bounty = bounty.Add(deposit.Deposit...)
+			k.RemoveExpirationDeposit(ctx, val.TrancheRef)
+		}
+		ordersPurged++
+	}
+
+	if ordersPurged == 0 {
+		return 0, nil, types.ErrNoExpiredLimitOrders
+	}
+
+	if !bounty.IsZero() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, callerAddr, bounty)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(types.CreatePurgeExpiredOrdersEvent(callerAddr, ordersPurged, bounty))
+
+	return ordersPurged, bounty, nil
+}
+
+// purgeLimitOrderExpiration archives the tranche of an expired LimitOrderExpiration and removes the expiration
+func (k Keeper) purgeLimitOrderExpiration(
+	ctx sdk.Context,
+	key []byte,
+	val types.LimitOrderExpiration,
+	archivedTranches map[string]bool,
+) {
+	var pairID types.TradePairID
+	if _, ok := archivedTranches[string(val.TrancheRef)]; !ok {
+		tranche, found := k.GetLimitOrderTrancheByKey(ctx, val.TrancheRef)
+		if found {
+			// Convert the tranche to an inactiveTranche
+			k.SetInactiveLimitOrderTranche(ctx, tranche)
+			k.RemoveLimitOrderTranche(ctx, tranche.Key)
+			archivedTranches[string(val.TrancheRef)] = true
+			k.QueueAutoSettleTranche(ctx, tranche.Key.TrancheKey)
+
+			pairID = *tranche.Key.TradePairId
+			ctx.EventManager().EmitEvent(types.CreateTickUpdateLimitOrderTranchePurge(tranche))
 		}
+	}
+
+	k.RemoveLimitOrderExpirationByKey(ctx, key)
+	ctx.EventManager().EmitEvents(types.GetEventsDecExpiringOrders(&pairID))
+}
+
+// SetExpirationDeposit sets an ExpirationDeposit in the store
+func (k Keeper) SetExpirationDeposit(ctx sdk.Context, deposit types.ExpirationDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&deposit)
+	store.Set(types.ExpirationDepositKey(deposit.TrancheRef), b)
+}
+
+// GetExpirationDeposit returns the ExpirationDeposit of a tranche
+func (k Keeper) GetExpirationDeposit(ctx sdk.Context, trancheRef []byte) (val types.ExpirationDeposit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.ExpirationDepositKey(trancheRef))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+
+	return val, true
+}
+
+// RemoveExpirationDeposit removes an ExpirationDeposit from the store
+func (k Keeper) RemoveExpirationDeposit(ctx sdk.Context, trancheRef []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ExpirationDepositKey(trancheRef))
+}
+
+// GetAllExpirationDeposit returns all ExpirationDeposits
+func (k Keeper) GetAllExpirationDeposit(ctx sdk.Context) (list []types.ExpirationDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExpirationDepositKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ExpirationDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// CollectExpirationDeposit escrows the expiration deposit set in the params for a GOOD_TIL_TIME tranche
+func (k Keeper) CollectExpirationDeposit(ctx sdk.Context, trancheRef []byte, depositor sdk.AccAddress) error {
+	deposit := k.GetParams(ctx).GoodTilExpirationDeposit
+	if deposit.IsZero() {
+		return nil
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, deposit)
+	if err != nil {
+		return err
+	}
+
+	k.SetExpirationDeposit(ctx, types.ExpirationDeposit{
+		TrancheRef: trancheRef,
+		Depositor:  depositor.String(),
+		Deposit:    deposit,
+	})
+
+	return nil
+}
+
+// RefundExpirationDeposit returns the expiration deposit of a tranche to its depositor. The deposit is only removed once
+// it has been sent so that a failed refund is kept to be claimed later.
+func (k Keeper) RefundExpirationDeposit(ctx sdk.Context, trancheRef []byte) error {
+	deposit, found := k.GetExpirationDeposit(ctx, trancheRef)
+	if !found {
+		return nil
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, depositor, deposit.Deposit)
+	if err != nil {
+		return err
+	}
+	writeCache()
+
+	k.RemoveExpirationDeposit(ctx, trancheRef)
+
+	return nil
+}
+
+// claimExpirationDeposit retries the refund of the expiration deposit of a purged tranche. A deposit is only left after
+// the purge if refunding it at the start of the block failed.
+func (k Keeper) claimExpirationDeposit(ctx sdk.Context, tranche *types.LimitOrderTranche) {
+	if !tranche.IsExpired(ctx) {
+		return
+	}
+
+	trancheRef := tranche.Key.KeyMarshal()
+	if _, found := k.GetLimitOrderExpiration(ctx, *tranche.ExpirationTime, trancheRef); found {
+		// Not purged yet, the deposit is still owed to whoever purges it
+		return
+	}
 
-		k.RemoveLimitOrderExpirationByKey(ctx, iterator.Key())
-		ctx.EventManager().EmitEvents(types.GetEventsDecExpiringOrders(&pairID))
+	if err := k.RefundExpirationDeposit(ctx, trancheRef); err != nil {
+		k.Logger(ctx).Error("failed to refund expiration deposit", "error", err)
 	}
 }
@@ -69,6 +69,7 @@ func (k Keeper) UpdateTranche(ctx sdk.Context, tranche *types.LimitOrderTranche)
 		k.RemoveLimitOrderTranche(ctx, tranche.Key)
 		// We are removing liquidity from the orderbook so we emit an event
 		ctx.EventManager().EmitEvents(types.GetEventsDecTotalOrders(tranche.Key.TradePairId))
+		k.QueueAutoSettleTranche(ctx, tranche.Key.TrancheKey)
 
 	// There is no TokenIn or Token Out ==> We can delete the tranche entirely
 	default:
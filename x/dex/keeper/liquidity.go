@@ -8,12 +8,16 @@ import (
 	"github.com/neutron-org/neutron/v5/x/dex/types"
 )
 
+// Swap swaps up to maxAmountTakerDenom against the liquidity of tradePairID in order of price. takerFee is the surcharge
+// in basis points paid on top of the amount filled against limit orders. It is added to the rebate pool of the filled
+// tranches and counts towards the input amount.
 func (k Keeper) Swap(
 	ctx sdk.Context,
 	tradePairID *types.TradePairID,
 	maxAmountTakerDenom math.Int,
 	maxAmountMakerDenom *math.Int,
 	limitPrice *math_utils.PrecDec,
+	takerFee uint64,
 ) (totalTakerCoin, totalMakerCoin sdk.Coin, orderFilled bool, err error) {
 	gasBefore := ctx.GasMeter().GasConsumed()
 	useMaxOut := maxAmountMakerDenom != nil
@@ -36,15 +40,35 @@ func (k Keeper) Swap(
 			break
 		}
 
+		tranche, isTranche := liq.(*types.LimitOrderTranche)
+		chargeTakerFee := isTranche && takerFee > 0
+		price := liq.Price()
+		maxAmountIn := remainingTakerDenom
+		if chargeTakerFee {
+			price = price.Mul(takerFeeMultiplier(takerFee))
+			// Leave room in maxAmountIn for the taker fee
+			denominator := math.NewIntFromUint64(types.MaxTraderFee)
+			maxAmountIn = maxAmountIn.Mul(denominator).Quo(denominator.Add(math.NewIntFromUint64(takerFee)))
+		}
+
 		// break as soon as we iterated past limitPrice
-		if limitPrice != nil && liq.Price().GT(*limitPrice) {
+		if limitPrice != nil && price.GT(*limitPrice) {
 			break
 		}
 
-		inAmount, outAmount := liq.Swap(remainingTakerDenom, remainingMakerDenom)
+		inAmount, outAmount := liq.Swap(maxAmountIn, remainingMakerDenom)
 
 		k.SaveLiquidity(ctx, liq)
 
+		if isTranche && inAmount.IsPositive() {
+			k.RecordLimitOrderFill(ctx, tranche.Key, inAmount, outAmount)
+			if chargeTakerFee {
+				surcharge := CalcTakerFee(inAmount, takerFee)
+				k.AddTrancheSurcharge(ctx, tranche.Key, surcharge, inAmount)
+				inAmount = inAmount.Add(surcharge)
+			}
+		}
+
 		remainingTakerDenom = remainingTakerDenom.Sub(inAmount)
 		totalMakerDenom = totalMakerDenom.Add(outAmount)
 
@@ -56,7 +80,7 @@ func (k Keeper) Swap(
 		// but due to rounding and inaccuracy of fixed decimal math, it is possible
 		// for liq.swap to use the full the amount of taker liquidity and have a leftover
 		// amount of the taker Denom > than 1 token worth of maker denom
-		if math_utils.NewPrecDecFromInt(remainingTakerDenom).Quo(liq.Price()).LT(math_utils.NewPrecDec(2)) {
+		if math_utils.NewPrecDecFromInt(remainingTakerDenom).Quo(price).LT(math_utils.NewPrecDec(2)) {
 			orderFilled = true
 			break
 		}
@@ -74,6 +98,11 @@ func (k Keeper) Swap(
 	}
 	totalTakerDenom := maxAmountTakerDenom.Sub(remainingTakerDenom)
 
+	// swaps are recorded even without hooks since they also feed the candles
+	if totalTakerDenom.IsPositive() {
+		k.RecordSwap(ctx, tradePairID, totalTakerDenom, totalMakerDenom)
+	}
+
 	gasAfter := ctx.GasMeter().GasConsumed()
 	ctx.EventManager().EmitEvents(types.GetEventsGasConsumed(gasBefore, gasAfter))
 
@@ -92,6 +121,7 @@ func (k Keeper) SwapWithCache(
 	maxAmountIn math.Int,
 	maxAmountOut *math.Int,
 	limitPrice *math_utils.PrecDec,
+	takerFee uint64,
 ) (totalIn, totalOut sdk.Coin, orderFilled bool, err error) {
 	cacheCtx, writeCache := ctx.CacheContext()
 	totalIn, totalOut, orderFilled, err = k.Swap(
@@ -100,6 +130,7 @@ func (k Keeper) SwapWithCache(
 		maxAmountIn,
 		maxAmountOut,
 		limitPrice,
+		takerFee,
 	)
 
 	writeCache()
@@ -130,6 +161,7 @@ func (k Keeper) TakerLimitOrderSwap(
 	limitPrice math_utils.PrecDec,
 	minAvgSellPrice math_utils.PrecDec,
 	orderType types.LimitOrderType,
+	takerFee uint64,
 ) (totalInCoin, totalOutCoin sdk.Coin, err error) {
 	totalInCoin, totalOutCoin, orderFilled, err := k.SwapWithCache(
 		ctx,
@@ -137,6 +169,7 @@ func (k Keeper) TakerLimitOrderSwap(
 		amountIn,
 		maxAmountOut,
 		&limitPrice,
+		takerFee,
 	)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
@@ -167,6 +200,7 @@ func (k Keeper) MakerLimitOrderSwap(
 	amountIn math.Int,
 	limitPrice math_utils.PrecDec,
 	minAvgSellPrice math_utils.PrecDec,
+	takerFee uint64,
 ) (totalInCoin, totalOutCoin sdk.Coin, filled bool, err error) {
 	totalInCoin, totalOutCoin, filled, err = k.SwapWithCache(
 		ctx,
@@ -174,6 +208,7 @@ func (k Keeper) MakerLimitOrderSwap(
 		amountIn,
 		nil,
 		&limitPrice,
+		takerFee,
 	)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, filled, err
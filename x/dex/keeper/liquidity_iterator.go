@@ -7,10 +7,11 @@ import (
 )
 
 type LiquidityIterator struct {
-	keeper      *Keeper
-	tradePairID *types.TradePairID
-	ctx         sdk.Context
-	iter        TickIterator
+	keeper        *Keeper
+	tradePairID   *types.TradePairID
+	ctx           sdk.Context
+	iter          TickIterator
+	volatilityFee uint64
 }
 
 func (k Keeper) NewLiquidityIterator(
@@ -18,10 +19,11 @@ func (k Keeper) NewLiquidityIterator(
 	tradePairID *types.TradePairID,
 ) *LiquidityIterator {
 	return &LiquidityIterator{
-		iter:        k.NewTickIterator(ctx, tradePairID),
-		keeper:      &k,
-		ctx:         ctx,
-		tradePairID: tradePairID,
+		iter:          k.NewTickIterator(ctx, tradePairID),
+		keeper:        &k,
+		ctx:           ctx,
+		tradePairID:   tradePairID,
+		volatilityFee: k.GetVolatilityFee(ctx, tradePairID.MustPairID()),
 	}
 }
 
@@ -73,7 +75,8 @@ func (s *LiquidityIterator) WrapTickLiquidity(tick types.TickLiquidity) types.Li
 			upperTick1 = counterpartReserves
 		}
 		return &types.PoolLiquidity{
-			TradePairID: s.tradePairID,
+			TradePairID:   s.tradePairID,
+			VolatilityFee: s.volatilityFee,
 			Pool: &types.Pool{
 				LowerTick0: lowerTick0,
 				UpperTick1: upperTick1,
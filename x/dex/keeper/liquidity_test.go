@@ -597,6 +597,7 @@ func (s *DexTestSuite) swapInt(
 		maxAmountIn,
 		nil,
 		nil,
+		0,
 	)
 }
 
@@ -624,6 +625,7 @@ func (s *DexTestSuite) swapWithMaxOut(
 		sdkmath.NewInt(maxAmountIn).Mul(denomMultiple),
 		&maxAmountOutInt,
 		nil,
+		0,
 	)
 	s.Assert().NoError(err)
 
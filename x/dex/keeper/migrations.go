@@ -6,6 +6,7 @@ import (
 	v3 "github.com/neutron-org/neutron/v5/x/dex/migrations/v3"
 	v4 "github.com/neutron-org/neutron/v5/x/dex/migrations/v4"
 	v5 "github.com/neutron-org/neutron/v5/x/dex/migrations/v5"
+	v6 "github.com/neutron-org/neutron/v5/x/dex/migrations/v6"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -32,3 +33,8 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
 }
+
+// Migrate5to6 migrates from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
+}
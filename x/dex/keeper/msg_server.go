@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -135,6 +136,10 @@ func (k MsgServer) PlaceLimitOrder(
 	if err != nil {
 		return &types.MsgPlaceLimitOrderResponse{}, err
 	}
+	// Auto-settled orders would never be settled
+	if msg.AutoSettle && k.GetParams(ctx).AutoSettlesPerBlock == 0 {
+		return &types.MsgPlaceLimitOrderResponse{}, types.ErrAutoSettleDisabled
+	}
 	tickIndex := msg.TickIndexInToOut
 	if msg.LimitSellPrice != nil {
 		limitBuyPrice := math_utils.OnePrecDec().Quo(*msg.LimitSellPrice)
@@ -160,6 +165,10 @@ func (k MsgServer) PlaceLimitOrder(
 		return &types.MsgPlaceLimitOrderResponse{}, err
 	}
 
+	if msg.AutoSettle {
+		k.RegisterAutoSettleOrder(ctx, trancheKey, receiverAddr)
+	}
+
 	return &types.MsgPlaceLimitOrderResponse{
 		TrancheKey:   trancheKey,
 		CoinIn:       coinIn,
@@ -226,6 +235,82 @@ func (k MsgServer) CancelLimitOrder(
 	}, nil
 }
 
+func (k MsgServer) BatchCancelLimitOrders(
+	goCtx context.Context,
+	msg *types.MsgBatchCancelLimitOrders,
+) (*types.MsgBatchCancelLimitOrdersResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgBatchCancelLimitOrders")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	trancheKeys := msg.TrancheKeys
+	selectByPair := len(trancheKeys) == 0
+	if selectByPair {
+		// This will never panic since the pairID has already been validated
+		pairID, _ := types.NewPairIDFromCanonicalString(msg.PairId)
+		trancheKeys = k.GetLimitOrderTrancheKeysForPair(ctx, callerAddr, pairID, msg.TokenIn)
+	}
+
+	canceledTrancheKeys, makerCoinsOut, takerCoinsOut, err := k.BatchCancelLimitOrdersCore(
+		goCtx,
+		trancheKeys,
+		selectByPair,
+		callerAddr,
+	)
+	if err != nil {
+		return &types.MsgBatchCancelLimitOrdersResponse{}, err
+	}
+
+	return &types.MsgBatchCancelLimitOrdersResponse{
+		TrancheKeys:   canceledTrancheKeys,
+		TakerCoinsOut: takerCoinsOut,
+		MakerCoinsOut: makerCoinsOut,
+	}, nil
+}
+
+func (k MsgServer) ReplaceLimitOrder(
+	goCtx context.Context,
+	msg *types.MsgReplaceLimitOrder,
+) (*types.MsgReplaceLimitOrderResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgReplaceLimitOrder")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	canceledMakerCoinOut, canceledTakerCoinOut, trancheKey, coinIn, swapInCoin, coinOutSwap, err := k.ReplaceLimitOrderCore(
+		goCtx,
+		msg.TrancheKey,
+		msg.AmountIn,
+		msg.LimitSellPrice,
+		msg.ExpirationTime,
+		callerAddr,
+	)
+	if err != nil {
+		return &types.MsgReplaceLimitOrderResponse{}, err
+	}
+
+	return &types.MsgReplaceLimitOrderResponse{
+		CanceledTakerCoinOut: canceledTakerCoinOut,
+		CanceledMakerCoinOut: canceledMakerCoinOut,
+		TrancheKey:           trancheKey,
+		CoinIn:               coinIn,
+		TakerCoinOut:         coinOutSwap,
+		TakerCoinIn:          swapInCoin,
+	}, nil
+}
+
 func (k MsgServer) MultiHopSwap(
 	goCtx context.Context,
 	msg *types.MsgMultiHopSwap,
@@ -260,6 +345,41 @@ func (k MsgServer) MultiHopSwap(
 	}, nil
 }
 
+func (k MsgServer) FlashSwap(
+	goCtx context.Context,
+	msg *types.MsgFlashSwap,
+) (*types.MsgFlashSwapResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgFlashSwap")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	coinOut, route, dust, coinIn, fee, err := k.FlashSwapCore(
+		goCtx,
+		msg.AmountIn,
+		msg.Routes,
+		msg.ExitLimitPrice,
+		msg.PickBestRoute,
+		callerAddr,
+		msg.CallbackData,
+	)
+	if err != nil {
+		return &types.MsgFlashSwapResponse{}, err
+	}
+	return &types.MsgFlashSwapResponse{
+		CoinOut: coinOut,
+		Route:   &types.MultiHopRoute{Hops: route},
+		Dust:    dust,
+		CoinIn:  coinIn,
+		Fee:     fee,
+	}, nil
+}
+
 func (k MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, errors.Wrap(err, "failed to validate MsgUpdateParams")
@@ -278,6 +398,361 @@ func (k MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+func (k MsgServer) SetPairTradingStatus(
+	goCtx context.Context,
+	req *types.MsgSetPairTradingStatus,
+) (*types.MsgSetPairTradingStatusResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetPairTradingStatus")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// This will never panic since the pair has already been validated
+	pairID := types.MustNewPairID(req.TokenA, req.TokenB)
+	k.Keeper.SetPairTradingStatus(ctx, pairID, req.Status)
+
+	ctx.EventManager().EmitEvent(types.CreateSetPairTradingStatusEvent(pairID, req.Status))
+
+	return &types.MsgSetPairTradingStatusResponse{}, nil
+}
+
+func (k MsgServer) SetDenomTradingStatus(
+	goCtx context.Context,
+	req *types.MsgSetDenomTradingStatus,
+) (*types.MsgSetDenomTradingStatusResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetDenomTradingStatus")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Keeper.SetDenomTradingStatus(ctx, req.Denom, req.Status)
+
+	ctx.EventManager().EmitEvent(types.CreateSetDenomTradingStatusEvent(req.Denom, req.Status))
+
+	return &types.MsgSetDenomTradingStatusResponse{}, nil
+}
+
+func (k MsgServer) SetPairFeeTiers(
+	goCtx context.Context,
+	req *types.MsgSetPairFeeTiers,
+) (*types.MsgSetPairFeeTiersResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetPairFeeTiers")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// This will never panic since the pair has already been validated
+	pairID := types.MustNewPairID(req.TokenA, req.TokenB)
+	k.Keeper.SetPairFeeTiers(ctx, pairID, req.FeeTiers)
+
+	ctx.EventManager().EmitEvent(types.CreateSetPairFeeTiersEvent(pairID, req.FeeTiers))
+
+	return &types.MsgSetPairFeeTiersResponse{}, nil
+}
+
+func (k MsgServer) SetTraderFeeTier(
+	goCtx context.Context,
+	req *types.MsgSetTraderFeeTier,
+) (*types.MsgSetTraderFeeTierResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetTraderFeeTier")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.Remove {
+		k.RemoveTraderFeeTierAssignment(ctx, req.Address)
+	} else {
+		numTiers := len(k.GetParams(ctx).TraderFeeTiers)
+		if int(req.Tier) >= numTiers {
+			return nil, errors.Wrapf(types.ErrInvalidTraderFeeTier, "tier %d, number of tiers %d", req.Tier, numTiers)
+		}
+		k.SetTraderFeeTierAssignment(ctx, req.Address, req.Tier)
+	}
+
+	ctx.EventManager().EmitEvent(types.CreateSetTraderFeeTierEvent(req.Address, req.Tier, req.Remove))
+
+	return &types.MsgSetTraderFeeTierResponse{}, nil
+}
+
+func (k MsgServer) ClaimMakerRebates(
+	goCtx context.Context,
+	msg *types.MsgClaimMakerRebates,
+) (*types.MsgClaimMakerRebatesResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgClaimMakerRebates")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	rebates, err := k.ClaimMakerRebatesCore(ctx, callerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimMakerRebatesResponse{Rebates: rebates}, nil
+}
+
+func (k MsgServer) PlaceTWAPOrder(
+	goCtx context.Context,
+	msg *types.MsgPlaceTWAPOrder,
+) (*types.MsgPlaceTWAPOrderResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgPlaceTWAPOrder")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	order, err := k.PlaceTWAPOrderCore(
+		goCtx,
+		callerAddr,
+		msg.TokenIn,
+		msg.TokenOut,
+		msg.TotalIn,
+		msg.Slices,
+		msg.Interval,
+		msg.MaxPrice,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlaceTWAPOrderResponse{OrderId: order.Id}, nil
+}
+
+func (k MsgServer) WithdrawTWAPOrder(
+	goCtx context.Context,
+	msg *types.MsgWithdrawTWAPOrder,
+) (*types.MsgWithdrawTWAPOrderResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgWithdrawTWAPOrder")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	coinOut, err := k.WithdrawTWAPOrderCore(goCtx, msg.OrderId, callerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawTWAPOrderResponse{CoinOut: coinOut}, nil
+}
+
+func (k MsgServer) PurgeExpiredOrders(
+	goCtx context.Context,
+	msg *types.MsgPurgeExpiredOrders,
+) (*types.MsgPurgeExpiredOrdersResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgPurgeExpiredOrders")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	ordersPurged, bounty, err := k.PurgeExpiredOrdersCore(goCtx, msg.Max, callerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPurgeExpiredOrdersResponse{OrdersPurged: ordersPurged, Bounty: bounty}, nil
+}
+
+func (k MsgServer) SubscribeHooks(
+	goCtx context.Context,
+	msg *types.MsgSubscribeHooks,
+) (*types.MsgSubscribeHooksResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSubscribeHooks")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.GetParams(ctx).IsWhitelistedHookSubscriber(msg.Creator) {
+		return nil, errors.Wrapf(types.ErrHookSubscriberNotWhitelisted, "%s", msg.Creator)
+	}
+
+	// This will never panic since msg.Validate() checks the pair
+	pairID := types.MustNewPairID(msg.TokenA, msg.TokenB)
+	k.SetHookSubscription(ctx, pairID, msg.Creator)
+
+	return &types.MsgSubscribeHooksResponse{}, nil
+}
+
+func (k MsgServer) UnsubscribeHooks(
+	goCtx context.Context,
+	msg *types.MsgUnsubscribeHooks,
+) (*types.MsgUnsubscribeHooksResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUnsubscribeHooks")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// This will never panic since msg.Validate() checks the pair
+	pairID := types.MustNewPairID(msg.TokenA, msg.TokenB)
+	if !k.HasHookSubscription(ctx, pairID, msg.Creator) {
+		return nil, errors.Wrapf(types.ErrHookSubscriptionNotFound, "%s %s", pairID.CanonicalString(), msg.Creator)
+	}
+
+	k.RemoveHookSubscription(ctx, pairID, msg.Creator)
+
+	return &types.MsgUnsubscribeHooksResponse{}, nil
+}
+
+func (k MsgServer) DepositRange(
+	goCtx context.Context,
+	msg *types.MsgDepositRange,
+) (*types.MsgDepositRangeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgDepositRange")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
+
+	pairID, err := types.NewPairID(msg.TokenA, msg.TokenB)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1 := SortAmounts(msg.TokenA, pairID.Token0, []math.Int{msg.AmountA}, []math.Int{msg.AmountB})
+
+	// normalizing may flip the sign of the ticks, so the bounds are re-sorted afterwards
+	tickIndexes := NormalizeAllTickIndexes(msg.TokenA, pairID.Token0, []int64{msg.LowerTickIndexAToB, msg.UpperTickIndexAToB})
+	lowerTickIndex, upperTickIndex := min(tickIndexes[0], tickIndexes[1]), max(tickIndexes[0], tickIndexes[1])
+
+	position, amount0Deposited, amount1Deposited, failedDeposits, err := k.DepositRangeCore(
+		goCtx,
+		pairID,
+		callerAddr,
+		receiverAddr,
+		amount0[0],
+		amount1[0],
+		lowerTickIndex,
+		upperTickIndex,
+		msg.TickSpacing,
+		msg.Fee,
+		msg.DistributionShape,
+		msg.Options,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositRangeResponse{
+		PositionId:        position.Id,
+		Reserve0Deposited: amount0Deposited,
+		Reserve1Deposited: amount1Deposited,
+		SharesIssued:      position.Shares,
+		FailedDeposits:    failedDeposits,
+	}, nil
+}
+
+func (k MsgServer) WithdrawRangePosition(
+	goCtx context.Context,
+	msg *types.MsgWithdrawRangePosition,
+) (*types.MsgWithdrawRangePositionResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgWithdrawRangePosition")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
+
+	reserve0Withdrawn, reserve1Withdrawn, sharesBurned, err := k.WithdrawRangePositionCore(
+		goCtx,
+		msg.PositionId,
+		callerAddr,
+		receiverAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawRangePositionResponse{
+		Reserve0Withdrawn: reserve0Withdrawn,
+		Reserve1Withdrawn: reserve1Withdrawn,
+		SharesBurned:      sharesBurned,
+	}, nil
+}
+
+func (k MsgServer) RebalanceRangePosition(
+	goCtx context.Context,
+	msg *types.MsgRebalanceRangePosition,
+) (*types.MsgRebalanceRangePositionResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRebalanceRangePosition")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	position, amount0Deposited, amount1Deposited, failedDeposits, err := k.RebalanceRangePositionCore(
+		goCtx,
+		msg.PositionId,
+		callerAddr,
+		msg.LowerTickIndex,
+		msg.UpperTickIndex,
+		msg.TickSpacing,
+		msg.DistributionShape,
+		msg.Options,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRebalanceRangePositionResponse{
+		Reserve0Deposited: amount0Deposited,
+		Reserve1Deposited: amount1Deposited,
+		SharesIssued:      position.Shares,
+		FailedDeposits:    failedDeposits,
+	}, nil
+}
+
 func (k MsgServer) AssertNotPaused(goCtx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	paused := k.GetParams(ctx).Paused
@@ -38,7 +38,8 @@ func (k Keeper) MultiHopSwapCore(
 ) (coinOut sdk.Coin, route []string, dust sdk.Coins, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	bestRoute, initialInCoin, err := k.CalulateMultiHopSwap(ctx, amountIn, routes, exitLimitPrice, pickBestRoute)
+	takerFee := k.GetTakerFee(ctx, callerAddr)
+	bestRoute, initialInCoin, err := k.CalulateMultiHopSwap(ctx, amountIn, routes, exitLimitPrice, pickBestRoute, takerFee)
 	if err != nil {
 		return sdk.Coin{}, []string{}, sdk.Coins{}, err
 	}
@@ -66,6 +67,10 @@ func (k Keeper) MultiHopSwapCore(
 		return sdk.Coin{}, []string{}, sdk.Coins{}, fmt.Errorf("failed to send out coin and dust to the receiver: %w", err)
 	}
 
+	if err := k.DispatchSwapHooks(ctx, callerAddr); err != nil {
+		return sdk.Coin{}, []string{}, sdk.Coins{}, err
+	}
+
 	ctx.EventManager().EmitEvent(types.CreateMultihopSwapEvent(
 		callerAddr,
 		receiverAddr,
@@ -81,13 +86,15 @@ func (k Keeper) MultiHopSwapCore(
 }
 
 // CalulateMultiHopSwap handles the core logic for MultiHopSwap -- simulating swap operations across all routes (when applicable)
-// and picking the best route to execute. It uses a cache and does not modify state.
+// and picking the best route to execute. It uses a cache and does not modify state. takerFee is the surcharge paid on
+// the amount filled against limit orders at every hop.
 func (k Keeper) CalulateMultiHopSwap(
 	ctx sdk.Context,
 	amountIn math.Int,
 	routes []*types.MultiHopRoute,
 	exitLimitPrice math_utils.PrecDec,
 	pickBestRoute bool,
+	takerFee uint64,
 ) (bestRoute MultiHopRouteOutput, initialInCoin sdk.Coin, err error) {
 	var routeErrors []error
 	initialInCoin = sdk.NewCoin(routes[0].Hops[0], amountIn)
@@ -102,6 +109,7 @@ func (k Keeper) CalulateMultiHopSwap(
 			initialInCoin,
 			exitLimitPrice,
 			stepCache,
+			takerFee,
 		)
 		if err != nil {
 			routeErrors = append(routeErrors, err)
@@ -145,6 +153,10 @@ func (k Keeper) HopsToRouteData(
 		if err != nil {
 			return routeArr, err
 		}
+		// Routes through halted pairs are skipped
+		if err := k.AssertCanSwap(ctx, tradePairID.MustPairID()); err != nil {
+			return routeArr, err
+		}
 		price, found := k.GetCurrPrice(ctx, tradePairID)
 		if !found {
 			return routeArr, types.ErrLimitPriceNotSatisfied
@@ -185,6 +197,7 @@ func (k Keeper) MultihopStep(
 	step MultihopStep,
 	inCoin sdk.Coin,
 	stepCache map[multihopCacheKey]StepResult,
+	takerFee uint64,
 ) (sdk.Coin, sdk.Coin, *types.BranchableCache, error) {
 	cacheKey := newCacheKey(step.tradePairID.TakerDenom, step.tradePairID.MakerDenom, inCoin.Amount)
 	val, ok := stepCache[cacheKey]
@@ -198,7 +211,7 @@ func (k Keeper) MultihopStep(
 	// To solve this without sending user dust we would have to pre-calculate the route such that
 	// the amount in will be used completely at each step.
 
-	dust, coinOut, err := k.SwapFullAmountIn(bCtx.Ctx, step.tradePairID, inCoin.Amount)
+	dust, coinOut, err := k.SwapFullAmountIn(bCtx.Ctx, step.tradePairID, inCoin.Amount, takerFee)
 	ctxBranch := bCtx.Branch()
 	stepCache[cacheKey] = StepResult{Ctx: bCtx, CoinOut: coinOut, Dust: dust, Err: err}
 	if err != nil {
@@ -214,6 +227,7 @@ func (k Keeper) RunMultihopRoute(
 	initialInCoin sdk.Coin,
 	exitLimitPrice math_utils.PrecDec,
 	stepCache map[multihopCacheKey]StepResult,
+	takerFee uint64,
 ) (sdk.Coins, sdk.Coin, func(), error) {
 	routeData, err := k.HopsToRouteData(ctx, route.Hops)
 	if err != nil {
@@ -240,6 +254,7 @@ func (k Keeper) RunMultihopRoute(
 			step,
 			inCoin,
 			stepCache,
+			takerFee,
 		)
 		inCoin = stepOutCoin
 		if err != nil {
@@ -272,6 +287,7 @@ func (k Keeper) SwapFullAmountIn(
 	ctx sdk.Context,
 	tradePairID *types.TradePairID,
 	amountIn math.Int,
+	takerFee uint64,
 ) (dust, totalOut sdk.Coin, err error) {
 	swapAmountTakerDenom, swapAmountMakerDenom, orderFilled, err := k.Swap(
 		ctx,
@@ -279,6 +295,7 @@ func (k Keeper) SwapFullAmountIn(
 		amountIn,
 		nil,
 		nil,
+		takerFee,
 	)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
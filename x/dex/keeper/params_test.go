@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	testkeeper "github.com/neutron-org/neutron/v5/testutil/dex/keeper"
@@ -37,6 +39,41 @@ func TestValidateParams(t *testing.T) {
 
 	badFees := []uint64{1, 2, 3, 3}
 	require.Error(t, types.Params{FeeTiers: badFees}.Validate())
+
+	subscriber := sdk.AccAddress([]byte("subscriber")).String()
+	require.NoError(t, types.Params{FeeTiers: goodFees, WhitelistedHookSubscribers: []string{subscriber}, HookGasLimit: 1}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, WhitelistedHookSubscribers: []string{subscriber}}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, WhitelistedHookSubscribers: []string{subscriber, subscriber}, HookGasLimit: 1}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, WhitelistedHookSubscribers: []string{"invalid"}, HookGasLimit: 1}.Validate())
+
+	require.NoError(t, types.Params{FeeTiers: goodFees, CandleIntervals: []uint64{60, 3600}, CandleRetention: 10}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, CandleIntervals: []uint64{60, 3600}}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, CandleIntervals: []uint64{60, 60}, CandleRetention: 10}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, CandleIntervals: []uint64{0}, CandleRetention: 10}.Validate())
+
+	require.NoError(t, types.Params{FeeTiers: goodFees, CandleIntervals: []uint64{60}, CandleRetention: 10, VolatilityFeeInterval: 60}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, CandleIntervals: []uint64{60}, CandleRetention: 10, VolatilityFeeInterval: 3600}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, MaxVolatilityFee: 10_001}.Validate())
+
+	goodTiers := []types.TraderFeeTier{
+		{MinVolume: math.ZeroInt(), TakerFee: 20, MakerRebate: 5},
+		{MinVolume: math.NewInt(1000), TakerFee: 10, MakerRebate: 10},
+	}
+	require.NoError(t, types.Params{FeeTiers: goodFees, TraderFeeTiers: goodTiers, TraderVolumeDenom: "untrn", TraderVolumeWindow: 30}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, TraderVolumeDenom: "untrn"}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, TraderVolumeDenom: "!", TraderVolumeWindow: 30}.Validate())
+	// the first tier must start at zero volume
+	require.Error(t, types.Params{FeeTiers: goodFees, TraderFeeTiers: goodTiers[1:]}.Validate())
+	// min volumes must increase
+	require.Error(t, types.Params{FeeTiers: goodFees, TraderFeeTiers: []types.TraderFeeTier{goodTiers[0], goodTiers[0]}}.Validate())
+	// maker rebates must not exceed any taker fee
+	require.Error(t, types.Params{FeeTiers: goodFees, TraderFeeTiers: []types.TraderFeeTier{
+		{MinVolume: math.ZeroInt(), TakerFee: 20, MakerRebate: 5},
+		{MinVolume: math.NewInt(1000), TakerFee: 10, MakerRebate: 15},
+	}}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, TraderFeeTiers: []types.TraderFeeTier{
+		{MinVolume: math.ZeroInt(), TakerFee: 10_001},
+	}}.Validate())
 }
 
 func (s *DexTestSuite) TestPauseDex() {
@@ -41,6 +41,7 @@ func (k Keeper) PlaceLimitOrderCore(
 		maxAmountOut,
 		minAvgSellPriceP,
 		receiverAddr,
+		k.GetTakerFee(ctx, callerAddr),
 	)
 	if err != nil {
 		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
@@ -72,6 +73,23 @@ func (k Keeper) PlaceLimitOrderCore(
 		}
 	}
 
+	// GOOD_TIL_TIME orders that rest on the book pay a deposit that is returned when they are canceled or purged
+	if orderType.IsGoodTil() && sharesIssued.IsPositive() {
+		trancheUser, _ := k.GetLimitOrderTrancheUser(ctx, receiverAddr.String(), trancheKey)
+		trancheRef := types.LimitOrderTrancheKey{
+			TradePairId:           trancheUser.TradePairId,
+			TickIndexTakerToMaker: trancheUser.TickIndexTakerToMaker,
+			TrancheKey:            trancheKey,
+		}.KeyMarshal()
+		if err := k.CollectExpirationDeposit(ctx, trancheRef, callerAddr); err != nil {
+			return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+		}
+	}
+
+	if err := k.DispatchSwapHooks(ctx, callerAddr); err != nil {
+		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+	}
+
 	// This will never panic because we've already successfully constructed a TradePairID above
 	pairID := takerTradePairID.MustPairID()
 	ctx.EventManager().EmitEvent(types.CreatePlaceLimitOrderEvent(
@@ -95,7 +113,7 @@ func (k Keeper) PlaceLimitOrderCore(
 }
 
 // ExecutePlaceLimitOrder handles the core logic for PlaceLimitOrder -- performing taker a swap
-// and (when applicable) adding a maker limit order to the orderbook.
+// and (when applicable) adding a maker limit order to the orderbook. takerFee is the surcharge paid on the taker swap.
 // IT DOES NOT PERFORM ANY BANKING OPERATIONS
 func (k Keeper) ExecutePlaceLimitOrder(
 	ctx sdk.Context,
@@ -107,6 +125,7 @@ func (k Keeper) ExecutePlaceLimitOrder(
 	maxAmountOut *math.Int,
 	minAvgSellPriceP *math_utils.PrecDec,
 	receiverAddr sdk.AccAddress,
+	takerFee uint64,
 ) (
 	trancheKey string,
 	totalIn math.Int,
@@ -117,6 +136,17 @@ func (k Keeper) ExecutePlaceLimitOrder(
 ) {
 	amountLeft := amountIn
 
+	// Taker only orders can still be placed against pairs that are WITHDRAW_ONLY
+	pairID := takerTradePairID.MustPairID()
+	if orderType.IsTakerOnly() {
+		err = k.AssertCanSwap(ctx, pairID)
+	} else {
+		err = k.AssertCanAddLiquidity(ctx, pairID)
+	}
+	if err != nil {
+		return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), math_utils.ZeroPrecDec(), err
+	}
+
 	limitBuyPrice, err := types.CalcPrice(tickIndexInToOut)
 	if err != nil {
 		return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), math_utils.ZeroPrecDec(), err
@@ -137,9 +167,9 @@ func (k Keeper) ExecutePlaceLimitOrder(
 
 	var orderFilled bool
 	if orderType.IsTakerOnly() {
-		swapInCoin, swapOutCoin, err = k.TakerLimitOrderSwap(ctx, *takerTradePairID, amountIn, maxAmountOut, limitBuyPrice, minAvgSellPrice, orderType)
+		swapInCoin, swapOutCoin, err = k.TakerLimitOrderSwap(ctx, *takerTradePairID, amountIn, maxAmountOut, limitBuyPrice, minAvgSellPrice, orderType, takerFee)
 	} else {
-		swapInCoin, swapOutCoin, orderFilled, err = k.MakerLimitOrderSwap(ctx, *takerTradePairID, amountIn, limitBuyPrice, minAvgSellPrice)
+		swapInCoin, swapOutCoin, orderFilled, err = k.MakerLimitOrderSwap(ctx, *takerTradePairID, amountIn, limitBuyPrice, minAvgSellPrice, takerFee)
 	}
 	if err != nil {
 		return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
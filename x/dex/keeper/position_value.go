@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetPoolDepositBasis sets a PoolDepositBasis in the store
+func (k Keeper) SetPoolDepositBasis(ctx sdk.Context, basis types.PoolDepositBasis) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&basis)
+	store.Set(types.PoolDepositBasisKey(basis.Address, basis.PoolId), b)
+}
+
+// GetPoolDepositBasis returns the PoolDepositBasis of address in a pool
+func (k Keeper) GetPoolDepositBasis(ctx sdk.Context, address string, poolID uint64) (val types.PoolDepositBasis, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.PoolDepositBasisKey(address, poolID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+
+	return val, true
+}
+
+// RemovePoolDepositBasis removes a PoolDepositBasis from the store
+func (k Keeper) RemovePoolDepositBasis(ctx sdk.Context, address string, poolID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PoolDepositBasisKey(address, poolID))
+}
+
+// GetAllPoolDepositBasis returns all PoolDepositBases
+func (k Keeper) GetAllPoolDepositBasis(ctx sdk.Context) (list []types.PoolDepositBasis) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PoolDepositBasisKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PoolDepositBasis
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RecordPoolDeposit adds the amounts deposited into a pool and the shares issued for them to the cost basis of the receiver
+func (k Keeper) RecordPoolDeposit(ctx sdk.Context, receiverAddr sdk.AccAddress, pool *types.Pool, amount0, amount1, shares math.Int) {
+	basis, found := k.GetPoolDepositBasis(ctx, receiverAddr.String(), pool.Id)
+	if !found {
+		basis = types.PoolDepositBasis{
+			Address: receiverAddr.String(),
+			PoolId:  pool.Id,
+			Shares:  math.ZeroInt(),
+			Amount0: math.ZeroInt(),
+			Amount1: math.ZeroInt(),
+		}
+	}
+
+	basis.Shares = basis.Shares.Add(shares)
+	basis.Amount0 = basis.Amount0.Add(amount0)
+	basis.Amount1 = basis.Amount1.Add(amount1)
+	basis.DepositPrice = pool.MustCalcPrice1To0Center()
+	k.SetPoolDepositBasis(ctx, basis)
+}
+
+// RecordPoolWithdrawal reduces the cost basis of the caller in a pool pro rata to the shares it withdraws out of the
+// shares it owns. Shares that were transferred away since the deposit are dropped from the basis first.
+func (k Keeper) RecordPoolWithdrawal(ctx sdk.Context, callerAddr sdk.AccAddress, poolID uint64, sharesToRemove, sharesOwned math.Int) {
+	basis, found := k.GetPoolDepositBasis(ctx, callerAddr.String(), poolID)
+	if !found {
+		return
+	}
+
+	if sharesToRemove.GTE(sharesOwned) {
+		k.RemovePoolDepositBasis(ctx, basis.Address, basis.PoolId)
+		return
+	}
+
+	tracked := math.MinInt(basis.Shares, sharesOwned)
+	remaining := tracked.Sub(sharesToRemove.Mul(tracked).Quo(sharesOwned))
+	if remaining.IsZero() {
+		k.RemovePoolDepositBasis(ctx, basis.Address, basis.PoolId)
+		return
+	}
+
+	basis.Amount0 = basis.Amount0.Mul(remaining).Quo(basis.Shares)
+	basis.Amount1 = basis.Amount1.Mul(remaining).Quo(basis.Shares)
+	basis.Shares = remaining
+	k.SetPoolDepositBasis(ctx, basis)
+}
+
+// GetPoolPositionValue values the pool shares of a deposit record against the cost basis of their owner. Pools trade at
+// a constant price so any growth in the value of the shares at the pool price comes from swap fees.
+func (k Keeper) GetPoolPositionValue(
+	ctx sdk.Context,
+	address string,
+	record *types.DepositRecord,
+) (positionValue types.PoolPositionValue, found bool) {
+	pool, found := k.GetPool(ctx, record.PairId, record.CenterTickIndex, record.Fee)
+	if !found {
+		return positionValue, false
+	}
+
+	sharesOwned := record.SharesOwned
+	totalShares := k.bankKeeper.GetSupply(ctx, pool.GetPoolDenom()).Amount
+	currentAmount0, currentAmount1 := pool.RedeemValue(sharesOwned, totalShares)
+	currentValue := types.CalcAmountAsToken0(currentAmount0, currentAmount1, pool.MustCalcPrice1To0Center())
+
+	positionValue = types.PoolPositionValue{
+		PairId:          record.PairId,
+		CenterTickIndex: record.CenterTickIndex,
+		Fee:             record.Fee,
+		SharesOwned:     sharesOwned,
+		UntrackedShares: sharesOwned,
+		CurrentAmount0:  currentAmount0,
+		CurrentAmount1:  currentAmount1,
+		DepositAmount0:  math.ZeroInt(),
+		DepositAmount1:  math.ZeroInt(),
+		DepositPrice:    math_utils.ZeroPrecDec(),
+		DepositValue:    math_utils.ZeroPrecDec(),
+		CurrentValue:    currentValue,
+		FeesEarned:      math_utils.ZeroPrecDec(),
+	}
+
+	basis, found := k.GetPoolDepositBasis(ctx, address, pool.Id)
+	if !found || !sharesOwned.IsPositive() {
+		return positionValue, true
+	}
+
+	tracked := math.MinInt(basis.Shares, sharesOwned)
+	positionValue.UntrackedShares = sharesOwned.Sub(tracked)
+	positionValue.DepositAmount0 = basis.Amount0.Mul(tracked).Quo(basis.Shares)
+	positionValue.DepositAmount1 = basis.Amount1.Mul(tracked).Quo(basis.Shares)
+	positionValue.DepositPrice = basis.DepositPrice
+	positionValue.DepositValue = types.CalcAmountAsToken0(
+		positionValue.DepositAmount0,
+		positionValue.DepositAmount1,
+		basis.DepositPrice,
+	)
+
+	trackedValue := currentValue.MulInt(tracked).QuoInt(sharesOwned)
+	positionValue.FeesEarned = math_utils.MaxPrecDec(math_utils.ZeroPrecDec(), trackedValue.Sub(positionValue.DepositValue))
+
+	return positionValue, true
+}
+
+// GetLimitOrderPositionValue values the part of a limit order that has not been withdrawn at the price of the order
+func (k Keeper) GetLimitOrderPositionValue(
+	ctx sdk.Context,
+	trancheUser *types.LimitOrderTrancheUser,
+) (positionValue types.LimitOrderPositionValue, found bool) {
+	tranche, _, found := k.FindLimitOrderTranche(ctx, &types.LimitOrderTrancheKey{
+		TradePairId:           trancheUser.TradePairId,
+		TickIndexTakerToMaker: trancheUser.TickIndexTakerToMaker,
+		TrancheKey:            trancheUser.TrancheKey,
+	})
+	if !found || !tranche.TotalMakerDenom.IsPositive() {
+		return positionValue, false
+	}
+
+	makerAmount := tranche.AmountUnfilled().MulInt(trancheUser.SharesOwned).QuoInt(tranche.TotalMakerDenom).TruncateInt()
+	_, takerAmount := tranche.CalcWithdrawAmount(trancheUser)
+
+	return types.LimitOrderPositionValue{
+		TradePairId:           trancheUser.TradePairId,
+		TickIndexTakerToMaker: trancheUser.TickIndexTakerToMaker,
+		TrancheKey:            trancheUser.TrancheKey,
+		CurrentMakerAmount:    makerAmount,
+		CurrentTakerAmount:    takerAmount,
+		DepositPrice:          tranche.MakerPrice,
+		DepositValue:          math_utils.NewPrecDecFromInt(trancheUser.SharesOwned.Sub(trancheUser.SharesWithdrawn)),
+		CurrentValue: math_utils.NewPrecDecFromInt(makerAmount).
+			Add(math_utils.NewPrecDecFromInt(takerAmount).Quo(tranche.MakerPrice)),
+	}, true
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) queryPositionValue(addr sdk.AccAddress) *types.QueryUserPositionValueResponse {
+	resp, err := s.App.DexKeeper.UserPositionValue(s.Ctx, &types.QueryUserPositionValueRequest{Address: addr.String()})
+	s.NoError(err)
+
+	return resp
+}
+
+func (s *DexTestSuite) TestPositionValueTracksPoolDeposit() {
+	s.fundAliceBalances(10, 10)
+
+	// WHEN alice deposits into a pool
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// THEN the position is valued at its deposit
+	resp := s.queryPositionValue(s.alice)
+	s.Len(resp.PoolPositions, 1)
+	position := resp.PoolPositions[0]
+	s.Equal(int64(0), position.CenterTickIndex)
+	s.Equal(uint64(1), position.Fee)
+	s.True(position.UntrackedShares.IsZero())
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), position.DepositAmount0)
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), position.DepositAmount1)
+	s.Equal(position.CurrentAmount0, position.DepositAmount0)
+	s.Equal(position.CurrentAmount1, position.DepositAmount1)
+	s.True(position.FeesEarned.IsZero())
+}
+
+func (s *DexTestSuite) TestPositionValueAccruesSwapFees() {
+	s.fundAliceBalances(10, 10)
+	s.fundBobBalances(10, 0)
+	s.aliceDeposits(NewDeposit(10, 10, 0, 20))
+
+	// WHEN bob swaps through the pool
+	s.bobLimitSells("TokenA", 30, 5, types.LimitOrderType_FILL_OR_KILL)
+
+	// THEN the position has earned fees and its reserves moved towards token0
+	position := s.queryPositionValue(s.alice).PoolPositions[0]
+	s.True(position.CurrentAmount0.GT(position.DepositAmount0))
+	s.True(position.CurrentAmount1.LT(position.DepositAmount1))
+	s.True(position.FeesEarned.IsPositive())
+	s.Equal(position.CurrentValue.Sub(position.DepositValue), position.FeesEarned)
+}
+
+func (s *DexTestSuite) TestPositionValueWithdrawReducesBasis() {
+	s.fundAliceBalances(10, 10)
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN alice withdraws half of her shares
+	s.aliceWithdraws(NewWithdrawal(10, 0, 1))
+
+	// THEN the cost basis is halved
+	position := s.queryPositionValue(s.alice).PoolPositions[0]
+	s.Equal(sdkmath.NewInt(5).Mul(denomMultiple), position.DepositAmount0)
+	s.Equal(sdkmath.NewInt(5).Mul(denomMultiple), position.DepositAmount1)
+
+	// WHEN she withdraws the rest
+	s.aliceWithdraws(NewWithdrawal(10, 0, 1))
+
+	// THEN the cost basis is removed
+	s.Empty(s.queryPositionValue(s.alice).PoolPositions)
+	s.Empty(s.App.DexKeeper.GetAllPoolDepositBasis(s.Ctx))
+}
+
+func (s *DexTestSuite) TestPositionValueTransferredSharesAreUntracked() {
+	s.fundAliceBalances(10, 10)
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN alice sends half of her shares to bob
+	shares := sdk.NewCoins(sdk.NewCoin(types.NewPoolDenom(0), sdkmath.NewInt(10).Mul(denomMultiple)))
+	s.NoError(s.App.BankKeeper.SendCoins(s.Ctx, s.alice, s.bob, shares))
+
+	// THEN bob's shares have no cost basis
+	bobPosition := s.queryPositionValue(s.bob).PoolPositions[0]
+	s.Equal(bobPosition.SharesOwned, bobPosition.UntrackedShares)
+	s.True(bobPosition.DepositValue.IsZero())
+
+	// AND alice's basis only covers the shares she still owns
+	alicePosition := s.queryPositionValue(s.alice).PoolPositions[0]
+	s.True(alicePosition.UntrackedShares.IsZero())
+	s.Equal(sdkmath.NewInt(5).Mul(denomMultiple), alicePosition.DepositAmount0)
+}
+
+func (s *DexTestSuite) TestPositionValueLimitOrder() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN alice places a limit order that is half filled
+	trancheKey := s.aliceLimitSells("TokenB", 0, 10)
+	s.bobLimitSells("TokenA", 10, 5, types.LimitOrderType_FILL_OR_KILL)
+
+	// THEN her position holds both the unfilled and the filled amounts
+	resp := s.queryPositionValue(s.alice)
+	s.Len(resp.LimitOrderPositions, 1)
+	position := resp.LimitOrderPositions[0]
+	s.Equal(trancheKey, position.TrancheKey)
+	s.Equal(sdkmath.NewInt(5).Mul(denomMultiple), position.CurrentMakerAmount)
+	s.Equal(sdkmath.NewInt(5).Mul(denomMultiple), position.CurrentTakerAmount)
+	s.Equal(math_utils.NewPrecDecFromInt(sdkmath.NewInt(10).Mul(denomMultiple)), position.DepositValue)
+	s.Equal(position.DepositValue, position.CurrentValue)
+
+	// WHEN she withdraws the filled amount
+	s.aliceWithdrawsLimitSell(trancheKey)
+
+	// THEN only the unfilled part remains
+	position = s.queryPositionValue(s.alice).LimitOrderPositions[0]
+	s.Equal(sdkmath.NewInt(5).Mul(denomMultiple), position.CurrentMakerAmount)
+	s.True(position.CurrentTakerAmount.IsZero())
+	s.Equal(math_utils.NewPrecDecFromInt(sdkmath.NewInt(5).Mul(denomMultiple)), position.DepositValue)
+}
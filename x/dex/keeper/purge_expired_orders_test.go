@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+var expirationDeposit = sdk.NewCoins(sdk.NewInt64Coin("TokenA", 100))
+
+func (s *DexTestSuite) setExpirationDeposit(deposit sdk.Coins) {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.GoodTilExpirationDeposit = deposit
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+}
+
+func (s *DexTestSuite) bobPurgesExpiredOrders(maxOrders uint64) (*types.MsgPurgeExpiredOrdersResponse, error) {
+	return s.msgServer.PurgeExpiredOrders(s.Ctx, types.NewMsgPurgeExpiredOrders(s.bob.String(), maxOrders))
+}
+
+func (s *DexTestSuite) TestPlaceGoodTilCollectsExpirationDeposit() {
+	s.setExpirationDeposit(expirationDeposit)
+	s.fundAliceBalances(1, 10)
+
+	// WHEN alice places a GOOD_TIL_TIME order
+	s.aliceLimitSellsGoodTil("TokenB", 0, 10, s.Ctx.BlockTime().Add(time.Hour))
+
+	// THEN the expiration deposit is taken from her
+	s.assertAliceBalancesInt(sdkmath.NewInt(1_000_000-100), sdkmath.ZeroInt())
+	s.Len(s.App.DexKeeper.GetAllExpirationDeposit(s.Ctx), 1)
+}
+
+func (s *DexTestSuite) TestCancelGoodTilRefundsExpirationDeposit() {
+	s.setExpirationDeposit(expirationDeposit)
+	s.fundAliceBalances(1, 10)
+	trancheKey := s.aliceLimitSellsGoodTil("TokenB", 0, 10, s.Ctx.BlockTime().Add(time.Hour))
+
+	// WHEN alice cancels her order
+	s.aliceCancelsLimitSell(trancheKey)
+
+	// THEN the expiration deposit is refunded
+	s.assertAliceBalances(1, 10)
+	s.Empty(s.App.DexKeeper.GetAllExpirationDeposit(s.Ctx))
+}
+
+func (s *DexTestSuite) TestPurgeExpiredOrdersPaysBounty() {
+	s.setExpirationDeposit(expirationDeposit)
+	s.fundAliceBalances(1, 20)
+	s.aliceLimitSellsGoodTil("TokenB", 0, 10, s.Ctx.BlockTime().Add(time.Hour))
+	s.aliceLimitSellsGoodTil("TokenB", 1, 10, s.Ctx.BlockTime().Add(time.Hour))
+
+	// WHEN bob purges the orders after they expire
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(2 * time.Hour))
+	resp, err := s.bobPurgesExpiredOrders(10)
+	s.NoError(err)
+
+	// THEN bob receives both deposits
+	s.Equal(uint64(2), resp.OrdersPurged)
+	s.Equal(sdk.NewCoins(sdk.NewInt64Coin("TokenA", 200)), resp.Bounty)
+	s.assertBobBalancesInt(sdkmath.NewInt(200), sdkmath.ZeroInt())
+
+	// AND the orders are archived
+	s.Empty(s.App.DexKeeper.GetAllLimitOrderExpiration(s.Ctx))
+	s.Empty(s.App.DexKeeper.GetAllExpirationDeposit(s.Ctx))
+	s.Len(s.App.DexKeeper.GetAllInactiveLimitOrderTranche(s.Ctx), 2)
+}
+
+func (s *DexTestSuite) TestPurgeExpiredOrdersRespectsMax() {
+	s.setExpirationDeposit(expirationDeposit)
+	s.fundAliceBalances(1, 20)
+	s.aliceLimitSellsGoodTil("TokenB", 0, 10, s.Ctx.BlockTime().Add(time.Hour))
+	s.aliceLimitSellsGoodTil("TokenB", 1, 10, s.Ctx.BlockTime().Add(time.Hour))
+
+	// WHEN bob purges a single order
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(2 * time.Hour))
+	resp, err := s.bobPurgesExpiredOrders(1)
+	s.NoError(err)
+
+	// THEN only one order is purged
+	s.Equal(uint64(1), resp.OrdersPurged)
+	s.Len(s.App.DexKeeper.GetAllLimitOrderExpiration(s.Ctx), 1)
+	s.Len(s.App.DexKeeper.GetAllExpirationDeposit(s.Ctx), 1)
+}
+
+func (s *DexTestSuite) TestPurgeExpiredOrdersNothingExpired() {
+	s.setExpirationDeposit(expirationDeposit)
+	s.fundAliceBalances(1, 10)
+	s.aliceLimitSellsGoodTil("TokenB", 0, 10, s.Ctx.BlockTime().Add(time.Hour))
+
+	// WHEN bob tries to purge before the order expires
+	_, err := s.bobPurgesExpiredOrders(10)
+
+	// THEN nothing is purged
+	s.ErrorIs(err, types.ErrNoExpiredLimitOrders)
+	s.Len(s.App.DexKeeper.GetAllLimitOrderExpiration(s.Ctx), 1)
+}
+
+func (s *DexTestSuite) TestBeginBlockPurgeRefundsExpirationDeposit() {
+	s.setExpirationDeposit(expirationDeposit)
+	s.fundAliceBalances(1, 10)
+	s.aliceLimitSellsGoodTil("TokenB", 0, 10, s.Ctx.BlockTime().Add(time.Hour))
+
+	// WHEN the order is purged at the start of a block
+	expiredTime := s.Ctx.BlockTime().Add(2 * time.Hour)
+	s.Ctx = s.Ctx.WithBlockTime(expiredTime)
+	s.App.DexKeeper.PurgeExpiredLimitOrders(s.Ctx, expiredTime)
+
+	// THEN the deposit is returned to alice
+	s.assertAliceBalancesInt(sdkmath.NewInt(1_000_000), sdkmath.ZeroInt())
+	s.Empty(s.App.DexKeeper.GetAllExpirationDeposit(s.Ctx))
+}
+
+func (s *DexTestSuite) TestBeginBlockPurgeKeepsUnrefundedExpirationDeposit() {
+	s.setExpirationDeposit(expirationDeposit)
+	s.fundAliceBalances(1, 10)
+	trancheKey := s.aliceLimitSellsGoodTil("TokenB", 0, 10, s.Ctx.BlockTime().Add(time.Hour))
+
+	// GIVEN the deposit is owed to an address that cannot receive funds
+	deposit := s.App.DexKeeper.GetAllExpirationDeposit(s.Ctx)[0]
+	deposit.Depositor = authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	s.App.DexKeeper.SetExpirationDeposit(s.Ctx, deposit)
+
+	// WHEN the order is purged at the start of a block
+	expiredTime := s.Ctx.BlockTime().Add(2 * time.Hour)
+	s.Ctx = s.Ctx.WithBlockTime(expiredTime)
+	s.App.DexKeeper.PurgeExpiredLimitOrders(s.Ctx, expiredTime)
+
+	// THEN the order is purged but the deposit is kept
+	s.Empty(s.App.DexKeeper.GetAllLimitOrderExpiration(s.Ctx))
+	s.Len(s.App.DexKeeper.GetAllExpirationDeposit(s.Ctx), 1)
+
+	// WHEN the deposit can be received again and alice withdraws her order
+	deposit.Depositor = s.alice.String()
+	s.App.DexKeeper.SetExpirationDeposit(s.Ctx, deposit)
+	s.aliceWithdrawsLimitSell(trancheKey)
+
+	// THEN she gets her tokens and the deposit back
+	s.assertAliceBalances(1, 10)
+	s.Empty(s.App.DexKeeper.GetAllExpirationDeposit(s.Ctx))
+}
+
+func (s *DexTestSuite) TestPurgeExpiredOrdersInvalidMax() {
+	_, err := s.bobPurgesExpiredOrders(0)
+	s.ErrorIs(err, types.ErrZeroPurgeMax)
+}
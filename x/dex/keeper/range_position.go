@@ -0,0 +1,332 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// Range positions hold their pool shares in a custody account derived from the dex module name. Only the position
+// owner can withdraw or rebalance the liquidity, and always as a whole.
+
+// DepositRangeCore spreads amount0 and amount1 over the pools of a range according to the distribution shape and
+// records the resulting shares as a RangePosition owned by ownerAddr. Tick indexes are normalized.
+func (k Keeper) DepositRangeCore(
+	goCtx context.Context,
+	pairID *types.PairID,
+	callerAddr sdk.AccAddress,
+	ownerAddr sdk.AccAddress,
+	amount0 math.Int,
+	amount1 math.Int,
+	lowerTickIndex int64,
+	upperTickIndex int64,
+	tickSpacing uint64,
+	fee uint64,
+	shape types.DistributionShape,
+	options *types.DepositOptions,
+) (position types.RangePosition, amount0Deposited, amount1Deposited math.Int, failedDeposits []*types.FailedDeposit, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	position = types.RangePosition{
+		Id:     k.GetRangePositionCount(ctx),
+		Owner:  ownerAddr.String(),
+		PairId: pairID,
+	}
+
+	amount0Deposited, amount1Deposited, failedDeposits, err = k.depositRange(
+		ctx,
+		&position,
+		callerAddr,
+		amount0,
+		amount1,
+		lowerTickIndex,
+		upperTickIndex,
+		tickSpacing,
+		fee,
+		shape,
+		options,
+	)
+	if err != nil {
+		return types.RangePosition{}, math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	k.SetRangePosition(ctx, position)
+	k.SetRangePositionCount(ctx, position.Id+1)
+
+	return position, amount0Deposited, amount1Deposited, failedDeposits, nil
+}
+
+// WithdrawRangePositionCore withdraws all of the liquidity of a range position to receiverAddr and removes the position
+func (k Keeper) WithdrawRangePositionCore(
+	goCtx context.Context,
+	positionID uint64,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+) (reserve0Withdrawn, reserve1Withdrawn math.Int, sharesBurned sdk.Coins, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	position, err := k.getOwnedRangePosition(ctx, positionID, callerAddr)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	reserve0Withdrawn, reserve1Withdrawn, sharesBurned, err = k.withdrawRange(ctx, position, receiverAddr)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	k.RemoveRangePosition(ctx, position)
+
+	return reserve0Withdrawn, reserve1Withdrawn, sharesBurned, nil
+}
+
+// RebalanceRangePositionCore withdraws all of the liquidity of a range position to its owner and deposits it again
+// over a new range. The position keeps its id and fee. Tick indexes are normalized.
+func (k Keeper) RebalanceRangePositionCore(
+	goCtx context.Context,
+	positionID uint64,
+	callerAddr sdk.AccAddress,
+	lowerTickIndex int64,
+	upperTickIndex int64,
+	tickSpacing uint64,
+	shape types.DistributionShape,
+	options *types.DepositOptions,
+) (position types.RangePosition, amount0Deposited, amount1Deposited math.Int, failedDeposits []*types.FailedDeposit, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	position, err = k.getOwnedRangePosition(ctx, positionID, callerAddr)
+	if err != nil {
+		return types.RangePosition{}, math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	reserve0, reserve1, _, err := k.withdrawRange(ctx, position, callerAddr)
+	if err != nil {
+		return types.RangePosition{}, math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	// remove the position before modifying it so that the owner index stays consistent
+	k.RemoveRangePosition(ctx, position)
+
+	amount0Deposited, amount1Deposited, failedDeposits, err = k.depositRange(
+		ctx,
+		&position,
+		callerAddr,
+		reserve0,
+		reserve1,
+		lowerTickIndex,
+		upperTickIndex,
+		tickSpacing,
+		position.Fee,
+		shape,
+		options,
+	)
+	if err != nil {
+		return types.RangePosition{}, math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	k.SetRangePosition(ctx, position)
+
+	return position, amount0Deposited, amount1Deposited, failedDeposits, nil
+}
+
+// depositRange deposits the amounts from callerAddr over the range and updates the position's range and shares
+func (k Keeper) depositRange(
+	ctx sdk.Context,
+	position *types.RangePosition,
+	callerAddr sdk.AccAddress,
+	amount0 math.Int,
+	amount1 math.Int,
+	lowerTickIndex int64,
+	upperTickIndex int64,
+	tickSpacing uint64,
+	fee uint64,
+	shape types.DistributionShape,
+	options *types.DepositOptions,
+) (amount0Deposited, amount1Deposited math.Int, failedDeposits []*types.FailedDeposit, err error) {
+	if err := types.ValidateRange(lowerTickIndex, upperTickIndex, tickSpacing, fee); err != nil {
+		return math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	tickIndexes := types.RangeTickIndexes(lowerTickIndex, upperTickIndex, tickSpacing)
+	weights := types.DistributionWeights(shape, len(tickIndexes))
+	parts0 := types.SplitAmount(amount0, weights)
+	parts1 := types.SplitAmount(amount1, weights)
+
+	// ticks where the shape rounds both amounts down to zero are skipped
+	var amounts0, amounts1 []math.Int
+	var depositTicks []int64
+	var fees []uint64
+	var depositOptions []*types.DepositOptions
+	for i, tickIndex := range tickIndexes {
+		if parts0[i].IsZero() && parts1[i].IsZero() {
+			continue
+		}
+		amounts0 = append(amounts0, parts0[i])
+		amounts1 = append(amounts1, parts1[i])
+		depositTicks = append(depositTicks, tickIndex)
+		fees = append(fees, fee)
+		depositOptions = append(depositOptions, options)
+	}
+
+	if len(depositTicks) == 0 {
+		return math.ZeroInt(), math.ZeroInt(), nil, types.ErrZeroDeposit
+	}
+
+	deposited0, deposited1, sharesIssued, failedDeposits, err := k.DepositCore(
+		ctx,
+		position.PairId,
+		callerAddr,
+		rangePositionCustodian(),
+		amounts0,
+		amounts1,
+		depositTicks,
+		fees,
+		depositOptions,
+	)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	if sharesIssued.IsZero() {
+		return math.ZeroInt(), math.ZeroInt(), nil, types.ErrRangeDepositFailed
+	}
+
+	amount0Deposited, amount1Deposited = math.ZeroInt(), math.ZeroInt()
+	for i := range deposited0 {
+		amount0Deposited = amount0Deposited.Add(deposited0[i])
+		amount1Deposited = amount1Deposited.Add(deposited1[i])
+	}
+
+	position.LowerTickIndex = lowerTickIndex
+	position.UpperTickIndex = upperTickIndex
+	position.TickSpacing = tickSpacing
+	position.Fee = fee
+	position.Shape = shape
+	position.Shares = sharesIssued
+
+	return amount0Deposited, amount1Deposited, failedDeposits, nil
+}
+
+// withdrawRange burns all of a position's shares and sends the reserves to receiverAddr
+func (k Keeper) withdrawRange(
+	ctx sdk.Context,
+	position types.RangePosition,
+	receiverAddr sdk.AccAddress,
+) (reserve0Withdrawn, reserve1Withdrawn math.Int, sharesBurned sdk.Coins, err error) {
+	sharesToRemove := make([]math.Int, len(position.Shares))
+	tickIndexes := make([]int64, len(position.Shares))
+	fees := make([]uint64, len(position.Shares))
+	for i, share := range position.Shares {
+		poolMetadata, err := k.GetPoolMetadataByDenom(ctx, share.Denom)
+		if err != nil {
+			return math.ZeroInt(), math.ZeroInt(), nil, err
+		}
+		sharesToRemove[i] = share.Amount
+		tickIndexes[i] = poolMetadata.Tick
+		fees[i] = poolMetadata.Fee
+	}
+
+	return k.WithdrawCore(
+		ctx,
+		position.PairId,
+		rangePositionCustodian(),
+		receiverAddr,
+		sharesToRemove,
+		tickIndexes,
+		fees,
+	)
+}
+
+func (k Keeper) getOwnedRangePosition(ctx sdk.Context, positionID uint64, callerAddr sdk.AccAddress) (types.RangePosition, error) {
+	position, found := k.GetRangePosition(ctx, positionID)
+	if !found {
+		return types.RangePosition{}, sdkerrors.Wrapf(types.ErrRangePositionNotFound, "position %d", positionID)
+	}
+
+	if position.Owner != callerAddr.String() {
+		return types.RangePosition{}, types.ErrNotRangePositionOwner
+	}
+
+	return position, nil
+}
+
+// rangePositionCustodian is the account holding the pool shares of all range positions. It cannot be the dex module
+// account itself since module accounts are blocked from receiving funds.
+func rangePositionCustodian() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.RangePositionCustodianName)
+}
+
+// SetRangePosition set a specific rangePosition in the store and index it by owner
+func (k Keeper) SetRangePosition(ctx sdk.Context, position types.RangePosition) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RangePositionKeyPrefix))
+	b := k.cdc.MustMarshal(&position)
+	store.Set(sdk.Uint64ToBigEndian(position.Id), b)
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	ownerStore.Set(types.RangePositionOwnerKey(position.Owner, position.Id), []byte{})
+}
+
+// GetRangePosition returns a rangePosition from its id
+func (k Keeper) GetRangePosition(ctx sdk.Context, id uint64) (val types.RangePosition, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RangePositionKeyPrefix))
+	b := store.Get(sdk.Uint64ToBigEndian(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRangePosition removes a rangePosition and its owner index from the store
+func (k Keeper) RemoveRangePosition(ctx sdk.Context, position types.RangePosition) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RangePositionKeyPrefix))
+	store.Delete(sdk.Uint64ToBigEndian(position.Id))
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	ownerStore.Delete(types.RangePositionOwnerKey(position.Owner, position.Id))
+}
+
+// GetAllRangePosition returns all rangePositions
+func (k Keeper) GetAllRangePosition(ctx sdk.Context) (list []types.RangePosition) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RangePositionKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RangePosition
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRangePositionCount get the total number of rangePositions ever created
+func (k Keeper) GetRangePositionCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.KeyPrefix(types.RangePositionCountKey))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetRangePositionCount set the total number of rangePositions ever created
+func (k Keeper) SetRangePositionCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.KeyPrefix(types.RangePositionCountKey), bz)
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// ReplaceLimitOrderCore handles the logic for MsgReplaceLimitOrder. It cancels the existing limit order and places
+// a new one with the same pair, side and order type. Any of amountIn, limitSellPrice and goodTil that are nil are
+// carried over from the canceled order.
+func (k Keeper) ReplaceLimitOrderCore(
+	goCtx context.Context,
+	trancheKey string,
+	amountIn *math.Int,
+	limitSellPrice *math_utils.PrecDec,
+	goodTil *time.Time,
+	callerAddr sdk.AccAddress,
+) (
+	canceledMakerCoinOut, canceledTakerCoinOut sdk.Coin,
+	newTrancheKey string,
+	totalInCoin, swapInCoin, swapOutCoin sdk.Coin,
+	err error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	trancheUser, found := k.GetLimitOrderTrancheUser(ctx, callerAddr.String(), trancheKey)
+	if !found {
+		return canceledMakerCoinOut, canceledTakerCoinOut, newTrancheKey, totalInCoin, swapInCoin, swapOutCoin,
+			sdkerrors.Wrapf(types.ErrValidLimitOrderTrancheNotFound, "%s", trancheKey)
+	}
+
+	tranche, _, found := k.FindLimitOrderTranche(
+		ctx,
+		&types.LimitOrderTrancheKey{
+			TradePairId:           trancheUser.TradePairId,
+			TickIndexTakerToMaker: trancheUser.TickIndexTakerToMaker,
+			TrancheKey:            trancheKey,
+		},
+	)
+	if !found {
+		return canceledMakerCoinOut, canceledTakerCoinOut, newTrancheKey, totalInCoin, swapInCoin, swapOutCoin,
+			sdkerrors.Wrapf(types.ErrValidLimitOrderTrancheNotFound, "%s", trancheKey)
+	}
+
+	orderType := trancheUser.OrderType
+	if goodTil == nil && orderType.IsGoodTil() {
+		goodTil = tranche.ExpirationTime
+	}
+	if goodTil != nil && !orderType.IsGoodTil() {
+		return canceledMakerCoinOut, canceledTakerCoinOut, newTrancheKey, totalInCoin, swapInCoin, swapOutCoin,
+			types.ErrExpirationOnWrongOrderType
+	}
+	if orderType.IsGoodTil() && !goodTil.After(ctx.BlockTime()) {
+		return canceledMakerCoinOut, canceledTakerCoinOut, newTrancheKey, totalInCoin, swapInCoin, swapOutCoin,
+			sdkerrors.Wrapf(types.ErrExpirationTimeInPast,
+				"Current BlockTime: %s; Provided ExpirationTime: %s",
+				ctx.BlockTime().String(),
+				goodTil.String(),
+			)
+	}
+
+	// The new order sells the maker denom of the existing order
+	tokenIn := trancheUser.TradePairId.MakerDenom
+	tokenOut := trancheUser.TradePairId.TakerDenom
+	tickIndexInToOut := trancheUser.TickIndexTakerToMaker * -1
+	if limitSellPrice != nil {
+		limitBuyPrice := math_utils.OnePrecDec().Quo(*limitSellPrice)
+		tickIndexInToOut, err = types.CalcTickIndexFromPrice(limitBuyPrice)
+		if err != nil {
+			return canceledMakerCoinOut, canceledTakerCoinOut, newTrancheKey, totalInCoin, swapInCoin, swapOutCoin,
+				sdkerrors.Wrapf(err, "invalid LimitSellPrice %s", limitSellPrice.String())
+		}
+	}
+
+	// Auto-settlement is carried over to the new order
+	autoSettle := k.HasAutoSettleOrder(ctx, trancheKey, callerAddr.String())
+
+	canceledMakerCoinOut, canceledTakerCoinOut, err = k.CancelLimitOrderCore(goCtx, trancheKey, callerAddr)
+	if err != nil {
+		return canceledMakerCoinOut, canceledTakerCoinOut, newTrancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+	}
+
+	newAmountIn := canceledMakerCoinOut.Amount
+	if amountIn != nil {
+		newAmountIn = *amountIn
+	}
+	if !newAmountIn.IsPositive() {
+		return canceledMakerCoinOut, canceledTakerCoinOut, newTrancheKey, totalInCoin, swapInCoin, swapOutCoin,
+			types.ErrZeroLimitOrder
+	}
+
+	newTrancheKey, totalInCoin, swapInCoin, swapOutCoin, err = k.PlaceLimitOrderCore(
+		goCtx,
+		tokenIn,
+		tokenOut,
+		newAmountIn,
+		tickIndexInToOut,
+		orderType,
+		goodTil,
+		nil,
+		nil,
+		callerAddr,
+		callerAddr,
+	)
+	if err != nil {
+		return canceledMakerCoinOut, canceledTakerCoinOut, newTrancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+	}
+
+	if autoSettle {
+		k.RegisterAutoSettleOrder(ctx, newTrancheKey, callerAddr)
+	}
+
+	return canceledMakerCoinOut, canceledTakerCoinOut, newTrancheKey, totalInCoin, swapInCoin, swapOutCoin, nil
+}
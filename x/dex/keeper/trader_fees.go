@@ -0,0 +1,367 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+// SetTraderFeeTierAssignment pins an address to a tier of the trader fee schedule
+func (k Keeper) SetTraderFeeTierAssignment(ctx sdk.Context, address string, tier uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TraderFeeTierAssignmentKeyPrefix))
+	b := k.cdc.MustMarshal(&types.TraderFeeTierAssignment{Address: address, Tier: tier})
+	store.Set(types.TraderFeeTierAssignmentKey(address), b)
+}
+
+// RemoveTraderFeeTierAssignment removes the tier assignment of an address so its tier is derived from its volume
+func (k Keeper) RemoveTraderFeeTierAssignment(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TraderFeeTierAssignmentKeyPrefix))
+	store.Delete(types.TraderFeeTierAssignmentKey(address))
+}
+
+// GetTraderFeeTierAssignment returns the tier assigned to an address, if any
+func (k Keeper) GetTraderFeeTierAssignment(ctx sdk.Context, address string) (tier uint32, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TraderFeeTierAssignmentKeyPrefix))
+	b := store.Get(types.TraderFeeTierAssignmentKey(address))
+	if b == nil {
+		return 0, false
+	}
+
+	var val types.TraderFeeTierAssignment
+	k.cdc.MustUnmarshal(b, &val)
+	return val.Tier, true
+}
+
+// GetAllTraderFeeTierAssignment returns all trader fee tier assignments
+func (k Keeper) GetAllTraderFeeTierAssignment(ctx sdk.Context) (list []types.TraderFeeTierAssignment) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TraderFeeTierAssignmentKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TraderFeeTierAssignment
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetTraderVolume sets the volume of an address for a day
+func (k Keeper) SetTraderVolume(ctx sdk.Context, traderVolume types.TraderVolume) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&traderVolume)
+	store.Set(types.TraderVolumeKey(traderVolume.Address, traderVolume.Day), b)
+}
+
+// GetAllTraderVolume returns the daily volumes of all addresses
+func (k Keeper) GetAllTraderVolume(ctx sdk.Context) (list []types.TraderVolume) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TraderVolumeKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TraderVolume
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetTraderVolume returns the volume of an address over the trader volume window, including the current day
+func (k Keeper) GetTraderVolume(ctx sdk.Context, address string) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TraderVolumePrefix(address))
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(k.firstVolumeDay(ctx)), nil)
+
+	defer iterator.Close()
+
+	volume := math.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TraderVolume
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		volume = volume.Add(val.Volume)
+	}
+
+	return volume
+}
+
+// RecordTraderVolume adds an amount traded by an address to its volume of the current day and prunes its volumes that
+// are outside of the trader volume window. Only amounts in the trader volume denom are counted.
+func (k Keeper) RecordTraderVolume(ctx sdk.Context, address string, coin sdk.Coin) {
+	params := k.GetParams(ctx)
+	if len(params.TraderFeeTiers) == 0 || params.TraderVolumeDenom == "" ||
+		coin.Denom != params.TraderVolumeDenom || !coin.Amount.IsPositive() {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TraderVolumePrefix(address))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(k.firstVolumeDay(ctx)))
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+
+	day := currentDay(ctx)
+	traderVolume := types.TraderVolume{Address: address, Day: day, Volume: math.ZeroInt()}
+	if b := ctx.KVStore(k.storeKey).Get(types.TraderVolumeKey(address, day)); b != nil {
+		k.cdc.MustUnmarshal(b, &traderVolume)
+	}
+	traderVolume.Volume = traderVolume.Volume.Add(coin.Amount)
+	k.SetTraderVolume(ctx, traderVolume)
+}
+
+// RecordTakerVolume adds the swaps of a trader to its volume. It is called for every dispatched swap whether or not
+// hooks are set, so that fee tiers don't depend on the hooks wiring.
+func (k Keeper) RecordTakerVolume(ctx sdk.Context, trader sdk.AccAddress, swaps []types.SwapRecord) {
+	for _, swap := range swaps {
+		k.RecordTraderVolume(ctx, trader.String(), sdk.NewCoin(swap.TradePairId.TakerDenom, swap.AmountIn))
+		k.RecordTraderVolume(ctx, trader.String(), sdk.NewCoin(swap.TradePairId.MakerDenom, swap.AmountOut))
+	}
+}
+
+func currentDay(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockTime().Unix() / secondsPerDay) //nolint:gosec
+}
+
+// firstVolumeDay returns the first day of the trader volume window
+func (k Keeper) firstVolumeDay(ctx sdk.Context) uint64 {
+	day := currentDay(ctx)
+	window := k.GetParams(ctx).TraderVolumeWindow
+	if window > day {
+		return 0
+	}
+	return day + 1 - window
+}
+
+// GetTraderFees returns the tier of the trader fee schedule that applies to an address. The tier is the one assigned
+// by governance if any, and is otherwise derived from the volume of the address. found is false if the trader fee
+// schedule is empty.
+func (k Keeper) GetTraderFees(ctx sdk.Context, address string) (tier uint32, fees types.TraderFeeTier, isAssigned, found bool) {
+	params := k.GetParams(ctx)
+	if len(params.TraderFeeTiers) == 0 {
+		return 0, types.TraderFeeTier{MinVolume: math.ZeroInt()}, false, false
+	}
+
+	tier, isAssigned = k.GetTraderFeeTierAssignment(ctx, address)
+	if !isAssigned {
+		tier = params.TraderFeeTierForVolume(k.GetTraderVolume(ctx, address))
+	}
+	// The schedule may have been shortened since the tier was assigned
+	if int(tier) >= len(params.TraderFeeTiers) {
+		tier = uint32(len(params.TraderFeeTiers) - 1) //nolint:gosec
+	}
+
+	return tier, params.TraderFeeTiers[tier], isAssigned, true
+}
+
+// GetTakerFee returns the surcharge in basis points paid by a trader on the amount filled against limit orders
+func (k Keeper) GetTakerFee(ctx sdk.Context, trader sdk.AccAddress) uint64 {
+	_, fees, _, _ := k.GetTraderFees(ctx, trader.String())
+	return fees.TakerFee
+}
+
+// CalcTakerFee returns the taker surcharge owed on a filled amount, rounded up. feeBps is in basis points.
+func CalcTakerFee(amount math.Int, feeBps uint64) math.Int {
+	denominator := math.NewIntFromUint64(types.MaxTraderFee)
+	return amount.Mul(math.NewIntFromUint64(feeBps)).Add(denominator).SubRaw(1).Quo(denominator)
+}
+
+// takerFeeMultiplier returns the factor by which the taker surcharge raises the price of limit orders
+func takerFeeMultiplier(feeBps uint64) math_utils.PrecDec {
+	return math_utils.NewPrecDecFromInt(math.NewIntFromUint64(types.MaxTraderFee + feeBps)).
+		QuoInt(math.NewIntFromUint64(types.MaxTraderFee))
+}
+
+// SetTrancheRebatePool sets the rebate pool of a tranche
+func (k Keeper) SetTrancheRebatePool(ctx sdk.Context, pool types.TrancheRebatePool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrancheRebatePoolKeyPrefix))
+	b := k.cdc.MustMarshal(&pool)
+	store.Set(types.TrancheRebatePoolKey(pool.TrancheKey), b)
+}
+
+// GetTrancheRebatePool returns the rebate pool of a tranche
+func (k Keeper) GetTrancheRebatePool(ctx sdk.Context, trancheKey string) (val types.TrancheRebatePool, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrancheRebatePoolKeyPrefix))
+	b := store.Get(types.TrancheRebatePoolKey(trancheKey))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveTrancheRebatePool removes the rebate pool of a tranche
+func (k Keeper) RemoveTrancheRebatePool(ctx sdk.Context, trancheKey string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrancheRebatePoolKeyPrefix))
+	store.Delete(types.TrancheRebatePoolKey(trancheKey))
+}
+
+// GetAllTrancheRebatePool returns the rebate pools of all tranches
+func (k Keeper) GetAllTrancheRebatePool(ctx sdk.Context) (list []types.TrancheRebatePool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrancheRebatePoolKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TrancheRebatePool
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddTrancheSurcharge adds a taker surcharge paid on a fill of a tranche to the rebate pool of the tranche
+func (k Keeper) AddTrancheSurcharge(ctx sdk.Context, trancheKey *types.LimitOrderTrancheKey, surcharge, fill math.Int) {
+	pool, found := k.GetTrancheRebatePool(ctx, trancheKey.TrancheKey)
+	if !found {
+		pool = types.TrancheRebatePool{
+			TrancheKey:     trancheKey.TrancheKey,
+			Surcharges:     sdk.NewCoin(trancheKey.TradePairId.TakerDenom, math.ZeroInt()),
+			UnsettledFills: math.ZeroInt(),
+		}
+	}
+
+	pool.Surcharges.Amount = pool.Surcharges.Amount.Add(surcharge)
+	pool.UnsettledFills = pool.UnsettledFills.Add(fill)
+	k.SetTrancheRebatePool(ctx, pool)
+}
+
+// SettleMakerRebate is called when a maker withdraws the filled amount of a tranche. It takes the share of the
+// surcharges paid on the tranche that corresponds to the withdrawn amount out of the tranche rebate pool, credits the
+// maker with a rebate according to its tier and sends the rest to the fee collector.
+func (k Keeper) SettleMakerRebate(ctx sdk.Context, trancheUser *types.LimitOrderTrancheUser, amountOut math.Int) error {
+	if !amountOut.IsPositive() {
+		return nil
+	}
+	k.recordMakerVolume(ctx, trancheUser, amountOut)
+
+	pool, found := k.GetTrancheRebatePool(ctx, trancheUser.TrancheKey)
+	if !found {
+		return nil
+	}
+
+	settled := math.MinInt(amountOut, pool.UnsettledFills)
+	share := pool.Surcharges.Amount
+	if settled.LT(pool.UnsettledFills) {
+		share = share.Mul(settled).Quo(pool.UnsettledFills)
+	}
+	pool.UnsettledFills = pool.UnsettledFills.Sub(settled)
+	pool.Surcharges.Amount = pool.Surcharges.Amount.Sub(share)
+	if pool.UnsettledFills.IsZero() {
+		k.RemoveTrancheRebatePool(ctx, pool.TrancheKey)
+	} else {
+		k.SetTrancheRebatePool(ctx, pool)
+	}
+
+	_, fees, _, _ := k.GetTraderFees(ctx, trancheUser.Address)
+	rebate := math.MinInt(share, settled.Mul(math.NewIntFromUint64(fees.MakerRebate)).Quo(math.NewIntFromUint64(types.MaxTraderFee)))
+	if rebate.IsPositive() {
+		k.AddMakerRebates(ctx, trancheUser.Address, sdk.NewCoins(sdk.NewCoin(pool.Surcharges.Denom, rebate)))
+	}
+
+	protocolFee := share.Sub(rebate)
+	if protocolFee.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(pool.Surcharges.Denom, protocolFee))
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins)
+	}
+
+	return nil
+}
+
+// recordMakerVolume adds the withdrawn fill of a maker to its volume. Fills of tranches selling the trader volume denom
+// are converted back into the volume denom at the tranche price.
+func (k Keeper) recordMakerVolume(ctx sdk.Context, trancheUser *types.LimitOrderTrancheUser, amountOut math.Int) {
+	tradePairID := trancheUser.TradePairId
+	if tradePairID.MakerDenom != k.GetParams(ctx).TraderVolumeDenom {
+		k.RecordTraderVolume(ctx, trancheUser.Address, sdk.NewCoin(tradePairID.TakerDenom, amountOut))
+		return
+	}
+
+	makerPrice, err := tradePairID.MakerPrice(trancheUser.TickIndexTakerToMaker)
+	if err != nil {
+		return
+	}
+	makerAmount := math_utils.NewPrecDecFromInt(amountOut).Quo(makerPrice).TruncateInt()
+	k.RecordTraderVolume(ctx, trancheUser.Address, sdk.NewCoin(tradePairID.MakerDenom, makerAmount))
+}
+
+// SetMakerRebates sets the unclaimed rebates of an address
+func (k Keeper) SetMakerRebates(ctx sdk.Context, makerRebates types.MakerRebates) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MakerRebatesKeyPrefix))
+	b := k.cdc.MustMarshal(&makerRebates)
+	store.Set(types.MakerRebatesKey(makerRebates.Address), b)
+}
+
+// GetMakerRebates returns the unclaimed rebates of an address
+func (k Keeper) GetMakerRebates(ctx sdk.Context, address string) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MakerRebatesKeyPrefix))
+	b := store.Get(types.MakerRebatesKey(address))
+	if b == nil {
+		return sdk.NewCoins()
+	}
+
+	var val types.MakerRebates
+	k.cdc.MustUnmarshal(b, &val)
+	return val.Rebates
+}
+
+// GetAllMakerRebates returns the unclaimed rebates of all addresses
+func (k Keeper) GetAllMakerRebates(ctx sdk.Context) (list []types.MakerRebates) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MakerRebatesKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MakerRebates
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddMakerRebates credits rebates to an address
+func (k Keeper) AddMakerRebates(ctx sdk.Context, address string, rebates sdk.Coins) {
+	k.SetMakerRebates(ctx, types.MakerRebates{
+		Address: address,
+		Rebates: k.GetMakerRebates(ctx, address).Add(rebates...),
+	})
+}
+
+// ClaimMakerRebatesCore sends all unclaimed rebates of an address to it
+func (k Keeper) ClaimMakerRebatesCore(ctx sdk.Context, callerAddr sdk.AccAddress) (sdk.Coins, error) {
+	rebates := k.GetMakerRebates(ctx, callerAddr.String())
+	if rebates.IsZero() {
+		return nil, types.ErrNoMakerRebates
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MakerRebatesKeyPrefix))
+	store.Delete(types.MakerRebatesKey(callerAddr.String()))
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, callerAddr, rebates); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(types.CreateClaimMakerRebatesEvent(callerAddr, rebates))
+
+	return rebates, nil
+}
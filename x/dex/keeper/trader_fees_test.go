@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) setTraderFeeTiers(tiers ...types.TraderFeeTier) {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.TraderFeeTiers = tiers
+	params.TraderVolumeDenom = "TokenA"
+	params.TraderVolumeWindow = 30
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+}
+
+func (s *DexTestSuite) traderFees(addr sdk.AccAddress) *types.QueryTraderFeesResponse {
+	resp, err := s.App.DexKeeper.TraderFees(s.Ctx, &types.QueryTraderFeesRequest{Address: addr.String()})
+	s.NoError(err)
+	return resp
+}
+
+func (s *DexTestSuite) TestTakerFeeAndMakerRebate() {
+	s.setTraderFeeTiers(types.TraderFeeTier{MinVolume: math.ZeroInt(), TakerFee: 20, MakerRebate: 10})
+	s.fundAliceBalances(0, 50)
+	s.fundBobBalances(10, 0)
+	feeCollector := s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBefore := s.App.BankKeeper.GetBalance(s.Ctx, feeCollector, "TokenA").Amount
+
+	// GIVEN alice limit sells 50 B at tick 0
+	trancheKey := s.aliceLimitSells("TokenB", 0, 50)
+
+	// WHEN bob market sells 10 A
+	s.bobLimitSells("TokenA", 50, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN bob pays a 20 bps surcharge on top of the 9.980039 A filled
+	s.assertBobBalancesInt(math.ZeroInt(), math.NewInt(9_980_039))
+	pool, found := s.App.DexKeeper.GetTrancheRebatePool(s.Ctx, trancheKey)
+	s.True(found)
+	s.Equal(sdk.NewInt64Coin("TokenA", 19_961), pool.Surcharges)
+	s.Equal(math.NewInt(9_980_039), pool.UnsettledFills)
+	s.Equal(math.NewInt(10_000_000), s.traderFees(s.bob).Volume)
+
+	// WHEN alice withdraws her filled order
+	s.aliceWithdrawsLimitSell(trancheKey)
+
+	// THEN she is credited a 10 bps rebate and the rest of the surcharge goes to the fee collector
+	_, found = s.App.DexKeeper.GetTrancheRebatePool(s.Ctx, trancheKey)
+	s.False(found)
+	aliceFees := s.traderFees(s.alice)
+	s.Equal(sdk.NewCoins(sdk.NewInt64Coin("TokenA", 9_980)), aliceFees.ClaimableRebates)
+	s.Equal(math.NewInt(9_980_039), aliceFees.Volume)
+	s.Equal(
+		feeCollectorBefore.AddRaw(9_981),
+		s.App.BankKeeper.GetBalance(s.Ctx, feeCollector, "TokenA").Amount,
+	)
+	_, broken := keeper.ModuleBalanceInvariant(s.App.DexKeeper)(s.Ctx)
+	s.False(broken)
+
+	// WHEN alice claims her rebates THEN they are sent to her
+	resp, err := s.msgServer.ClaimMakerRebates(s.Ctx, types.NewMsgClaimMakerRebates(s.alice.String()))
+	s.NoError(err)
+	s.Equal(sdk.NewCoins(sdk.NewInt64Coin("TokenA", 9_980)), resp.Rebates)
+	s.assertAliceBalancesInt(math.NewInt(9_980_039+9_980), math.NewInt(0))
+	s.True(s.traderFees(s.alice).ClaimableRebates.IsZero())
+
+	// AND there is nothing left to claim
+	_, err = s.msgServer.ClaimMakerRebates(s.Ctx, types.NewMsgClaimMakerRebates(s.alice.String()))
+	s.ErrorIs(err, types.ErrNoMakerRebates)
+}
+
+func (s *DexTestSuite) TestMakerRebateSplitBetweenMakers() {
+	s.setTraderFeeTiers(types.TraderFeeTier{MinVolume: math.ZeroInt(), TakerFee: 20, MakerRebate: 20})
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(0, 10)
+	s.fundCarolBalances(20, 0)
+
+	// GIVEN alice and bob both limit sell 10 B into the same tranche and carol fills the tranche
+	trancheKey := s.aliceLimitSells("TokenB", 0, 10)
+	s.bobLimitSells("TokenB", 0, 10)
+	s.carolLimitSells("TokenA", 50, 20, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	pool, _ := s.App.DexKeeper.GetTrancheRebatePool(s.Ctx, trancheKey)
+	surcharges := pool.Surcharges.Amount
+
+	// WHEN both makers withdraw
+	s.aliceWithdrawsLimitSell(trancheKey)
+	s.bobWithdrawsLimitSell(trancheKey)
+
+	// THEN the surcharges are split evenly between them and at most rounding dust is left in the pool
+	aliceRebates := s.traderFees(s.alice).ClaimableRebates.AmountOf("TokenA")
+	bobRebates := s.traderFees(s.bob).ClaimableRebates.AmountOf("TokenA")
+	s.True(aliceRebates.IsPositive())
+	s.True(aliceRebates.Sub(bobRebates).Abs().LTE(math.OneInt()))
+	pool, _ = s.App.DexKeeper.GetTrancheRebatePool(s.Ctx, trancheKey)
+	s.True(pool.Surcharges.Amount.LTE(math.OneInt()))
+	s.True(aliceRebates.Add(bobRebates).LTE(surcharges))
+}
+
+func (s *DexTestSuite) TestTakerVolumeRecordedWithoutHooks() {
+	s.setTraderFeeTiers(types.TraderFeeTier{MinVolume: math.ZeroInt()})
+	s.App.DexKeeper.ReplaceHooks(nil)
+	s.msgServer = keeper.NewMsgServerImpl(s.App.DexKeeper)
+	s.fundAliceBalances(0, 50)
+	s.fundBobBalances(10, 0)
+	s.aliceLimitSells("TokenB", 0, 50)
+
+	// GIVEN no hooks are set WHEN bob market sells 10 A
+	s.bobLimitSells("TokenA", 50, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN his taker volume is still recorded
+	s.True(s.traderFees(s.bob).Volume.IsPositive())
+}
+
+func (s *DexTestSuite) TestTraderFeeTiers() {
+	s.setTraderFeeTiers(
+		types.TraderFeeTier{MinVolume: math.ZeroInt(), TakerFee: 20},
+		types.TraderFeeTier{MinVolume: math.NewInt(5_000_000), TakerFee: 10, MakerRebate: 5},
+	)
+
+	// GIVEN bob has no volume THEN he is in the first tier
+	s.Equal(uint32(0), s.traderFees(s.bob).Tier)
+	s.Equal(uint64(20), s.traderFees(s.bob).TakerFee)
+
+	// WHEN bob trades 6 A THEN he moves up a tier
+	s.App.DexKeeper.RecordTraderVolume(s.Ctx, s.bob.String(), sdk.NewInt64Coin("TokenA", 6_000_000))
+	fees := s.traderFees(s.bob)
+	s.Equal(uint32(1), fees.Tier)
+	s.Equal(uint64(10), fees.TakerFee)
+	s.Equal(uint64(5), fees.MakerRebate)
+	s.False(fees.IsAssigned)
+
+	// AND trades in other denoms do not count
+	s.App.DexKeeper.RecordTraderVolume(s.Ctx, s.alice.String(), sdk.NewInt64Coin("TokenB", 6_000_000))
+	s.Equal(uint32(0), s.traderFees(s.alice).Tier)
+
+	// WHEN the volume leaves the 30 day window THEN bob falls back to the first tier
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(30 * 24 * time.Hour))
+	s.Equal(uint32(0), s.traderFees(s.bob).Tier)
+	s.True(s.traderFees(s.bob).Volume.IsZero())
+
+	// WHEN governance assigns bob the second tier THEN it applies regardless of volume
+	_, err := s.msgServer.SetTraderFeeTier(s.Ctx, types.NewMsgSetTraderFeeTier(s.App.DexKeeper.GetAuthority(), s.bob.String(), 1, false))
+	s.NoError(err)
+	fees = s.traderFees(s.bob)
+	s.Equal(uint32(1), fees.Tier)
+	s.True(fees.IsAssigned)
+
+	// AND tiers that do not exist cannot be assigned
+	_, err = s.msgServer.SetTraderFeeTier(s.Ctx, types.NewMsgSetTraderFeeTier(s.App.DexKeeper.GetAuthority(), s.bob.String(), 2, false))
+	s.ErrorIs(err, types.ErrInvalidTraderFeeTier)
+
+	// AND only governance can assign tiers
+	_, err = s.msgServer.SetTraderFeeTier(s.Ctx, types.NewMsgSetTraderFeeTier(s.alice.String(), s.alice.String(), 1, false))
+	s.ErrorContains(err, "invalid authority")
+
+	// WHEN the assignment is removed THEN the tier is derived from the volume again
+	_, err = s.msgServer.SetTraderFeeTier(s.Ctx, types.NewMsgSetTraderFeeTier(s.App.DexKeeper.GetAuthority(), s.bob.String(), 0, true))
+	s.NoError(err)
+	s.False(s.traderFees(s.bob).IsAssigned)
+	s.Equal(uint32(0), s.traderFees(s.bob).Tier)
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetPairTradingStatus sets the trading status for a pair. Setting a pair back to ACTIVE removes the record.
+func (k Keeper) SetPairTradingStatus(ctx sdk.Context, pairID *types.PairID, status types.TradingStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PairTradingStatusKeyPrefix))
+	key := types.PairTradingStatusKey(pairID)
+	if status.IsActive() {
+		store.Delete(key)
+		return
+	}
+
+	b := k.cdc.MustMarshal(&types.PairTradingStatus{PairId: pairID, Status: status})
+	store.Set(key, b)
+}
+
+// GetPairTradingStatus returns the trading status set for a pair. Pairs without a status are ACTIVE.
+func (k Keeper) GetPairTradingStatus(ctx sdk.Context, pairID *types.PairID) types.TradingStatus {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PairTradingStatusKeyPrefix))
+	b := store.Get(types.PairTradingStatusKey(pairID))
+	if b == nil {
+		return types.TradingStatus_ACTIVE
+	}
+
+	var val types.PairTradingStatus
+	k.cdc.MustUnmarshal(b, &val)
+	return val.Status
+}
+
+// GetAllPairTradingStatus returns all non-active pair trading statuses
+func (k Keeper) GetAllPairTradingStatus(ctx sdk.Context) (list []types.PairTradingStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PairTradingStatusKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PairTradingStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetDenomTradingStatus sets the trading status for every pair containing the denom.
+// Setting a denom back to ACTIVE removes the record.
+func (k Keeper) SetDenomTradingStatus(ctx sdk.Context, denom string, status types.TradingStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DenomTradingStatusKeyPrefix))
+	key := types.DenomTradingStatusKey(denom)
+	if status.IsActive() {
+		store.Delete(key)
+		return
+	}
+
+	b := k.cdc.MustMarshal(&types.DenomTradingStatus{Denom: denom, Status: status})
+	store.Set(key, b)
+}
+
+// GetDenomTradingStatus returns the trading status set for a denom. Denoms without a status are ACTIVE.
+func (k Keeper) GetDenomTradingStatus(ctx sdk.Context, denom string) types.TradingStatus {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DenomTradingStatusKeyPrefix))
+	b := store.Get(types.DenomTradingStatusKey(denom))
+	if b == nil {
+		return types.TradingStatus_ACTIVE
+	}
+
+	var val types.DenomTradingStatus
+	k.cdc.MustUnmarshal(b, &val)
+	return val.Status
+}
+
+// GetAllDenomTradingStatus returns all non-active denom trading statuses
+func (k Keeper) GetAllDenomTradingStatus(ctx sdk.Context) (list []types.DenomTradingStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DenomTradingStatusKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DenomTradingStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetEffectiveTradingStatus returns the most restrictive of the pair status and the status of both of its tokens
+func (k Keeper) GetEffectiveTradingStatus(ctx sdk.Context, pairID *types.PairID) types.TradingStatus {
+	return types.MostRestrictive(
+		k.GetPairTradingStatus(ctx, pairID),
+		k.GetDenomTradingStatus(ctx, pairID.Token0),
+		k.GetDenomTradingStatus(ctx, pairID.Token1),
+	)
+}
+
+// AssertCanAddLiquidity ensures that deposits and maker limit orders are allowed for the pair
+func (k Keeper) AssertCanAddLiquidity(ctx sdk.Context, pairID *types.PairID) error {
+	if err := k.GetEffectiveTradingStatus(ctx, pairID).CanAddLiquidity(); err != nil {
+		return sdkerrors.Wrapf(err, "%s", pairID.CanonicalString())
+	}
+	return nil
+}
+
+// AssertCanSwap ensures that swaps against existing liquidity are allowed for the pair
+func (k Keeper) AssertCanSwap(ctx sdk.Context, pairID *types.PairID) error {
+	if err := k.GetEffectiveTradingStatus(ctx, pairID).CanSwap(); err != nil {
+		return sdkerrors.Wrapf(err, "%s", pairID.CanonicalString())
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) setPairTradingStatus(tokenA, tokenB string, status types.TradingStatus) {
+	_, err := s.msgServer.SetPairTradingStatus(s.Ctx, &types.MsgSetPairTradingStatus{
+		Authority: s.App.DexKeeper.GetAuthority(),
+		TokenA:    tokenA,
+		TokenB:    tokenB,
+		Status:    status,
+	})
+	s.NoError(err)
+}
+
+func (s *DexTestSuite) setDenomTradingStatus(denom string, status types.TradingStatus) {
+	_, err := s.msgServer.SetDenomTradingStatus(s.Ctx, &types.MsgSetDenomTradingStatus{
+		Authority: s.App.DexKeeper.GetAuthority(),
+		Denom:     denom,
+		Status:    status,
+	})
+	s.NoError(err)
+}
+
+func (s *DexTestSuite) TestPairHalted() {
+	s.fundAliceBalances(100, 100)
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED)
+
+	// WHEN the pair is halted
+	s.setPairTradingStatus("TokenA", "TokenB", types.TradingStatus_HALTED)
+
+	// THEN deposits, limit orders and swaps fail
+	s.assertAliceDepositFails(types.ErrPairHalted, NewDeposit(0, 10, 0, 1))
+	s.assertAliceLimitSellFails(types.ErrPairHalted, "TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED)
+	s.assertAliceLimitSellFails(types.ErrPairHalted, "TokenB", -2, 1, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.aliceMultiHopSwapFails(types.ErrPairHalted, [][]string{{"TokenB", "TokenA"}}, 1, math_utils.MustNewPrecDecFromStr("0.01"), false)
+
+	// AND cancels and withdrawals still succeed
+	s.aliceWithdraws(NewWithdrawal(5, 0, 1))
+	s.aliceCancelsLimitSell(trancheKey)
+
+	// WHEN the pair is made active again
+	s.setPairTradingStatus("TokenA", "TokenB", types.TradingStatus_ACTIVE)
+
+	// THEN everything succeeds
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+	s.aliceLimitSells("TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED)
+	s.aliceMultiHopSwaps([][]string{{"TokenB", "TokenA"}}, 1, math_utils.MustNewPrecDecFromStr("0.01"), false)
+}
+
+func (s *DexTestSuite) TestPairWithdrawOnly() {
+	s.fundAliceBalances(100, 100)
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+
+	// WHEN the pair is withdraw-only
+	s.setPairTradingStatus("TokenB", "TokenA", types.TradingStatus_WITHDRAW_ONLY)
+
+	// THEN deposits and maker limit orders fail
+	s.assertAliceDepositFails(types.ErrPairWithdrawOnly, NewDeposit(0, 10, 0, 1))
+	s.assertAliceLimitSellFails(types.ErrPairWithdrawOnly, "TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED)
+
+	// AND swaps against existing liquidity succeed
+	s.aliceLimitSells("TokenB", -2, 1, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.aliceMultiHopSwaps([][]string{{"TokenB", "TokenA"}}, 1, math_utils.MustNewPrecDecFromStr("0.01"), false)
+
+	// AND withdrawals succeed
+	s.aliceWithdraws(NewWithdrawal(5, 0, 1))
+}
+
+func (s *DexTestSuite) TestDenomHalted() {
+	s.fundAliceBalances(100, 100)
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN TokenA is halted
+	s.setDenomTradingStatus("TokenA", types.TradingStatus_HALTED)
+
+	// THEN every pair containing TokenA is halted
+	s.assertAliceDepositFails(types.ErrPairHalted, NewDeposit(0, 10, 0, 1))
+	s.aliceMultiHopSwapFails(types.ErrPairHalted, [][]string{{"TokenB", "TokenA"}}, 1, math_utils.MustNewPrecDecFromStr("0.01"), false)
+
+	// AND the effective status is reported by the query
+	resp, err := s.App.DexKeeper.PairTradingStatus(s.Ctx, &types.QueryPairTradingStatusRequest{PairId: "TokenA<>TokenB"})
+	s.NoError(err)
+	s.Equal(types.TradingStatus_ACTIVE, resp.PairStatus)
+	s.Equal(types.TradingStatus_HALTED, resp.Token0Status)
+	s.Equal(types.TradingStatus_ACTIVE, resp.Token1Status)
+	s.Equal(types.TradingStatus_HALTED, resp.EffectiveStatus)
+
+	// AND withdrawals succeed
+	s.aliceWithdraws(NewWithdrawal(5, 0, 1))
+}
+
+func (s *DexTestSuite) TestMultiHopSkipsHaltedPair() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN the best route is through E<>X but TokenE is halted
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 0, 1),
+		NewPoolSetup("TokenB", "TokenD", 0, 100, 0, 1),
+		NewPoolSetup("TokenD", "TokenX", 0, 1000, -2000, 1),
+		NewPoolSetup("TokenB", "TokenE", 0, 100, 0, 1),
+		NewPoolSetup("TokenE", "TokenX", 0, 1000, -3000, 1),
+	)
+	s.setDenomTradingStatus("TokenE", types.TradingStatus_HALTED)
+
+	// WHEN alice multihopswaps picking the best route
+	routes := [][]string{
+		{"TokenA", "TokenB", "TokenD", "TokenX"},
+		{"TokenA", "TokenB", "TokenE", "TokenX"},
+	}
+	s.aliceMultiHopSwaps(routes, 100, math_utils.MustNewPrecDecFromStr("0.9"), true)
+
+	// THEN the swap goes through D<>X and the halted pools are untouched
+	s.assertLiquidityAtTickWithDenomInt(
+		&types.PairID{Token0: "TokenB", Token1: "TokenE"},
+		math.NewInt(0),
+		math.NewInt(100_000_000),
+		0,
+		1,
+	)
+	s.assertLiquidityAtTickWithDenomInt(
+		&types.PairID{Token0: "TokenE", Token1: "TokenX"},
+		math.NewInt(0),
+		math.NewInt(1_000_000_000),
+		-3000,
+		1,
+	)
+	s.assertLiquidityAtTickWithDenomInt(
+		&types.PairID{Token0: "TokenD", Token1: "TokenX"},
+		math.NewInt(99_980_001),
+		math.NewInt(877_897_583),
+		-2000,
+		1,
+	)
+}
+
+func (s *DexTestSuite) TestSetTradingStatusInvalidAuthority() {
+	_, err := s.msgServer.SetPairTradingStatus(s.Ctx, &types.MsgSetPairTradingStatus{
+		Authority: s.alice.String(),
+		TokenA:    "TokenA",
+		TokenB:    "TokenB",
+		Status:    types.TradingStatus_HALTED,
+	})
+	s.ErrorContains(err, "invalid authority")
+
+	_, err = s.msgServer.SetDenomTradingStatus(s.Ctx, &types.MsgSetDenomTradingStatus{
+		Authority: s.alice.String(),
+		Denom:     "TokenA",
+		Status:    types.TradingStatus_HALTED,
+	})
+	s.ErrorContains(err, "invalid authority")
+}
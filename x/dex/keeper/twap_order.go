@@ -0,0 +1,346 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// TWAP orders hold their unsold amount in the dex module account. A slice is executed at the end of the block in
+// which it is due by swapping against the orderbook at or below the max price of the order; slices that cannot be
+// executed are recorded as skipped and their amount is spread over the remaining slices. Once the last slice has been
+// executed the unsold amount is returned to the creator and the order is removed. Its fills are kept so that the
+// results of completed and withdrawn orders remain queryable.
+
+// PlaceTWAPOrderCore escrows totalIn from callerAddr and creates a TWAPOrder whose first slice is executed at the end
+// of the current block
+func (k Keeper) PlaceTWAPOrderCore(
+	goCtx context.Context,
+	callerAddr sdk.AccAddress,
+	tokenIn string,
+	tokenOut string,
+	totalIn math.Int,
+	slices uint64,
+	interval uint64,
+	maxPrice math_utils.PrecDec,
+) (order types.TWAPOrder, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := types.NewTradePairID(tokenIn, tokenOut); err != nil {
+		return types.TWAPOrder{}, err
+	}
+
+	// Slices would never be executed
+	if k.GetParams(ctx).TwapSlicesPerBlock == 0 {
+		return types.TWAPOrder{}, types.ErrTWAPOrdersDisabled
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		callerAddr,
+		types.ModuleName,
+		sdk.Coins{sdk.NewCoin(tokenIn, totalIn)},
+	)
+	if err != nil {
+		return types.TWAPOrder{}, err
+	}
+
+	order = types.TWAPOrder{
+		Id:                k.GetTWAPOrderCount(ctx),
+		Creator:           callerAddr.String(),
+		TokenIn:           tokenIn,
+		TokenOut:          tokenOut,
+		TotalIn:           totalIn,
+		Slices:            slices,
+		Interval:          interval,
+		MaxPrice:          maxPrice,
+		RemainingIn:       totalIn,
+		TotalOut:          math.ZeroInt(),
+		NextExecutionTime: ctx.BlockTime(),
+	}
+	k.SetTWAPOrder(ctx, order)
+	k.SetTWAPOrderCount(ctx, order.Id+1)
+
+	ctx.EventManager().EmitEvent(types.CreatePlaceTWAPOrderEvent(order))
+
+	return order, nil
+}
+
+// WithdrawTWAPOrderCore returns the unsold amount of a TWAP order to its creator and removes the order
+func (k Keeper) WithdrawTWAPOrderCore(
+	goCtx context.Context,
+	orderID uint64,
+	callerAddr sdk.AccAddress,
+) (coinOut sdk.Coin, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	order, found := k.GetTWAPOrder(ctx, orderID)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrTWAPOrderNotFound, "order %d", orderID)
+	}
+
+	if order.Creator != callerAddr.String() {
+		return sdk.Coin{}, types.ErrNotTWAPOrderOwner
+	}
+
+	coinOut, err = k.closeTWAPOrder(ctx, order)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(types.CreateWithdrawTWAPOrderEvent(order))
+
+	return coinOut, nil
+}
+
+// ExecuteTWAPOrders executes the slices of TWAP orders that are due, earliest first and at most
+// Params.TwapSlicesPerBlock of them. Nothing is executed while the dex is paused.
+func (k Keeper) ExecuteTWAPOrders(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.Paused {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TWAPOrderScheduleKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var dueOrders []types.TWAPOrder
+	for ; iterator.Valid() && uint64(len(dueOrders)) < params.TwapSlicesPerBlock; iterator.Next() {
+		order, found := k.GetTWAPOrder(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			// should never happen
+			continue
+		}
+		if order.NextExecutionTime.After(ctx.BlockTime()) {
+			break
+		}
+		dueOrders = append(dueOrders, order)
+	}
+	iterator.Close()
+
+	for _, order := range dueOrders {
+		k.executeTWAPSlice(ctx, order)
+	}
+}
+
+func (k Keeper) executeTWAPSlice(ctx sdk.Context, order types.TWAPOrder) {
+	fill := types.TWAPOrderFill{
+		OrderId:   order.Id,
+		Slice:     order.SlicesExecuted,
+		Time:      ctx.BlockTime(),
+		AmountIn:  math.ZeroInt(),
+		AmountOut: math.ZeroInt(),
+	}
+
+	coinIn, coinOut, err := k.swapTWAPSlice(ctx, order, order.NextSliceAmount())
+	if err != nil {
+		k.Logger(ctx).Debug(
+			"Skipped TWAP order slice",
+			"err", err,
+			"order_id", order.Id,
+			"slice", fill.Slice,
+		)
+		fill.Skipped = true
+	} else {
+		fill.AmountIn = coinIn.Amount
+		fill.AmountOut = coinOut.Amount
+	}
+
+	// remove the order before modifying it so that the schedule index stays consistent
+	k.RemoveTWAPOrder(ctx, order)
+
+	order.RemainingIn = order.RemainingIn.Sub(fill.AmountIn)
+	order.TotalOut = order.TotalOut.Add(fill.AmountOut)
+	order.SlicesExecuted++
+	order.NextExecutionTime = ctx.BlockTime().Add(order.IntervalDuration())
+
+	ctx.EventManager().EmitEvent(types.CreateTWAPOrderSliceEvent(order, fill))
+	k.SetTWAPOrderFill(ctx, fill)
+
+	if order.IsComplete() {
+		if _, err := k.closeTWAPOrder(ctx, order); err != nil {
+			// should never happen, keep the order so that it can be withdrawn
+			k.Logger(ctx).Error("Failed to close TWAP order", "err", err, "order_id", order.Id)
+			k.SetTWAPOrder(ctx, order)
+		}
+		return
+	}
+
+	k.SetTWAPOrder(ctx, order)
+}
+
+// swapTWAPSlice sells amountIn of the escrowed funds of a TWAP order and sends the proceeds to its creator.
+// Slices run in EndBlock, so each one is given TWAPSliceGasLimit gas. State changes are only written if the slice succeeds.
+func (k Keeper) swapTWAPSlice(ctx sdk.Context, order types.TWAPOrder, amountIn math.Int) (coinIn, coinOut sdk.Coin, err error) {
+	tradePairID, err := types.NewTradePairID(order.TokenIn, order.TokenOut)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	err = runWithGasLimit(ctx, types.TWAPSliceGasLimit, func(ctx sdk.Context) error {
+		if err := k.AssertCanSwap(ctx, tradePairID.MustPairID()); err != nil {
+			return err
+		}
+
+		creatorAddr := sdk.MustAccAddressFromBech32(order.Creator)
+		coinIn, coinOut, _, err = k.Swap(
+			ctx,
+			tradePairID,
+			amountIn,
+			nil,
+			&order.MaxPrice,
+			k.GetTakerFee(ctx, creatorAddr),
+		)
+		if err != nil {
+			return err
+		}
+
+		if !coinOut.IsPositive() {
+			return types.ErrNoLiquidity
+		}
+
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, sdk.Coins{coinOut})
+		if err != nil {
+			return err
+		}
+
+		return k.DispatchSwapHooks(ctx, creatorAddr)
+	})
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	return coinIn, coinOut, nil
+}
+
+// closeTWAPOrder returns the unsold amount of a TWAP order to its creator and removes the order. Its fills are kept.
+func (k Keeper) closeTWAPOrder(ctx sdk.Context, order types.TWAPOrder) (coinOut sdk.Coin, err error) {
+	coinOut = sdk.NewCoin(order.TokenIn, order.RemainingIn)
+	if coinOut.IsPositive() {
+		creatorAddr := sdk.MustAccAddressFromBech32(order.Creator)
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, sdk.Coins{coinOut})
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	k.RemoveTWAPOrder(ctx, order)
+
+	return coinOut, nil
+}
+
+// SetTWAPOrder set a specific twapOrder in the store and index it by creator and next execution time
+func (k Keeper) SetTWAPOrder(ctx sdk.Context, order types.TWAPOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TWAPOrderKeyPrefix))
+	b := k.cdc.MustMarshal(&order)
+	store.Set(sdk.Uint64ToBigEndian(order.Id), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	indexStore.Set(types.TWAPOrderCreatorKey(order.Creator, order.Id), []byte{})
+	indexStore.Set(types.TWAPOrderScheduleKey(order.NextExecutionTime, order.Id), sdk.Uint64ToBigEndian(order.Id))
+}
+
+// GetTWAPOrder returns a twapOrder from its id
+func (k Keeper) GetTWAPOrder(ctx sdk.Context, id uint64) (val types.TWAPOrder, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TWAPOrderKeyPrefix))
+	b := store.Get(sdk.Uint64ToBigEndian(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveTWAPOrder removes a twapOrder and its indexes from the store
+func (k Keeper) RemoveTWAPOrder(ctx sdk.Context, order types.TWAPOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TWAPOrderKeyPrefix))
+	store.Delete(sdk.Uint64ToBigEndian(order.Id))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	indexStore.Delete(types.TWAPOrderCreatorKey(order.Creator, order.Id))
+	indexStore.Delete(types.TWAPOrderScheduleKey(order.NextExecutionTime, order.Id))
+}
+
+// GetAllTWAPOrder returns all twapOrders
+func (k Keeper) GetAllTWAPOrder(ctx sdk.Context) (list []types.TWAPOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TWAPOrderKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TWAPOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetTWAPOrderCount get the total number of twapOrders ever created
+func (k Keeper) GetTWAPOrderCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.KeyPrefix(types.TWAPOrderCountKey))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetTWAPOrderCount set the total number of twapOrders ever created
+func (k Keeper) SetTWAPOrderCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.KeyPrefix(types.TWAPOrderCountKey), bz)
+}
+
+// SetTWAPOrderFill sets the result of a slice of a twapOrder
+func (k Keeper) SetTWAPOrderFill(ctx sdk.Context, fill types.TWAPOrderFill) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&fill)
+	store.Set(types.TWAPOrderFillKey(fill.OrderId, fill.Slice), b)
+}
+
+// GetTWAPOrderFills returns the results of the slices of a twapOrder, oldest first
+func (k Keeper) GetTWAPOrderFills(ctx sdk.Context, orderID uint64) (list []types.TWAPOrderFill) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TWAPOrderFillPrefix(orderID))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TWAPOrderFill
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllTWAPOrderFill returns the results of the slices of all twapOrders
+func (k Keeper) GetAllTWAPOrderFill(ctx sdk.Context) (list []types.TWAPOrderFill) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TWAPOrderFillKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TWAPOrderFill
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) bobPlacesTWAPOrder(totalIn, slices, interval int, maxPrice string) uint64 {
+	resp, err := s.msgServer.PlaceTWAPOrder(s.Ctx, types.NewMsgPlaceTWAPOrder(
+		s.bob.String(),
+		"TokenA",
+		"TokenB",
+		sdkmath.NewInt(int64(totalIn)).Mul(denomMultiple),
+		uint64(slices),
+		uint64(interval),
+		math_utils.MustNewPrecDecFromStr(maxPrice),
+	))
+	s.NoError(err)
+
+	return resp.OrderId
+}
+
+func (s *DexTestSuite) executeTWAPOrdersAt(blockTime time.Time) {
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+	s.App.DexKeeper.ExecuteTWAPOrders(s.Ctx)
+}
+
+func (s *DexTestSuite) TestTWAPOrderExecutesSlices() {
+	s.fundAliceBalances(0, 100)
+	s.fundBobBalances(10, 0)
+	start := s.Ctx.BlockTime()
+
+	// GIVEN liquidity for B at a price of 1
+	s.aliceLimitSells("TokenB", 0, 100)
+
+	// WHEN bob places a TWAP order selling 10 A in 2 slices a minute apart
+	orderID := s.bobPlacesTWAPOrder(10, 2, 60, "1.01")
+	s.assertBobBalances(0, 0)
+
+	// THEN the first slice is executed at the end of the block
+	s.executeTWAPOrdersAt(start)
+	s.assertBobBalances(0, 5)
+	resp, err := s.App.DexKeeper.TWAPOrder(s.Ctx, &types.QueryGetTWAPOrderRequest{Id: orderID})
+	s.NoError(err)
+	s.Equal(sdkmath.NewInt(5_000_000), resp.TwapOrder.RemainingIn)
+	s.Equal(sdkmath.NewInt(5_000_000), resp.TwapOrder.TotalOut)
+	s.Equal(uint64(1), resp.TwapOrder.SlicesExecuted)
+	s.Len(resp.Fills, 1)
+	s.False(resp.Fills[0].Skipped)
+	_, broken := keeper.ModuleBalanceInvariant(s.App.DexKeeper)(s.Ctx)
+	s.False(broken)
+
+	// AND the second slice is not executed before the interval has passed
+	s.executeTWAPOrdersAt(start.Add(30 * time.Second))
+	s.assertBobBalances(0, 5)
+
+	// WHEN the interval has passed THEN the last slice is executed and the order is removed
+	s.executeTWAPOrdersAt(start.Add(60 * time.Second))
+	s.assertBobBalances(0, 10)
+	_, found := s.App.DexKeeper.GetTWAPOrder(s.Ctx, orderID)
+	s.False(found)
+
+	// AND the results of its slices can still be queried
+	fillsResp, err := s.App.DexKeeper.TWAPOrderFills(s.Ctx, &types.QueryTWAPOrderFillsRequest{OrderId: orderID})
+	s.NoError(err)
+	s.Len(fillsResp.Fills, 2)
+	s.Equal(sdkmath.NewInt(5_000_000), fillsResp.Fills[1].AmountOut)
+}
+
+func (s *DexTestSuite) TestTWAPOrderSkipsSlicesAboveMaxPrice() {
+	s.fundAliceBalances(0, 100)
+	s.fundBobBalances(10, 0)
+	start := s.Ctx.BlockTime()
+
+	// GIVEN liquidity for B only above bob's max price
+	s.aliceLimitSells("TokenB", 1000, 100)
+
+	// WHEN bob places a TWAP order and its first slice is due
+	orderID := s.bobPlacesTWAPOrder(10, 2, 60, "1.01")
+	s.executeTWAPOrdersAt(start)
+
+	// THEN the slice is skipped and nothing is sold
+	s.assertBobBalances(0, 0)
+	resp, err := s.App.DexKeeper.TWAPOrder(s.Ctx, &types.QueryGetTWAPOrderRequest{Id: orderID})
+	s.NoError(err)
+	s.Equal(sdkmath.NewInt(10_000_000), resp.TwapOrder.RemainingIn)
+	s.Len(resp.Fills, 1)
+	s.True(resp.Fills[0].Skipped)
+
+	// WHEN bob withdraws the order THEN the unsold amount is returned to him
+	withdrawResp, err := s.msgServer.WithdrawTWAPOrder(s.Ctx, types.NewMsgWithdrawTWAPOrder(s.bob.String(), orderID))
+	s.NoError(err)
+	s.Equal(sdkmath.NewInt(10_000_000), withdrawResp.CoinOut.Amount)
+	s.assertBobBalances(10, 0)
+	_, found := s.App.DexKeeper.GetTWAPOrder(s.Ctx, orderID)
+	s.False(found)
+}
+
+func (s *DexTestSuite) TestTWAPOrderLastSliceReturnsUnsoldAmount() {
+	s.fundAliceBalances(0, 3)
+	s.fundBobBalances(10, 0)
+	start := s.Ctx.BlockTime()
+
+	// GIVEN less liquidity than bob wants to buy
+	s.aliceLimitSells("TokenB", 0, 3)
+
+	// WHEN bob's single slice TWAP order is executed
+	orderID := s.bobPlacesTWAPOrder(10, 1, 60, "1.01")
+	s.executeTWAPOrdersAt(start)
+
+	// THEN he receives the available liquidity and the unsold amount
+	s.assertBobBalances(7, 3)
+	_, found := s.App.DexKeeper.GetTWAPOrder(s.Ctx, orderID)
+	s.False(found)
+}
+
+func (s *DexTestSuite) TestTWAPOrderWithdrawByOtherAccountFails() {
+	s.fundBobBalances(10, 0)
+	orderID := s.bobPlacesTWAPOrder(10, 2, 60, "1.01")
+
+	_, err := s.msgServer.WithdrawTWAPOrder(s.Ctx, types.NewMsgWithdrawTWAPOrder(s.alice.String(), orderID))
+	s.ErrorIs(err, types.ErrNotTWAPOrderOwner)
+
+	_, err = s.msgServer.WithdrawTWAPOrder(s.Ctx, types.NewMsgWithdrawTWAPOrder(s.bob.String(), orderID+1))
+	s.ErrorIs(err, types.ErrTWAPOrderNotFound)
+}
+
+func (s *DexTestSuite) TestTWAPOrderInvalidSlicesFails() {
+	s.fundBobBalances(10, 0)
+
+	_, err := s.msgServer.PlaceTWAPOrder(s.Ctx, types.NewMsgPlaceTWAPOrder(
+		s.bob.String(),
+		"TokenA",
+		"TokenB",
+		sdkmath.NewInt(10),
+		0,
+		60,
+		math_utils.OnePrecDec(),
+	))
+	s.ErrorIs(err, types.ErrInvalidTWAPOrder)
+}
+
+func (s *DexTestSuite) TestTWAPOrderInvalidIntervalFails() {
+	s.fundBobBalances(10, 0)
+
+	_, err := s.msgServer.PlaceTWAPOrder(s.Ctx, types.NewMsgPlaceTWAPOrder(
+		s.bob.String(),
+		"TokenA",
+		"TokenB",
+		sdkmath.NewInt(10),
+		2,
+		types.MaxTWAPOrderInterval+1,
+		math_utils.OnePrecDec(),
+	))
+	s.ErrorIs(err, types.ErrInvalidTWAPOrder)
+}
+
+func (s *DexTestSuite) TestTWAPOrderDisabledFails() {
+	s.fundBobBalances(10, 0)
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.TwapSlicesPerBlock = 0
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	_, err := s.msgServer.PlaceTWAPOrder(s.Ctx, types.NewMsgPlaceTWAPOrder(
+		s.bob.String(),
+		"TokenA",
+		"TokenB",
+		sdkmath.NewInt(10),
+		2,
+		60,
+		math_utils.OnePrecDec(),
+	))
+	s.ErrorIs(err, types.ErrTWAPOrdersDisabled)
+	s.assertBobBalances(10, 0)
+}
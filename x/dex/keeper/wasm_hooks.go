@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// WasmHooks forwards dex hooks to contracts subscribed to the pair via sudo calls.
+// Each call runs with Params.HookGasLimit gas in a cached context. Contract errors and out of gas
+// failures are logged and discarded so that a subscriber can never block dex operations.
+type WasmHooks struct {
+	k              Keeper
+	contractKeeper types.ContractKeeper
+}
+
+var _ types.DexHooks = WasmHooks{}
+
+func NewWasmHooks(k Keeper, contractKeeper types.ContractKeeper) WasmHooks {
+	return WasmHooks{
+		k:              k,
+		contractKeeper: contractKeeper,
+	}
+}
+
+func (h WasmHooks) AfterDeposit(
+	ctx sdk.Context,
+	depositor, receiver sdk.AccAddress,
+	pairID *types.PairID,
+	amount0, amount1 math.Int,
+	sharesIssued sdk.Coins,
+) error {
+	h.sudoSubscribers(ctx, pairID, types.DexHookSudoMsg{
+		AfterDeposit: &types.AfterDepositSudoMsg{
+			Depositor:    depositor.String(),
+			Receiver:     receiver.String(),
+			PairID:       pairID.CanonicalString(),
+			Amount0:      amount0.String(),
+			Amount1:      amount1.String(),
+			SharesIssued: CWCoinsFromSDKCoins(sharesIssued),
+		},
+	})
+	return nil
+}
+
+func (h WasmHooks) AfterWithdraw(
+	ctx sdk.Context,
+	withdrawer, receiver sdk.AccAddress,
+	pairID *types.PairID,
+	amount0, amount1 math.Int,
+	sharesBurned sdk.Coins,
+) error {
+	h.sudoSubscribers(ctx, pairID, types.DexHookSudoMsg{
+		AfterWithdraw: &types.AfterWithdrawSudoMsg{
+			Withdrawer:   withdrawer.String(),
+			Receiver:     receiver.String(),
+			PairID:       pairID.CanonicalString(),
+			Amount0:      amount0.String(),
+			Amount1:      amount1.String(),
+			SharesBurned: CWCoinsFromSDKCoins(sharesBurned),
+		},
+	})
+	return nil
+}
+
+func (h WasmHooks) AfterSwap(ctx sdk.Context, trader sdk.AccAddress, tradePairID *types.TradePairID, coinIn, coinOut sdk.Coin) error {
+	pairID := tradePairID.MustPairID()
+	h.sudoSubscribers(ctx, pairID, types.DexHookSudoMsg{
+		AfterSwap: &types.AfterSwapSudoMsg{
+			Trader:  trader.String(),
+			PairID:  pairID.CanonicalString(),
+			CoinIn:  CWCoinFromSDKCoin(coinIn),
+			CoinOut: CWCoinFromSDKCoin(coinOut),
+		},
+	})
+	return nil
+}
+
+func (h WasmHooks) AfterLimitOrderFilled(ctx sdk.Context, trancheKey *types.LimitOrderTrancheKey, coinIn, coinOut sdk.Coin) error {
+	pairID := trancheKey.TradePairId.MustPairID()
+	h.sudoSubscribers(ctx, pairID, types.DexHookSudoMsg{
+		AfterLimitOrderFilled: &types.AfterLimitOrderFilledSudoMsg{
+			PairID:     pairID.CanonicalString(),
+			TrancheKey: trancheKey.TrancheKey,
+			TickIndex:  trancheKey.TickIndexTakerToMaker,
+			CoinIn:     CWCoinFromSDKCoin(coinIn),
+			CoinOut:    CWCoinFromSDKCoin(coinOut),
+		},
+	})
+	return nil
+}
+
+func (h WasmHooks) sudoSubscribers(ctx sdk.Context, pairID *types.PairID, msg types.DexHookSudoMsg) {
+	subscribers := h.k.GetHookSubscribers(ctx, pairID)
+	if len(subscribers) == 0 {
+		return
+	}
+
+	msgBz, err := json.Marshal(msg)
+	if err != nil {
+		// should never happen
+		h.k.Logger(ctx).Error("failed to marshal dex hook sudo msg", "err", err)
+		return
+	}
+
+	params := h.k.GetParams(ctx)
+	for _, contractAddr := range subscribers {
+		// Subscriptions are kept when a contract is removed from the whitelist so that it can be re-enabled,
+		// but the contract is no longer called.
+		if !params.IsWhitelistedHookSubscriber(contractAddr) {
+			h.k.Logger(ctx).Debug(
+				"Skipped dex hook for contract that is not whitelisted",
+				"contract", contractAddr,
+				"pair", pairID.CanonicalString(),
+			)
+			continue
+		}
+
+		err := sudoWithGasLimit(ctx, h.contractKeeper, sdk.MustAccAddressFromBech32(contractAddr), msgBz, params.HookGasLimit)
+		if err != nil {
+			h.k.Logger(ctx).Error(
+				"Dex hook sudo call failed",
+				"err", err,
+				"contract", contractAddr,
+				"pair", pairID.CanonicalString(),
+			)
+		}
+	}
+}
+
+// sudoWithGasLimit calls the contract with a capped gas meter. State changes made by the contract are only
+// written if the call succeeds. The gas used is always charged to the parent context.
+func sudoWithGasLimit(
+	ctx sdk.Context,
+	contractKeeper types.ContractKeeper,
+	contractAddr sdk.AccAddress,
+	msg []byte,
+	gasLimit uint64,
+) (err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	childCtx := cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = types.ErrHookOutOfGas.Wrapf("%s", outOfGas.Descriptor)
+		}
+		// consume gas used for calling contract to the parent ctx
+		ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumedToLimit(), "dex hook sudo gas")
+	}()
+
+	if _, err = contractKeeper.Sudo(childCtx, contractAddr, msg); err != nil {
+		return err
+	}
+
+	writeCache()
+
+	return nil
+}
+
+// runWithGasLimit runs fn in a cached ctx with its own gas meter of gasLimit and consumes the gas it used from the parent
+// ctx. State changes are only written if fn succeeds. Running out of gas is returned as an error instead of panicking so
+// that work done in BeginBlock or EndBlock is bounded and cannot halt the chain.
+func runWithGasLimit(ctx sdk.Context, gasLimit uint64, fn func(ctx sdk.Context) error) (err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	childCtx := cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = types.ErrOutOfGas.Wrapf("%s", outOfGas.Descriptor)
+		}
+		ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumedToLimit(), "dex gas limited execution")
+	}()
+
+	if err = fn(childCtx); err != nil {
+		return err
+	}
+
+	writeCache()
+
+	return nil
+}
+
+func CWCoinFromSDKCoin(in sdk.Coin) wasmvmtypes.Coin {
+	return wasmvmtypes.Coin{
+		Denom:  in.GetDenom(),
+		Amount: in.Amount.String(),
+	}
+}
+
+func CWCoinsFromSDKCoins(in sdk.Coins) []wasmvmtypes.Coin {
+	coins := make([]wasmvmtypes.Coin, len(in))
+	for i, c := range in {
+		coins[i] = CWCoinFromSDKCoin(c)
+	}
+	return coins
+}
@@ -70,6 +70,13 @@ func (k Keeper) WithdrawCore(
 		}
 	}
 
+	if k.hooks != nil {
+		err := k.hooks.AfterWithdraw(ctx, callerAddr, receiverAddr, pairID, totalReserve0ToRemove, totalReserve1ToRemove, coinsToBurn)
+		if err != nil {
+			return math.ZeroInt(), math.ZeroInt(), nil, err
+		}
+	}
+
 	return totalReserve0ToRemove, totalReserve1ToRemove, coinsToBurn, nil
 }
 
@@ -117,6 +124,7 @@ func (k Keeper) ExecuteWithdraw(
 
 		totalShares := k.bankKeeper.GetSupply(ctx, poolDenom).Amount.Sub(alreadyWithdrawnOfDenom)
 		outAmount0, outAmount1 := pool.Withdraw(sharesToRemove, totalShares)
+		k.RecordPoolWithdrawal(ctx, callerAddr, pool.Id, sharesToRemove, sharesOwned)
 
 		// Save both sides of the pool. If one or both sides are empty they will be deleted.
 		k.UpdatePool(ctx, pool)
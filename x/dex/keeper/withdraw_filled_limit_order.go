@@ -18,6 +18,17 @@ func (k Keeper) WithdrawFilledLimitOrderCore(
 ) (takerCoinOut, makerCoinOut sdk.Coin, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// The tranche user and purged tranche are looked up before the withdrawal since they may be removed by it
+	trancheUser, _ := k.GetLimitOrderTrancheUser(ctx, callerAddr.String(), trancheKey)
+	var purgedTranche *types.LimitOrderTranche
+	if trancheUser != nil && trancheUser.OrderType.IsGoodTil() {
+		purgedTranche, _ = k.GetInactiveLimitOrderTranche(ctx, &types.LimitOrderTrancheKey{
+			TradePairId:           trancheUser.TradePairId,
+			TickIndexTakerToMaker: trancheUser.TickIndexTakerToMaker,
+			TrancheKey:            trancheKey,
+		})
+	}
+
 	takerCoinOut, makerCoinOut, err = k.ExecuteWithdrawFilledLimitOrder(ctx, trancheKey, callerAddr)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
@@ -31,6 +42,14 @@ func (k Keeper) WithdrawFilledLimitOrderCore(
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	if err := k.SettleMakerRebate(ctx, trancheUser, takerCoinOut.Amount); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if purgedTranche != nil {
+		k.claimExpirationDeposit(ctx, purgedTranche)
+	}
+
 	makerDenom := makerCoinOut.Denom
 	takerDenom := takerCoinOut.Denom
 	// This will never panic since TradePairID has already been successfully constructed by ExecuteWithdrawFilledLimitOrder
@@ -0,0 +1,48 @@
+package v6
+
+import (
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// MigrateStore performs in-place store migrations.
+// v6 adds params for hooks, flash swaps, candles, trader volume tiers, TWAP orders and auto-settled orders. Their defaults must be set since zero disables them.
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	return migrateParams(ctx, cdc, storeKey)
+}
+
+func migrateParams(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating dex params...")
+
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.KeyPrefix(types.ParamsKey))
+	if bz == nil {
+		return errors.New("cannot fetch dex params from KV store")
+	}
+
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+	params.HookGasLimit = types.DefaultHookGasLimit
+	params.FlashSwapFee = types.DefaultFlashSwapFee
+	params.MaxFlashSwapDepth = types.DefaultMaxFlashSwapDepth
+	params.CandleIntervals = types.DefaultCandleIntervals
+	params.CandleRetention = types.DefaultCandleRetention
+	params.TraderVolumeWindow = types.DefaultTraderVolumeWindow
+	params.TwapSlicesPerBlock = types.DefaultTWAPSlicesPerBlock
+	params.AutoSettlesPerBlock = types.DefaultAutoSettlesPerBlock
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyPrefix(types.ParamsKey), bz)
+
+	ctx.Logger().Info("Finished migrating dex params")
+
+	return nil
+}
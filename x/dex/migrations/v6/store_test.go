@@ -0,0 +1,50 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v5/testutil"
+	v6 "github.com/neutron-org/neutron/v5/x/dex/migrations/v6"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+type V6DexMigrationTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(V6DexMigrationTestSuite))
+}
+
+func (suite *V6DexMigrationTestSuite) TestParamsUpgrade() {
+	var (
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		storeKey = app.GetKey(types.StoreKey)
+		ctx      = suite.ChainA.GetContext()
+		cdc      = app.AppCodec()
+	)
+
+	// Params stored before v6 don't have the new fields
+	oldParams := types.Params{
+		FeeTiers:              []uint64{1, 5},
+		MaxJitsPerBlock:       types.DefaultMaxJITsPerBlock,
+		GoodTilPurgeAllowance: types.DefaultGoodTilPurgeAllowance,
+	}
+	suite.Require().NoError(app.DexKeeper.SetParams(ctx, oldParams))
+
+	suite.Require().NoError(v6.MigrateStore(ctx, cdc, storeKey))
+
+	params := app.DexKeeper.GetParams(ctx)
+	suite.Require().Equal(oldParams.FeeTiers, params.FeeTiers)
+	suite.Require().Equal(types.DefaultHookGasLimit, params.HookGasLimit)
+	suite.Require().Equal(types.DefaultFlashSwapFee, params.FlashSwapFee)
+	suite.Require().Equal(types.DefaultMaxFlashSwapDepth, params.MaxFlashSwapDepth)
+	suite.Require().Equal(types.DefaultCandleIntervals, params.CandleIntervals)
+	suite.Require().Equal(types.DefaultCandleRetention, params.CandleRetention)
+	suite.Require().Equal(types.DefaultTraderVolumeWindow, params.TraderVolumeWindow)
+	suite.Require().Equal(types.DefaultTWAPSlicesPerBlock, params.TwapSlicesPerBlock)
+	suite.Require().Equal(types.DefaultAutoSettlesPerBlock, params.AutoSettlesPerBlock)
+	suite.Require().NoError(params.Validate())
+}
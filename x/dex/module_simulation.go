@@ -47,6 +47,21 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgMultiHopSwap int = 100
 
+	opWeightMsgBatchCancelLimitOrders          = "op_weight_msg_batch_cancel_limit_orders"
+	defaultWeightMsgBatchCancelLimitOrders int = 20
+
+	opWeightMsgReplaceLimitOrder          = "op_weight_msg_replace_limit_order"
+	defaultWeightMsgReplaceLimitOrder int = 50
+
+	opWeightMsgDepositRange          = "op_weight_msg_deposit_range"
+	defaultWeightMsgDepositRange int = 50
+
+	opWeightMsgWithdrawRangePosition          = "op_weight_msg_withdraw_range_position"
+	defaultWeightMsgWithdrawRangePosition int = 30
+
+	opWeightMsgRebalanceRangePosition          = "op_weight_msg_rebalance_range_position"
+	defaultWeightMsgRebalanceRangePosition int = 30
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -69,7 +84,9 @@ func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedP
 }
 
 // RegisterStoreDecoder registers a decoder
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = dexsimulation.NewDecodeStore(am.cdc)
+}
 
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(
@@ -158,6 +175,76 @@ func (am AppModule) WeightedOperations(
 		dexsimulation.SimulateMsgMultiHopSwap(am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgBatchCancelLimitOrders int
+	simState.AppParams.GetOrGenerate(
+		opWeightMsgBatchCancelLimitOrders,
+		&weightMsgBatchCancelLimitOrders,
+		nil,
+		func(_ *rand.Rand) {
+			weightMsgBatchCancelLimitOrders = defaultWeightMsgBatchCancelLimitOrders
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBatchCancelLimitOrders,
+		dexsimulation.SimulateMsgBatchCancelLimitOrders(am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgReplaceLimitOrder int
+	simState.AppParams.GetOrGenerate(
+		opWeightMsgReplaceLimitOrder,
+		&weightMsgReplaceLimitOrder,
+		nil,
+		func(_ *rand.Rand) {
+			weightMsgReplaceLimitOrder = defaultWeightMsgReplaceLimitOrder
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgReplaceLimitOrder,
+		dexsimulation.SimulateMsgReplaceLimitOrder(am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDepositRange int
+	simState.AppParams.GetOrGenerate(
+		opWeightMsgDepositRange,
+		&weightMsgDepositRange,
+		nil,
+		func(_ *rand.Rand) {
+			weightMsgDepositRange = defaultWeightMsgDepositRange
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDepositRange,
+		dexsimulation.SimulateMsgDepositRange(am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgWithdrawRangePosition int
+	simState.AppParams.GetOrGenerate(
+		opWeightMsgWithdrawRangePosition,
+		&weightMsgWithdrawRangePosition,
+		nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawRangePosition = defaultWeightMsgWithdrawRangePosition
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgWithdrawRangePosition,
+		dexsimulation.SimulateMsgWithdrawRangePosition(am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRebalanceRangePosition int
+	simState.AppParams.GetOrGenerate(
+		opWeightMsgRebalanceRangePosition,
+		&weightMsgRebalanceRangePosition,
+		nil,
+		func(_ *rand.Rand) {
+			weightMsgRebalanceRangePosition = defaultWeightMsgRebalanceRangePosition
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRebalanceRangePosition,
+		dexsimulation.SimulateMsgRebalanceRangePosition(am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -13,17 +13,54 @@ import (
 
 func SimulateMsgCancelLimitOrder(
 	_ types.BankKeeper,
-	_ keeper.Keeper,
+	k keeper.Keeper,
 ) simtypes.Operation {
-	return func(r *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context, accs []simtypes.Account, _ string,
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgCancelLimitOrder{
-			Creator: simAccount.Address.String(),
+		simAccount, trancheUser, ok := randomTrancheUser(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgCancelLimitOrder{}), "no limit orders"), nil, nil
 		}
 
-		// TODO: Handling the CancelLimitOrder simulation
+		msg := types.NewMsgCancelLimitOrder(simAccount.Address.String(), trancheUser.TrancheKey)
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "CancelLimitOrder simulation not implemented"), nil, nil
+		return deliver(ctx, msg, keeper.NewMsgServerImpl(k).CancelLimitOrder)
+	}
+}
+
+func SimulateMsgBatchCancelLimitOrders(
+	_ types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, trancheUser, ok := randomTrancheUser(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgBatchCancelLimitOrders{}), "no limit orders"), nil, nil
+		}
+		trancheUsers := k.GetAllLimitOrderTrancheUserForAddress(ctx, simAccount.Address)
+
+		var msg *types.MsgBatchCancelLimitOrders
+		if r.Intn(2) == 0 {
+			tradePairID := trancheUser.TradePairId
+			msg = types.NewMsgBatchCancelLimitOrdersForPair(
+				simAccount.Address.String(),
+				tradePairID.MustPairID().CanonicalString(),
+				tradePairID.MakerDenom,
+			)
+		} else {
+			var trancheKeys []string
+			for _, trancheUser := range trancheUsers {
+				if r.Intn(2) == 0 {
+					trancheKeys = append(trancheKeys, trancheUser.TrancheKey)
+				}
+			}
+			if len(trancheKeys) == 0 {
+				trancheKeys = append(trancheKeys, trancheUser.TrancheKey)
+			}
+			msg = types.NewMsgBatchCancelLimitOrders(simAccount.Address.String(), trancheKeys)
+		}
+
+		return deliver(ctx, msg, keeper.NewMsgServerImpl(k).BatchCancelLimitOrders)
 	}
 }
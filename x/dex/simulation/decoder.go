@@ -0,0 +1,163 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding dex type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TickLiquidityKeyPrefix)):
+			var tickA, tickB types.TickLiquidity
+			cdc.MustUnmarshal(kvA.Value, &tickA)
+			cdc.MustUnmarshal(kvB.Value, &tickB)
+			return fmt.Sprintf("%v\n%v", tickA, tickB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.InactiveLimitOrderTrancheKeyPrefix)):
+			var trancheA, trancheB types.LimitOrderTranche
+			cdc.MustUnmarshal(kvA.Value, &trancheA)
+			cdc.MustUnmarshal(kvB.Value, &trancheB)
+			return fmt.Sprintf("%v\n%v", trancheA, trancheB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.LimitOrderTrancheUserKeyPrefix)):
+			var trancheUserA, trancheUserB types.LimitOrderTrancheUser
+			cdc.MustUnmarshal(kvA.Value, &trancheUserA)
+			cdc.MustUnmarshal(kvB.Value, &trancheUserB)
+			return fmt.Sprintf("%v\n%v", trancheUserA, trancheUserB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.LimitOrderExpirationKeyPrefix)):
+			var expirationA, expirationB types.LimitOrderExpiration
+			cdc.MustUnmarshal(kvA.Value, &expirationA)
+			cdc.MustUnmarshal(kvB.Value, &expirationB)
+			return fmt.Sprintf("%v\n%v", expirationA, expirationB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PoolMetadataKeyPrefix)):
+			var poolMetadataA, poolMetadataB types.PoolMetadata
+			cdc.MustUnmarshal(kvA.Value, &poolMetadataA)
+			cdc.MustUnmarshal(kvB.Value, &poolMetadataB)
+			return fmt.Sprintf("%v\n%v", poolMetadataA, poolMetadataB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PairTradingStatusKeyPrefix)):
+			var statusA, statusB types.PairTradingStatus
+			cdc.MustUnmarshal(kvA.Value, &statusA)
+			cdc.MustUnmarshal(kvB.Value, &statusB)
+			return fmt.Sprintf("%v\n%v", statusA, statusB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.DenomTradingStatusKeyPrefix)):
+			var statusA, statusB types.DenomTradingStatus
+			cdc.MustUnmarshal(kvA.Value, &statusA)
+			cdc.MustUnmarshal(kvB.Value, &statusB)
+			return fmt.Sprintf("%v\n%v", statusA, statusB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.HookSubscriptionKeyPrefix)):
+			var subscriptionA, subscriptionB types.HookSubscription
+			cdc.MustUnmarshal(kvA.Value, &subscriptionA)
+			cdc.MustUnmarshal(kvB.Value, &subscriptionB)
+			return fmt.Sprintf("%v\n%v", subscriptionA, subscriptionB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.RangePositionKeyPrefix)):
+			var positionA, positionB types.RangePosition
+			cdc.MustUnmarshal(kvA.Value, &positionA)
+			cdc.MustUnmarshal(kvB.Value, &positionB)
+			return fmt.Sprintf("%v\n%v", positionA, positionB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PairFeeTiersKeyPrefix)):
+			var feeTiersA, feeTiersB types.PairFeeTiers
+			cdc.MustUnmarshal(kvA.Value, &feeTiersA)
+			cdc.MustUnmarshal(kvB.Value, &feeTiersB)
+			return fmt.Sprintf("%v\n%v", feeTiersA, feeTiersB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.CandleKeyPrefix)):
+			var candleA, candleB types.Candle
+			cdc.MustUnmarshal(kvA.Value, &candleA)
+			cdc.MustUnmarshal(kvB.Value, &candleB)
+			return fmt.Sprintf("%v\n%v", candleA, candleB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TraderFeeTierAssignmentKeyPrefix)):
+			var assignmentA, assignmentB types.TraderFeeTierAssignment
+			cdc.MustUnmarshal(kvA.Value, &assignmentA)
+			cdc.MustUnmarshal(kvB.Value, &assignmentB)
+			return fmt.Sprintf("%v\n%v", assignmentA, assignmentB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TraderVolumeKeyPrefix)):
+			var volumeA, volumeB types.TraderVolume
+			cdc.MustUnmarshal(kvA.Value, &volumeA)
+			cdc.MustUnmarshal(kvB.Value, &volumeB)
+			return fmt.Sprintf("%v\n%v", volumeA, volumeB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TrancheRebatePoolKeyPrefix)):
+			var poolA, poolB types.TrancheRebatePool
+			cdc.MustUnmarshal(kvA.Value, &poolA)
+			cdc.MustUnmarshal(kvB.Value, &poolB)
+			return fmt.Sprintf("%v\n%v", poolA, poolB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.MakerRebatesKeyPrefix)):
+			var rebatesA, rebatesB types.MakerRebates
+			cdc.MustUnmarshal(kvA.Value, &rebatesA)
+			cdc.MustUnmarshal(kvB.Value, &rebatesB)
+			return fmt.Sprintf("%v\n%v", rebatesA, rebatesB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TWAPOrderKeyPrefix)):
+			var orderA, orderB types.TWAPOrder
+			cdc.MustUnmarshal(kvA.Value, &orderA)
+			cdc.MustUnmarshal(kvB.Value, &orderB)
+			return fmt.Sprintf("%v\n%v", orderA, orderB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TWAPOrderFillKeyPrefix)):
+			var fillA, fillB types.TWAPOrderFill
+			cdc.MustUnmarshal(kvA.Value, &fillA)
+			cdc.MustUnmarshal(kvB.Value, &fillB)
+			return fmt.Sprintf("%v\n%v", fillA, fillB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PoolDepositBasisKeyPrefix)):
+			var basisA, basisB types.PoolDepositBasis
+			cdc.MustUnmarshal(kvA.Value, &basisA)
+			cdc.MustUnmarshal(kvB.Value, &basisB)
+			return fmt.Sprintf("%v\n%v", basisA, basisB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.ExpirationDepositKeyPrefix)):
+			var depositA, depositB types.ExpirationDeposit
+			cdc.MustUnmarshal(kvA.Value, &depositA)
+			cdc.MustUnmarshal(kvB.Value, &depositB)
+			return fmt.Sprintf("%v\n%v", depositA, depositB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.AutoSettleOrderKeyPrefix)):
+			var orderA, orderB types.AutoSettleOrder
+			cdc.MustUnmarshal(kvA.Value, &orderA)
+			cdc.MustUnmarshal(kvB.Value, &orderB)
+			return fmt.Sprintf("%v\n%v", orderA, orderB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.AutoSettleQueueKeyPrefix)):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.ParamsKey)):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PoolIDKeyPrefix)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PoolCountKeyPrefix)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.RangePositionCountKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TWAPOrderCountKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TWAPOrderScheduleKeyPrefix)):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.RangePositionOwnerKeyPrefix)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TWAPOrderCreatorKeyPrefix)):
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		default:
+			panic(fmt.Sprintf("invalid dex key prefix %X", kvA.Key))
+		}
+	}
+}
@@ -3,6 +3,7 @@ package simulation
 import (
 	"math/rand"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
@@ -12,18 +13,38 @@ import (
 )
 
 func SimulateMsgDeposit(
-	_ types.BankKeeper,
-	_ keeper.Keeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
 ) simtypes.Operation {
-	return func(r *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context, accs []simtypes.Account, _ string,
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgDeposit{
-			Creator: simAccount.Address.String(),
+		coinA, coinB, ok := randomPair(r, ctx, bk, simAccount.Address)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgDeposit{}), "not enough denoms"), nil, nil
 		}
 
-		// TODO: Handling the Deposit simulation
+		// mostly deposit on a single side to avoid depositing behind enemy lines
+		amountA, amountB := randomAmount(r, coinA.Amount), randomAmount(r, coinB.Amount)
+		switch r.Intn(3) {
+		case 0:
+			amountB = math.ZeroInt()
+		case 1:
+			amountA = math.ZeroInt()
+		}
+
+		msg := types.NewMsgDeposit(
+			simAccount.Address.String(),
+			simAccount.Address.String(),
+			coinA.Denom,
+			coinB.Denom,
+			[]math.Int{amountA},
+			[]math.Int{amountB},
+			[]int64{randomTickIndex(r)},
+			[]uint64{randomFee(r, ctx, k, coinA.Denom, coinB.Denom)},
+			[]*types.DepositOptions{{DisableAutoswap: r.Intn(2) == 0}},
+		)
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "Deposit simulation not implemented"), nil, nil
+		return deliver(ctx, msg, keeper.NewMsgServerImpl(k).Deposit)
 	}
 }
@@ -7,23 +7,70 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
 	"github.com/neutron-org/neutron/v5/x/dex/keeper"
 	"github.com/neutron-org/neutron/v5/x/dex/types"
 )
 
 func SimulateMsgMultiHopSwap(
-	_ types.BankKeeper,
-	_ keeper.Keeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
 ) simtypes.Operation {
-	return func(r *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context, accs []simtypes.Account, _ string,
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgMultiHopSwap{
-			Creator: simAccount.Address.String(),
+		balances := tradableBalances(ctx, bk, simAccount.Address)
+
+		// index the existing liquidity by the denom it can be bought with
+		liquidityByTaker := make(map[string][]string)
+		for _, tick := range k.GetAllTickLiquidity(ctx) {
+			if !tick.HasToken() {
+				continue
+			}
+
+			var tradePairID *types.TradePairID
+			switch liquidity := tick.Liquidity.(type) {
+			case *types.TickLiquidity_PoolReserves:
+				tradePairID = liquidity.PoolReserves.Key.TradePairId
+			case *types.TickLiquidity_LimitOrderTranche:
+				tradePairID = liquidity.LimitOrderTranche.Key.TradePairId
+			}
+			liquidityByTaker[tradePairID.TakerDenom] = append(liquidityByTaker[tradePairID.TakerDenom], tradePairID.MakerDenom)
+		}
+
+		var candidates sdk.Coins
+		for _, coin := range balances {
+			if len(liquidityByTaker[coin.Denom]) > 0 {
+				candidates = append(candidates, coin)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgMultiHopSwap{}), "no tradable liquidity"), nil, nil
+		}
+
+		coinIn := candidates[r.Intn(len(candidates))]
+		route := []string{coinIn.Denom}
+		for hops := simtypes.RandIntBetween(r, 1, 4); hops > 0; hops-- {
+			makers := liquidityByTaker[route[len(route)-1]]
+			if len(makers) == 0 {
+				break
+			}
+			next := makers[r.Intn(len(makers))]
+			if next == coinIn.Denom {
+				break
+			}
+			route = append(route, next)
 		}
 
-		// TODO: Handling the MultiHopSwap simulation
+		msg := types.NewMsgMultiHopSwap(
+			simAccount.Address.String(),
+			simAccount.Address.String(),
+			[][]string{route},
+			randomAmount(r, coinIn.Amount),
+			math_utils.NewPrecDecWithPrec(1, 18),
+			r.Intn(2) == 0,
+		)
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "MultiHopSwap simulation not implemented"), nil, nil
+		return deliver(ctx, msg, keeper.NewMsgServerImpl(k).MultiHopSwap)
 	}
 }
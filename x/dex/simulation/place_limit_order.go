@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,18 +13,64 @@ import (
 )
 
 func SimulateMsgPlaceLimitOrder(
-	_ types.BankKeeper,
-	_ keeper.Keeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
 ) simtypes.Operation {
-	return func(r *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context, accs []simtypes.Account, _ string,
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgPlaceLimitOrder{
-			Creator: simAccount.Address.String(),
+		coinIn, coinOut, ok := randomPair(r, ctx, bk, simAccount.Address)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgPlaceLimitOrder{}), "not enough denoms"), nil, nil
 		}
 
-		// TODO: Handling the PlaceLimitOrder simulation
+		orderType := types.LimitOrderType(r.Intn(len(types.LimitOrderType_name))) //nolint:gosec
+		var goodTil *time.Time
+		if orderType.IsGoodTil() {
+			expirationTime := ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 3600)) * time.Second)
+			goodTil = &expirationTime
+		}
+
+		msg := types.NewMsgPlaceLimitOrder(
+			simAccount.Address.String(),
+			simAccount.Address.String(),
+			coinIn.Denom,
+			coinOut.Denom,
+			randomTickIndex(r),
+			randomAmount(r, coinIn.Amount),
+			orderType,
+			goodTil,
+			nil,
+			nil,
+		)
+
+		return deliver(ctx, msg, keeper.NewMsgServerImpl(k).PlaceLimitOrder)
+	}
+}
+
+func SimulateMsgReplaceLimitOrder(
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, trancheUser, ok := randomTrancheUser(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReplaceLimitOrder{}), "no limit orders"), nil, nil
+		}
+
+		balance := bk.GetBalance(ctx, simAccount.Address, trancheUser.TradePairId.MakerDenom)
+		amountIn := randomAmount(r, balance.Amount.Add(trancheUser.SharesOwned))
+		price := types.MustCalcPrice(randomTickIndex(r))
+
+		msg := types.NewMsgReplaceLimitOrder(
+			simAccount.Address.String(),
+			trancheUser.TrancheKey,
+			&amountIn,
+			&price,
+			nil,
+		)
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "PlaceLimitOrder simulation not implemented"), nil, nil
+		return deliver(ctx, msg, keeper.NewMsgServerImpl(k).ReplaceLimitOrder)
 	}
 }
@@ -0,0 +1,106 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// randomRange returns a range spanning at most 10 pools
+func randomRange(r *rand.Rand) (lowerTickIndex, upperTickIndex int64, tickSpacing uint64) {
+	tickSpacing = uint64(simtypes.RandIntBetween(r, 1, 50)) //nolint:gosec
+	lowerTickIndex = randomTickIndex(r)
+	upperTickIndex = lowerTickIndex + int64(tickSpacing)*int64(r.Intn(10)) //nolint:gosec
+
+	return lowerTickIndex, upperTickIndex, tickSpacing
+}
+
+func randomDistributionShape(r *rand.Rand) types.DistributionShape {
+	return types.DistributionShape(r.Intn(len(types.DistributionShape_name))) //nolint:gosec
+}
+
+func SimulateMsgDepositRange(
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		coinA, coinB, ok := randomPair(r, ctx, bk, simAccount.Address)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgDepositRange{}), "not enough denoms"), nil, nil
+		}
+
+		amountA, amountB := randomAmount(r, coinA.Amount), math.ZeroInt()
+		if r.Intn(2) == 0 {
+			amountA, amountB = amountB, randomAmount(r, coinB.Amount)
+		}
+		lowerTickIndex, upperTickIndex, tickSpacing := randomRange(r)
+
+		msg := types.NewMsgDepositRange(
+			simAccount.Address.String(),
+			simAccount.Address.String(),
+			coinA.Denom,
+			coinB.Denom,
+			amountA,
+			amountB,
+			lowerTickIndex,
+			upperTickIndex,
+			tickSpacing,
+			randomFee(r, ctx, k, coinA.Denom, coinB.Denom),
+			randomDistributionShape(r),
+			&types.DepositOptions{DisableAutoswap: true},
+		)
+
+		return deliver(ctx, msg, keeper.NewMsgServerImpl(k).DepositRange)
+	}
+}
+
+func SimulateMsgWithdrawRangePosition(
+	_ types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, position, ok := randomRangePosition(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgWithdrawRangePosition{}), "no range positions"), nil, nil
+		}
+
+		msg := types.NewMsgWithdrawRangePosition(simAccount.Address.String(), simAccount.Address.String(), position.Id)
+
+		return deliver(ctx, msg, keeper.NewMsgServerImpl(k).WithdrawRangePosition)
+	}
+}
+
+func SimulateMsgRebalanceRangePosition(
+	_ types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, position, ok := randomRangePosition(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgRebalanceRangePosition{}), "no range positions"), nil, nil
+		}
+
+		lowerTickIndex, upperTickIndex, tickSpacing := randomRange(r)
+		msg := types.NewMsgRebalanceRangePosition(
+			simAccount.Address.String(),
+			position.Id,
+			lowerTickIndex,
+			upperTickIndex,
+			tickSpacing,
+			randomDistributionShape(r),
+			&types.DepositOptions{DisableAutoswap: true},
+		)
+
+		return deliver(ctx, msg, keeper.NewMsgServerImpl(k).RebalanceRangePosition)
+	}
+}
@@ -1,10 +1,20 @@
 package simulation
 
 import (
+	"context"
+	"math/rand"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
 )
 
+// maxSimTickIndex bounds the ticks used by simulated messages so that liquidity is concentrated enough to be traded
+const maxSimTickIndex = 1000
+
 // FindAccount find a specific address from an account list
 func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
 	creator, err := sdk.AccAddressFromBech32(address)
@@ -14,3 +24,121 @@ func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, boo
 
 	return simtypes.FindAccount(accs, creator)
 }
+
+// deliver executes msg against the dex msg server in a cached context so that a failed message leaves no state behind.
+// Messages the dex rejects are reported as no-ops since random messages routinely hit expected failure conditions.
+func deliver[T sdk.Msg, R any](
+	ctx sdk.Context,
+	msg T,
+	handler func(context.Context, T) (R, error),
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	// operations run outside of a transaction so there is no block gas meter. The dex uses the block gas consumed to
+	// generate unique tranche keys, so the operation gas meter, which accumulates over the block, is used instead.
+	if ctx.BlockGasMeter() == nil {
+		ctx = ctx.WithBlockGasMeter(ctx.GasMeter())
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if _, err := handler(cacheCtx, msg); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), err.Error()), nil, nil
+	}
+	writeCache()
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
+
+// tradableBalances returns the positive balances of an account excluding pool shares
+func tradableBalances(ctx sdk.Context, bk types.BankKeeper, addr sdk.AccAddress) sdk.Coins {
+	balances := sdk.NewCoins()
+	bk.IterateAccountBalances(ctx, addr, func(coin sdk.Coin) bool {
+		if types.ValidatePoolDenom(coin.Denom) != nil && coin.IsPositive() {
+			balances = balances.Add(coin)
+		}
+		return false
+	})
+
+	return balances
+}
+
+// randomPair picks two different tradable denoms held by addr
+func randomPair(r *rand.Rand, ctx sdk.Context, bk types.BankKeeper, addr sdk.AccAddress) (coinA, coinB sdk.Coin, ok bool) {
+	balances := tradableBalances(ctx, bk, addr)
+	if len(balances) < 2 {
+		return sdk.Coin{}, sdk.Coin{}, false
+	}
+
+	perm := r.Perm(len(balances))
+	return balances[perm[0]], balances[perm[1]], true
+}
+
+// randomAmount returns a positive amount of at most a tenth of balance so that accounts can act many times
+func randomAmount(r *rand.Rand, balance math.Int) math.Int {
+	maxAmount := balance.QuoRaw(10)
+	if !maxAmount.IsPositive() {
+		return balance
+	}
+
+	return simtypes.RandomAmount(r, maxAmount).AddRaw(1)
+}
+
+func randomTickIndex(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, -maxSimTickIndex, maxSimTickIndex+1))
+}
+
+func randomFee(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, tokenA, tokenB string) uint64 {
+	feeTiers := k.GetValidFees(ctx, types.MustNewPairID(tokenA, tokenB))
+	return feeTiers[r.Intn(len(feeTiers))]
+}
+
+// randomTrancheUser picks a limit order placed by one of the simulation accounts
+func randomTrancheUser(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+) (simtypes.Account, *types.LimitOrderTrancheUser, bool) {
+	trancheUsers := k.GetAllLimitOrderTrancheUser(ctx)
+	if len(trancheUsers) == 0 {
+		return simtypes.Account{}, nil, false
+	}
+
+	trancheUser := trancheUsers[r.Intn(len(trancheUsers))]
+	simAccount, found := FindAccount(accs, trancheUser.Address)
+
+	return simAccount, trancheUser, found
+}
+
+// randomRangePosition picks a range position owned by one of the simulation accounts
+func randomRangePosition(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+) (simtypes.Account, types.RangePosition, bool) {
+	positions := k.GetAllRangePosition(ctx)
+	if len(positions) == 0 {
+		return simtypes.Account{}, types.RangePosition{}, false
+	}
+
+	position := positions[r.Intn(len(positions))]
+	simAccount, found := FindAccount(accs, position.Owner)
+
+	return simAccount, position, found
+}
+
+// randomDeposit picks a pool deposit of one of the simulation accounts
+func randomDeposit(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+) (simtypes.Account, *types.DepositRecord, bool) {
+	for _, i := range r.Perm(len(accs)) {
+		deposits := k.GetAllDepositsForAddress(ctx, accs[i].Address)
+		if len(deposits) > 0 {
+			return accs[i], deposits[r.Intn(len(deposits))], true
+		}
+	}
+
+	return simtypes.Account{}, nil, false
+}
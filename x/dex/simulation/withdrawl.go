@@ -3,6 +3,7 @@ package simulation
 import (
 	"math/rand"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
@@ -13,17 +14,31 @@ import (
 
 func SimulateMsgWithdrawal(
 	_ types.BankKeeper,
-	_ keeper.Keeper,
+	k keeper.Keeper,
 ) simtypes.Operation {
-	return func(r *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context, accs []simtypes.Account, _ string,
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgWithdrawal{
-			Creator: simAccount.Address.String(),
+		simAccount, deposit, ok := randomDeposit(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgWithdrawal{}), "no deposits"), nil, nil
 		}
 
-		// TODO: Handling the Withdrawal simulation
+		// withdraw everything half of the time so that pools are also emptied
+		sharesToRemove := deposit.SharesOwned
+		if sharesToRemove.GT(math.OneInt()) && r.Intn(2) == 0 {
+			sharesToRemove = simtypes.RandomAmount(r, deposit.SharesOwned.SubRaw(1)).AddRaw(1)
+		}
+
+		msg := types.NewMsgWithdrawal(
+			simAccount.Address.String(),
+			simAccount.Address.String(),
+			deposit.PairId.Token0,
+			deposit.PairId.Token1,
+			[]math.Int{sharesToRemove},
+			[]int64{deposit.CenterTickIndex},
+			[]uint64{deposit.Fee},
+		)
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "Withdrawal simulation not implemented"), nil, nil
+		return deliver(ctx, msg, keeper.NewMsgServerImpl(k).Withdrawal)
 	}
 }
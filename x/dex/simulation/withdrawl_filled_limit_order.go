@@ -13,17 +13,17 @@ import (
 
 func SimulateMsgWithdrawFilledLimitOrder(
 	_ types.BankKeeper,
-	_ keeper.Keeper,
+	k keeper.Keeper,
 ) simtypes.Operation {
-	return func(r *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context, accs []simtypes.Account, _ string,
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgWithdrawFilledLimitOrder{
-			Creator: simAccount.Address.String(),
+		simAccount, trancheUser, ok := randomTrancheUser(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgWithdrawFilledLimitOrder{}), "no limit orders"), nil, nil
 		}
 
-		// TODO: Handling the WithdrawFilledLimitOrder simulation
+		msg := types.NewMsgWithdrawFilledLimitOrder(simAccount.Address.String(), trancheUser.TrancheKey)
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "WithdrawFilledLimitOrder simulation not implemented"), nil, nil
+		return deliver(ctx, msg, keeper.NewMsgServerImpl(k).WithdrawFilledLimitOrder)
 	}
 }
@@ -0,0 +1,17 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// AutoSettleSudoMsg is the sudo message sent to a contract once the proceeds of its auto-settled limit order have been
+// sent to it
+type AutoSettleSudoMsg struct {
+	LimitOrderSettled *LimitOrderSettledSudoMsg `json:"limit_order_settled"`
+}
+
+type LimitOrderSettledSudoMsg struct {
+	TrancheKey   string           `json:"tranche_key"`
+	TakerCoinOut wasmvmtypes.Coin `json:"taker_coin_out"`
+	MakerCoinOut wasmvmtypes.Coin `json:"maker_coin_out"`
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+// NewCandle creates a candle from the first trade of an interval
+func NewCandle(pairID *PairID, interval uint64, openTime int64, price math_utils.PrecDec, volume0, volume1 math.Int) Candle {
+	return Candle{
+		PairId:     pairID,
+		Interval:   interval,
+		OpenTime:   openTime,
+		Open:       price,
+		High:       price,
+		Low:        price,
+		Close:      price,
+		Volume0:    volume0,
+		Volume1:    volume1,
+		TradeCount: 1,
+	}
+}
+
+// AddTrade updates the candle with a trade executed after all trades already included
+func (c *Candle) AddTrade(price math_utils.PrecDec, volume0, volume1 math.Int) {
+	if price.GT(c.High) {
+		c.High = price
+	}
+	if price.LT(c.Low) {
+		c.Low = price
+	}
+	c.Close = price
+	c.Volume0 = c.Volume0.Add(volume0)
+	c.Volume1 = c.Volume1.Add(volume1)
+	c.TradeCount++
+}
+
+// CandleOpenTime returns the start of the interval containing unixTime
+func CandleOpenTime(unixTime int64, interval uint64) int64 {
+	return unixTime - unixTime%int64(interval) //nolint:gosec
+}
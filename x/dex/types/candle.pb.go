@@ -0,0 +1,736 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/candle.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_neutron_org_neutron_v5_utils_math "github.com/neutron-org/neutron/v5/utils/math"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Candle aggregates the swaps of a pair over a time interval. Prices are the amount of token1 paid per token0.
+type Candle struct {
+	PairId *PairID `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Length of the candle in seconds
+	Interval uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Unix time at which the candle starts
+	OpenTime int64                                                `protobuf:"varint,3,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	Open     github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,4,opt,name=open,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"open" yaml:"open"`
+	High     github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,5,opt,name=high,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"high" yaml:"high"`
+	Low      github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,6,opt,name=low,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"low" yaml:"low"`
+	Close    github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,7,opt,name=close,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"close" yaml:"close"`
+	// Amount of token0 traded
+	Volume0 cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=volume0,proto3,customtype=cosmossdk.io/math.Int" json:"volume0" yaml:"volume0"`
+	// Amount of token1 traded
+	Volume1    cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=volume1,proto3,customtype=cosmossdk.io/math.Int" json:"volume1" yaml:"volume1"`
+	TradeCount uint64                `protobuf:"varint,10,opt,name=trade_count,json=tradeCount,proto3" json:"trade_count,omitempty"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7850eeb7f243562, []int{0}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *Candle) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Candle) GetOpenTime() int64 {
+	if m != nil {
+		return m.OpenTime
+	}
+	return 0
+}
+
+func (m *Candle) GetTradeCount() uint64 {
+	if m != nil {
+		return m.TradeCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Candle)(nil), "neutron.dex.Candle")
+}
+
+func init() { proto.RegisterFile("neutron/dex/candle.proto", fileDescriptor_f7850eeb7f243562) }
+
+var fileDescriptor_f7850eeb7f243562 = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xbd, 0x6e, 0xdb, 0x30,
+	0x10, 0xc7, 0xad, 0xfa, 0x9b, 0x2e, 0x3a, 0xb0, 0x2d, 0xc0, 0xba, 0x80, 0x68, 0x68, 0xf2, 0xd0,
+	0x48, 0x75, 0x3f, 0x96, 0x8c, 0x49, 0x80, 0xc2, 0x5b, 0xaa, 0xa6, 0x4b, 0x17, 0x43, 0x91, 0x08,
+	0x99, 0x88, 0xa4, 0x33, 0x28, 0xca, 0x71, 0xde, 0xa2, 0x8f, 0x95, 0x31, 0x63, 0xd1, 0x81, 0x08,
+	0xec, 0x2d, 0xa3, 0x9f, 0xa0, 0x20, 0x29, 0x27, 0x41, 0x97, 0xa2, 0x70, 0xb6, 0xbb, 0xff, 0x9d,
+	0x7e, 0x3f, 0x40, 0xe0, 0x21, 0x52, 0xb0, 0x4a, 0x0a, 0x28, 0x82, 0x84, 0xad, 0x82, 0x38, 0x2a,
+	0x92, 0x8c, 0xf9, 0x0b, 0x01, 0x12, 0xf0, 0xa0, 0x9e, 0xf8, 0x09, 0x5b, 0x0d, 0x5f, 0xa5, 0x90,
+	0x82, 0xc9, 0x03, 0x5d, 0xd9, 0x95, 0xe1, 0x9b, 0xc7, 0x1f, 0x2f, 0x22, 0x2e, 0x66, 0x3c, 0xb1,
+	0x23, 0xef, 0xb6, 0x8d, 0x3a, 0xc7, 0x06, 0x87, 0xdf, 0xa1, 0x6e, 0x3d, 0x23, 0xce, 0xc8, 0x19,
+	0x0f, 0x3e, 0xbc, 0xf4, 0x1f, 0xa1, 0xfd, 0xd3, 0x88, 0x8b, 0xe9, 0x49, 0xd8, 0xd1, 0x3b, 0xd3,
+	0x04, 0x0f, 0x51, 0x8f, 0x17, 0x92, 0x89, 0x65, 0x94, 0x91, 0x67, 0x23, 0x67, 0xdc, 0x0a, 0xef,
+	0x7b, 0xfc, 0x16, 0xf5, 0x61, 0xc1, 0x8a, 0x99, 0xe4, 0x39, 0x23, 0xcd, 0x91, 0x33, 0x6e, 0x86,
+	0x3d, 0x1d, 0x9c, 0xf1, 0x9c, 0xe1, 0x14, 0xb5, 0x74, 0x4d, 0x5a, 0x23, 0x67, 0xdc, 0x3f, 0xfa,
+	0x76, 0xad, 0x68, 0xe3, 0xb7, 0xa2, 0x9f, 0x52, 0x2e, 0xe7, 0xd5, 0xb9, 0x1f, 0x43, 0x1e, 0xd4,
+	0xd6, 0x03, 0x10, 0xe9, 0xae, 0x0e, 0x96, 0x9f, 0x83, 0x4a, 0xf2, 0xac, 0x0c, 0xf2, 0x48, 0xce,
+	0xfd, 0x53, 0xc1, 0xe2, 0x13, 0x16, 0xdf, 0x29, 0x6a, 0x58, 0x5b, 0x45, 0x07, 0x57, 0x51, 0x9e,
+	0x1d, 0x7a, 0xba, 0xf3, 0x42, 0x13, 0x6a, 0xd1, 0x9c, 0xa7, 0x73, 0xd2, 0x7e, 0x1a, 0x91, 0x66,
+	0x3d, 0x88, 0x74, 0xe7, 0x85, 0x26, 0xc4, 0x31, 0x6a, 0x66, 0x70, 0x49, 0x3a, 0xc6, 0xf3, 0x75,
+	0x4f, 0x8f, 0x46, 0x6d, 0x15, 0x45, 0x56, 0x93, 0xc1, 0xa5, 0x17, 0xea, 0x08, 0x5f, 0xa0, 0x76,
+	0x9c, 0x41, 0xc9, 0x48, 0xd7, 0x68, 0xbe, 0xef, 0xa9, 0xb1, 0xb0, 0xad, 0xa2, 0xcf, 0xad, 0xc8,
+	0xb4, 0x5e, 0x68, 0x63, 0x7c, 0x86, 0xba, 0x4b, 0xc8, 0xaa, 0x9c, 0xbd, 0x27, 0x3d, 0xa3, 0x3b,
+	0xac, 0x75, 0xaf, 0x63, 0x28, 0x73, 0x28, 0xcb, 0xe4, 0xc2, 0xe7, 0x60, 0x99, 0xd3, 0x42, 0xde,
+	0x29, 0xba, 0xdb, 0xdf, 0x2a, 0xfa, 0xc2, 0x12, 0xeb, 0xc0, 0x0b, 0x77, 0xa3, 0x07, 0xea, 0x84,
+	0xf4, 0xff, 0x8b, 0x3a, 0xf9, 0x9b, 0x3a, 0xb9, 0xa7, 0x4e, 0x30, 0x45, 0x03, 0x29, 0xa2, 0x84,
+	0xcd, 0x62, 0xa8, 0x0a, 0x49, 0x90, 0x79, 0x8b, 0xc8, 0x44, 0xc7, 0x3a, 0x39, 0xfa, 0x72, 0xbd,
+	0x76, 0x9d, 0x9b, 0xb5, 0xeb, 0xdc, 0xae, 0x5d, 0xe7, 0xe7, 0xc6, 0x6d, 0xdc, 0x6c, 0xdc, 0xc6,
+	0xaf, 0x8d, 0xdb, 0xf8, 0x71, 0xf0, 0xef, 0x9f, 0xb7, 0x32, 0x37, 0x23, 0xaf, 0x16, 0xac, 0x3c,
+	0xef, 0x98, 0x93, 0xf9, 0xf8, 0x67, 0x00, 0xb3, 0xf1, 0x81, 0x47, 0x8c, 0x03, 0x00, 0x00,
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeCount != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.TradeCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Volume1.Size()
+		i -= size
+		if _, err := m.Volume1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Volume0.Size()
+		i -= size
+		if _, err := m.Volume0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.OpenTime != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.OpenTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Interval != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCandle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCandle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCandle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovCandle(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovCandle(uint64(m.Interval))
+	}
+	if m.OpenTime != 0 {
+		n += 1 + sovCandle(uint64(m.OpenTime))
+	}
+	l = m.Open.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Volume0.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Volume1.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	if m.TradeCount != 0 {
+		n += 1 + sovCandle(uint64(m.TradeCount))
+	}
+	return n
+}
+
+func sovCandle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCandle(x uint64) (n int) {
+	return sovCandle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenTime", wireType)
+			}
+			m.OpenTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeCount", wireType)
+			}
+			m.TradeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCandle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCandle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCandle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCandle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCandle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCandle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCandle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCandle = fmt.Errorf("proto: unexpected end of group")
+)
@@ -13,7 +13,23 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "dex/PlaceLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgWithdrawFilledLimitOrder{}, "dex/WithdrawFilledLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "dex/CancelLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgBatchCancelLimitOrders{}, "dex/BatchCancelLimitOrders", nil)
+	cdc.RegisterConcrete(&MsgReplaceLimitOrder{}, "dex/ReplaceLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgMultiHopSwap{}, "dex/MultiHopSwap", nil)
+	cdc.RegisterConcrete(&MsgSetPairTradingStatus{}, "dex/SetPairTradingStatus", nil)
+	cdc.RegisterConcrete(&MsgSetDenomTradingStatus{}, "dex/SetDenomTradingStatus", nil)
+	cdc.RegisterConcrete(&MsgSubscribeHooks{}, "dex/MsgSubscribeHooks", nil)
+	cdc.RegisterConcrete(&MsgUnsubscribeHooks{}, "dex/MsgUnsubscribeHooks", nil)
+	cdc.RegisterConcrete(&MsgDepositRange{}, "dex/MsgDepositRange", nil)
+	cdc.RegisterConcrete(&MsgWithdrawRangePosition{}, "dex/MsgWithdrawRangePosition", nil)
+	cdc.RegisterConcrete(&MsgRebalanceRangePosition{}, "dex/MsgRebalanceRangePosition", nil)
+	cdc.RegisterConcrete(&MsgFlashSwap{}, "dex/MsgFlashSwap", nil)
+	cdc.RegisterConcrete(&MsgSetPairFeeTiers{}, "dex/MsgSetPairFeeTiers", nil)
+	cdc.RegisterConcrete(&MsgSetTraderFeeTier{}, "dex/MsgSetTraderFeeTier", nil)
+	cdc.RegisterConcrete(&MsgClaimMakerRebates{}, "dex/MsgClaimMakerRebates", nil)
+	cdc.RegisterConcrete(&MsgPlaceTWAPOrder{}, "dex/MsgPlaceTWAPOrder", nil)
+	cdc.RegisterConcrete(&MsgWithdrawTWAPOrder{}, "dex/MsgWithdrawTWAPOrder", nil)
+	cdc.RegisterConcrete(&MsgPurgeExpiredOrders{}, "dex/MsgPurgeExpiredOrders", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -33,9 +49,51 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCancelLimitOrder{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchCancelLimitOrders{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReplaceLimitOrder{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMultiHopSwap{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetPairTradingStatus{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetDenomTradingStatus{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubscribeHooks{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUnsubscribeHooks{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDepositRange{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWithdrawRangePosition{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRebalanceRangePosition{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFlashSwap{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetPairFeeTiers{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetTraderFeeTier{},
+		&MsgClaimMakerRebates{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPlaceTWAPOrder{},
+		&MsgWithdrawTWAPOrder{},
+		&MsgPurgeExpiredOrders{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
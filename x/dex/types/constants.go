@@ -1,3 +1,9 @@
 package types
 
-const ConsensusVersion = 5
+const ConsensusVersion = 6
+
+// AutoSettleGasLimit bounds the gas used to settle a single auto-settled limit order at the end of the block
+const AutoSettleGasLimit uint64 = 1_000_000
+
+// TWAPSliceGasLimit bounds the gas used to execute a single slice of a TWAP order at the end of the block
+const TWAPSliceGasLimit uint64 = 2_000_000
@@ -229,4 +229,129 @@ var (
 		1165,
 		"MinAverageSellPrice must be nil or > 0.",
 	)
+	ErrPairHalted = sdkerrors.Register(
+		ModuleName,
+		1166,
+		"Trading has been halted for this pair, only withdrawals and cancellations are allowed",
+	)
+	ErrPairWithdrawOnly = sdkerrors.Register(
+		ModuleName,
+		1167,
+		"Pair is in withdraw-only mode, new liquidity cannot be added",
+	)
+	ErrInvalidTradingStatus = sdkerrors.Register(
+		ModuleName,
+		1168,
+		"Invalid trading status",
+	)
+	ErrInvalidBatchCancel = sdkerrors.Register(
+		ModuleName,
+		1169,
+		"Invalid batch cancel, must specify either tranche keys or a pair ID",
+	)
+	ErrHookSubscriberNotWhitelisted = sdkerrors.Register(
+		ModuleName,
+		1170,
+		"Contract is not whitelisted to subscribe to dex hooks",
+	)
+	ErrHookSubscriptionNotFound = sdkerrors.Register(
+		ModuleName,
+		1171,
+		"Hook subscription not found",
+	)
+	ErrHookOutOfGas = sdkerrors.Register(
+		ModuleName,
+		1172,
+		"Dex hook ran out of gas",
+	)
+	ErrInvalidRangePosition = sdkerrors.Register(
+		ModuleName,
+		1173,
+		"Invalid range position",
+	)
+	ErrRangePositionNotFound = sdkerrors.Register(
+		ModuleName,
+		1174,
+		"Range position not found",
+	)
+	ErrNotRangePositionOwner = sdkerrors.Register(
+		ModuleName,
+		1175,
+		"Only the owner can manage a range position",
+	)
+	ErrRangeDepositFailed = sdkerrors.Register(
+		ModuleName,
+		1176,
+		"No liquidity could be deposited for the range position",
+	)
+	ErrFlashSwapCallerNotContract = sdkerrors.Register(
+		ModuleName,
+		1177,
+		"Flash swaps can only be executed by contracts",
+	)
+	ErrFlashSwapDepthExceeded = sdkerrors.Register(
+		ModuleName,
+		1178,
+		"Maximum depth of nested flash swaps exceeded",
+	)
+	ErrFlashSwapNotRepaid = sdkerrors.Register(
+		ModuleName,
+		1179,
+		"Flash swap input and fee were not repaid",
+	)
+	ErrInvalidTraderFeeTier = sdkerrors.Register(
+		ModuleName,
+		1180,
+		"Trader fee tier does not exist",
+	)
+	ErrNoMakerRebates = sdkerrors.Register(
+		ModuleName,
+		1181,
+		"No maker rebates to claim",
+	)
+	ErrAutoSettleTakerOnly = sdkerrors.Register(
+		ModuleName,
+		1182,
+		"Auto-settle cannot be used with taker-only order types",
+	)
+	ErrInvalidTWAPOrder = sdkerrors.Register(
+		ModuleName,
+		1183,
+		"Invalid TWAP order",
+	)
+	ErrTWAPOrderNotFound = sdkerrors.Register(
+		ModuleName,
+		1184,
+		"TWAP order not found",
+	)
+	ErrNotTWAPOrderOwner = sdkerrors.Register(
+		ModuleName,
+		1185,
+		"Only the creator of a TWAP order can withdraw it",
+	)
+	ErrZeroPurgeMax = sdkerrors.Register(
+		ModuleName,
+		1186,
+		"Max number of orders to purge must be positive",
+	)
+	ErrNoExpiredLimitOrders = sdkerrors.Register(
+		ModuleName,
+		1187,
+		"No expired limit orders to purge",
+	)
+	ErrOutOfGas = sdkerrors.Register(
+		ModuleName,
+		1188,
+		"Ran out of gas",
+	)
+	ErrTWAPOrdersDisabled = sdkerrors.Register(
+		ModuleName,
+		1189,
+		"TWAP orders are disabled since no slices are executed per block",
+	)
+	ErrAutoSettleDisabled = sdkerrors.Register(
+		ModuleName,
+		1190,
+		"Auto-settled limit orders are disabled since no orders are settled per block",
+	)
 )
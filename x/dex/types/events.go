@@ -56,6 +56,17 @@ const (
 	AttributeSharesOwned          = "SharesOwned"
 	AttributeSharesWithdrawn      = "SharesWithdrawn"
 	AttributeMinAvgSellPrice      = "MinAvgSellPrice"
+	AttributeFeeTiers             = "FeeTiers"
+	AttributeTradingStatus        = "TradingStatus"
+	AttributeAddress              = "Address"
+	AttributeTier                 = "Tier"
+	AttributeRebates              = "Rebates"
+	AttributeRemove               = "Remove"
+	AttributeOrderID              = "OrderId"
+	AttributeSlice                = "Slice"
+	AttributeSkipped              = "Skipped"
+	AttributeOrdersPurged         = "OrdersPurged"
+	AttributeBounty               = "Bounty"
 )
 
 // Event Keys
@@ -71,6 +82,16 @@ const (
 	EventTypeGoodTilPurgeHitGasLimit = "GoodTilPurgeHitGasLimit"
 	TrancheUserUpdateEventKey        = "TrancheUserUpdate"
 	EventTypeTrancheUserUpdate       = "TrancheUserUpdate"
+	SetPairTradingStatusEventKey     = "SetPairTradingStatus"
+	SetDenomTradingStatusEventKey    = "SetDenomTradingStatus"
+	SetPairFeeTiersEventKey          = "SetPairFeeTiers"
+	FlashSwapEventKey                = "FlashSwap"
+	SetTraderFeeTierEventKey         = "SetTraderFeeTier"
+	ClaimMakerRebatesEventKey        = "ClaimMakerRebates"
+	PlaceTWAPOrderEventKey           = "PlaceTWAPOrder"
+	WithdrawTWAPOrderEventKey        = "WithdrawTWAPOrder"
+	TWAPOrderSliceEventKey           = "TWAPOrderSlice"
+	PurgeExpiredOrdersEventKey       = "PurgeExpiredOrders"
 	// EventTypeNeutronMessage defines the event type used by the Interchain Queries module events.
 	EventTypeNeutronMessage = "neutron"
 )
@@ -161,6 +182,34 @@ func CreateMultihopSwapEvent(
 	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
 }
 
+func CreateFlashSwapEvent(
+	creator sdk.AccAddress,
+	coinIn sdk.Coin,
+	coinOut sdk.Coin,
+	fee sdk.Coin,
+	route []string,
+	dust sdk.Coins,
+) sdk.Event {
+	dustStrings := make([]string, 0, dust.Len())
+	for _, item := range dust {
+		dustStrings = append(dustStrings, item.String())
+	}
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, FlashSwapEventKey),
+		sdk.NewAttribute(AttributeCreator, creator.String()),
+		sdk.NewAttribute(AttributeTokenIn, coinIn.Denom),
+		sdk.NewAttribute(AttributeTokenOut, coinOut.Denom),
+		sdk.NewAttribute(AttributeAmountIn, coinIn.Amount.String()),
+		sdk.NewAttribute(AttributeAmountOut, coinOut.Amount.String()),
+		sdk.NewAttribute(AttributeFee, fee.Amount.String()),
+		sdk.NewAttribute(AttributeRoute, strings.Join(route, ",")),
+		sdk.NewAttribute(AttributeDust, strings.Join(dustStrings, ",")),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
 func CreatePlaceLimitOrderEvent(
 	creator sdk.AccAddress,
 	receiver sdk.AccAddress,
@@ -263,6 +312,111 @@ func CancelLimitOrderEvent(
 	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
 }
 
+func CreateSetPairTradingStatusEvent(pairID *PairID, status TradingStatus) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, SetPairTradingStatusEventKey),
+		sdk.NewAttribute(AttributeToken0, pairID.Token0),
+		sdk.NewAttribute(AttributeToken1, pairID.Token1),
+		sdk.NewAttribute(AttributeTradingStatus, status.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func CreateSetPairFeeTiersEvent(pairID *PairID, feeTiers []uint64) sdk.Event {
+	feeTiersStr := make([]string, len(feeTiers))
+	for i, fee := range feeTiers {
+		feeTiersStr[i] = strconv.FormatUint(fee, 10)
+	}
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, SetPairFeeTiersEventKey),
+		sdk.NewAttribute(AttributeToken0, pairID.Token0),
+		sdk.NewAttribute(AttributeToken1, pairID.Token1),
+		sdk.NewAttribute(AttributeFeeTiers, strings.Join(feeTiersStr, ",")),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func CreateSetTraderFeeTierEvent(address string, tier uint32, remove bool) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, SetTraderFeeTierEventKey),
+		sdk.NewAttribute(AttributeAddress, address),
+		sdk.NewAttribute(AttributeTier, strconv.FormatUint(uint64(tier), 10)),
+		sdk.NewAttribute(AttributeRemove, strconv.FormatBool(remove)),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func CreateClaimMakerRebatesEvent(creator sdk.AccAddress, rebates sdk.Coins) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, ClaimMakerRebatesEventKey),
+		sdk.NewAttribute(AttributeCreator, creator.String()),
+		sdk.NewAttribute(AttributeRebates, rebates.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func CreatePlaceTWAPOrderEvent(order TWAPOrder) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, PlaceTWAPOrderEventKey),
+		sdk.NewAttribute(AttributeCreator, order.Creator),
+		sdk.NewAttribute(AttributeOrderID, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(AttributeTokenIn, order.TokenIn),
+		sdk.NewAttribute(AttributeTokenOut, order.TokenOut),
+		sdk.NewAttribute(AttributeAmountIn, order.TotalIn.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func CreateWithdrawTWAPOrderEvent(order TWAPOrder) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, WithdrawTWAPOrderEventKey),
+		sdk.NewAttribute(AttributeCreator, order.Creator),
+		sdk.NewAttribute(AttributeOrderID, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(AttributeWithdrawn, order.RemainingIn.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func CreateTWAPOrderSliceEvent(order TWAPOrder, fill TWAPOrderFill) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, TWAPOrderSliceEventKey),
+		sdk.NewAttribute(AttributeCreator, order.Creator),
+		sdk.NewAttribute(AttributeOrderID, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(AttributeSlice, strconv.FormatUint(fill.Slice, 10)),
+		sdk.NewAttribute(AttributeTokenIn, order.TokenIn),
+		sdk.NewAttribute(AttributeTokenOut, order.TokenOut),
+		sdk.NewAttribute(AttributeAmountIn, fill.AmountIn.String()),
+		sdk.NewAttribute(AttributeAmountOut, fill.AmountOut.String()),
+		sdk.NewAttribute(AttributeSkipped, strconv.FormatBool(fill.Skipped)),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func CreateSetDenomTradingStatusEvent(denom string, status TradingStatus) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, SetDenomTradingStatusEventKey),
+		sdk.NewAttribute(AttributeDenom, denom),
+		sdk.NewAttribute(AttributeTradingStatus, status.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
 func TickUpdateEvent(
 	token0 string,
 	token1 string,
@@ -451,3 +605,15 @@ func TrancheUserUpdateEvent(trancheUser LimitOrderTrancheUser) sdk.Event {
 	}
 	return sdk.NewEvent(EventTypeTrancheUserUpdate, attrs...)
 }
+
+func CreatePurgeExpiredOrdersEvent(creator sdk.AccAddress, ordersPurged uint64, bounty sdk.Coins) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, PurgeExpiredOrdersEventKey),
+		sdk.NewAttribute(AttributeCreator, creator.String()),
+		sdk.NewAttribute(AttributeOrdersPurged, strconv.FormatUint(ordersPurged, 10)),
+		sdk.NewAttribute(AttributeBounty, bounty.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// FlashSwapSudoMsg is the sudo message sent to the contract executing a flash swap once the output of the swap has
+// been sent to it. The contract must hold AmountOwed when the call returns.
+type FlashSwapSudoMsg struct {
+	FlashSwapCallback *FlashSwapCallbackSudoMsg `json:"flash_swap_callback"`
+}
+
+type FlashSwapCallbackSudoMsg struct {
+	CoinOut    wasmvmtypes.Coin   `json:"coin_out"`
+	Dust       []wasmvmtypes.Coin `json:"dust"`
+	Route      []string           `json:"route"`
+	AmountOwed wasmvmtypes.Coin   `json:"amount_owed"`
+	Fee        wasmvmtypes.Coin   `json:"fee"`
+	Data       []byte             `json:"data,omitempty"`
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis returns the default Capability genesis state
@@ -11,6 +13,21 @@ func DefaultGenesis() *GenesisState {
 		TickLiquidityList:             []*TickLiquidity{},
 		InactiveLimitOrderTrancheList: []*LimitOrderTranche{},
 		PoolMetadataList:              []PoolMetadata{},
+		PairTradingStatusList:         []PairTradingStatus{},
+		DenomTradingStatusList:        []DenomTradingStatus{},
+		HookSubscriptionList:          []HookSubscription{},
+		RangePositionList:             []RangePosition{},
+		CandleList:                    []Candle{},
+		PairFeeTiersList:              []PairFeeTiers{},
+		TraderFeeTierAssignmentList:   []TraderFeeTierAssignment{},
+		TraderVolumeList:              []TraderVolume{},
+		TrancheRebatePoolList:         []TrancheRebatePool{},
+		MakerRebatesList:              []MakerRebates{},
+		AutoSettleOrderList:           []AutoSettleOrder{},
+		TwapOrderList:                 []TWAPOrder{},
+		TwapOrderFillList:             []TWAPOrderFill{},
+		PoolDepositBasisList:          []PoolDepositBasis{},
+		ExpirationDepositList:         []ExpirationDeposit{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -68,6 +85,231 @@ func (gs GenesisState) Validate() error {
 		}
 		poolMetadataIDMap[elem.Id] = true
 	}
+	// Check for duplicated or invalid pairTradingStatus
+	pairTradingStatusMap := make(map[string]struct{})
+	for _, elem := range gs.PairTradingStatusList {
+		if elem.PairId == nil {
+			return fmt.Errorf("pairTradingStatus is missing a pairID")
+		}
+		index := elem.PairId.CanonicalString()
+		if _, ok := pairTradingStatusMap[index]; ok {
+			return fmt.Errorf("duplicated index for pairTradingStatus")
+		}
+		if err := elem.Status.Validate(); err != nil {
+			return err
+		}
+		pairTradingStatusMap[index] = struct{}{}
+	}
+	// Check for duplicated or invalid denomTradingStatus
+	denomTradingStatusMap := make(map[string]struct{})
+	for _, elem := range gs.DenomTradingStatusList {
+		if _, ok := denomTradingStatusMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated index for denomTradingStatus")
+		}
+		if err := elem.Status.Validate(); err != nil {
+			return err
+		}
+		denomTradingStatusMap[elem.Denom] = struct{}{}
+	}
+	// Check for duplicated or invalid hookSubscription
+	hookSubscriptionMap := make(map[string]struct{})
+	for _, elem := range gs.HookSubscriptionList {
+		if elem.PairId == nil {
+			return fmt.Errorf("hookSubscription is missing a pairID")
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.ContractAddress); err != nil {
+			return fmt.Errorf("invalid hookSubscription contract address: %w", err)
+		}
+		index := string(HookSubscriptionKey(elem.PairId, elem.ContractAddress))
+		if _, ok := hookSubscriptionMap[index]; ok {
+			return fmt.Errorf("duplicated index for hookSubscription")
+		}
+		hookSubscriptionMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in rangePosition
+	rangePositionIDMap := make(map[uint64]bool)
+	for _, elem := range gs.RangePositionList {
+		if _, ok := rangePositionIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for rangePosition")
+		}
+		if elem.Id >= gs.RangePositionCount {
+			return fmt.Errorf("rangePosition id should be lower than the rangePosition count")
+		}
+		if elem.PairId == nil {
+			return fmt.Errorf("rangePosition is missing a pairID")
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Owner); err != nil {
+			return fmt.Errorf("invalid rangePosition owner: %w", err)
+		}
+		rangePositionIDMap[elem.Id] = true
+	}
+	// Check for duplicated or invalid candle
+	candleMap := make(map[string]struct{})
+	for _, elem := range gs.CandleList {
+		if elem.PairId == nil {
+			return fmt.Errorf("candle is missing a pairID")
+		}
+		if elem.Interval == 0 {
+			return fmt.Errorf("candle interval must be positive")
+		}
+		index := string(CandleKey(elem.PairId, elem.Interval, elem.OpenTime))
+		if _, ok := candleMap[index]; ok {
+			return fmt.Errorf("duplicated index for candle")
+		}
+		candleMap[index] = struct{}{}
+	}
+	// Check for duplicated or invalid pairFeeTiers
+	pairFeeTiersMap := make(map[string]struct{})
+	for _, elem := range gs.PairFeeTiersList {
+		if elem.PairId == nil {
+			return fmt.Errorf("pairFeeTiers is missing a pairID")
+		}
+		index := elem.PairId.CanonicalString()
+		if _, ok := pairFeeTiersMap[index]; ok {
+			return fmt.Errorf("duplicated index for pairFeeTiers")
+		}
+		if len(elem.FeeTiers) == 0 {
+			return fmt.Errorf("pairFeeTiers for %s has no fee tiers", index)
+		}
+		if err := validateFeeTiers(elem.FeeTiers); err != nil {
+			return fmt.Errorf("invalid pairFeeTiers for %s: %w", index, err)
+		}
+		pairFeeTiersMap[index] = struct{}{}
+	}
+	// Check for duplicated or invalid traderFeeTierAssignment
+	traderFeeTierAssignmentMap := make(map[string]struct{})
+	for _, elem := range gs.TraderFeeTierAssignmentList {
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid traderFeeTierAssignment address: %w", err)
+		}
+		if _, ok := traderFeeTierAssignmentMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated index for traderFeeTierAssignment")
+		}
+		traderFeeTierAssignmentMap[elem.Address] = struct{}{}
+	}
+	// Check for duplicated or invalid traderVolume
+	traderVolumeMap := make(map[string]struct{})
+	for _, elem := range gs.TraderVolumeList {
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid traderVolume address: %w", err)
+		}
+		if elem.Volume.IsNil() || elem.Volume.IsNegative() {
+			return fmt.Errorf("traderVolume must not be negative")
+		}
+		index := string(TraderVolumeKey(elem.Address, elem.Day))
+		if _, ok := traderVolumeMap[index]; ok {
+			return fmt.Errorf("duplicated index for traderVolume")
+		}
+		traderVolumeMap[index] = struct{}{}
+	}
+	// Check for duplicated or invalid trancheRebatePool
+	trancheRebatePoolMap := make(map[string]struct{})
+	for _, elem := range gs.TrancheRebatePoolList {
+		if err := elem.Surcharges.Validate(); err != nil {
+			return fmt.Errorf("invalid trancheRebatePool surcharges: %w", err)
+		}
+		if elem.UnsettledFills.IsNil() || !elem.UnsettledFills.IsPositive() {
+			return fmt.Errorf("trancheRebatePool unsettled fills must be positive")
+		}
+		if _, ok := trancheRebatePoolMap[elem.TrancheKey]; ok {
+			return fmt.Errorf("duplicated index for trancheRebatePool")
+		}
+		trancheRebatePoolMap[elem.TrancheKey] = struct{}{}
+	}
+	// Check for duplicated or invalid makerRebates
+	makerRebatesMap := make(map[string]struct{})
+	for _, elem := range gs.MakerRebatesList {
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid makerRebates address: %w", err)
+		}
+		if err := elem.Rebates.Validate(); err != nil {
+			return fmt.Errorf("invalid makerRebates: %w", err)
+		}
+		if _, ok := makerRebatesMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated index for makerRebates")
+		}
+		makerRebatesMap[elem.Address] = struct{}{}
+	}
+	// Check for duplicated or invalid autoSettleOrder
+	autoSettleOrderMap := make(map[string]struct{})
+	for _, elem := range gs.AutoSettleOrderList {
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid autoSettleOrder address: %w", err)
+		}
+		index := string(AutoSettleOrderKey(elem.TrancheKey, elem.Address))
+		if _, ok := autoSettleOrderMap[index]; ok {
+			return fmt.Errorf("duplicated index for autoSettleOrder")
+		}
+		autoSettleOrderMap[index] = struct{}{}
+	}
+	// Check for duplicated autoSettleQueue
+	autoSettleQueueMap := make(map[string]struct{})
+	for _, trancheKey := range gs.AutoSettleQueue {
+		if _, ok := autoSettleQueueMap[trancheKey]; ok {
+			return fmt.Errorf("duplicated index for autoSettleQueue")
+		}
+		autoSettleQueueMap[trancheKey] = struct{}{}
+	}
+	// Check for duplicated ID in twapOrder
+	twapOrderIDMap := make(map[uint64]bool)
+	for _, elem := range gs.TwapOrderList {
+		if _, ok := twapOrderIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for twapOrder")
+		}
+		if elem.Id >= gs.TwapOrderCount {
+			return fmt.Errorf("twapOrder id should be lower than the twapOrder count")
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Creator); err != nil {
+			return fmt.Errorf("invalid twapOrder creator: %w", err)
+		}
+		if elem.RemainingIn.IsNil() || elem.RemainingIn.IsNegative() {
+			return fmt.Errorf("twapOrder remaining amount cannot be negative")
+		}
+		if elem.Interval == 0 || elem.Interval > MaxTWAPOrderInterval {
+			return fmt.Errorf("twapOrder interval must be between 1 and %d seconds", MaxTWAPOrderInterval)
+		}
+		twapOrderIDMap[elem.Id] = true
+	}
+	// Check for duplicated twapOrderFill. Fills of completed or withdrawn orders are kept, so they only need to
+	// belong to an order that was created.
+	twapOrderFillMap := make(map[string]struct{})
+	for _, elem := range gs.TwapOrderFillList {
+		if elem.OrderId >= gs.TwapOrderCount {
+			return fmt.Errorf("twapOrderFill for unknown twapOrder %d", elem.OrderId)
+		}
+		index := string(TWAPOrderFillKey(elem.OrderId, elem.Slice))
+		if _, ok := twapOrderFillMap[index]; ok {
+			return fmt.Errorf("duplicated index for twapOrderFill")
+		}
+		twapOrderFillMap[index] = struct{}{}
+	}
+	// Check for duplicated index in poolDepositBasis
+	poolDepositBasisMap := make(map[string]struct{})
+	for _, elem := range gs.PoolDepositBasisList {
+		index := string(PoolDepositBasisKey(elem.Address, elem.PoolId))
+		if _, ok := poolDepositBasisMap[index]; ok {
+			return fmt.Errorf("duplicated index for poolDepositBasis")
+		}
+		if elem.Shares.IsNil() || !elem.Shares.IsPositive() {
+			return fmt.Errorf("poolDepositBasis shares must be positive")
+		}
+		poolDepositBasisMap[index] = struct{}{}
+	}
+	// Check for duplicated index in expirationDeposit
+	expirationDepositMap := make(map[string]struct{})
+	for _, elem := range gs.ExpirationDepositList {
+		index := string(ExpirationDepositKey(elem.TrancheRef))
+		if _, ok := expirationDepositMap[index]; ok {
+			return fmt.Errorf("duplicated index for expirationDeposit")
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Depositor); err != nil {
+			return fmt.Errorf("invalid expirationDeposit depositor: %w", err)
+		}
+		if err := elem.Deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid expirationDeposit: %w", err)
+		}
+		expirationDepositMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
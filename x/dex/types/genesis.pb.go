@@ -25,12 +25,31 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the dex module's genesis state.
 type GenesisState struct {
-	Params                        Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	TickLiquidityList             []*TickLiquidity         `protobuf:"bytes,2,rep,name=tick_liquidity_list,json=tickLiquidityList,proto3" json:"tick_liquidity_list,omitempty"`
-	InactiveLimitOrderTrancheList []*LimitOrderTranche     `protobuf:"bytes,3,rep,name=inactive_limit_order_tranche_list,json=inactiveLimitOrderTrancheList,proto3" json:"inactive_limit_order_tranche_list,omitempty"`
-	LimitOrderTrancheUserList     []*LimitOrderTrancheUser `protobuf:"bytes,4,rep,name=limit_order_tranche_user_list,json=limitOrderTrancheUserList,proto3" json:"limit_order_tranche_user_list,omitempty"`
-	PoolMetadataList              []PoolMetadata           `protobuf:"bytes,5,rep,name=pool_metadata_list,json=poolMetadataList,proto3" json:"pool_metadata_list"`
-	PoolCount                     uint64                   `protobuf:"varint,6,opt,name=pool_count,json=poolCount,proto3" json:"pool_count,omitempty"`
+	Params                        Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TickLiquidityList             []*TickLiquidity          `protobuf:"bytes,2,rep,name=tick_liquidity_list,json=tickLiquidityList,proto3" json:"tick_liquidity_list,omitempty"`
+	InactiveLimitOrderTrancheList []*LimitOrderTranche      `protobuf:"bytes,3,rep,name=inactive_limit_order_tranche_list,json=inactiveLimitOrderTrancheList,proto3" json:"inactive_limit_order_tranche_list,omitempty"`
+	LimitOrderTrancheUserList     []*LimitOrderTrancheUser  `protobuf:"bytes,4,rep,name=limit_order_tranche_user_list,json=limitOrderTrancheUserList,proto3" json:"limit_order_tranche_user_list,omitempty"`
+	PoolMetadataList              []PoolMetadata            `protobuf:"bytes,5,rep,name=pool_metadata_list,json=poolMetadataList,proto3" json:"pool_metadata_list"`
+	PoolCount                     uint64                    `protobuf:"varint,6,opt,name=pool_count,json=poolCount,proto3" json:"pool_count,omitempty"`
+	PairTradingStatusList         []PairTradingStatus       `protobuf:"bytes,7,rep,name=pair_trading_status_list,json=pairTradingStatusList,proto3" json:"pair_trading_status_list"`
+	DenomTradingStatusList        []DenomTradingStatus      `protobuf:"bytes,8,rep,name=denom_trading_status_list,json=denomTradingStatusList,proto3" json:"denom_trading_status_list"`
+	HookSubscriptionList          []HookSubscription        `protobuf:"bytes,9,rep,name=hook_subscription_list,json=hookSubscriptionList,proto3" json:"hook_subscription_list"`
+	RangePositionList             []RangePosition           `protobuf:"bytes,10,rep,name=range_position_list,json=rangePositionList,proto3" json:"range_position_list"`
+	RangePositionCount            uint64                    `protobuf:"varint,11,opt,name=range_position_count,json=rangePositionCount,proto3" json:"range_position_count,omitempty"`
+	CandleList                    []Candle                  `protobuf:"bytes,12,rep,name=candle_list,json=candleList,proto3" json:"candle_list"`
+	PairFeeTiersList              []PairFeeTiers            `protobuf:"bytes,13,rep,name=pair_fee_tiers_list,json=pairFeeTiersList,proto3" json:"pair_fee_tiers_list"`
+	TraderFeeTierAssignmentList   []TraderFeeTierAssignment `protobuf:"bytes,14,rep,name=trader_fee_tier_assignment_list,json=traderFeeTierAssignmentList,proto3" json:"trader_fee_tier_assignment_list"`
+	TraderVolumeList              []TraderVolume            `protobuf:"bytes,15,rep,name=trader_volume_list,json=traderVolumeList,proto3" json:"trader_volume_list"`
+	TrancheRebatePoolList         []TrancheRebatePool       `protobuf:"bytes,16,rep,name=tranche_rebate_pool_list,json=trancheRebatePoolList,proto3" json:"tranche_rebate_pool_list"`
+	MakerRebatesList              []MakerRebates            `protobuf:"bytes,17,rep,name=maker_rebates_list,json=makerRebatesList,proto3" json:"maker_rebates_list"`
+	AutoSettleOrderList           []AutoSettleOrder         `protobuf:"bytes,18,rep,name=auto_settle_order_list,json=autoSettleOrderList,proto3" json:"auto_settle_order_list"`
+	TwapOrderList                 []TWAPOrder               `protobuf:"bytes,19,rep,name=twap_order_list,json=twapOrderList,proto3" json:"twap_order_list"`
+	TwapOrderCount                uint64                    `protobuf:"varint,20,opt,name=twap_order_count,json=twapOrderCount,proto3" json:"twap_order_count,omitempty"`
+	TwapOrderFillList             []TWAPOrderFill           `protobuf:"bytes,21,rep,name=twap_order_fill_list,json=twapOrderFillList,proto3" json:"twap_order_fill_list"`
+	PoolDepositBasisList          []PoolDepositBasis        `protobuf:"bytes,22,rep,name=pool_deposit_basis_list,json=poolDepositBasisList,proto3" json:"pool_deposit_basis_list"`
+	ExpirationDepositList         []ExpirationDeposit       `protobuf:"bytes,23,rep,name=expiration_deposit_list,json=expirationDepositList,proto3" json:"expiration_deposit_list"`
+	// Keys of the tranches whose auto-settled orders are waiting to be settled
+	AutoSettleQueue []string `protobuf:"bytes,24,rep,name=auto_settle_queue,json=autoSettleQueue,proto3" json:"auto_settle_queue,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +127,132 @@ func (m *GenesisState) GetPoolCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPairTradingStatusList() []PairTradingStatus {
+	if m != nil {
+		return m.PairTradingStatusList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomTradingStatusList() []DenomTradingStatus {
+	if m != nil {
+		return m.DenomTradingStatusList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHookSubscriptionList() []HookSubscription {
+	if m != nil {
+		return m.HookSubscriptionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRangePositionList() []RangePosition {
+	if m != nil {
+		return m.RangePositionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRangePositionCount() uint64 {
+	if m != nil {
+		return m.RangePositionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCandleList() []Candle {
+	if m != nil {
+		return m.CandleList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPairFeeTiersList() []PairFeeTiers {
+	if m != nil {
+		return m.PairFeeTiersList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTraderFeeTierAssignmentList() []TraderFeeTierAssignment {
+	if m != nil {
+		return m.TraderFeeTierAssignmentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTraderVolumeList() []TraderVolume {
+	if m != nil {
+		return m.TraderVolumeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrancheRebatePoolList() []TrancheRebatePool {
+	if m != nil {
+		return m.TrancheRebatePoolList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMakerRebatesList() []MakerRebates {
+	if m != nil {
+		return m.MakerRebatesList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoSettleOrderList() []AutoSettleOrder {
+	if m != nil {
+		return m.AutoSettleOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTwapOrderList() []TWAPOrder {
+	if m != nil {
+		return m.TwapOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTwapOrderCount() uint64 {
+	if m != nil {
+		return m.TwapOrderCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTwapOrderFillList() []TWAPOrderFill {
+	if m != nil {
+		return m.TwapOrderFillList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolDepositBasisList() []PoolDepositBasis {
+	if m != nil {
+		return m.PoolDepositBasisList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExpirationDepositList() []ExpirationDeposit {
+	if m != nil {
+		return m.ExpirationDepositList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoSettleQueue() []string {
+	if m != nil {
+		return m.AutoSettleQueue
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "neutron.dex.GenesisState")
 }
@@ -115,33 +260,67 @@ func init() {
 func init() { proto.RegisterFile("neutron/dex/genesis.proto", fileDescriptor_0c051a8a0d58cd8b) }
 
 var fileDescriptor_0c051a8a0d58cd8b = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0xaa, 0xda, 0x40,
-	0x14, 0x86, 0x93, 0x6a, 0x85, 0x8e, 0x5d, 0xb4, 0xb1, 0x8b, 0x18, 0x30, 0xa6, 0x42, 0x41, 0x0a,
-	0x26, 0xd4, 0xd2, 0x17, 0xb0, 0x0b, 0x37, 0x4a, 0xc5, 0xda, 0x4d, 0x37, 0x61, 0x4c, 0x86, 0x38,
-	0x35, 0xc9, 0xa4, 0x93, 0x13, 0xd1, 0xb7, 0xe8, 0x63, 0xb9, 0x74, 0xd9, 0x4d, 0xcb, 0x45, 0x5f,
-	0xe4, 0x92, 0x99, 0x11, 0x12, 0xae, 0xf7, 0xde, 0xdd, 0x70, 0xce, 0x37, 0xff, 0x37, 0x73, 0x66,
-	0x50, 0x37, 0x25, 0x05, 0x70, 0x96, 0x7a, 0x21, 0xd9, 0x7b, 0x11, 0x49, 0x49, 0x4e, 0x73, 0x37,
-	0xe3, 0x0c, 0x98, 0xd1, 0x56, 0x2d, 0x37, 0x24, 0x7b, 0xeb, 0x5d, 0xc4, 0x22, 0x26, 0xea, 0x5e,
-	0xb9, 0x92, 0x88, 0xf5, 0xa1, 0xba, 0x3b, 0xa6, 0x09, 0x05, 0x9f, 0xf1, 0x90, 0x70, 0x1f, 0x38,
-	0x4e, 0x83, 0x0d, 0x51, 0xd8, 0xc7, 0x67, 0x30, 0xbf, 0xc8, 0x09, 0x57, 0xac, 0x59, 0x65, 0x33,
-	0xcc, 0x71, 0xa2, 0xce, 0x63, 0xf5, 0x6b, 0x1d, 0xc6, 0x62, 0x3f, 0x21, 0x80, 0x43, 0x0c, 0x58,
-	0x01, 0x4e, 0x15, 0x00, 0x1a, 0x6c, 0xfd, 0x98, 0xfe, 0x2e, 0x68, 0x48, 0xe1, 0x20, 0x89, 0xc1,
-	0xbf, 0x06, 0x7a, 0x3d, 0x95, 0x97, 0xfc, 0x0e, 0x18, 0x88, 0xf1, 0x09, 0xb5, 0xa4, 0xc3, 0xd4,
-	0x1d, 0x7d, 0xd8, 0x1e, 0x77, 0xdc, 0xca, 0xa5, 0xdd, 0x85, 0x68, 0x4d, 0x9a, 0xc7, 0xff, 0x7d,
-	0x6d, 0xa9, 0x40, 0x63, 0x81, 0x3a, 0xf5, 0x6c, 0x3f, 0xa6, 0x39, 0x98, 0x2f, 0x9c, 0xc6, 0xb0,
-	0x3d, 0xb6, 0x6a, 0xfb, 0x57, 0x34, 0xd8, 0xce, 0xae, 0x98, 0x88, 0xd1, 0x97, 0x6f, 0xa1, 0x5a,
-	0x9c, 0xd1, 0x1c, 0x8c, 0x14, 0xbd, 0xa7, 0x29, 0x0e, 0x80, 0xee, 0x88, 0x7f, 0x6b, 0x3a, 0x22,
-	0xbf, 0x21, 0xf2, 0xed, 0x5a, 0xfe, 0xac, 0x84, 0xbf, 0x95, 0xec, 0x4a, 0xa2, 0xca, 0xd1, 0xbb,
-	0xc6, 0x3d, 0x00, 0x84, 0xef, 0x17, 0xea, 0x3d, 0xf6, 0x08, 0xd2, 0xd5, 0x14, 0xae, 0xc1, 0xd3,
-	0xae, 0x1f, 0x39, 0xe1, 0xca, 0xd7, 0x8d, 0x6f, 0x35, 0x85, 0x6b, 0x8e, 0x8c, 0xda, 0x53, 0x49,
-	0xc1, 0x4b, 0x21, 0xe8, 0xd6, 0x87, 0xcd, 0x58, 0x3c, 0x57, 0x94, 0x1a, 0xf9, 0x9b, 0xac, 0x52,
-	0x13, 0x71, 0x3d, 0x84, 0x44, 0x5c, 0xc0, 0x8a, 0x14, 0xcc, 0x96, 0xa3, 0x0f, 0x9b, 0xcb, 0x57,
-	0x65, 0xe5, 0x6b, 0x59, 0x98, 0x4c, 0x8f, 0x67, 0x5b, 0x3f, 0x9d, 0x6d, 0xfd, 0xee, 0x6c, 0xeb,
-	0x7f, 0x2e, 0xb6, 0x76, 0xba, 0xd8, 0xda, 0xdf, 0x8b, 0xad, 0xfd, 0x1c, 0x45, 0x14, 0x36, 0xc5,
-	0xda, 0x0d, 0x58, 0xe2, 0x29, 0xeb, 0x88, 0xf1, 0xe8, 0xba, 0xf6, 0x76, 0x5f, 0xbc, 0xbd, 0xfc,
-	0x37, 0x87, 0x8c, 0xe4, 0xeb, 0x96, 0xf8, 0x2f, 0x9f, 0xef, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6c,
-	0x1a, 0x11, 0x18, 0x1f, 0x03, 0x00, 0x00,
+	// 950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0x4d, 0x73, 0x1b, 0x35,
+	0x18, 0xc7, 0x63, 0x12, 0x02, 0x91, 0xdb, 0x24, 0x7e, 0xa9, 0xe3, 0xa4, 0xb5, 0x63, 0x3a, 0x30,
+	0xe3, 0xe9, 0x4c, 0x6d, 0x28, 0xc3, 0x85, 0x5b, 0xd2, 0xd0, 0x72, 0x48, 0xc0, 0x75, 0x02, 0x1d,
+	0x3a, 0x74, 0x84, 0xec, 0x55, 0x1d, 0xe1, 0xdd, 0xd5, 0x56, 0xab, 0x0d, 0xe9, 0xb7, 0xe0, 0x63,
+	0xf5, 0xd8, 0x23, 0x27, 0x86, 0x49, 0xbe, 0x05, 0x27, 0x46, 0x8f, 0xa4, 0xb5, 0xe4, 0xa8, 0xf4,
+	0x96, 0x79, 0x9e, 0xbf, 0x7e, 0x7f, 0xf9, 0x79, 0xd1, 0x06, 0xed, 0xa6, 0xb4, 0x90, 0x82, 0xa7,
+	0xc3, 0x88, 0x5e, 0x0e, 0x67, 0x34, 0xa5, 0x39, 0xcb, 0x07, 0x99, 0xe0, 0x92, 0xd7, 0xab, 0x26,
+	0x35, 0x88, 0xe8, 0xe5, 0x5e, 0x73, 0xc6, 0x67, 0x1c, 0xe2, 0x43, 0xf5, 0x97, 0x96, 0xec, 0x75,
+	0xdc, 0xd3, 0xa4, 0x90, 0x1c, 0xe7, 0x54, 0xca, 0x98, 0x9a, 0x74, 0xdb, 0x4d, 0x4f, 0x49, 0x1a,
+	0x95, 0x99, 0xbb, 0x6e, 0xe6, 0x15, 0xa5, 0x58, 0x32, 0x2a, 0x8c, 0xf1, 0xde, 0x8e, 0x9b, 0x3c,
+	0xe7, 0x7c, 0x6e, 0x13, 0x7d, 0x37, 0x11, 0xb3, 0x84, 0x49, 0xcc, 0x45, 0x44, 0x05, 0xa6, 0x97,
+	0x19, 0x13, 0x44, 0x32, 0x9e, 0x1a, 0xe5, 0x17, 0xef, 0x53, 0x4a, 0x41, 0xd2, 0xe9, 0xb9, 0xbd,
+	0xc6, 0x83, 0x0f, 0xc8, 0x70, 0x91, 0x53, 0x11, 0xfa, 0x31, 0x19, 0x11, 0x24, 0xb1, 0xd7, 0xda,
+	0xf7, 0x32, 0x9c, 0xc7, 0x38, 0xa1, 0x92, 0x44, 0x44, 0x12, 0x23, 0xe8, 0xf9, 0x82, 0x9c, 0xa9,
+	0x9b, 0xe2, 0x0b, 0x12, 0x17, 0x34, 0xa4, 0x10, 0x24, 0x9d, 0x51, 0x6c, 0x75, 0x46, 0xe1, 0x35,
+	0x4a, 0xd0, 0x09, 0x91, 0x34, 0x0f, 0x1d, 0x96, 0x6c, 0x3a, 0xc7, 0x31, 0x7b, 0x5d, 0xb0, 0x88,
+	0xc9, 0x37, 0x41, 0x85, 0x20, 0x11, 0x4b, 0x67, 0x38, 0x97, 0x44, 0x16, 0x96, 0x71, 0xcf, 0x53,
+	0xfc, 0x41, 0x32, 0x5d, 0x08, 0x9d, 0xbd, 0xff, 0xef, 0x26, 0xba, 0xf5, 0x54, 0x0f, 0xc7, 0xa9,
+	0x24, 0x92, 0xd6, 0xbf, 0x42, 0xeb, 0xba, 0x04, 0xed, 0x4a, 0xaf, 0xd2, 0xaf, 0x3e, 0x6a, 0x0c,
+	0x9c, 0x61, 0x19, 0x8c, 0x20, 0x75, 0xb8, 0xf6, 0xf6, 0xef, 0xfd, 0x95, 0xb1, 0x11, 0xd6, 0x47,
+	0xa8, 0xe1, 0xdf, 0x0d, 0xc7, 0x2c, 0x97, 0xed, 0x8f, 0x7a, 0xab, 0xfd, 0xea, 0xa3, 0x3d, 0xef,
+	0xfc, 0x19, 0x9b, 0xce, 0x8f, 0xad, 0x0c, 0x30, 0x95, 0x71, 0x4d, 0xba, 0xc1, 0x63, 0x96, 0xcb,
+	0x7a, 0x8a, 0x3e, 0x63, 0x29, 0x99, 0x4a, 0x76, 0x41, 0x71, 0xa8, 0x79, 0xc0, 0x5f, 0x05, 0x7e,
+	0xd7, 0xe3, 0x1f, 0x2b, 0xf1, 0x8f, 0x4a, 0x7b, 0xa6, 0xa5, 0xc6, 0xa3, 0x63, 0x71, 0x37, 0x04,
+	0xe0, 0xf7, 0x3b, 0xea, 0xbc, 0x6f, 0x46, 0xb4, 0xd7, 0x1a, 0x78, 0xdd, 0xff, 0x7f, 0xaf, 0x9f,
+	0x72, 0x2a, 0x8c, 0xdf, 0x6e, 0x1c, 0x4a, 0x82, 0xd7, 0x09, 0xaa, 0x7b, 0x93, 0xa4, 0x0d, 0x3e,
+	0x06, 0x83, 0x5d, 0xbf, 0xd8, 0x9c, 0xc7, 0x27, 0x46, 0x65, 0x4a, 0xbe, 0x9d, 0x39, 0x31, 0xc0,
+	0x75, 0x10, 0x02, 0xdc, 0x94, 0x17, 0xa9, 0x6c, 0xaf, 0xf7, 0x2a, 0xfd, 0xb5, 0xf1, 0x86, 0x8a,
+	0x3c, 0x56, 0x81, 0xfa, 0x4b, 0xd4, 0xce, 0x08, 0x13, 0xd8, 0x1f, 0x0d, 0xed, 0xf9, 0x49, 0xa0,
+	0x80, 0x23, 0xc2, 0xc4, 0x99, 0xd6, 0x9e, 0x82, 0xd4, 0x18, 0xdf, 0xc9, 0x96, 0x13, 0xe0, 0xfe,
+	0x1b, 0xda, 0x8d, 0x68, 0xca, 0x93, 0x20, 0xff, 0x53, 0xe0, 0xef, 0x7b, 0xfc, 0x23, 0xa5, 0x0e,
+	0x19, 0xb4, 0xa2, 0x1b, 0x19, 0x70, 0xf8, 0x05, 0xb5, 0xd4, 0x43, 0x81, 0xf3, 0x62, 0x92, 0x4f,
+	0x05, 0xcb, 0x60, 0xc1, 0x00, 0xbf, 0x01, 0xf8, 0x8e, 0x87, 0xff, 0x9e, 0xf3, 0xf9, 0xa9, 0xa3,
+	0x34, 0xf0, 0xe6, 0xf9, 0x52, 0x1c, 0xd0, 0x23, 0xd4, 0xf0, 0x17, 0x52, 0x73, 0x51, 0x60, 0x6e,
+	0xc7, 0x4a, 0x37, 0x32, 0x32, 0x03, 0xad, 0x09, 0x37, 0x08, 0xc4, 0x2f, 0x51, 0x73, 0x89, 0xa8,
+	0xdb, 0x52, 0x85, 0xb6, 0xd4, 0xbd, 0x03, 0xba, 0x3f, 0xdf, 0xa2, 0xaa, 0x7e, 0x3e, 0xb5, 0xf7,
+	0xad, 0xde, 0xea, 0x8d, 0x9d, 0x7b, 0x0c, 0x79, 0x63, 0x8a, 0xb4, 0x1a, 0xdc, 0x7e, 0x40, 0x0d,
+	0xe8, 0x6d, 0xf9, 0xca, 0x6a, 0xc6, 0xed, 0xd0, 0x28, 0x11, 0x26, 0x9e, 0x50, 0x7a, 0xa6, 0x54,
+	0xe5, 0x28, 0x39, 0x31, 0xe0, 0x65, 0x68, 0x5f, 0xb5, 0x91, 0x2e, 0x88, 0x98, 0xe4, 0x39, 0x9b,
+	0xa5, 0x09, 0x4d, 0xa5, 0x66, 0x6f, 0x02, 0xfb, 0x73, 0x7f, 0xa7, 0xe1, 0x8c, 0x21, 0x1d, 0x94,
+	0x07, 0x8c, 0xcd, 0x5d, 0x19, 0x4e, 0xdb, 0x5d, 0x30, 0x8e, 0x17, 0x3c, 0x2e, 0x12, 0x53, 0x84,
+	0xad, 0xc0, 0x0f, 0xd0, 0x26, 0x3f, 0x83, 0xca, 0xfe, 0x00, 0xe9, 0xc4, 0x00, 0xf7, 0x12, 0xb5,
+	0xed, 0xea, 0xea, 0x77, 0x14, 0xc3, 0x6a, 0x00, 0x74, 0x3b, 0x30, 0xec, 0x66, 0x35, 0xc7, 0xa0,
+	0x55, 0xdb, 0x66, 0x87, 0x5d, 0x2e, 0x27, 0xec, 0x6d, 0x13, 0x32, 0xa7, 0xc2, 0xc0, 0x4d, 0xb9,
+	0x6b, 0x81, 0xdb, 0x9e, 0x28, 0x99, 0x3e, 0x5d, 0x96, 0x3b, 0x71, 0x62, 0x80, 0x7b, 0x8e, 0x5a,
+	0xce, 0x87, 0xd5, 0x3c, 0x3d, 0x80, 0xac, 0x03, 0xf2, 0x9e, 0x87, 0x3c, 0x28, 0x24, 0x3f, 0x05,
+	0x25, 0xbc, 0x2a, 0x86, 0xda, 0x20, 0x7e, 0x18, 0xc0, 0x47, 0x68, 0x6b, 0xf1, 0xce, 0x6b, 0x62,
+	0x03, 0x88, 0x2d, 0xff, 0xd7, 0x3f, 0x3f, 0x18, 0xb9, 0xac, 0xdb, 0xea, 0xd0, 0x82, 0xd2, 0x47,
+	0xdb, 0x0e, 0x45, 0xcf, 0x71, 0x13, 0xe6, 0x78, 0xb3, 0x14, 0xea, 0x19, 0x7e, 0x86, 0x9a, 0x8e,
+	0xf2, 0x15, 0x8b, 0x4d, 0xc9, 0xef, 0x84, 0x3e, 0x00, 0xd6, 0xf4, 0x09, 0x8b, 0x6d, 0xb9, 0x6b,
+	0x25, 0x4f, 0x05, 0xc1, 0xfc, 0x05, 0xda, 0x81, 0xd6, 0x45, 0x14, 0x36, 0x09, 0x4f, 0x48, 0xce,
+	0x4c, 0xbd, 0x5b, 0x81, 0xb5, 0x57, 0x2d, 0x3a, 0xd2, 0xd2, 0x43, 0xa5, 0xb4, 0x6b, 0x9f, 0x2d,
+	0xc5, 0x81, 0xfd, 0x2b, 0xda, 0x59, 0xfc, 0x57, 0x51, 0x3a, 0x00, 0x7b, 0x27, 0x30, 0x24, 0xdf,
+	0x95, 0x5a, 0x4b, 0x32, 0x43, 0x42, 0x97, 0x13, 0x40, 0x7f, 0x80, 0x6a, 0x6e, 0x57, 0x5f, 0x17,
+	0xb4, 0xa0, 0xed, 0x76, 0x6f, 0xb5, 0xbf, 0x31, 0xde, 0x5a, 0x34, 0xeb, 0x99, 0x0a, 0x1f, 0x3e,
+	0x7d, 0x7b, 0xd5, 0xad, 0xbc, 0xbb, 0xea, 0x56, 0xfe, 0xb9, 0xea, 0x56, 0xfe, 0xbc, 0xee, 0xae,
+	0xbc, 0xbb, 0xee, 0xae, 0xfc, 0x75, 0xdd, 0x5d, 0x79, 0xf1, 0x70, 0xc6, 0xe4, 0x79, 0x31, 0x19,
+	0x4c, 0x79, 0x32, 0x34, 0x97, 0x79, 0xc8, 0xc5, 0xcc, 0xfe, 0x3d, 0xbc, 0xf8, 0x66, 0x78, 0xa9,
+	0x3f, 0xe8, 0x6f, 0x32, 0x9a, 0x4f, 0xd6, 0xe1, 0x63, 0xfe, 0xf5, 0x7f, 0x03, 0x00, 0x25, 0x0b,
+	0xb5, 0x89, 0xf4, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -164,6 +343,253 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoSettleQueue) > 0 {
+		for iNdEx := len(m.AutoSettleQueue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoSettleQueue[iNdEx])
+			copy(dAtA[i:], m.AutoSettleQueue[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoSettleQueue[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.ExpirationDepositList) > 0 {
+		for iNdEx := len(m.ExpirationDepositList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpirationDepositList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.PoolDepositBasisList) > 0 {
+		for iNdEx := len(m.PoolDepositBasisList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolDepositBasisList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.TwapOrderFillList) > 0 {
+		for iNdEx := len(m.TwapOrderFillList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapOrderFillList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.TwapOrderCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TwapOrderCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.TwapOrderList) > 0 {
+		for iNdEx := len(m.TwapOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.AutoSettleOrderList) > 0 {
+		for iNdEx := len(m.AutoSettleOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoSettleOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.MakerRebatesList) > 0 {
+		for iNdEx := len(m.MakerRebatesList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MakerRebatesList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.TrancheRebatePoolList) > 0 {
+		for iNdEx := len(m.TrancheRebatePoolList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrancheRebatePoolList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TraderVolumeList) > 0 {
+		for iNdEx := len(m.TraderVolumeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderVolumeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TraderFeeTierAssignmentList) > 0 {
+		for iNdEx := len(m.TraderFeeTierAssignmentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderFeeTierAssignmentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PairFeeTiersList) > 0 {
+		for iNdEx := len(m.PairFeeTiersList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairFeeTiersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.CandleList) > 0 {
+		for iNdEx := len(m.CandleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CandleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.RangePositionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RangePositionCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RangePositionList) > 0 {
+		for iNdEx := len(m.RangePositionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RangePositionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.HookSubscriptionList) > 0 {
+		for iNdEx := len(m.HookSubscriptionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookSubscriptionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DenomTradingStatusList) > 0 {
+		for iNdEx := len(m.DenomTradingStatusList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTradingStatusList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PairTradingStatusList) > 0 {
+		for iNdEx := len(m.PairTradingStatusList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairTradingStatusList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.PoolCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolCount))
 		i--
@@ -176,155 +602,804 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.LimitOrderTrancheUserList) > 0 {
-		for iNdEx := len(m.LimitOrderTrancheUserList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LimitOrderTrancheUserList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LimitOrderTrancheUserList) > 0 {
+		for iNdEx := len(m.LimitOrderTrancheUserList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrderTrancheUserList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InactiveLimitOrderTrancheList) > 0 {
+		for iNdEx := len(m.InactiveLimitOrderTrancheList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InactiveLimitOrderTrancheList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TickLiquidityList) > 0 {
+		for iNdEx := len(m.TickLiquidityList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TickLiquidityList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TickLiquidityList) > 0 {
+		for _, e := range m.TickLiquidityList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InactiveLimitOrderTrancheList) > 0 {
+		for _, e := range m.InactiveLimitOrderTrancheList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LimitOrderTrancheUserList) > 0 {
+		for _, e := range m.LimitOrderTrancheUserList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolMetadataList) > 0 {
+		for _, e := range m.PoolMetadataList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PoolCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolCount))
+	}
+	if len(m.PairTradingStatusList) > 0 {
+		for _, e := range m.PairTradingStatusList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomTradingStatusList) > 0 {
+		for _, e := range m.DenomTradingStatusList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HookSubscriptionList) > 0 {
+		for _, e := range m.HookSubscriptionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RangePositionList) > 0 {
+		for _, e := range m.RangePositionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RangePositionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.RangePositionCount))
+	}
+	if len(m.CandleList) > 0 {
+		for _, e := range m.CandleList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairFeeTiersList) > 0 {
+		for _, e := range m.PairFeeTiersList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TraderFeeTierAssignmentList) > 0 {
+		for _, e := range m.TraderFeeTierAssignmentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TraderVolumeList) > 0 {
+		for _, e := range m.TraderVolumeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TrancheRebatePoolList) > 0 {
+		for _, e := range m.TrancheRebatePoolList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MakerRebatesList) > 0 {
+		for _, e := range m.MakerRebatesList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoSettleOrderList) > 0 {
+		for _, e := range m.AutoSettleOrderList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TwapOrderList) > 0 {
+		for _, e := range m.TwapOrderList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TwapOrderCount != 0 {
+		n += 2 + sovGenesis(uint64(m.TwapOrderCount))
+	}
+	if len(m.TwapOrderFillList) > 0 {
+		for _, e := range m.TwapOrderFillList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolDepositBasisList) > 0 {
+		for _, e := range m.PoolDepositBasisList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExpirationDepositList) > 0 {
+		for _, e := range m.ExpirationDepositList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoSettleQueue) > 0 {
+		for _, s := range m.AutoSettleQueue {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickLiquidityList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickLiquidityList = append(m.TickLiquidityList, &TickLiquidity{})
+			if err := m.TickLiquidityList[len(m.TickLiquidityList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveLimitOrderTrancheList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InactiveLimitOrderTrancheList = append(m.InactiveLimitOrderTrancheList, &LimitOrderTranche{})
+			if err := m.InactiveLimitOrderTrancheList[len(m.InactiveLimitOrderTrancheList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTrancheUserList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrderTrancheUserList = append(m.LimitOrderTrancheUserList, &LimitOrderTrancheUser{})
+			if err := m.LimitOrderTrancheUserList[len(m.LimitOrderTrancheUserList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolMetadataList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolMetadataList = append(m.PoolMetadataList, PoolMetadata{})
+			if err := m.PoolMetadataList[len(m.PoolMetadataList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCount", wireType)
+			}
+			m.PoolCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairTradingStatusList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairTradingStatusList = append(m.PairTradingStatusList, PairTradingStatus{})
+			if err := m.PairTradingStatusList[len(m.PairTradingStatusList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTradingStatusList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTradingStatusList = append(m.DenomTradingStatusList, DenomTradingStatus{})
+			if err := m.DenomTradingStatusList[len(m.DenomTradingStatusList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookSubscriptionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookSubscriptionList = append(m.HookSubscriptionList, HookSubscription{})
+			if err := m.HookSubscriptionList[len(m.HookSubscriptionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangePositionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangePositionList = append(m.RangePositionList, RangePosition{})
+			if err := m.RangePositionList[len(m.RangePositionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangePositionCount", wireType)
+			}
+			m.RangePositionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangePositionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandleList = append(m.CandleList, Candle{})
+			if err := m.CandleList[len(m.CandleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairFeeTiersList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairFeeTiersList = append(m.PairFeeTiersList, PairFeeTiers{})
+			if err := m.PairFeeTiersList[len(m.PairFeeTiersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderFeeTierAssignmentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderFeeTierAssignmentList = append(m.TraderFeeTierAssignmentList, TraderFeeTierAssignment{})
+			if err := m.TraderFeeTierAssignmentList[len(m.TraderFeeTierAssignmentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderVolumeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.InactiveLimitOrderTrancheList) > 0 {
-		for iNdEx := len(m.InactiveLimitOrderTrancheList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InactiveLimitOrderTrancheList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderVolumeList = append(m.TraderVolumeList, TraderVolume{})
+			if err := m.TraderVolumeList[len(m.TraderVolumeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheRebatePoolList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.TickLiquidityList) > 0 {
-		for iNdEx := len(m.TickLiquidityList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TickLiquidityList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheRebatePoolList = append(m.TrancheRebatePoolList, TrancheRebatePool{})
+			if err := m.TrancheRebatePoolList[len(m.TrancheRebatePoolList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebatesList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.TickLiquidityList) > 0 {
-		for _, e := range m.TickLiquidityList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.InactiveLimitOrderTrancheList) > 0 {
-		for _, e := range m.InactiveLimitOrderTrancheList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.LimitOrderTrancheUserList) > 0 {
-		for _, e := range m.LimitOrderTrancheUserList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.PoolMetadataList) > 0 {
-		for _, e := range m.PoolMetadataList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.PoolCount != 0 {
-		n += 1 + sovGenesis(uint64(m.PoolCount))
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MakerRebatesList = append(m.MakerRebatesList, MakerRebates{})
+			if err := m.MakerRebatesList[len(m.MakerRebatesList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettleOrderList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -351,13 +1426,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AutoSettleOrderList = append(m.AutoSettleOrderList, AutoSettleOrder{})
+			if err := m.AutoSettleOrderList[len(m.AutoSettleOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickLiquidityList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapOrderList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -384,14 +1460,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TickLiquidityList = append(m.TickLiquidityList, &TickLiquidity{})
-			if err := m.TickLiquidityList[len(m.TickLiquidityList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TwapOrderList = append(m.TwapOrderList, TWAPOrder{})
+			if err := m.TwapOrderList[len(m.TwapOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapOrderCount", wireType)
+			}
+			m.TwapOrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapOrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InactiveLimitOrderTrancheList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapOrderFillList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -418,14 +1513,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InactiveLimitOrderTrancheList = append(m.InactiveLimitOrderTrancheList, &LimitOrderTranche{})
-			if err := m.InactiveLimitOrderTrancheList[len(m.InactiveLimitOrderTrancheList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TwapOrderFillList = append(m.TwapOrderFillList, TWAPOrderFill{})
+			if err := m.TwapOrderFillList[len(m.TwapOrderFillList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTrancheUserList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDepositBasisList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -452,14 +1547,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LimitOrderTrancheUserList = append(m.LimitOrderTrancheUserList, &LimitOrderTrancheUser{})
-			if err := m.LimitOrderTrancheUserList[len(m.LimitOrderTrancheUserList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolDepositBasisList = append(m.PoolDepositBasisList, PoolDepositBasis{})
+			if err := m.PoolDepositBasisList[len(m.PoolDepositBasisList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolMetadataList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDepositList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -486,16 +1581,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolMetadataList = append(m.PoolMetadataList, PoolMetadata{})
-			if err := m.PoolMetadataList[len(m.PoolMetadataList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExpirationDepositList = append(m.ExpirationDepositList, ExpirationDeposit{})
+			if err := m.ExpirationDepositList[len(m.ExpirationDepositList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCount", wireType)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettleQueue", wireType)
 			}
-			m.PoolCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -505,11 +1600,24 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoSettleQueue = append(m.AutoSettleQueue, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,8 +3,10 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	"github.com/neutron-org/neutron/v5/testutil/common/sample"
 	"github.com/neutron-org/neutron/v5/x/dex/types"
 )
 
@@ -183,6 +185,47 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "twapOrderFill of a closed twapOrder",
+			genState: &types.GenesisState{
+				TwapOrderFillList: []types.TWAPOrderFill{
+					{
+						OrderId: 0,
+						Slice:   0,
+					},
+				},
+				TwapOrderCount: 1,
+			},
+			valid: true,
+		},
+		{
+			desc: "twapOrderFill of an unknown twapOrder",
+			genState: &types.GenesisState{
+				TwapOrderFillList: []types.TWAPOrderFill{
+					{
+						OrderId: 1,
+						Slice:   0,
+					},
+				},
+				TwapOrderCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "twapOrder interval too long",
+			genState: &types.GenesisState{
+				TwapOrderList: []types.TWAPOrder{
+					{
+						Id:          0,
+						Creator:     sample.AccAddress(),
+						RemainingIn: math.OneInt(),
+						Interval:    types.MaxTWAPOrderInterval + 1,
+					},
+				},
+				TwapOrderCount: 1,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
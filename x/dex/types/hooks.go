@@ -0,0 +1,90 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DexHooks is called by the dex keeper after liquidity events. Hooks are only invoked once the
+// triggering action has succeeded, and an error returned by a hook fails the whole message.
+type DexHooks interface {
+	// AfterDeposit is called after a deposit with the total amounts of token0 and token1 deposited
+	AfterDeposit(
+		ctx sdk.Context,
+		depositor, receiver sdk.AccAddress,
+		pairID *PairID,
+		amount0, amount1 math.Int,
+		sharesIssued sdk.Coins,
+	) error
+	// AfterWithdraw is called after a withdrawal with the total amounts of token0 and token1 withdrawn
+	AfterWithdraw(
+		ctx sdk.Context,
+		withdrawer, receiver sdk.AccAddress,
+		pairID *PairID,
+		amount0, amount1 math.Int,
+		sharesBurned sdk.Coins,
+	) error
+	// AfterSwap is called once per trade pair swapped through by a limit order or multihop swap
+	AfterSwap(ctx sdk.Context, trader sdk.AccAddress, tradePairID *TradePairID, coinIn, coinOut sdk.Coin) error
+	// AfterLimitOrderFilled is called once per limit order tranche that was (partially) filled by a swap.
+	// coinIn is the taker denom paid into the tranche and coinOut is the maker denom taken out of it.
+	AfterLimitOrderFilled(ctx sdk.Context, trancheKey *LimitOrderTrancheKey, coinIn, coinOut sdk.Coin) error
+}
+
+var _ DexHooks = MultiDexHooks{}
+
+// MultiDexHooks combines multiple dex hooks, all hook functions are run in array sequence
+type MultiDexHooks []DexHooks
+
+func NewMultiDexHooks(hooks ...DexHooks) MultiDexHooks {
+	return hooks
+}
+
+func (h MultiDexHooks) AfterDeposit(
+	ctx sdk.Context,
+	depositor, receiver sdk.AccAddress,
+	pairID *PairID,
+	amount0, amount1 math.Int,
+	sharesIssued sdk.Coins,
+) error {
+	for i := range h {
+		if err := h[i].AfterDeposit(ctx, depositor, receiver, pairID, amount0, amount1, sharesIssued); err != nil {
+			return sdkerrors.Wrap(err, "AfterDeposit hook failed")
+		}
+	}
+	return nil
+}
+
+func (h MultiDexHooks) AfterWithdraw(
+	ctx sdk.Context,
+	withdrawer, receiver sdk.AccAddress,
+	pairID *PairID,
+	amount0, amount1 math.Int,
+	sharesBurned sdk.Coins,
+) error {
+	for i := range h {
+		if err := h[i].AfterWithdraw(ctx, withdrawer, receiver, pairID, amount0, amount1, sharesBurned); err != nil {
+			return sdkerrors.Wrap(err, "AfterWithdraw hook failed")
+		}
+	}
+	return nil
+}
+
+func (h MultiDexHooks) AfterSwap(ctx sdk.Context, trader sdk.AccAddress, tradePairID *TradePairID, coinIn, coinOut sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterSwap(ctx, trader, tradePairID, coinIn, coinOut); err != nil {
+			return sdkerrors.Wrap(err, "AfterSwap hook failed")
+		}
+	}
+	return nil
+}
+
+func (h MultiDexHooks) AfterLimitOrderFilled(ctx sdk.Context, trancheKey *LimitOrderTrancheKey, coinIn, coinOut sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterLimitOrderFilled(ctx, trancheKey, coinIn, coinOut); err != nil {
+			return sdkerrors.Wrap(err, "AfterLimitOrderFilled hook failed")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,948 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/hooks.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SwapRecord is kept in the transient store while a message is executing and
+// is passed to DexHooks.AfterSwap once the message has succeeded.
+type SwapRecord struct {
+	TradePairId *TradePairID          `protobuf:"bytes,1,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	AmountIn    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	AmountOut   cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount_out,json=amountOut,proto3,customtype=cosmossdk.io/math.Int" json:"amount_out" yaml:"amount_out"`
+}
+
+func (m *SwapRecord) Reset()         { *m = SwapRecord{} }
+func (m *SwapRecord) String() string { return proto.CompactTextString(m) }
+func (*SwapRecord) ProtoMessage()    {}
+func (*SwapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fa71135defb795f, []int{0}
+}
+func (m *SwapRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRecord.Merge(m, src)
+}
+func (m *SwapRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRecord proto.InternalMessageInfo
+
+func (m *SwapRecord) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+// LimitOrderFillRecord is kept in the transient store while a message is
+// executing and is passed to DexHooks.AfterLimitOrderFilled once the message
+// has succeeded.
+type LimitOrderFillRecord struct {
+	TrancheKey *LimitOrderTrancheKey `protobuf:"bytes,1,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	// Amount of the taker denom paid into the tranche
+	AmountIn cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	// Amount of the maker denom taken out of the tranche
+	AmountOut cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount_out,json=amountOut,proto3,customtype=cosmossdk.io/math.Int" json:"amount_out" yaml:"amount_out"`
+}
+
+func (m *LimitOrderFillRecord) Reset()         { *m = LimitOrderFillRecord{} }
+func (m *LimitOrderFillRecord) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderFillRecord) ProtoMessage()    {}
+func (*LimitOrderFillRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fa71135defb795f, []int{1}
+}
+func (m *LimitOrderFillRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderFillRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderFillRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderFillRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderFillRecord.Merge(m, src)
+}
+func (m *LimitOrderFillRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderFillRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderFillRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderFillRecord proto.InternalMessageInfo
+
+func (m *LimitOrderFillRecord) GetTrancheKey() *LimitOrderTrancheKey {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return nil
+}
+
+// HookSubscription subscribes a contract to dex hook sudo calls for a pair
+type HookSubscription struct {
+	PairId          *PairID `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	ContractAddress string  `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *HookSubscription) Reset()         { *m = HookSubscription{} }
+func (m *HookSubscription) String() string { return proto.CompactTextString(m) }
+func (*HookSubscription) ProtoMessage()    {}
+func (*HookSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fa71135defb795f, []int{2}
+}
+func (m *HookSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookSubscription.Merge(m, src)
+}
+func (m *HookSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookSubscription proto.InternalMessageInfo
+
+func (m *HookSubscription) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *HookSubscription) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*SwapRecord)(nil), "neutron.dex.SwapRecord")
+	proto.RegisterType((*LimitOrderFillRecord)(nil), "neutron.dex.LimitOrderFillRecord")
+	proto.RegisterType((*HookSubscription)(nil), "neutron.dex.HookSubscription")
+}
+
+func init() { proto.RegisterFile("neutron/dex/hooks.proto", fileDescriptor_0fa71135defb795f) }
+
+var fileDescriptor_0fa71135defb795f = []byte{
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x92, 0xc1, 0x8b, 0xd3, 0x40,
+	0x14, 0xc6, 0x9b, 0x0a, 0xab, 0x3b, 0x45, 0xac, 0x71, 0xc5, 0xba, 0x87, 0x64, 0x0d, 0x08, 0x2b,
+	0xb8, 0x09, 0x28, 0x5e, 0x44, 0x44, 0x8b, 0xa8, 0x45, 0x61, 0x25, 0xbb, 0x27, 0x3d, 0x0c, 0xd3,
+	0xcc, 0xd0, 0x0e, 0x69, 0xe6, 0x85, 0x99, 0x17, 0x6d, 0xff, 0x03, 0x8f, 0x1e, 0xfd, 0x93, 0xf6,
+	0xb8, 0x47, 0xf1, 0x10, 0xa4, 0xbd, 0x79, 0xdc, 0xbf, 0x40, 0xd2, 0xcc, 0xb6, 0xc9, 0xc9, 0xf3,
+	0xde, 0x5e, 0xbe, 0xf7, 0x7d, 0x6f, 0xc2, 0x8f, 0x8f, 0xdc, 0x53, 0xa2, 0x40, 0x0d, 0x2a, 0xe2,
+	0x62, 0x1e, 0x4d, 0x01, 0x52, 0x13, 0xe6, 0x1a, 0x10, 0xdc, 0x9e, 0x5d, 0x84, 0x5c, 0xcc, 0xf7,
+	0xf7, 0x26, 0x30, 0x81, 0xb5, 0x1e, 0x55, 0x53, 0x6d, 0xd9, 0x7f, 0xd8, 0xcc, 0xce, 0x64, 0x26,
+	0x91, 0x82, 0xe6, 0x42, 0x53, 0xd4, 0x4c, 0x25, 0x53, 0x61, 0x6d, 0xf7, 0x9b, 0xb6, 0x9c, 0x49,
+	0x4d, 0x25, 0xb7, 0x2b, 0xbf, 0xb9, 0x42, 0xcd, 0xb8, 0xa0, 0x2d, 0x43, 0xf0, 0xbd, 0x4b, 0xc8,
+	0xc9, 0x37, 0x96, 0xc7, 0x22, 0x01, 0xcd, 0xdd, 0x17, 0xe4, 0x66, 0xcb, 0x35, 0x70, 0x0e, 0x9c,
+	0xc3, 0xde, 0x93, 0x41, 0xd8, 0xf8, 0xd9, 0xf0, 0xb4, 0x72, 0x7c, 0x62, 0x52, 0x8f, 0xde, 0xc4,
+	0x3d, 0xdc, 0x7c, 0x70, 0xf7, 0x0b, 0xd9, 0x65, 0x19, 0x14, 0x0a, 0xa9, 0x54, 0x83, 0xee, 0x81,
+	0x73, 0xb8, 0x3b, 0x7c, 0x79, 0x56, 0xfa, 0x9d, 0xdf, 0xa5, 0x7f, 0x37, 0x01, 0x93, 0x81, 0x31,
+	0x3c, 0x0d, 0x25, 0x44, 0x19, 0xc3, 0x69, 0x38, 0x52, 0xf8, 0xb7, 0xf4, 0xb7, 0x89, 0x8b, 0xd2,
+	0xef, 0x2f, 0x58, 0x36, 0x7b, 0x1e, 0x6c, 0xa4, 0x20, 0xbe, 0x51, 0xcf, 0x23, 0xe5, 0x52, 0x42,
+	0xac, 0x0e, 0x05, 0x0e, 0xae, 0xad, 0xaf, 0xbf, 0xfa, 0xdf, 0xf5, 0x46, 0xe4, 0xa2, 0xf4, 0x6f,
+	0xb7, 0xce, 0x43, 0x81, 0x41, 0x6c, 0x9f, 0x3f, 0x2e, 0x30, 0xf8, 0xd9, 0x25, 0x7b, 0x1f, 0x2b,
+	0xc8, 0xc7, 0x15, 0xe3, 0xb7, 0x72, 0x36, 0xb3, 0x50, 0x86, 0xa4, 0x67, 0x81, 0xd3, 0x54, 0x2c,
+	0x2c, 0x92, 0x07, 0x2d, 0x24, 0xdb, 0xdc, 0x69, 0xed, 0xfc, 0x20, 0x16, 0x31, 0xc1, 0xcd, 0x7c,
+	0xc5, 0xd1, 0xa4, 0xa4, 0xff, 0x1e, 0x20, 0x3d, 0x29, 0xc6, 0x26, 0xd1, 0x32, 0x47, 0x09, 0xca,
+	0x7d, 0x4c, 0xae, 0xb7, 0x4b, 0x72, 0xa7, 0x45, 0xc4, 0xf6, 0x63, 0x27, 0xaf, 0xab, 0xf1, 0x88,
+	0xf4, 0x13, 0x50, 0xa8, 0x59, 0x82, 0x94, 0x71, 0xae, 0x85, 0x31, 0x35, 0x86, 0xf8, 0xd6, 0xa5,
+	0xfe, 0xba, 0x96, 0x87, 0xef, 0xce, 0x96, 0x9e, 0x73, 0xbe, 0xf4, 0x9c, 0x3f, 0x4b, 0xcf, 0xf9,
+	0xb1, 0xf2, 0x3a, 0xe7, 0x2b, 0xaf, 0xf3, 0x6b, 0xe5, 0x75, 0x3e, 0x1f, 0x4d, 0x24, 0x4e, 0x8b,
+	0x71, 0x98, 0x40, 0x16, 0xd9, 0xb7, 0x8e, 0x40, 0x4f, 0x2e, 0xe7, 0xe8, 0xeb, 0xb3, 0x68, 0x5e,
+	0x37, 0x7d, 0x91, 0x0b, 0x33, 0xde, 0x59, 0x57, 0xfc, 0xe9, 0xbf, 0x01, 0x00, 0x93, 0x66, 0x42,
+	0x4c, 0x83, 0x03, 0x00, 0x00,
+}
+
+func (m *SwapRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AmountOut.Size()
+		i -= size
+		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHooks(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHooks(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHooks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderFillRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderFillRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderFillRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AmountOut.Size()
+		i -= size
+		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHooks(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHooks(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TrancheKey != nil {
+		{
+			size, err := m.TrancheKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHooks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintHooks(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHooks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHooks(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHooks(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SwapRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovHooks(uint64(l))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovHooks(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovHooks(uint64(l))
+	return n
+}
+
+func (m *LimitOrderFillRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrancheKey != nil {
+		l = m.TrancheKey.Size()
+		n += 1 + l + sovHooks(uint64(l))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovHooks(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovHooks(uint64(l))
+	return n
+}
+
+func (m *HookSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovHooks(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovHooks(uint64(l))
+	}
+	return n
+}
+
+func sovHooks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHooks(x uint64) (n int) {
+	return sovHooks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SwapRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHooks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHooks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHooks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHooks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrderFillRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHooks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderFillRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderFillRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHooks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrancheKey == nil {
+				m.TrancheKey = &LimitOrderTrancheKey{}
+			}
+			if err := m.TrancheKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHooks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHooks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHooks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHooks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHooks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHooks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHooks(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHooks
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHooks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHooks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHooks
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHooks
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHooks
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHooks        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHooks          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHooks = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// DexHookSudoMsg is the sudo message sent to contracts subscribed to dex hooks.
+// Exactly one of the fields is set.
+type DexHookSudoMsg struct {
+	AfterDeposit          *AfterDepositSudoMsg          `json:"after_deposit,omitempty"`
+	AfterWithdraw         *AfterWithdrawSudoMsg         `json:"after_withdraw,omitempty"`
+	AfterSwap             *AfterSwapSudoMsg             `json:"after_swap,omitempty"`
+	AfterLimitOrderFilled *AfterLimitOrderFilledSudoMsg `json:"after_limit_order_filled,omitempty"`
+}
+
+type AfterDepositSudoMsg struct {
+	Depositor    string             `json:"depositor"`
+	Receiver     string             `json:"receiver"`
+	PairID       string             `json:"pair_id"`
+	Amount0      string             `json:"amount0"`
+	Amount1      string             `json:"amount1"`
+	SharesIssued []wasmvmtypes.Coin `json:"shares_issued"`
+}
+
+type AfterWithdrawSudoMsg struct {
+	Withdrawer   string             `json:"withdrawer"`
+	Receiver     string             `json:"receiver"`
+	PairID       string             `json:"pair_id"`
+	Amount0      string             `json:"amount0"`
+	Amount1      string             `json:"amount1"`
+	SharesBurned []wasmvmtypes.Coin `json:"shares_burned"`
+}
+
+type AfterSwapSudoMsg struct {
+	Trader  string           `json:"trader"`
+	PairID  string           `json:"pair_id"`
+	CoinIn  wasmvmtypes.Coin `json:"coin_in"`
+	CoinOut wasmvmtypes.Coin `json:"coin_out"`
+}
+
+type AfterLimitOrderFilledSudoMsg struct {
+	PairID     string           `json:"pair_id"`
+	TrancheKey string           `json:"tranche_key"`
+	TickIndex  int64            `json:"tick_index_taker_to_maker"`
+	CoinIn     wasmvmtypes.Coin `json:"coin_in"`
+	CoinOut    wasmvmtypes.Coin `json:"coin_out"`
+}
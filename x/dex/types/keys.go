@@ -55,6 +55,84 @@ const (
 
 	// JITPerBlock is the key to retrieve the number of JIT limit orders place in a single block
 	JITsInBlockKey = "JITsInBlock/count/"
+
+	// PairTradingStatusKeyPrefix is the prefix to retrieve all PairTradingStatus
+	PairTradingStatusKeyPrefix = "PairTradingStatus/value/"
+
+	// DenomTradingStatusKeyPrefix is the prefix to retrieve all DenomTradingStatus
+	DenomTradingStatusKeyPrefix = "DenomTradingStatus/value/"
+
+	// HookSubscriptionKeyPrefix is the prefix to retrieve all HookSubscriptions
+	HookSubscriptionKeyPrefix = "HookSubscription/value/"
+
+	// HookSwapRecordKeyPrefix is the transient store prefix for swaps pending dispatch to DexHooks
+	HookSwapRecordKeyPrefix = "HookSwapRecord/value/"
+
+	// HookFillRecordKeyPrefix is the transient store prefix for limit order fills pending dispatch to DexHooks
+	HookFillRecordKeyPrefix = "HookFillRecord/value/"
+
+	// HookRecordCountKey is the transient store key for the hook record sequence
+	HookRecordCountKey = "HookRecord/count/"
+
+	// RangePositionKeyPrefix is the prefix to retrieve all RangePositions
+	RangePositionKeyPrefix = "RangePosition/value/"
+
+	// RangePositionOwnerKeyPrefix is the prefix of the index of RangePositions by owner
+	RangePositionOwnerKeyPrefix = "RangePosition/owner/"
+
+	// RangePositionCountKey is the key to retrieve the RangePosition count
+	RangePositionCountKey = "RangePosition/count/"
+
+	// FlashSwapDepthKey is the transient store key for the number of flash swaps currently executing
+	FlashSwapDepthKey = "FlashSwap/depth/"
+
+	// CandleKeyPrefix is the prefix to retrieve all Candles
+	CandleKeyPrefix = "Candle/value/"
+
+	// PairFeeTiersKeyPrefix is the prefix to retrieve all PairFeeTiers
+	PairFeeTiersKeyPrefix = "PairFeeTiers/value/"
+
+	// BlockTradeKeyPrefix is the transient store prefix for swaps executed in the current block
+	BlockTradeKeyPrefix = "BlockTrade/value/"
+
+	// TraderFeeTierAssignmentKeyPrefix is the prefix to retrieve all TraderFeeTierAssignments
+	TraderFeeTierAssignmentKeyPrefix = "TraderFeeTierAssignment/value/"
+
+	// TraderVolumeKeyPrefix is the prefix to retrieve all TraderVolumes
+	TraderVolumeKeyPrefix = "TraderVolume/value/"
+
+	// TrancheRebatePoolKeyPrefix is the prefix to retrieve all TrancheRebatePools
+	TrancheRebatePoolKeyPrefix = "TrancheRebatePool/value/"
+
+	// MakerRebatesKeyPrefix is the prefix to retrieve all MakerRebates
+	MakerRebatesKeyPrefix = "MakerRebates/value/"
+
+	// AutoSettleOrderKeyPrefix is the prefix to retrieve all AutoSettleOrders
+	AutoSettleOrderKeyPrefix = "AutoSettleOrder/value/"
+
+	// TWAPOrderKeyPrefix is the prefix to retrieve all TWAPOrders
+	TWAPOrderKeyPrefix = "TWAPOrder/value/"
+
+	// TWAPOrderCreatorKeyPrefix is the prefix of the index of TWAPOrders by creator
+	TWAPOrderCreatorKeyPrefix = "TWAPOrder/creator/"
+
+	// TWAPOrderScheduleKeyPrefix is the prefix of the index of TWAPOrders by next execution time
+	TWAPOrderScheduleKeyPrefix = "TWAPOrder/schedule/"
+
+	// TWAPOrderCountKey is the key to retrieve the TWAPOrder count
+	TWAPOrderCountKey = "TWAPOrder/count/"
+
+	// TWAPOrderFillKeyPrefix is the prefix to retrieve all TWAPOrderFills
+	TWAPOrderFillKeyPrefix = "TWAPOrderFill/value/"
+
+	// PoolDepositBasisKeyPrefix is the prefix to retrieve all PoolDepositBases
+	PoolDepositBasisKeyPrefix = "PoolDepositBasis/value/"
+
+	// ExpirationDepositKeyPrefix is the prefix to retrieve all ExpirationDeposits
+	ExpirationDepositKeyPrefix = "ExpirationDeposit/value/"
+
+	// AutoSettleQueueKeyPrefix is the prefix to retrieve the tranches whose auto-settled orders are waiting to be settled
+	AutoSettleQueueKeyPrefix = "AutoSettleQueue/value/"
 )
 
 func KeyPrefix(p string) []byte {
@@ -178,6 +256,174 @@ func PoolIDKey(
 	return key
 }
 
+func PairTradingStatusKey(pairID *PairID) []byte {
+	key := []byte(pairID.CanonicalString())
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func DenomTradingStatusKey(denom string) []byte {
+	key := []byte(denom)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func PairFeeTiersKey(pairID *PairID) []byte {
+	key := []byte(pairID.CanonicalString())
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func HookSubscriptionPairPrefix(pairID *PairID) []byte {
+	key := []byte(pairID.CanonicalString())
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func HookSubscriptionKey(pairID *PairID, contractAddr string) []byte {
+	key := HookSubscriptionPairPrefix(pairID)
+	key = append(key, []byte(contractAddr)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func RangePositionOwnerPrefix(owner string) []byte {
+	key := KeyPrefix(RangePositionOwnerKeyPrefix)
+	key = append(key, []byte(owner)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func RangePositionOwnerKey(owner string, id uint64) []byte {
+	key := RangePositionOwnerPrefix(owner)
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+
+	return key
+}
+
+// CandlePrefix returns the store prefix of all candles of a pair for an interval. Candles under the prefix are ordered
+// by open time.
+func CandlePrefix(pairID *PairID, interval uint64) []byte {
+	key := KeyPrefix(CandleKeyPrefix)
+	key = append(key, []byte(pairID.CanonicalString())...)
+	key = append(key, []byte("/")...)
+	key = append(key, sdk.Uint64ToBigEndian(interval)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func CandleKey(pairID *PairID, interval uint64, openTime int64) []byte {
+	key := CandlePrefix(pairID, interval)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(openTime))...) //nolint:gosec
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func TraderFeeTierAssignmentKey(address string) []byte {
+	key := []byte(address)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func TrancheRebatePoolKey(trancheKey string) []byte {
+	key := []byte(trancheKey)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func MakerRebatesKey(address string) []byte {
+	key := []byte(address)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AutoSettleOrderTranchePrefix returns the store prefix of all AutoSettleOrders of a tranche
+func AutoSettleOrderTranchePrefix(trancheKey string) []byte {
+	key := KeyPrefix(AutoSettleOrderKeyPrefix)
+	key = append(key, []byte(trancheKey)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func AutoSettleOrderKey(trancheKey, address string) []byte {
+	key := AutoSettleOrderTranchePrefix(trancheKey)
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func TWAPOrderCreatorPrefix(creator string) []byte {
+	key := KeyPrefix(TWAPOrderCreatorKeyPrefix)
+	key = append(key, []byte(creator)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func TWAPOrderCreatorKey(creator string, id uint64) []byte {
+	key := TWAPOrderCreatorPrefix(creator)
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+
+	return key
+}
+
+// TWAPOrderScheduleKey returns the key of a TWAPOrder in the schedule index. Keys are ordered by execution time.
+func TWAPOrderScheduleKey(executionTime time.Time, id uint64) []byte {
+	key := KeyPrefix(TWAPOrderScheduleKeyPrefix)
+	key = append(key, TimeBytes(executionTime)...)
+	key = append(key, []byte("/")...)
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+
+	return key
+}
+
+// TWAPOrderFillPrefix returns the store prefix of all fills of a TWAPOrder. Fills under the prefix are ordered by slice.
+func TWAPOrderFillPrefix(orderID uint64) []byte {
+	key := KeyPrefix(TWAPOrderFillKeyPrefix)
+	key = append(key, sdk.Uint64ToBigEndian(orderID)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func TWAPOrderFillKey(orderID, slice uint64) []byte {
+	key := TWAPOrderFillPrefix(orderID)
+	key = append(key, sdk.Uint64ToBigEndian(slice)...)
+
+	return key
+}
+
+// TraderVolumePrefix returns the store prefix of all daily volumes of an address. Volumes under the prefix are ordered
+// by day.
+func TraderVolumePrefix(address string) []byte {
+	key := KeyPrefix(TraderVolumeKeyPrefix)
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func TraderVolumeKey(address string, day uint64) []byte {
+	key := TraderVolumePrefix(address)
+	key = append(key, sdk.Uint64ToBigEndian(day)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
 const (
 	// NOTE: have to add letter so that LP deposits are indexed ahead of LimitOrders
 	LiquidityTypePoolReserves = "A_PoolDeposit"
@@ -194,3 +440,27 @@ const (
 
 // Dummy Address used for simulate queries
 const DummyAddress = "neutron1pq7j6za5zjcl3um9t5gfyleues336tv04tyq0k"
+
+// PoolDepositBasisAddressPrefix returns the store prefix of all PoolDepositBases of an address
+func PoolDepositBasisAddressPrefix(address string) []byte {
+	key := KeyPrefix(PoolDepositBasisKeyPrefix)
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func PoolDepositBasisKey(address string, poolID uint64) []byte {
+	key := PoolDepositBasisAddressPrefix(address)
+	key = append(key, sdk.Uint64ToBigEndian(poolID)...)
+
+	return key
+}
+
+func ExpirationDepositKey(trancheRef []byte) []byte {
+	key := KeyPrefix(ExpirationDepositKeyPrefix)
+	key = append(key, trancheRef...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
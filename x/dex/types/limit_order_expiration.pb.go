@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -80,8 +82,71 @@ func (m *LimitOrderExpiration) GetTrancheRef() []byte {
 	return nil
 }
 
+// ExpirationDeposit is collected when a GOOD_TIL_TIME limit order is placed. It is refunded when the order is
+// canceled or purged at the start of a block and paid as a bounty to whoever purges the order with MsgPurgeExpiredOrders.
+type ExpirationDeposit struct {
+	TrancheRef []byte                                   `protobuf:"bytes,1,opt,name=tranche_ref,json=trancheRef,proto3" json:"tranche_ref,omitempty"`
+	Depositor  string                                   `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Deposit    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+}
+
+func (m *ExpirationDeposit) Reset()         { *m = ExpirationDeposit{} }
+func (m *ExpirationDeposit) String() string { return proto.CompactTextString(m) }
+func (*ExpirationDeposit) ProtoMessage()    {}
+func (*ExpirationDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61264397cad6ae82, []int{1}
+}
+func (m *ExpirationDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpirationDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpirationDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpirationDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpirationDeposit.Merge(m, src)
+}
+func (m *ExpirationDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpirationDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpirationDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpirationDeposit proto.InternalMessageInfo
+
+func (m *ExpirationDeposit) GetTrancheRef() []byte {
+	if m != nil {
+		return m.TrancheRef
+	}
+	return nil
+}
+
+func (m *ExpirationDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *ExpirationDeposit) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LimitOrderExpiration)(nil), "neutron.dex.LimitOrderExpiration")
+	proto.RegisterType((*ExpirationDeposit)(nil), "neutron.dex.ExpirationDeposit")
 }
 
 func init() {
@@ -89,24 +154,31 @@ func init() {
 }
 
 var fileDescriptor_61264397cad6ae82 = []byte{
-	// 265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc8, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0x49, 0xad, 0xd0, 0xcf, 0xc9, 0xcc, 0xcd, 0x2c, 0x89, 0xcf, 0x2f,
-	0x4a, 0x49, 0x2d, 0x8a, 0x4f, 0xad, 0x28, 0xc8, 0x2c, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0xaa, 0xd4, 0x4b, 0x49, 0xad, 0x90, 0x12, 0x49, 0xcf,
-	0x4f, 0xcf, 0x07, 0x8b, 0xeb, 0x83, 0x58, 0x10, 0x25, 0x52, 0xf2, 0xe9, 0xf9, 0xf9, 0xe9, 0x39,
-	0xa9, 0xfa, 0x60, 0x5e, 0x52, 0x69, 0x9a, 0x7e, 0x49, 0x66, 0x6e, 0x6a, 0x71, 0x49, 0x62, 0x6e,
-	0x01, 0x44, 0x81, 0x52, 0x1b, 0x23, 0x97, 0x88, 0x0f, 0xc8, 0x12, 0x7f, 0x90, 0x1d, 0xae, 0x70,
-	0x2b, 0x84, 0x7c, 0xb9, 0xf8, 0x11, 0x16, 0xc6, 0x83, 0xb4, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70,
-	0x1b, 0x49, 0xe9, 0x41, 0xcc, 0xd4, 0x83, 0x99, 0xa9, 0x17, 0x02, 0x33, 0xd3, 0x89, 0xe3, 0xc4,
-	0x3d, 0x79, 0x86, 0x09, 0xf7, 0xe5, 0x19, 0x83, 0xf8, 0x10, 0x9a, 0x41, 0xd2, 0x42, 0xf2, 0x5c,
-	0xdc, 0x25, 0x45, 0x89, 0x79, 0xc9, 0x19, 0xa9, 0xf1, 0x45, 0xa9, 0x69, 0x12, 0x4c, 0x0a, 0x8c,
-	0x1a, 0x3c, 0x41, 0x5c, 0x50, 0xa1, 0xa0, 0xd4, 0x34, 0x27, 0xf7, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x87, 0xfa, 0x58, 0x37, 0xbf, 0x28, 0x1d, 0xc6, 0xd6, 0x2f, 0x33, 0xd5, 0xaf, 0x00, 0x07,
-	0x56, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x5d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xb0, 0xba, 0xc8, 0x12, 0x48, 0x01, 0x00, 0x00,
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4f, 0x6e, 0xda, 0x40,
+	0x18, 0xc5, 0x3d, 0x45, 0x6a, 0xcb, 0xb8, 0x6a, 0x55, 0x8b, 0x05, 0x45, 0x95, 0x8d, 0x58, 0x79,
+	0xc3, 0x4c, 0xa1, 0xea, 0x05, 0x68, 0xa2, 0x6c, 0x12, 0x45, 0xb2, 0xb2, 0xca, 0xc6, 0xf2, 0x9f,
+	0xc1, 0x8c, 0x82, 0xfd, 0x59, 0x33, 0x03, 0x72, 0x2e, 0x90, 0x35, 0xe7, 0xc8, 0x0d, 0x72, 0x03,
+	0x96, 0x2c, 0xb3, 0x0a, 0x11, 0x5c, 0x24, 0xb2, 0x3d, 0x8e, 0x23, 0x56, 0xfe, 0xe6, 0xf3, 0x7b,
+	0x3f, 0x3f, 0x3d, 0x0f, 0x76, 0x33, 0xb6, 0x52, 0x02, 0x32, 0x1a, 0xb3, 0x82, 0x2e, 0x79, 0xca,
+	0x95, 0x0f, 0x22, 0x66, 0xc2, 0x67, 0x45, 0xce, 0x45, 0xa0, 0x38, 0x64, 0x24, 0x17, 0xa0, 0xc0,
+	0x32, 0xb5, 0x92, 0xc4, 0xac, 0x18, 0xd8, 0x11, 0xc8, 0x14, 0x24, 0x0d, 0x03, 0xc9, 0xe8, 0x7a,
+	0x12, 0x32, 0x15, 0x4c, 0x68, 0x04, 0x5c, 0x8b, 0x07, 0xbd, 0x04, 0x12, 0xa8, 0x46, 0x5a, 0x4e,
+	0x7a, 0xeb, 0x24, 0x00, 0xc9, 0x92, 0xd1, 0xea, 0x14, 0xae, 0xe6, 0x54, 0xf1, 0x94, 0x49, 0x15,
+	0xa4, 0x79, 0x2d, 0x18, 0x3d, 0x20, 0xdc, 0xbb, 0x2c, 0x43, 0x5c, 0x97, 0x19, 0xce, 0xdf, 0x23,
+	0x58, 0x57, 0xf8, 0x47, 0x1b, 0xc8, 0x2f, 0x6d, 0x7d, 0x34, 0x44, 0xae, 0x39, 0x1d, 0x90, 0x9a,
+	0x49, 0x1a, 0x26, 0xb9, 0x69, 0x98, 0xb3, 0xaf, 0xdb, 0x17, 0xc7, 0xd8, 0xec, 0x1d, 0xe4, 0x7d,
+	0x6f, 0xcd, 0xe5, 0x6b, 0xcb, 0xc1, 0xa6, 0x12, 0x41, 0x16, 0x2d, 0x98, 0x2f, 0xd8, 0xbc, 0xff,
+	0x69, 0x88, 0xdc, 0x6f, 0x1e, 0xd6, 0x2b, 0x8f, 0xcd, 0x47, 0x4f, 0x08, 0xff, 0x6c, 0x3f, 0x7f,
+	0xc6, 0x72, 0x90, 0x5c, 0x9d, 0xda, 0xd0, 0xa9, 0xcd, 0xfa, 0x8d, 0xbb, 0x71, 0xad, 0x05, 0x51,
+	0x51, 0xbb, 0x5e, 0xbb, 0xb0, 0x18, 0xfe, 0xa2, 0x0f, 0xfd, 0xce, 0xb0, 0xe3, 0x9a, 0xd3, 0x5f,
+	0xa4, 0xae, 0x91, 0x94, 0x35, 0x12, 0x5d, 0x23, 0xf9, 0x0f, 0x3c, 0x9b, 0xfd, 0x29, 0xb3, 0x3f,
+	0xee, 0x1d, 0x37, 0xe1, 0x6a, 0xb1, 0x0a, 0x49, 0x04, 0x29, 0xd5, 0x9d, 0xd7, 0x8f, 0xb1, 0x8c,
+	0xef, 0xa8, 0xba, 0xcf, 0x99, 0xac, 0x0c, 0xd2, 0x6b, 0xd8, 0xb3, 0x8b, 0xed, 0xc1, 0x46, 0xbb,
+	0x83, 0x8d, 0x5e, 0x0f, 0x36, 0xda, 0x1c, 0x6d, 0x63, 0x77, 0xb4, 0x8d, 0xe7, 0xa3, 0x6d, 0xdc,
+	0x8e, 0x3f, 0xc0, 0xf4, 0xdf, 0x1c, 0x83, 0x48, 0x9a, 0x99, 0xae, 0xff, 0xd1, 0xa2, 0xba, 0x08,
+	0x15, 0x37, 0xfc, 0x5c, 0x75, 0xfa, 0xf7, 0x6d, 0x00, 0x2f, 0xae, 0x01, 0x87, 0x24, 0x02, 0x00,
+	0x00,
 }
 
 func (m *LimitOrderExpiration) Marshal() (dAtA []byte, err error) {
@@ -147,6 +219,57 @@ func (m *LimitOrderExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExpirationDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpirationDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpirationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLimitOrderExpiration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintLimitOrderExpiration(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TrancheRef) > 0 {
+		i -= len(m.TrancheRef)
+		copy(dAtA[i:], m.TrancheRef)
+		i = encodeVarintLimitOrderExpiration(dAtA, i, uint64(len(m.TrancheRef)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLimitOrderExpiration(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLimitOrderExpiration(v)
 	base := offset
@@ -173,6 +296,29 @@ func (m *LimitOrderExpiration) Size() (n int) {
 	return n
 }
 
+func (m *ExpirationDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TrancheRef)
+	if l > 0 {
+		n += 1 + l + sovLimitOrderExpiration(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovLimitOrderExpiration(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovLimitOrderExpiration(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovLimitOrderExpiration(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -296,6 +442,156 @@ func (m *LimitOrderExpiration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExpirationDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimitOrderExpiration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpirationDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpirationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheRef", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrderExpiration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLimitOrderExpiration
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrderExpiration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheRef = append(m.TrancheRef[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrancheRef == nil {
+				m.TrancheRef = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrderExpiration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrderExpiration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrderExpiration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrderExpiration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrderExpiration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrderExpiration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimitOrderExpiration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimitOrderExpiration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLimitOrderExpiration(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,85 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgBatchCancelLimitOrders = "batch_cancel_limit_orders"
+
+var _ sdk.Msg = &MsgBatchCancelLimitOrders{}
+
+func NewMsgBatchCancelLimitOrders(creator string, trancheKeys []string) *MsgBatchCancelLimitOrders {
+	return &MsgBatchCancelLimitOrders{
+		Creator:     creator,
+		TrancheKeys: trancheKeys,
+	}
+}
+
+func NewMsgBatchCancelLimitOrdersForPair(creator, pairID, tokenIn string) *MsgBatchCancelLimitOrders {
+	return &MsgBatchCancelLimitOrders{
+		Creator: creator,
+		PairId:  pairID,
+		TokenIn: tokenIn,
+	}
+}
+
+func (msg *MsgBatchCancelLimitOrders) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBatchCancelLimitOrders) Type() string {
+	return TypeMsgBatchCancelLimitOrders
+}
+
+func (msg *MsgBatchCancelLimitOrders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBatchCancelLimitOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgBatchCancelLimitOrders) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.TrancheKeys) > 0 {
+		if msg.PairId != "" || msg.TokenIn != "" {
+			return sdkerrors.Wrapf(ErrInvalidBatchCancel, "tranche keys cannot be combined with a pair ID or token in")
+		}
+
+		trancheKeys := make(map[string]struct{}, len(msg.TrancheKeys))
+		for _, trancheKey := range msg.TrancheKeys {
+			if _, ok := trancheKeys[trancheKey]; ok {
+				return sdkerrors.Wrapf(ErrInvalidBatchCancel, "duplicate tranche key %s", trancheKey)
+			}
+			trancheKeys[trancheKey] = struct{}{}
+		}
+
+		return nil
+	}
+
+	if msg.PairId == "" {
+		return ErrInvalidBatchCancel
+	}
+
+	pairID, err := NewPairIDFromCanonicalString(msg.PairId)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBatchCancel, "invalid pair ID (%s)", err)
+	}
+
+	if msg.TokenIn != "" && msg.TokenIn != pairID.Token0 && msg.TokenIn != pairID.Token1 {
+		return sdkerrors.Wrapf(ErrInvalidBatchCancel, "token in %s is not part of pair %s", msg.TokenIn, msg.PairId)
+	}
+
+	return nil
+}
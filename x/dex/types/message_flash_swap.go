@@ -0,0 +1,73 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+const TypeMsgFlashSwap = "flash_swap"
+
+var _ sdk.Msg = &MsgFlashSwap{}
+
+func NewMsgFlashSwap(
+	creator string,
+	routesArr [][]string,
+	amountIn math.Int,
+	exitLimitPrice math_utils.PrecDec,
+	pickBestRoute bool,
+	callbackData []byte,
+) *MsgFlashSwap {
+	routes := make([]*MultiHopRoute, len(routesArr))
+	for i, hops := range routesArr {
+		routes[i] = &MultiHopRoute{Hops: hops}
+	}
+
+	return &MsgFlashSwap{
+		Creator:        creator,
+		Routes:         routes,
+		AmountIn:       amountIn,
+		ExitLimitPrice: exitLimitPrice,
+		PickBestRoute:  pickBestRoute,
+		CallbackData:   callbackData,
+	}
+}
+
+func (msg *MsgFlashSwap) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFlashSwap) Type() string {
+	return TypeMsgFlashSwap
+}
+
+func (msg *MsgFlashSwap) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgFlashSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgFlashSwap) Validate() error {
+	if err := validateAddress(msg.Creator, "creator"); err != nil {
+		return err
+	}
+	if err := validateRoutes(msg.Routes); err != nil {
+		return err
+	}
+	if err := validateAmountIn(msg.AmountIn); err != nil {
+		return err
+	}
+	if err := validateExitLimitPrice(msg.ExitLimitPrice); err != nil {
+		return err
+	}
+	return nil
+}
@@ -109,6 +109,10 @@ func (msg *MsgPlaceLimitOrder) Validate() error {
 		}
 	}
 
+	if msg.AutoSettle && msg.OrderType.IsTakerOnly() {
+		return ErrAutoSettleTakerOnly
+	}
+
 	if IsTickOutOfRange(msg.TickIndexInToOut) {
 		return ErrTickOutsideRange
 	}
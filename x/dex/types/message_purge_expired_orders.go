@@ -0,0 +1,51 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgPurgeExpiredOrders = "purge-expired-orders"
+
+var _ sdk.Msg = &MsgPurgeExpiredOrders{}
+
+func NewMsgPurgeExpiredOrders(creator string, maxOrders uint64) *MsgPurgeExpiredOrders {
+	return &MsgPurgeExpiredOrders{
+		Creator: creator,
+		Max:     maxOrders,
+	}
+}
+
+func (msg *MsgPurgeExpiredOrders) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPurgeExpiredOrders) Type() string {
+	return TypeMsgPurgeExpiredOrders
+}
+
+func (msg *MsgPurgeExpiredOrders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPurgeExpiredOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgPurgeExpiredOrders) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Max == 0 {
+		return ErrZeroPurgeMax
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeMsgDepositRange           = "deposit_range"
+	TypeMsgWithdrawRangePosition  = "withdraw_range_position"
+	TypeMsgRebalanceRangePosition = "rebalance_range_position"
+)
+
+var (
+	_ sdk.Msg = &MsgDepositRange{}
+	_ sdk.Msg = &MsgWithdrawRangePosition{}
+	_ sdk.Msg = &MsgRebalanceRangePosition{}
+)
+
+func NewMsgDepositRange(
+	creator,
+	receiver,
+	tokenA,
+	tokenB string,
+	amountA,
+	amountB math.Int,
+	lowerTickIndex,
+	upperTickIndex int64,
+	tickSpacing,
+	fee uint64,
+	shape DistributionShape,
+	options *DepositOptions,
+) *MsgDepositRange {
+	return &MsgDepositRange{
+		Creator:            creator,
+		Receiver:           receiver,
+		TokenA:             tokenA,
+		TokenB:             tokenB,
+		AmountA:            amountA,
+		AmountB:            amountB,
+		LowerTickIndexAToB: lowerTickIndex,
+		UpperTickIndexAToB: upperTickIndex,
+		TickSpacing:        tickSpacing,
+		Fee:                fee,
+		DistributionShape:  shape,
+		Options:            options,
+	}
+}
+
+func (msg *MsgDepositRange) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDepositRange) Type() string {
+	return TypeMsgDepositRange
+}
+
+func (msg *MsgDepositRange) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDepositRange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgDepositRange) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	if _, err := NewPairID(msg.TokenA, msg.TokenB); err != nil {
+		return err
+	}
+
+	if msg.AmountA.IsNil() || msg.AmountB.IsNil() || msg.AmountA.IsNegative() || msg.AmountB.IsNegative() {
+		return ErrZeroDeposit
+	}
+
+	if msg.AmountA.IsZero() && msg.AmountB.IsZero() {
+		return ErrZeroDeposit
+	}
+
+	if err := ValidateRange(msg.LowerTickIndexAToB, msg.UpperTickIndexAToB, msg.TickSpacing, msg.Fee); err != nil {
+		return err
+	}
+
+	return ValidateDistributionShape(msg.DistributionShape)
+}
+
+func NewMsgWithdrawRangePosition(creator, receiver string, positionID uint64) *MsgWithdrawRangePosition {
+	return &MsgWithdrawRangePosition{
+		Creator:    creator,
+		Receiver:   receiver,
+		PositionId: positionID,
+	}
+}
+
+func (msg *MsgWithdrawRangePosition) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawRangePosition) Type() string {
+	return TypeMsgWithdrawRangePosition
+}
+
+func (msg *MsgWithdrawRangePosition) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgWithdrawRangePosition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgWithdrawRangePosition) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	return nil
+}
+
+func NewMsgRebalanceRangePosition(
+	creator string,
+	positionID uint64,
+	lowerTickIndex,
+	upperTickIndex int64,
+	tickSpacing uint64,
+	shape DistributionShape,
+	options *DepositOptions,
+) *MsgRebalanceRangePosition {
+	return &MsgRebalanceRangePosition{
+		Creator:           creator,
+		PositionId:        positionID,
+		LowerTickIndex:    lowerTickIndex,
+		UpperTickIndex:    upperTickIndex,
+		TickSpacing:       tickSpacing,
+		DistributionShape: shape,
+		Options:           options,
+	}
+}
+
+func (msg *MsgRebalanceRangePosition) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRebalanceRangePosition) Type() string {
+	return TypeMsgRebalanceRangePosition
+}
+
+func (msg *MsgRebalanceRangePosition) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRebalanceRangePosition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgRebalanceRangePosition) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// the fee of the position is kept, so only the tick bounds are validated here
+	if err := ValidateRange(msg.LowerTickIndex, msg.UpperTickIndex, msg.TickSpacing, 0); err != nil {
+		return err
+	}
+
+	return ValidateDistributionShape(msg.DistributionShape)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+const TypeMsgReplaceLimitOrder = "replace_limit_order"
+
+var _ sdk.Msg = &MsgReplaceLimitOrder{}
+
+func NewMsgReplaceLimitOrder(
+	creator,
+	trancheKey string,
+	amountIn *math.Int,
+	price *math_utils.PrecDec,
+	goodTil *time.Time,
+) *MsgReplaceLimitOrder {
+	return &MsgReplaceLimitOrder{
+		Creator:        creator,
+		TrancheKey:     trancheKey,
+		AmountIn:       amountIn,
+		LimitSellPrice: price,
+		ExpirationTime: goodTil,
+	}
+}
+
+func (msg *MsgReplaceLimitOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReplaceLimitOrder) Type() string {
+	return TypeMsgReplaceLimitOrder
+}
+
+func (msg *MsgReplaceLimitOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReplaceLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgReplaceLimitOrder) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.AmountIn != nil && !msg.AmountIn.IsPositive() {
+		return ErrZeroLimitOrder
+	}
+
+	if msg.LimitSellPrice != nil && IsPriceOutOfRange(*msg.LimitSellPrice) {
+		return ErrPriceOutsideRange
+	}
+
+	return nil
+}
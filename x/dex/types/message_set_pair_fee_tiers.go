@@ -0,0 +1,56 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetPairFeeTiers = "set-pair-fee-tiers"
+
+var _ sdk.Msg = &MsgSetPairFeeTiers{}
+
+func NewMsgSetPairFeeTiers(authority, tokenA, tokenB string, feeTiers []uint64) *MsgSetPairFeeTiers {
+	return &MsgSetPairFeeTiers{
+		Authority: authority,
+		TokenA:    tokenA,
+		TokenB:    tokenB,
+		FeeTiers:  feeTiers,
+	}
+}
+
+func (msg *MsgSetPairFeeTiers) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPairFeeTiers) Type() string {
+	return TypeMsgSetPairFeeTiers
+}
+
+func (msg *MsgSetPairFeeTiers) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetPairFeeTiers) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgSetPairFeeTiers) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if _, err := NewPairID(msg.TokenA, msg.TokenB); err != nil {
+		return err
+	}
+
+	if err := validateFeeTiers(msg.FeeTiers); err != nil {
+		return errorsmod.Wrap(ErrInvalidFee, err.Error())
+	}
+
+	return nil
+}
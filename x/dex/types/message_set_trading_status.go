@@ -0,0 +1,99 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeMsgSetPairTradingStatus  = "set-pair-trading-status"
+	TypeMsgSetDenomTradingStatus = "set-denom-trading-status"
+)
+
+var (
+	_ sdk.Msg = &MsgSetPairTradingStatus{}
+	_ sdk.Msg = &MsgSetDenomTradingStatus{}
+)
+
+func NewMsgSetPairTradingStatus(authority, tokenA, tokenB string, status TradingStatus) *MsgSetPairTradingStatus {
+	return &MsgSetPairTradingStatus{
+		Authority: authority,
+		TokenA:    tokenA,
+		TokenB:    tokenB,
+		Status:    status,
+	}
+}
+
+func (msg *MsgSetPairTradingStatus) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPairTradingStatus) Type() string {
+	return TypeMsgSetPairTradingStatus
+}
+
+func (msg *MsgSetPairTradingStatus) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetPairTradingStatus) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgSetPairTradingStatus) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if _, err := NewPairID(msg.TokenA, msg.TokenB); err != nil {
+		return err
+	}
+
+	return msg.Status.Validate()
+}
+
+func NewMsgSetDenomTradingStatus(authority, denom string, status TradingStatus) *MsgSetDenomTradingStatus {
+	return &MsgSetDenomTradingStatus{
+		Authority: authority,
+		Denom:     denom,
+		Status:    status,
+	}
+}
+
+func (msg *MsgSetDenomTradingStatus) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetDenomTradingStatus) Type() string {
+	return TypeMsgSetDenomTradingStatus
+}
+
+func (msg *MsgSetDenomTradingStatus) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetDenomTradingStatus) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgSetDenomTradingStatus) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return msg.Status.Validate()
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeMsgSubscribeHooks   = "subscribe_hooks"
+	TypeMsgUnsubscribeHooks = "unsubscribe_hooks"
+)
+
+var (
+	_ sdk.Msg = &MsgSubscribeHooks{}
+	_ sdk.Msg = &MsgUnsubscribeHooks{}
+)
+
+func NewMsgSubscribeHooks(creator, tokenA, tokenB string) *MsgSubscribeHooks {
+	return &MsgSubscribeHooks{
+		Creator: creator,
+		TokenA:  tokenA,
+		TokenB:  tokenB,
+	}
+}
+
+func (msg *MsgSubscribeHooks) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubscribeHooks) Type() string {
+	return TypeMsgSubscribeHooks
+}
+
+func (msg *MsgSubscribeHooks) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubscribeHooks) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgSubscribeHooks) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err := NewPairID(msg.TokenA, msg.TokenB)
+	return err
+}
+
+func NewMsgUnsubscribeHooks(creator, tokenA, tokenB string) *MsgUnsubscribeHooks {
+	return &MsgUnsubscribeHooks{
+		Creator: creator,
+		TokenA:  tokenA,
+		TokenB:  tokenB,
+	}
+}
+
+func (msg *MsgUnsubscribeHooks) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnsubscribeHooks) Type() string {
+	return TypeMsgUnsubscribeHooks
+}
+
+func (msg *MsgUnsubscribeHooks) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnsubscribeHooks) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgUnsubscribeHooks) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err := NewPairID(msg.TokenA, msg.TokenB)
+	return err
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeMsgSetTraderFeeTier  = "set-trader-fee-tier"
+	TypeMsgClaimMakerRebates = "claim-maker-rebates"
+)
+
+var (
+	_ sdk.Msg = &MsgSetTraderFeeTier{}
+	_ sdk.Msg = &MsgClaimMakerRebates{}
+)
+
+func NewMsgSetTraderFeeTier(authority, address string, tier uint32, remove bool) *MsgSetTraderFeeTier {
+	return &MsgSetTraderFeeTier{
+		Authority: authority,
+		Address:   address,
+		Tier:      tier,
+		Remove:    remove,
+	}
+}
+
+func (msg *MsgSetTraderFeeTier) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetTraderFeeTier) Type() string {
+	return TypeMsgSetTraderFeeTier
+}
+
+func (msg *MsgSetTraderFeeTier) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetTraderFeeTier) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgSetTraderFeeTier) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid trader address (%s)", err)
+	}
+
+	return nil
+}
+
+func NewMsgClaimMakerRebates(creator string) *MsgClaimMakerRebates {
+	return &MsgClaimMakerRebates{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgClaimMakerRebates) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimMakerRebates) Type() string {
+	return TypeMsgClaimMakerRebates
+}
+
+func (msg *MsgClaimMakerRebates) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgClaimMakerRebates) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgClaimMakerRebates) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
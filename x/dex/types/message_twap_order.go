@@ -0,0 +1,131 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+const (
+	TypeMsgPlaceTWAPOrder    = "place-twap-order"
+	TypeMsgWithdrawTWAPOrder = "withdraw-twap-order"
+)
+
+var (
+	_ sdk.Msg = &MsgPlaceTWAPOrder{}
+	_ sdk.Msg = &MsgWithdrawTWAPOrder{}
+)
+
+func NewMsgPlaceTWAPOrder(
+	creator, tokenIn, tokenOut string,
+	totalIn math.Int,
+	slices, interval uint64,
+	maxPrice math_utils.PrecDec,
+) *MsgPlaceTWAPOrder {
+	return &MsgPlaceTWAPOrder{
+		Creator:  creator,
+		TokenIn:  tokenIn,
+		TokenOut: tokenOut,
+		TotalIn:  totalIn,
+		Slices:   slices,
+		Interval: interval,
+		MaxPrice: maxPrice,
+	}
+}
+
+func (msg *MsgPlaceTWAPOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlaceTWAPOrder) Type() string {
+	return TypeMsgPlaceTWAPOrder
+}
+
+func (msg *MsgPlaceTWAPOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlaceTWAPOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgPlaceTWAPOrder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenIn); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenom, "Error TokenIn denom (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenOut); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenom, "Error TokenOut denom (%s)", err)
+	}
+
+	if msg.TokenIn == msg.TokenOut {
+		return errorsmod.Wrapf(ErrInvalidDenom, "tokenIn cannot equal tokenOut")
+	}
+
+	if msg.Slices == 0 || msg.Slices > MaxTWAPOrderSlices {
+		return errorsmod.Wrapf(ErrInvalidTWAPOrder, "slices must be between 1 and %d", MaxTWAPOrderSlices)
+	}
+
+	if msg.Interval == 0 || msg.Interval > MaxTWAPOrderInterval {
+		return errorsmod.Wrapf(ErrInvalidTWAPOrder, "interval must be between 1 and %d seconds", MaxTWAPOrderInterval)
+	}
+
+	if msg.TotalIn.IsNil() || msg.TotalIn.LT(math.NewIntFromUint64(msg.Slices)) {
+		return errorsmod.Wrap(ErrInvalidTWAPOrder, "total in must be at least one unit per slice")
+	}
+
+	if msg.MaxPrice.IsNil() || !msg.MaxPrice.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidTWAPOrder, "max price must be positive")
+	}
+
+	return nil
+}
+
+func NewMsgWithdrawTWAPOrder(creator string, orderID uint64) *MsgWithdrawTWAPOrder {
+	return &MsgWithdrawTWAPOrder{
+		Creator: creator,
+		OrderId: orderID,
+	}
+}
+
+func (msg *MsgWithdrawTWAPOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawTWAPOrder) Type() string {
+	return TypeMsgWithdrawTWAPOrder
+}
+
+func (msg *MsgWithdrawTWAPOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgWithdrawTWAPOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgWithdrawTWAPOrder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
@@ -3,7 +3,10 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"golang.org/x/exp/slices"
 	"gopkg.in/yaml.v2"
 )
 
@@ -20,6 +23,63 @@ var (
 	DefaultGoodTilPurgeAllowance uint64 = 540_000
 )
 
+var (
+	KeyWhitelistedHookSubscribers     = []byte("WhitelistedHookSubscribers")
+	DefaultWhitelistedHookSubscribers []string
+	KeyHookGasLimit                          = []byte("HookGasLimit")
+	DefaultHookGasLimit               uint64 = 250_000
+)
+
+var (
+	KeyFlashSwapFee                 = []byte("FlashSwapFee")
+	DefaultFlashSwapFee      uint64 = 5
+	KeyMaxFlashSwapDepth            = []byte("MaxFlashSwapDepth")
+	DefaultMaxFlashSwapDepth uint64 = 3
+	MaxFlashSwapFee          uint64 = 10_000
+)
+
+var (
+	KeyCandleIntervals            = []byte("CandleIntervals")
+	DefaultCandleIntervals        = []uint64{60, 3600, 86400}
+	KeyCandleRetention            = []byte("CandleRetention")
+	DefaultCandleRetention uint64 = 1440
+)
+
+var (
+	KeyVolatilityFeeInterval            = []byte("VolatilityFeeInterval")
+	DefaultVolatilityFeeInterval uint64 = 0
+	KeyVolatilityFeeRate                = []byte("VolatilityFeeRate")
+	DefaultVolatilityFeeRate     uint64 = 0
+	KeyMaxVolatilityFee                 = []byte("MaxVolatilityFee")
+	DefaultMaxVolatilityFee      uint64 = 0
+	MaxVolatilityFeeCap          uint64 = 10_000
+)
+
+var (
+	KeyTraderFeeTiers         = []byte("TraderFeeTiers")
+	DefaultTraderFeeTiers     []TraderFeeTier
+	KeyTraderVolumeDenom             = []byte("TraderVolumeDenom")
+	DefaultTraderVolumeDenom         = ""
+	KeyTraderVolumeWindow            = []byte("TraderVolumeWindow")
+	DefaultTraderVolumeWindow uint64 = 30
+	MaxTraderFee              uint64 = 10_000
+)
+
+var (
+	KeyTWAPSlicesPerBlock            = []byte("TWAPSlicesPerBlock")
+	DefaultTWAPSlicesPerBlock uint64 = 100
+)
+
+var (
+	KeyAutoSettlesPerBlock            = []byte("AutoSettlesPerBlock")
+	DefaultAutoSettlesPerBlock uint64 = 100
+)
+
+var (
+	KeyGoodTilExpirationDeposit     = []byte("GoodTilExpirationDeposit")
+	DefaultGoodTilExpirationDeposit sdk.Coins
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -32,6 +92,22 @@ func NewParams(feeTiers []uint64, paused bool, maxJITsPerBlock, goodTilPurgeAllo
 		Paused:                paused,
 		MaxJitsPerBlock:       maxJITsPerBlock,
 		GoodTilPurgeAllowance: goodTilPurgeAllowance,
+		// Hooks are configured separately by governance
+		WhitelistedHookSubscribers: DefaultWhitelistedHookSubscribers,
+		HookGasLimit:               DefaultHookGasLimit,
+		FlashSwapFee:               DefaultFlashSwapFee,
+		MaxFlashSwapDepth:          DefaultMaxFlashSwapDepth,
+		CandleIntervals:            DefaultCandleIntervals,
+		CandleRetention:            DefaultCandleRetention,
+		VolatilityFeeInterval:      DefaultVolatilityFeeInterval,
+		VolatilityFeeRate:          DefaultVolatilityFeeRate,
+		MaxVolatilityFee:           DefaultMaxVolatilityFee,
+		TraderFeeTiers:             DefaultTraderFeeTiers,
+		TraderVolumeDenom:          DefaultTraderVolumeDenom,
+		TraderVolumeWindow:         DefaultTraderVolumeWindow,
+		TwapSlicesPerBlock:         DefaultTWAPSlicesPerBlock,
+		GoodTilExpirationDeposit:   DefaultGoodTilExpirationDeposit,
+		AutoSettlesPerBlock:        DefaultAutoSettlesPerBlock,
 	}
 }
 
@@ -47,6 +123,21 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyPaused, &p.Paused, validatePaused),
 		paramtypes.NewParamSetPair(KeyMaxJITsPerBlock, &p.MaxJitsPerBlock, validateMaxJITsPerBlock),
 		paramtypes.NewParamSetPair(KeyGoodTilPurgeAllowance, &p.GoodTilPurgeAllowance, validatePurgeAllowance),
+		paramtypes.NewParamSetPair(KeyWhitelistedHookSubscribers, &p.WhitelistedHookSubscribers, validateWhitelistedHookSubscribers),
+		paramtypes.NewParamSetPair(KeyHookGasLimit, &p.HookGasLimit, validateHookGasLimit),
+		paramtypes.NewParamSetPair(KeyFlashSwapFee, &p.FlashSwapFee, validateFlashSwapFee),
+		paramtypes.NewParamSetPair(KeyMaxFlashSwapDepth, &p.MaxFlashSwapDepth, validateMaxFlashSwapDepth),
+		paramtypes.NewParamSetPair(KeyCandleIntervals, &p.CandleIntervals, validateCandleIntervals),
+		paramtypes.NewParamSetPair(KeyCandleRetention, &p.CandleRetention, validateCandleRetention),
+		paramtypes.NewParamSetPair(KeyVolatilityFeeInterval, &p.VolatilityFeeInterval, validateVolatilityFeeInterval),
+		paramtypes.NewParamSetPair(KeyVolatilityFeeRate, &p.VolatilityFeeRate, validateVolatilityFeeRate),
+		paramtypes.NewParamSetPair(KeyMaxVolatilityFee, &p.MaxVolatilityFee, validateMaxVolatilityFee),
+		paramtypes.NewParamSetPair(KeyTraderFeeTiers, &p.TraderFeeTiers, validateTraderFeeTiers),
+		paramtypes.NewParamSetPair(KeyTraderVolumeDenom, &p.TraderVolumeDenom, validateTraderVolumeDenom),
+		paramtypes.NewParamSetPair(KeyTraderVolumeWindow, &p.TraderVolumeWindow, validateTraderVolumeWindow),
+		paramtypes.NewParamSetPair(KeyTWAPSlicesPerBlock, &p.TwapSlicesPerBlock, validateTWAPSlicesPerBlock),
+		paramtypes.NewParamSetPair(KeyGoodTilExpirationDeposit, &p.GoodTilExpirationDeposit, validateGoodTilExpirationDeposit),
+		paramtypes.NewParamSetPair(KeyAutoSettlesPerBlock, &p.AutoSettlesPerBlock, validateAutoSettlesPerBlock),
 	}
 }
 
@@ -73,6 +164,63 @@ func (p Params) Validate() error {
 	if err := validatePurgeAllowance(p.GoodTilPurgeAllowance); err != nil {
 		return err
 	}
+	if err := validateWhitelistedHookSubscribers(p.WhitelistedHookSubscribers); err != nil {
+		return fmt.Errorf("invalid whitelisted hook subscribers: %w", err)
+	}
+	if err := validateHookGasLimit(p.HookGasLimit); err != nil {
+		return err
+	}
+	if len(p.WhitelistedHookSubscribers) > 0 && p.HookGasLimit == 0 {
+		return fmt.Errorf("hook gas limit must be positive when hook subscribers are whitelisted")
+	}
+	if err := validateFlashSwapFee(p.FlashSwapFee); err != nil {
+		return fmt.Errorf("invalid flash swap fee: %w", err)
+	}
+	if err := validateMaxFlashSwapDepth(p.MaxFlashSwapDepth); err != nil {
+		return err
+	}
+	if err := validateCandleIntervals(p.CandleIntervals); err != nil {
+		return fmt.Errorf("invalid candle intervals: %w", err)
+	}
+	if err := validateCandleRetention(p.CandleRetention); err != nil {
+		return err
+	}
+	if len(p.CandleIntervals) > 0 && p.CandleRetention == 0 {
+		return fmt.Errorf("candle retention must be positive when candle intervals are set")
+	}
+	if err := validateVolatilityFeeInterval(p.VolatilityFeeInterval); err != nil {
+		return err
+	}
+	if err := validateVolatilityFeeRate(p.VolatilityFeeRate); err != nil {
+		return err
+	}
+	if err := validateMaxVolatilityFee(p.MaxVolatilityFee); err != nil {
+		return fmt.Errorf("invalid max volatility fee: %w", err)
+	}
+	if p.VolatilityFeeInterval != 0 && !slices.Contains(p.CandleIntervals, p.VolatilityFeeInterval) {
+		return fmt.Errorf("volatility fee interval %d is not a candle interval", p.VolatilityFeeInterval)
+	}
+	if err := validateTraderFeeTiers(p.TraderFeeTiers); err != nil {
+		return fmt.Errorf("invalid trader fee tiers: %w", err)
+	}
+	if err := validateTraderVolumeDenom(p.TraderVolumeDenom); err != nil {
+		return fmt.Errorf("invalid trader volume denom: %w", err)
+	}
+	if err := validateTraderVolumeWindow(p.TraderVolumeWindow); err != nil {
+		return err
+	}
+	if p.TraderVolumeDenom != "" && p.TraderVolumeWindow == 0 {
+		return fmt.Errorf("trader volume window must be positive when a trader volume denom is set")
+	}
+	if err := validateTWAPSlicesPerBlock(p.TwapSlicesPerBlock); err != nil {
+		return err
+	}
+	if err := validateGoodTilExpirationDeposit(p.GoodTilExpirationDeposit); err != nil {
+		return fmt.Errorf("invalid good til expiration deposit: %w", err)
+	}
+	if err := validateAutoSettlesPerBlock(p.AutoSettlesPerBlock); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -118,3 +266,213 @@ func validatePurgeAllowance(v interface{}) error {
 
 	return nil
 }
+
+func validateWhitelistedHookSubscribers(v interface{}) error {
+	subscribers, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	subscriberMap := make(map[string]bool)
+	for _, s := range subscribers {
+		if _, err := sdk.AccAddressFromBech32(s); err != nil {
+			return fmt.Errorf("invalid subscriber address %s: %w", s, err)
+		}
+		if _, ok := subscriberMap[s]; ok {
+			return fmt.Errorf("duplicate subscriber found")
+		}
+		subscriberMap[s] = true
+	}
+	return nil
+}
+
+func validateHookGasLimit(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateFlashSwapFee(v interface{}) error {
+	fee, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if fee > MaxFlashSwapFee {
+		return fmt.Errorf("fee %d exceeds %d basis points", fee, MaxFlashSwapFee)
+	}
+
+	return nil
+}
+
+func validateMaxFlashSwapDepth(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateCandleIntervals(v interface{}) error {
+	intervals, ok := v.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	intervalMap := make(map[uint64]bool)
+	for _, i := range intervals {
+		if i == 0 {
+			return fmt.Errorf("candle interval must be positive")
+		}
+		if _, ok := intervalMap[i]; ok {
+			return fmt.Errorf("duplicate candle interval found")
+		}
+		intervalMap[i] = true
+	}
+	return nil
+}
+
+func validateCandleRetention(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateVolatilityFeeInterval(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateVolatilityFeeRate(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateMaxVolatilityFee(v interface{}) error {
+	fee, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if fee > MaxVolatilityFeeCap {
+		return fmt.Errorf("fee %d exceeds %d basis points", fee, MaxVolatilityFeeCap)
+	}
+
+	return nil
+}
+
+func validateTraderFeeTiers(v interface{}) error {
+	tiers, ok := v.([]TraderFeeTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	for i, tier := range tiers {
+		if tier.MinVolume.IsNil() || tier.MinVolume.IsNegative() {
+			return fmt.Errorf("min volume of tier %d must not be negative", i)
+		}
+		if i == 0 && !tier.MinVolume.IsZero() {
+			return fmt.Errorf("min volume of the first tier must be zero")
+		}
+		if i > 0 && tier.MinVolume.LTE(tiers[i-1].MinVolume) {
+			return fmt.Errorf("min volumes must be strictly increasing")
+		}
+		if tier.TakerFee > MaxTraderFee {
+			return fmt.Errorf("taker fee %d exceeds %d basis points", tier.TakerFee, MaxTraderFee)
+		}
+	}
+
+	// Rebates are paid out of the surcharges, so no maker may earn more than the cheapest taker pays
+	for i, tier := range tiers {
+		for _, other := range tiers {
+			if tier.MakerRebate > other.TakerFee {
+				return fmt.Errorf("maker rebate of tier %d exceeds the taker fee %d", i, other.TakerFee)
+			}
+		}
+	}
+	return nil
+}
+
+func validateTraderVolumeDenom(v interface{}) error {
+	denom, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if denom == "" {
+		return nil
+	}
+	return sdk.ValidateDenom(denom)
+}
+
+func validateTraderVolumeWindow(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateTWAPSlicesPerBlock(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateAutoSettlesPerBlock(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateGoodTilExpirationDeposit(v interface{}) error {
+	deposit, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return deposit.Validate()
+}
+
+// TraderFeeTierForVolume returns the index of the highest trader fee tier whose min volume is covered by volume
+func (p Params) TraderFeeTierForVolume(volume math.Int) uint32 {
+	var tier uint32
+	for i, t := range p.TraderFeeTiers {
+		if volume.GTE(t.MinVolume) {
+			tier = uint32(i)
+		}
+	}
+	return tier
+}
+
+// IsWhitelistedHookSubscriber returns true if the contract is allowed to subscribe to dex hooks
+func (p Params) IsWhitelistedHookSubscriber(contractAddr string) bool {
+	for _, s := range p.WhitelistedHookSubscribers {
+		if s == contractAddr {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -29,6 +31,39 @@ type Params struct {
 	Paused                bool     `protobuf:"varint,3,opt,name=paused,proto3" json:"paused"`
 	MaxJitsPerBlock       uint64   `protobuf:"varint,4,opt,name=max_jits_per_block,json=maxJitsPerBlock,proto3" json:"max_jits_per_block,omitempty"`
 	GoodTilPurgeAllowance uint64   `protobuf:"varint,5,opt,name=good_til_purge_allowance,json=goodTilPurgeAllowance,proto3" json:"good_til_purge_allowance,omitempty"`
+	// Contracts that are allowed to subscribe to dex hooks
+	WhitelistedHookSubscribers []string `protobuf:"bytes,6,rep,name=whitelisted_hook_subscribers,json=whitelistedHookSubscribers,proto3" json:"whitelisted_hook_subscribers,omitempty"`
+	// Gas limit for each sudo call made to a contract subscribed to dex hooks or receiving an auto-settled limit order
+	HookGasLimit uint64 `protobuf:"varint,7,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty"`
+	// Fee charged on the input amount of flash swaps, in basis points
+	FlashSwapFee uint64 `protobuf:"varint,8,opt,name=flash_swap_fee,json=flashSwapFee,proto3" json:"flash_swap_fee,omitempty"`
+	// Maximum number of flash swaps that can be nested in each other's callbacks. Flash swaps are disabled if 0
+	MaxFlashSwapDepth uint64 `protobuf:"varint,9,opt,name=max_flash_swap_depth,json=maxFlashSwapDepth,proto3" json:"max_flash_swap_depth,omitempty"`
+	// Lengths in seconds of the candles aggregated for every traded pair
+	CandleIntervals []uint64 `protobuf:"varint,10,rep,packed,name=candle_intervals,json=candleIntervals,proto3" json:"candle_intervals,omitempty"`
+	// Number of candles kept for each pair and interval
+	CandleRetention uint64 `protobuf:"varint,11,opt,name=candle_retention,json=candleRetention,proto3" json:"candle_retention,omitempty"`
+	// Candle interval used to measure the recent price range of a pair for the volatility fee.
+	// The volatility fee is disabled if 0
+	VolatilityFeeInterval uint64 `protobuf:"varint,12,opt,name=volatility_fee_interval,json=volatilityFeeInterval,proto3" json:"volatility_fee_interval,omitempty"`
+	// Volatility fee charged on swaps against pools, in basis points per 100 ticks of the recent price range
+	VolatilityFeeRate uint64 `protobuf:"varint,13,opt,name=volatility_fee_rate,json=volatilityFeeRate,proto3" json:"volatility_fee_rate,omitempty"`
+	// Maximum volatility fee, in basis points
+	MaxVolatilityFee uint64 `protobuf:"varint,14,opt,name=max_volatility_fee,json=maxVolatilityFee,proto3" json:"max_volatility_fee,omitempty"`
+	// Taker fee and maker rebate schedule by trader volume. Taker fees and maker rebates are disabled if empty
+	TraderFeeTiers []TraderFeeTier `protobuf:"bytes,15,rep,name=trader_fee_tiers,json=traderFeeTiers,proto3" json:"trader_fee_tiers"`
+	// Denom in which trader volume is measured. Only trades involving this denom count towards the volume
+	TraderVolumeDenom string `protobuf:"bytes,16,opt,name=trader_volume_denom,json=traderVolumeDenom,proto3" json:"trader_volume_denom,omitempty"`
+	// Number of days over which trader volume is summed
+	TraderVolumeWindow uint64 `protobuf:"varint,17,opt,name=trader_volume_window,json=traderVolumeWindow,proto3" json:"trader_volume_window,omitempty"`
+	// Maximum number of TWAP order slices executed at the end of a block. Slices that do not fit are delayed
+	TwapSlicesPerBlock uint64 `protobuf:"varint,18,opt,name=twap_slices_per_block,json=twapSlicesPerBlock,proto3" json:"twap_slices_per_block,omitempty"`
+	// Deposit collected for every GOOD_TIL_TIME limit order. It is paid as a bounty to the caller of
+	// MsgPurgeExpiredOrders that purges the order once it has expired. No deposit is collected if empty
+	GoodTilExpirationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,19,rep,name=good_til_expiration_deposit,json=goodTilExpirationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"good_til_expiration_deposit"`
+	// Maximum number of auto-settled limit orders settled at the end of a block. Orders that do not fit are settled in
+	// the next blocks
+	AutoSettlesPerBlock uint64 `protobuf:"varint,20,opt,name=auto_settles_per_block,json=autoSettlesPerBlock,proto3" json:"auto_settles_per_block,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -91,6 +126,111 @@ func (m *Params) GetGoodTilPurgeAllowance() uint64 {
 	return 0
 }
 
+func (m *Params) GetWhitelistedHookSubscribers() []string {
+	if m != nil {
+		return m.WhitelistedHookSubscribers
+	}
+	return nil
+}
+
+func (m *Params) GetHookGasLimit() uint64 {
+	if m != nil {
+		return m.HookGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetFlashSwapFee() uint64 {
+	if m != nil {
+		return m.FlashSwapFee
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFlashSwapDepth() uint64 {
+	if m != nil {
+		return m.MaxFlashSwapDepth
+	}
+	return 0
+}
+
+func (m *Params) GetCandleIntervals() []uint64 {
+	if m != nil {
+		return m.CandleIntervals
+	}
+	return nil
+}
+
+func (m *Params) GetCandleRetention() uint64 {
+	if m != nil {
+		return m.CandleRetention
+	}
+	return 0
+}
+
+func (m *Params) GetVolatilityFeeInterval() uint64 {
+	if m != nil {
+		return m.VolatilityFeeInterval
+	}
+	return 0
+}
+
+func (m *Params) GetVolatilityFeeRate() uint64 {
+	if m != nil {
+		return m.VolatilityFeeRate
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVolatilityFee() uint64 {
+	if m != nil {
+		return m.MaxVolatilityFee
+	}
+	return 0
+}
+
+func (m *Params) GetTraderFeeTiers() []TraderFeeTier {
+	if m != nil {
+		return m.TraderFeeTiers
+	}
+	return nil
+}
+
+func (m *Params) GetTraderVolumeDenom() string {
+	if m != nil {
+		return m.TraderVolumeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetTraderVolumeWindow() uint64 {
+	if m != nil {
+		return m.TraderVolumeWindow
+	}
+	return 0
+}
+
+func (m *Params) GetTwapSlicesPerBlock() uint64 {
+	if m != nil {
+		return m.TwapSlicesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetGoodTilExpirationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.GoodTilExpirationDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetAutoSettlesPerBlock() uint64 {
+	if m != nil {
+		return m.AutoSettlesPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "neutron.dex.Params")
 }
@@ -98,26 +238,53 @@ func init() {
 func init() { proto.RegisterFile("neutron/dex/params.proto", fileDescriptor_84a6bffcfc21009c) }
 
 var fileDescriptor_84a6bffcfc21009c = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0x31, 0x4b, 0x03, 0x31,
-	0x14, 0xc7, 0x2f, 0xf4, 0x2c, 0x35, 0x0e, 0x42, 0x50, 0x08, 0x0a, 0x69, 0xe9, 0x54, 0x90, 0x36,
-	0x83, 0x88, 0xe0, 0x66, 0x17, 0xc1, 0xa9, 0x94, 0x4e, 0x2e, 0x21, 0xed, 0xbd, 0xc6, 0xe8, 0x5d,
-	0x13, 0x92, 0x9c, 0x9e, 0xdf, 0xc2, 0xd1, 0xd1, 0x2f, 0xe1, 0x77, 0x70, 0xec, 0xe8, 0x24, 0x72,
-	0xb7, 0xf9, 0x29, 0xe4, 0xce, 0xeb, 0xf4, 0xfe, 0xef, 0xff, 0x7b, 0xff, 0xe1, 0xfd, 0x31, 0xdd,
-	0x40, 0x1e, 0x9c, 0xd9, 0xf0, 0x04, 0x0a, 0x6e, 0xa5, 0x93, 0x99, 0x9f, 0x58, 0x67, 0x82, 0x21,
-	0x07, 0x2d, 0x99, 0x24, 0x50, 0x9c, 0x1c, 0x29, 0xa3, 0x4c, 0xe3, 0xf3, 0x5a, 0xfd, 0x9f, 0x0c,
-	0x3f, 0x10, 0xee, 0xce, 0x9a, 0x0c, 0x39, 0xc5, 0xfb, 0x6b, 0x00, 0x11, 0x34, 0x38, 0x4f, 0xd1,
-	0xa0, 0x33, 0x8a, 0xe7, 0xbd, 0x35, 0xc0, 0xa2, 0xde, 0xc9, 0x10, 0x77, 0xad, 0xcc, 0x3d, 0x24,
-	0xb4, 0x33, 0x40, 0xa3, 0xde, 0x14, 0xff, 0x7e, 0xf7, 0x5b, 0x67, 0xde, 0x4e, 0x72, 0x86, 0x49,
-	0x26, 0x0b, 0xf1, 0xa0, 0x83, 0x17, 0x16, 0x9c, 0x58, 0xa6, 0x66, 0xf5, 0x48, 0xe3, 0x01, 0x1a,
-	0xc5, 0xf3, 0xc3, 0x4c, 0x16, 0xb7, 0x3a, 0xf8, 0x19, 0xb8, 0x69, 0x6d, 0x93, 0x4b, 0x4c, 0x95,
-	0x31, 0x89, 0x08, 0x3a, 0x15, 0x36, 0x77, 0x0a, 0x84, 0x4c, 0x53, 0xf3, 0x2c, 0x37, 0x2b, 0xa0,
-	0x7b, 0x4d, 0xe4, 0xb8, 0xe6, 0x0b, 0x9d, 0xce, 0x6a, 0x7a, 0xbd, 0x83, 0x57, 0xf1, 0xdb, 0x7b,
-	0x3f, 0x9a, 0xde, 0x7c, 0x96, 0x0c, 0x6d, 0x4b, 0x86, 0x7e, 0x4a, 0x86, 0x5e, 0x2b, 0x16, 0x6d,
-	0x2b, 0x16, 0x7d, 0x55, 0x2c, 0xba, 0x1b, 0x2b, 0x1d, 0xee, 0xf3, 0xe5, 0x64, 0x65, 0x32, 0xde,
-	0xfe, 0x3f, 0x36, 0x4e, 0xed, 0x34, 0x7f, 0xba, 0xe0, 0x45, 0x53, 0x55, 0x78, 0xb1, 0xe0, 0x97,
-	0xdd, 0xa6, 0x87, 0xf3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc6, 0xda, 0x12, 0xf5, 0x46, 0x01,
-	0x00, 0x00,
+	// 724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x54, 0x4f, 0x73, 0xe3, 0x34,
+	0x14, 0x8f, 0x27, 0x21, 0x24, 0xea, 0xd2, 0xa6, 0x6a, 0x16, 0xb4, 0x59, 0x26, 0xc9, 0xec, 0x70,
+	0x08, 0x03, 0xb5, 0xb7, 0xec, 0x00, 0x33, 0x9c, 0x20, 0x94, 0x2c, 0xec, 0x70, 0xe8, 0x38, 0x9d,
+	0x32, 0xc3, 0x45, 0x23, 0xdb, 0x2f, 0x89, 0x88, 0x6d, 0x79, 0x24, 0x25, 0x71, 0xcf, 0xdc, 0x38,
+	0x71, 0xe4, 0xc8, 0x99, 0x4f, 0xd2, 0x63, 0x8f, 0x9c, 0x0a, 0xd3, 0xde, 0xf8, 0x14, 0x8c, 0x64,
+	0x27, 0x75, 0xf6, 0x64, 0xf9, 0xf7, 0x47, 0xef, 0x3d, 0xe9, 0x3d, 0x21, 0x92, 0xc2, 0x4a, 0x4b,
+	0x91, 0x7a, 0x11, 0xe4, 0x5e, 0xc6, 0x24, 0x4b, 0x94, 0x9b, 0x49, 0xa1, 0x05, 0x3e, 0x28, 0x19,
+	0x37, 0x82, 0xbc, 0xd7, 0x0f, 0x85, 0x4a, 0x84, 0xf2, 0x02, 0xa6, 0xc0, 0x5b, 0x9f, 0x05, 0xa0,
+	0xd9, 0x99, 0x17, 0x0a, 0x9e, 0x16, 0xe2, 0x5e, 0x77, 0x2e, 0xe6, 0xc2, 0x2e, 0x3d, 0xb3, 0x2a,
+	0xd1, 0x67, 0xd5, 0xcd, 0x25, 0x04, 0x4c, 0x43, 0xb9, 0xfb, 0x8b, 0x5f, 0x5b, 0xa8, 0x79, 0x61,
+	0xc3, 0xe1, 0xe7, 0xa8, 0x3d, 0x03, 0xa0, 0x9a, 0x83, 0x54, 0xc4, 0x19, 0xd6, 0x47, 0x0d, 0xbf,
+	0x35, 0x03, 0xb8, 0x34, 0xff, 0xf8, 0x05, 0x6a, 0x66, 0x6c, 0xa5, 0x20, 0x22, 0xf5, 0xa1, 0x33,
+	0x6a, 0x8d, 0xd1, 0x7f, 0x77, 0x83, 0x12, 0xf1, 0xcb, 0x2f, 0xfe, 0x04, 0xe1, 0x84, 0xe5, 0xf4,
+	0x17, 0xae, 0x15, 0xcd, 0x40, 0xd2, 0x20, 0x16, 0xe1, 0x92, 0x34, 0x86, 0xce, 0xa8, 0xe1, 0x1f,
+	0x25, 0x2c, 0x7f, 0xc3, 0xb5, 0xba, 0x00, 0x39, 0x36, 0x30, 0xfe, 0x12, 0x91, 0xb9, 0x10, 0x11,
+	0xd5, 0x3c, 0xa6, 0xd9, 0x4a, 0xce, 0x81, 0xb2, 0x38, 0x16, 0x1b, 0x96, 0x86, 0x40, 0xde, 0xb1,
+	0x96, 0xa7, 0x86, 0xbf, 0xe4, 0xf1, 0x85, 0x61, 0xbf, 0xd9, 0x92, 0xf8, 0x6b, 0xf4, 0xe1, 0x66,
+	0xc1, 0x35, 0xc4, 0x5c, 0x69, 0x88, 0xe8, 0x42, 0x88, 0x25, 0x55, 0xab, 0x40, 0x85, 0x92, 0x07,
+	0x26, 0xf3, 0xe6, 0xb0, 0x3e, 0x6a, 0xfb, 0xbd, 0x8a, 0xe6, 0x7b, 0x21, 0x96, 0xd3, 0x47, 0x05,
+	0xfe, 0x08, 0x1d, 0x5a, 0xd7, 0x9c, 0x29, 0x1a, 0xf3, 0x84, 0x6b, 0xf2, 0xae, 0x0d, 0xf8, 0xc4,
+	0xa0, 0xaf, 0x99, 0xfa, 0xd1, 0x60, 0x46, 0x35, 0x8b, 0x99, 0x5a, 0x50, 0xb5, 0x61, 0x19, 0x9d,
+	0x01, 0x90, 0x56, 0xa1, 0xb2, 0xe8, 0x74, 0xc3, 0xb2, 0x09, 0x00, 0xf6, 0x50, 0xd7, 0xd4, 0x5c,
+	0x51, 0x46, 0x90, 0xe9, 0x05, 0x69, 0x5b, 0xed, 0x71, 0xc2, 0xf2, 0xc9, 0x56, 0x7e, 0x6e, 0x08,
+	0xfc, 0x31, 0xea, 0x84, 0x2c, 0x8d, 0x62, 0xa0, 0x3c, 0xd5, 0x20, 0xd7, 0x2c, 0x56, 0x04, 0xd9,
+	0xc3, 0x3e, 0x2a, 0xf0, 0x1f, 0xb6, 0x70, 0x45, 0x2a, 0x41, 0x43, 0xaa, 0xb9, 0x48, 0xc9, 0x41,
+	0x71, 0x9a, 0x05, 0xee, 0x6f, 0x61, 0xfc, 0x05, 0xfa, 0x60, 0x2d, 0x62, 0xa6, 0x79, 0xcc, 0xf5,
+	0xb5, 0x49, 0x76, 0xb7, 0x3b, 0x79, 0x52, 0x1c, 0xe6, 0x23, 0x3d, 0x81, 0x5d, 0x0c, 0xec, 0xa2,
+	0x93, 0xb7, 0x7c, 0x92, 0x69, 0x20, 0xef, 0x15, 0xd9, 0xef, 0x79, 0x7c, 0xa6, 0x01, 0x7f, 0x5a,
+	0x5c, 0xf1, 0xbe, 0x87, 0x1c, 0x5a, 0x79, 0x27, 0x61, 0xf9, 0x55, 0xd5, 0x81, 0xdf, 0xa0, 0x8e,
+	0x96, 0x2c, 0x02, 0x49, 0x1f, 0x1b, 0xeb, 0x68, 0x58, 0x1f, 0x1d, 0x7c, 0xd6, 0x73, 0x2b, 0x5d,
+	0xed, 0x5e, 0x5a, 0xd1, 0xa4, 0xe8, 0xb5, 0x71, 0xe3, 0xe6, 0x6e, 0x50, 0xf3, 0x0f, 0x75, 0x15,
+	0x54, 0x26, 0xd3, 0x72, 0xaf, 0xb5, 0x88, 0x57, 0x09, 0xd0, 0x08, 0x52, 0x91, 0x90, 0xce, 0xd0,
+	0x19, 0xb5, 0xfd, 0xe3, 0x82, 0xba, 0xb2, 0xcc, 0xb9, 0x21, 0xf0, 0x4b, 0xd4, 0xdd, 0xd7, 0x6f,
+	0x78, 0x1a, 0x89, 0x0d, 0x39, 0xb6, 0xb9, 0xe2, 0xaa, 0xe1, 0x27, 0xcb, 0xe0, 0x33, 0xf4, 0x54,
+	0x9b, 0x0b, 0x54, 0x31, 0x0f, 0xa1, 0xda, 0xc1, 0xb8, 0xb4, 0x6c, 0x58, 0x36, 0xb5, 0xdc, 0xae,
+	0x89, 0x7f, 0x73, 0xd0, 0xf3, 0x5d, 0x17, 0x43, 0x9e, 0x71, 0xc9, 0xcc, 0x75, 0x98, 0x1e, 0x10,
+	0x8a, 0x6b, 0x72, 0x62, 0x8b, 0x7d, 0xe6, 0x16, 0x53, 0xeb, 0x9a, 0xa9, 0x75, 0xcb, 0xa9, 0x75,
+	0xbf, 0x15, 0x3c, 0x1d, 0xbf, 0x34, 0xb5, 0xfe, 0xf5, 0xcf, 0x60, 0x34, 0xe7, 0x7a, 0xb1, 0x0a,
+	0xdc, 0x50, 0x24, 0x5e, 0x39, 0xe2, 0xc5, 0xe7, 0x54, 0x45, 0x4b, 0x4f, 0x5f, 0x67, 0xa0, 0xac,
+	0x41, 0xf9, 0xa4, 0x9c, 0x8a, 0xef, 0x76, 0xd1, 0xce, 0x8b, 0x60, 0xf8, 0x15, 0x7a, 0x9f, 0xad,
+	0xb4, 0xa0, 0x0a, 0xb4, 0x8e, 0xf7, 0x0a, 0xe8, 0xda, 0x02, 0x4e, 0x0c, 0x3b, 0x2d, 0xc8, 0x6d,
+	0x05, 0x5f, 0x35, 0xfe, 0xf8, 0x73, 0x50, 0x1b, 0xbf, 0xbe, 0xb9, 0xef, 0x3b, 0xb7, 0xf7, 0x7d,
+	0xe7, 0xdf, 0xfb, 0xbe, 0xf3, 0xfb, 0x43, 0xbf, 0x76, 0xfb, 0xd0, 0xaf, 0xfd, 0xfd, 0xd0, 0xaf,
+	0xfd, 0x7c, 0x5a, 0x49, 0xac, 0xbc, 0xb2, 0x53, 0x21, 0xe7, 0xdb, 0xb5, 0xb7, 0xfe, 0xdc, 0xcb,
+	0xed, 0xb3, 0x62, 0x73, 0x0c, 0x9a, 0xf6, 0x55, 0x79, 0xf5, 0xff, 0x00, 0x8b, 0xa7, 0xb8, 0xe1,
+	0xcf, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -140,6 +307,128 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoSettlesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoSettlesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.GoodTilExpirationDeposit) > 0 {
+		for iNdEx := len(m.GoodTilExpirationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GoodTilExpirationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.TwapSlicesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TwapSlicesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.TraderVolumeWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TraderVolumeWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.TraderVolumeDenom) > 0 {
+		i -= len(m.TraderVolumeDenom)
+		copy(dAtA[i:], m.TraderVolumeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TraderVolumeDenom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.TraderFeeTiers) > 0 {
+		for iNdEx := len(m.TraderFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderFeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.MaxVolatilityFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxVolatilityFee))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.VolatilityFeeRate != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VolatilityFeeRate))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.VolatilityFeeInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VolatilityFeeInterval))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CandleRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CandleRetention))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.CandleIntervals) > 0 {
+		dAtA2 := make([]byte, len(m.CandleIntervals)*10)
+		var j1 int
+		for _, num := range m.CandleIntervals {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaxFlashSwapDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFlashSwapDepth))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FlashSwapFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FlashSwapFee))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HookGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HookGasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.WhitelistedHookSubscribers) > 0 {
+		for iNdEx := len(m.WhitelistedHookSubscribers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WhitelistedHookSubscribers[iNdEx])
+			copy(dAtA[i:], m.WhitelistedHookSubscribers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.WhitelistedHookSubscribers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.GoodTilPurgeAllowance != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.GoodTilPurgeAllowance))
 		i--
@@ -161,20 +450,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.FeeTiers) > 0 {
-		dAtA2 := make([]byte, len(m.FeeTiers)*10)
-		var j1 int
+		dAtA4 := make([]byte, len(m.FeeTiers)*10)
+		var j3 int
 		for _, num := range m.FeeTiers {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j3++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintParams(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -214,6 +503,65 @@ func (m *Params) Size() (n int) {
 	if m.GoodTilPurgeAllowance != 0 {
 		n += 1 + sovParams(uint64(m.GoodTilPurgeAllowance))
 	}
+	if len(m.WhitelistedHookSubscribers) > 0 {
+		for _, s := range m.WhitelistedHookSubscribers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.HookGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.HookGasLimit))
+	}
+	if m.FlashSwapFee != 0 {
+		n += 1 + sovParams(uint64(m.FlashSwapFee))
+	}
+	if m.MaxFlashSwapDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxFlashSwapDepth))
+	}
+	if len(m.CandleIntervals) > 0 {
+		l = 0
+		for _, e := range m.CandleIntervals {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if m.CandleRetention != 0 {
+		n += 1 + sovParams(uint64(m.CandleRetention))
+	}
+	if m.VolatilityFeeInterval != 0 {
+		n += 1 + sovParams(uint64(m.VolatilityFeeInterval))
+	}
+	if m.VolatilityFeeRate != 0 {
+		n += 1 + sovParams(uint64(m.VolatilityFeeRate))
+	}
+	if m.MaxVolatilityFee != 0 {
+		n += 1 + sovParams(uint64(m.MaxVolatilityFee))
+	}
+	if len(m.TraderFeeTiers) > 0 {
+		for _, e := range m.TraderFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.TraderVolumeDenom)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.TraderVolumeWindow != 0 {
+		n += 2 + sovParams(uint64(m.TraderVolumeWindow))
+	}
+	if m.TwapSlicesPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.TwapSlicesPerBlock))
+	}
+	if len(m.GoodTilExpirationDeposit) > 0 {
+		for _, e := range m.GoodTilExpirationDeposit {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AutoSettlesPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.AutoSettlesPerBlock))
+	}
 	return n
 }
 
@@ -386,6 +734,404 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedHookSubscribers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedHookSubscribers = append(m.WhitelistedHookSubscribers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookGasLimit", wireType)
+			}
+			m.HookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashSwapFee", wireType)
+			}
+			m.FlashSwapFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlashSwapFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFlashSwapDepth", wireType)
+			}
+			m.MaxFlashSwapDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFlashSwapDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CandleIntervals = append(m.CandleIntervals, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CandleIntervals) == 0 {
+					m.CandleIntervals = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CandleIntervals = append(m.CandleIntervals, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleIntervals", wireType)
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleRetention", wireType)
+			}
+			m.CandleRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CandleRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityFeeInterval", wireType)
+			}
+			m.VolatilityFeeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VolatilityFeeInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityFeeRate", wireType)
+			}
+			m.VolatilityFeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VolatilityFeeRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVolatilityFee", wireType)
+			}
+			m.MaxVolatilityFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVolatilityFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderFeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderFeeTiers = append(m.TraderFeeTiers, TraderFeeTier{})
+			if err := m.TraderFeeTiers[len(m.TraderFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderVolumeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderVolumeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderVolumeWindow", wireType)
+			}
+			m.TraderVolumeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TraderVolumeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapSlicesPerBlock", wireType)
+			}
+			m.TwapSlicesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapSlicesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilExpirationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoodTilExpirationDeposit = append(m.GoodTilExpirationDeposit, types.Coin{})
+			if err := m.GoodTilExpirationDeposit[len(m.GoodTilExpirationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettlesPerBlock", wireType)
+			}
+			m.AutoSettlesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoSettlesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -9,19 +9,54 @@ import (
 type PoolLiquidity struct {
 	TradePairID *TradePairID
 	Pool        *Pool
+	// VolatilityFee is charged on top of the pool fee in basis points of the taker amount and is paid to the pool
+	VolatilityFee uint64
 }
 
 func (pl *PoolLiquidity) Swap(
 	maxAmountTakerDenomIn math.Int,
 	maxAmountMakerDenomOut *math.Int,
 ) (inAmount, outAmount math.Int) {
-	return pl.Pool.Swap(
+	if pl.VolatilityFee == 0 {
+		return pl.Pool.Swap(
+			pl.TradePairID,
+			maxAmountTakerDenomIn,
+			maxAmountMakerDenomOut,
+		)
+	}
+
+	// Leave room in maxAmountTakerDenomIn for the volatility fee
+	denominator := math.NewIntFromUint64(MaxVolatilityFeeCap)
+	maxAmountSwapped := maxAmountTakerDenomIn.Mul(denominator).Quo(denominator.Add(math.NewIntFromUint64(pl.VolatilityFee)))
+	inAmount, outAmount = pl.Pool.Swap(
 		pl.TradePairID,
-		maxAmountTakerDenomIn,
+		maxAmountSwapped,
 		maxAmountMakerDenomOut,
 	)
+
+	volatilityFee := CalcVolatilityFee(inAmount, pl.VolatilityFee)
+	takerReserves := pl.Pool.LowerTick0
+	if pl.TradePairID.IsMakerDenomToken0() {
+		takerReserves = pl.Pool.UpperTick1
+	}
+	takerReserves.ReservesMakerDenom = takerReserves.ReservesMakerDenom.Add(volatilityFee)
+
+	return inAmount.Add(volatilityFee), outAmount
 }
 
 func (pl *PoolLiquidity) Price() math_utils.PrecDec {
-	return pl.Pool.Price(pl.TradePairID)
+	price := pl.Pool.Price(pl.TradePairID)
+	if pl.VolatilityFee == 0 {
+		return price
+	}
+
+	feeMultiplier := math_utils.NewPrecDecFromInt(math.NewIntFromUint64(MaxVolatilityFeeCap + pl.VolatilityFee)).
+		QuoInt(math.NewIntFromUint64(MaxVolatilityFeeCap))
+	return price.Mul(feeMultiplier)
+}
+
+// CalcVolatilityFee returns the volatility fee owed on a swapped amount, rounded up
+func CalcVolatilityFee(amount math.Int, fee uint64) math.Int {
+	denominator := math.NewIntFromUint64(MaxVolatilityFeeCap)
+	return amount.Mul(math.NewIntFromUint64(fee)).Add(denominator).SubRaw(1).Quo(denominator)
 }
@@ -0,0 +1,1821 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/position_value.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_neutron_org_neutron_v5_utils_math "github.com/neutron-org/neutron/v5/utils/math"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolDepositBasis is the cost basis of the pool shares an address received from deposits into a pool
+type PoolDepositBasis struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PoolId  uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Shares issued by deposits that have not been withdrawn yet
+	Shares cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.Int" json:"shares" yaml:"shares"`
+	// Amounts of token0 and token1 deposited for the shares
+	Amount0 cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount0,proto3,customtype=cosmossdk.io/math.Int" json:"amount0" yaml:"amount0"`
+	Amount1 cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount1,proto3,customtype=cosmossdk.io/math.Int" json:"amount1" yaml:"amount1"`
+	// Price of token1 in terms of token0 at the time of the deposits
+	DepositPrice github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,6,opt,name=deposit_price,json=depositPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"deposit_price" yaml:"deposit_price"`
+}
+
+func (m *PoolDepositBasis) Reset()         { *m = PoolDepositBasis{} }
+func (m *PoolDepositBasis) String() string { return proto.CompactTextString(m) }
+func (*PoolDepositBasis) ProtoMessage()    {}
+func (*PoolDepositBasis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac6d0d8184014d77, []int{0}
+}
+func (m *PoolDepositBasis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDepositBasis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDepositBasis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolDepositBasis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDepositBasis.Merge(m, src)
+}
+func (m *PoolDepositBasis) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDepositBasis) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDepositBasis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolDepositBasis proto.InternalMessageInfo
+
+func (m *PoolDepositBasis) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PoolDepositBasis) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// PoolPositionValue is the value of the pool shares held by an address. Values are denominated in token0.
+type PoolPositionValue struct {
+	PairId          *PairID               `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	CenterTickIndex int64                 `protobuf:"varint,2,opt,name=center_tick_index,json=centerTickIndex,proto3" json:"center_tick_index,omitempty"`
+	Fee             uint64                `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	SharesOwned     cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=shares_owned,json=sharesOwned,proto3,customtype=cosmossdk.io/math.Int" json:"shares_owned" yaml:"shares_owned"`
+	// Shares that were not issued to the address by a deposit, ie. received by a transfer. They have no cost basis.
+	UntrackedShares cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=untracked_shares,json=untrackedShares,proto3,customtype=cosmossdk.io/math.Int" json:"untracked_shares" yaml:"untracked_shares"`
+	// Amounts of token0 and token1 the shares currently redeem for
+	CurrentAmount0 cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=current_amount0,json=currentAmount0,proto3,customtype=cosmossdk.io/math.Int" json:"current_amount0" yaml:"current_amount0"`
+	CurrentAmount1 cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=current_amount1,json=currentAmount1,proto3,customtype=cosmossdk.io/math.Int" json:"current_amount1" yaml:"current_amount1"`
+	// Amounts of token0 and token1 deposited for the tracked shares
+	DepositAmount0 cosmossdk_io_math.Int                                `protobuf:"bytes,8,opt,name=deposit_amount0,json=depositAmount0,proto3,customtype=cosmossdk.io/math.Int" json:"deposit_amount0" yaml:"deposit_amount0"`
+	DepositAmount1 cosmossdk_io_math.Int                                `protobuf:"bytes,9,opt,name=deposit_amount1,json=depositAmount1,proto3,customtype=cosmossdk.io/math.Int" json:"deposit_amount1" yaml:"deposit_amount1"`
+	DepositPrice   github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,10,opt,name=deposit_price,json=depositPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"deposit_price" yaml:"deposit_price"`
+	// Value of the deposits of the tracked shares at the deposit price
+	DepositValue github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,11,opt,name=deposit_value,json=depositValue,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"deposit_value" yaml:"deposit_value"`
+	// Value of all shares at the pool price
+	CurrentValue github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,12,opt,name=current_value,json=currentValue,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"current_value" yaml:"current_value"`
+	// Swap fees accrued by the tracked shares since they were deposited
+	FeesEarned github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,13,opt,name=fees_earned,json=feesEarned,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"fees_earned" yaml:"fees_earned"`
+}
+
+func (m *PoolPositionValue) Reset()         { *m = PoolPositionValue{} }
+func (m *PoolPositionValue) String() string { return proto.CompactTextString(m) }
+func (*PoolPositionValue) ProtoMessage()    {}
+func (*PoolPositionValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac6d0d8184014d77, []int{1}
+}
+func (m *PoolPositionValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolPositionValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolPositionValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolPositionValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPositionValue.Merge(m, src)
+}
+func (m *PoolPositionValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolPositionValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPositionValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolPositionValue proto.InternalMessageInfo
+
+func (m *PoolPositionValue) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *PoolPositionValue) GetCenterTickIndex() int64 {
+	if m != nil {
+		return m.CenterTickIndex
+	}
+	return 0
+}
+
+func (m *PoolPositionValue) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+// LimitOrderPositionValue is the value of the part of a limit order that has not been withdrawn yet.
+// Values are denominated in the maker denom.
+type LimitOrderPositionValue struct {
+	TradePairId           *TradePairID `protobuf:"bytes,1,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	TickIndexTakerToMaker int64        `protobuf:"varint,2,opt,name=tick_index_taker_to_maker,json=tickIndexTakerToMaker,proto3" json:"tick_index_taker_to_maker,omitempty"`
+	TrancheKey            string       `protobuf:"bytes,3,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	// Unfilled amount of the order that can be cancelled
+	CurrentMakerAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=current_maker_amount,json=currentMakerAmount,proto3,customtype=cosmossdk.io/math.Int" json:"current_maker_amount" yaml:"current_maker_amount"`
+	// Filled amount of the order that can be withdrawn
+	CurrentTakerAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=current_taker_amount,json=currentTakerAmount,proto3,customtype=cosmossdk.io/math.Int" json:"current_taker_amount" yaml:"current_taker_amount"`
+	// Price of the maker denom in terms of the taker denom the order was placed at
+	DepositPrice github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,6,opt,name=deposit_price,json=depositPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"deposit_price" yaml:"deposit_price"`
+	// Amount placed that has not been withdrawn yet
+	DepositValue github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,7,opt,name=deposit_value,json=depositValue,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"deposit_value" yaml:"deposit_value"`
+	// Value of the unfilled and filled amounts at the order price
+	CurrentValue github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,8,opt,name=current_value,json=currentValue,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"current_value" yaml:"current_value"`
+}
+
+func (m *LimitOrderPositionValue) Reset()         { *m = LimitOrderPositionValue{} }
+func (m *LimitOrderPositionValue) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderPositionValue) ProtoMessage()    {}
+func (*LimitOrderPositionValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac6d0d8184014d77, []int{2}
+}
+func (m *LimitOrderPositionValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderPositionValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderPositionValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderPositionValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderPositionValue.Merge(m, src)
+}
+func (m *LimitOrderPositionValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderPositionValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderPositionValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderPositionValue proto.InternalMessageInfo
+
+func (m *LimitOrderPositionValue) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *LimitOrderPositionValue) GetTickIndexTakerToMaker() int64 {
+	if m != nil {
+		return m.TickIndexTakerToMaker
+	}
+	return 0
+}
+
+func (m *LimitOrderPositionValue) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PoolDepositBasis)(nil), "neutron.dex.PoolDepositBasis")
+	proto.RegisterType((*PoolPositionValue)(nil), "neutron.dex.PoolPositionValue")
+	proto.RegisterType((*LimitOrderPositionValue)(nil), "neutron.dex.LimitOrderPositionValue")
+}
+
+func init() { proto.RegisterFile("neutron/dex/position_value.proto", fileDescriptor_ac6d0d8184014d77) }
+
+var fileDescriptor_ac6d0d8184014d77 = []byte{
+	// 856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x3d, 0x6f, 0xdb, 0x46,
+	0x18, 0x16, 0x2b, 0x47, 0x4a, 0x4e, 0x92, 0xed, 0x5c, 0x9c, 0x9a, 0x49, 0x01, 0xd1, 0xe0, 0x14,
+	0x14, 0x8d, 0x54, 0xf6, 0x03, 0x08, 0x82, 0x2e, 0x35, 0x54, 0x14, 0x6a, 0x53, 0x44, 0xb8, 0x0a,
+	0x1d, 0xba, 0x10, 0x0c, 0xef, 0x2c, 0x1d, 0x24, 0xf1, 0x84, 0xe3, 0xc9, 0x96, 0xd6, 0x0e, 0x1d,
+	0x3a, 0xf5, 0x67, 0x79, 0xf4, 0x58, 0x74, 0x20, 0x5a, 0x7b, 0x73, 0x37, 0xfe, 0x82, 0xe2, 0x78,
+	0x47, 0x8b, 0xa4, 0xe5, 0xaa, 0x76, 0x0c, 0xd8, 0x93, 0xf8, 0x7e, 0xdc, 0xf3, 0x3c, 0xe2, 0xfb,
+	0x71, 0x04, 0x7b, 0x01, 0x99, 0x09, 0xce, 0x82, 0x36, 0x26, 0xf3, 0xf6, 0x94, 0x85, 0x54, 0x50,
+	0x16, 0xb8, 0x87, 0xde, 0x78, 0x46, 0x5a, 0x53, 0xce, 0x04, 0x83, 0x35, 0x9d, 0xd1, 0xc2, 0x64,
+	0xfe, 0x7c, 0x67, 0xc0, 0x06, 0x2c, 0xf1, 0xb7, 0xe5, 0x93, 0x4a, 0x79, 0xfe, 0x2c, 0x07, 0xe2,
+	0x51, 0xee, 0x52, 0xac, 0x43, 0x56, 0x36, 0x24, 0xb8, 0x87, 0x89, 0x9b, 0x4b, 0xb0, 0x4f, 0xca,
+	0x60, 0xbb, 0xc7, 0xd8, 0xb8, 0x43, 0x12, 0xf6, 0x7d, 0x2f, 0xa4, 0x21, 0x34, 0x41, 0xd5, 0xc3,
+	0x98, 0x93, 0x30, 0x34, 0x8d, 0x3d, 0xe3, 0xc5, 0x23, 0x94, 0x9a, 0x70, 0x17, 0x54, 0xa7, 0x8c,
+	0x8d, 0x5d, 0x8a, 0xcd, 0x0f, 0xf6, 0x8c, 0x17, 0x1b, 0xa8, 0x22, 0xcd, 0x2e, 0x86, 0x3d, 0x50,
+	0x09, 0x87, 0x1e, 0x27, 0xa1, 0x59, 0x96, 0x27, 0xf6, 0x5f, 0x1d, 0x47, 0x56, 0xe9, 0xcf, 0xc8,
+	0x7a, 0xea, 0xb3, 0x70, 0xc2, 0xc2, 0x10, 0x8f, 0x5a, 0x94, 0xb5, 0x27, 0x9e, 0x18, 0xb6, 0xba,
+	0x81, 0x38, 0x8f, 0x2c, 0x9d, 0x1e, 0x47, 0x56, 0x63, 0xe1, 0x4d, 0xc6, 0xaf, 0x6d, 0x65, 0xdb,
+	0x48, 0x07, 0x60, 0x1f, 0x54, 0xbd, 0x09, 0x9b, 0x05, 0xe2, 0x53, 0x73, 0x23, 0x81, 0x7c, 0xbd,
+	0x0e, 0x32, 0xcd, 0x8f, 0x23, 0x6b, 0x53, 0x61, 0x6a, 0x87, 0x8d, 0xd2, 0xd0, 0x12, 0xd5, 0x31,
+	0x1f, 0x5c, 0x0b, 0xd5, 0x29, 0xa2, 0x3a, 0x17, 0xa8, 0x0e, 0xfc, 0xcd, 0x00, 0x0d, 0xac, 0xde,
+	0xa0, 0x3b, 0xe5, 0xd4, 0x27, 0x66, 0x25, 0x01, 0x27, 0x1a, 0xfc, 0x8b, 0x01, 0x15, 0xc3, 0xd9,
+	0xbb, 0x96, 0xcf, 0x26, 0x6d, 0x5d, 0x91, 0x97, 0x8c, 0x0f, 0xd2, 0xe7, 0xf6, 0xe1, 0x97, 0xed,
+	0x99, 0xa0, 0xe3, 0x50, 0xf1, 0xf6, 0x38, 0xf1, 0x3b, 0xc4, 0x3f, 0x8f, 0xac, 0x3c, 0x68, 0x1c,
+	0x59, 0x3b, 0x4a, 0x41, 0xce, 0x6d, 0xa3, 0xba, 0xb6, 0x7b, 0x89, 0xf9, 0x37, 0x00, 0x8f, 0x65,
+	0x49, 0x7b, 0xba, 0x9d, 0x7e, 0x92, 0xdd, 0x04, 0x3f, 0x01, 0x55, 0x5d, 0xf9, 0xa4, 0xa6, 0xb5,
+	0xcf, 0x9e, 0xb4, 0x32, 0x9d, 0xd5, 0xea, 0x79, 0x94, 0x77, 0x3b, 0xa8, 0x22, 0x73, 0xba, 0x18,
+	0x7e, 0x0c, 0x1e, 0xfb, 0x24, 0x10, 0x84, 0xbb, 0x82, 0xfa, 0x23, 0x97, 0x06, 0x98, 0xcc, 0x93,
+	0x8a, 0x97, 0xd1, 0x96, 0x0a, 0xf4, 0xa9, 0x3f, 0xea, 0x4a, 0x37, 0xdc, 0x06, 0xe5, 0x03, 0x42,
+	0x92, 0xba, 0x6f, 0x20, 0xf9, 0x08, 0x07, 0xa0, 0xae, 0x8a, 0xe8, 0xb2, 0xa3, 0x80, 0x60, 0x5d,
+	0xbf, 0xce, 0xba, 0x37, 0x9d, 0x3b, 0x14, 0x47, 0xd6, 0x93, 0x6c, 0x63, 0x28, 0xaf, 0x8d, 0x6a,
+	0xca, 0x7c, 0x2b, 0x2d, 0x78, 0x04, 0xb6, 0x67, 0x81, 0xe0, 0x9e, 0x3f, 0x22, 0xd8, 0xd5, 0xfd,
+	0xa7, 0xca, 0xfa, 0x66, 0x1d, 0xd9, 0xa5, 0x83, 0x71, 0x64, 0xed, 0x2a, 0xc2, 0x62, 0xc4, 0x46,
+	0x5b, 0x17, 0xae, 0x1f, 0x55, 0x73, 0x86, 0x60, 0xcb, 0x9f, 0x71, 0x4e, 0x02, 0xe1, 0xa6, 0x4d,
+	0xaa, 0x2a, 0xfe, 0xdd, 0x3a, 0xde, 0xe2, 0xb9, 0x38, 0xb2, 0x3e, 0x54, 0xb4, 0x85, 0x80, 0x8d,
+	0x36, 0xb5, 0xe7, 0x6b, 0xdd, 0xbb, 0x97, 0x48, 0x1d, 0xb3, 0x7a, 0x23, 0x52, 0xe7, 0x2a, 0x52,
+	0xa7, 0x48, 0xea, 0x48, 0xd2, 0xb4, 0xdb, 0xd2, 0x7f, 0xfa, 0xf0, 0x7f, 0x92, 0x16, 0xce, 0x2d,
+	0x49, 0x0b, 0x01, 0x1b, 0x6d, 0x6a, 0x4f, 0xe6, 0x9f, 0xe6, 0x73, 0x1c, 0xf3, 0xd1, 0x8d, 0x48,
+	0x9d, 0xab, 0x48, 0x9d, 0x22, 0xe9, 0xaa, 0x21, 0x06, 0x77, 0x36, 0xc4, 0x39, 0x31, 0xc9, 0x75,
+	0x60, 0xd6, 0x6e, 0x57, 0x4c, 0x02, 0x7a, 0x59, 0x4c, 0xe2, 0x5e, 0x8a, 0x51, 0xbb, 0x43, 0x8a,
+	0x49, 0x1b, 0x45, 0x89, 0xa9, 0xdf, 0x8e, 0x98, 0x1c, 0xe8, 0x52, 0x4c, 0xce, 0x6d, 0xa3, 0xba,
+	0xb6, 0x95, 0x98, 0x5f, 0x0c, 0x50, 0x3b, 0x20, 0x24, 0x74, 0x89, 0xc7, 0xe5, 0x72, 0x69, 0x24,
+	0x52, 0xbc, 0xf7, 0x94, 0x92, 0x85, 0x8c, 0x23, 0x0b, 0x2a, 0x21, 0x19, 0xa7, 0x8d, 0x80, 0xb4,
+	0xbe, 0x51, 0xc6, 0x3f, 0x15, 0xb0, 0xfb, 0x86, 0x4e, 0xa8, 0x78, 0xcb, 0x31, 0xe1, 0xf9, 0x4d,
+	0xfb, 0x15, 0x68, 0xe4, 0x6e, 0x5a, 0xbd, 0x6f, 0xcd, 0xdc, 0xbe, 0xed, 0xcb, 0x0c, 0xbd, 0x74,
+	0x6b, 0xe2, 0xc2, 0xc0, 0xf0, 0x15, 0x78, 0xb6, 0x5c, 0xb9, 0xae, 0xf0, 0x46, 0x72, 0x07, 0x33,
+	0x77, 0x22, 0x1f, 0xf4, 0x06, 0x7e, 0x2a, 0xd2, 0xdd, 0xdb, 0x97, 0xde, 0x3e, 0xfb, 0x41, 0xfe,
+	0x40, 0x0b, 0x48, 0xa0, 0xc0, 0x1f, 0x12, 0x77, 0x44, 0x16, 0xea, 0x1e, 0x46, 0x40, 0xbb, 0xbe,
+	0x27, 0x0b, 0xf8, 0xab, 0x01, 0x76, 0xd2, 0x57, 0x9b, 0xe0, 0xe9, 0x59, 0xd0, 0xfb, 0xb9, 0xbf,
+	0x6e, 0xb6, 0x56, 0x1e, 0x8e, 0x23, 0xeb, 0xa3, 0x7c, 0xd5, 0xb2, 0x51, 0x1b, 0x41, 0xed, 0x4e,
+	0x34, 0xaa, 0x51, 0xcb, 0x09, 0x11, 0x59, 0x21, 0x0f, 0xae, 0x29, 0x44, 0xfc, 0xa7, 0x10, 0xb1,
+	0x52, 0x48, 0x3f, 0x23, 0xe4, 0x3e, 0xdd, 0xdb, 0x2b, 0x46, 0xbe, 0x7a, 0x9f, 0x46, 0xfe, 0xe1,
+	0x9d, 0x8d, 0xfc, 0xfe, 0xb7, 0xc7, 0xa7, 0x4d, 0xe3, 0xe4, 0xb4, 0x69, 0xfc, 0x75, 0xda, 0x34,
+	0x7e, 0x3f, 0x6b, 0x96, 0x4e, 0xce, 0x9a, 0xa5, 0x3f, 0xce, 0x9a, 0xa5, 0x9f, 0x5f, 0xae, 0x97,
+	0x31, 0x57, 0xdf, 0xbe, 0x8b, 0x29, 0x09, 0xdf, 0x55, 0x92, 0x8f, 0xde, 0xcf, 0xff, 0x1d, 0x00,
+	0xb8, 0x9e, 0xfe, 0xd9, 0x77, 0x0b, 0x00, 0x00,
+}
+
+func (m *PoolDepositBasis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDepositBasis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDepositBasis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DepositPrice.Size()
+		i -= size
+		if _, err := m.DepositPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintPositionValue(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPositionValue(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolPositionValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolPositionValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPositionValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeesEarned.Size()
+		i -= size
+		if _, err := m.FeesEarned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.CurrentValue.Size()
+		i -= size
+		if _, err := m.CurrentValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.DepositValue.Size()
+		i -= size
+		if _, err := m.DepositValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.DepositPrice.Size()
+		i -= size
+		if _, err := m.DepositPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.DepositAmount1.Size()
+		i -= size
+		if _, err := m.DepositAmount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.DepositAmount0.Size()
+		i -= size
+		if _, err := m.DepositAmount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CurrentAmount1.Size()
+		i -= size
+		if _, err := m.CurrentAmount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CurrentAmount0.Size()
+		i -= size
+		if _, err := m.CurrentAmount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.UntrackedShares.Size()
+		i -= size
+		if _, err := m.UntrackedShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SharesOwned.Size()
+		i -= size
+		if _, err := m.SharesOwned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Fee != 0 {
+		i = encodeVarintPositionValue(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CenterTickIndex != 0 {
+		i = encodeVarintPositionValue(dAtA, i, uint64(m.CenterTickIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPositionValue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderPositionValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderPositionValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderPositionValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentValue.Size()
+		i -= size
+		if _, err := m.CurrentValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DepositValue.Size()
+		i -= size
+		if _, err := m.DepositValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DepositPrice.Size()
+		i -= size
+		if _, err := m.DepositPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CurrentTakerAmount.Size()
+		i -= size
+		if _, err := m.CurrentTakerAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CurrentMakerAmount.Size()
+		i -= size
+		if _, err := m.CurrentMakerAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPositionValue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintPositionValue(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TickIndexTakerToMaker != 0 {
+		i = encodeVarintPositionValue(dAtA, i, uint64(m.TickIndexTakerToMaker))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPositionValue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPositionValue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPositionValue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolDepositBasis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPositionValue(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovPositionValue(uint64(m.PoolId))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.Amount0.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.DepositPrice.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	return n
+}
+
+func (m *PoolPositionValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovPositionValue(uint64(l))
+	}
+	if m.CenterTickIndex != 0 {
+		n += 1 + sovPositionValue(uint64(m.CenterTickIndex))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovPositionValue(uint64(m.Fee))
+	}
+	l = m.SharesOwned.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.UntrackedShares.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.CurrentAmount0.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.CurrentAmount1.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.DepositAmount0.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.DepositAmount1.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.DepositPrice.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.DepositValue.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.CurrentValue.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.FeesEarned.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	return n
+}
+
+func (m *LimitOrderPositionValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovPositionValue(uint64(l))
+	}
+	if m.TickIndexTakerToMaker != 0 {
+		n += 1 + sovPositionValue(uint64(m.TickIndexTakerToMaker))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovPositionValue(uint64(l))
+	}
+	l = m.CurrentMakerAmount.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.CurrentTakerAmount.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.DepositPrice.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.DepositValue.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	l = m.CurrentValue.Size()
+	n += 1 + l + sovPositionValue(uint64(l))
+	return n
+}
+
+func sovPositionValue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPositionValue(x uint64) (n int) {
+	return sovPositionValue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolDepositBasis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPositionValue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolDepositBasis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolDepositBasis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPositionValue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolPositionValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPositionValue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolPositionValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolPositionValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CenterTickIndex", wireType)
+			}
+			m.CenterTickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CenterTickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesOwned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesOwned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntrackedShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UntrackedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentAmount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentAmount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAmount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositAmount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAmount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositAmount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesEarned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeesEarned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPositionValue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrderPositionValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPositionValue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderPositionValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderPositionValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexTakerToMaker", wireType)
+			}
+			m.TickIndexTakerToMaker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexTakerToMaker |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMakerAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentMakerAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTakerAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentTakerAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPositionValue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPositionValue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPositionValue(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPositionValue
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPositionValue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPositionValue
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPositionValue
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPositionValue
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPositionValue        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPositionValue          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPositionValue = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,112 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+const (
+	// MaxRangePositionTicks is the maximum number of pools a single range position can be spread over
+	MaxRangePositionTicks = 100
+	// RangePositionCustodianName is used to derive the address holding the pool shares of range positions
+	RangePositionCustodianName = ModuleName + "/range_positions"
+)
+
+// ValidateRange checks that a range of pools is within the valid tick bounds and does not span more than
+// MaxRangePositionTicks pools
+func ValidateRange(lowerTickIndex, upperTickIndex int64, tickSpacing, fee uint64) error {
+	if err := ValidateTickFee(lowerTickIndex, fee); err != nil {
+		return err
+	}
+
+	if err := ValidateTickFee(upperTickIndex, fee); err != nil {
+		return err
+	}
+
+	if lowerTickIndex > upperTickIndex {
+		return sdkerrors.Wrapf(ErrInvalidRangePosition, "lower tick %d is greater than upper tick %d", lowerTickIndex, upperTickIndex)
+	}
+
+	if tickSpacing == 0 {
+		return sdkerrors.Wrap(ErrInvalidRangePosition, "tick spacing must be positive")
+	}
+
+	width := uint64(upperTickIndex - lowerTickIndex) //nolint:gosec
+	if width%tickSpacing != 0 {
+		return sdkerrors.Wrapf(ErrInvalidRangePosition, "range width %d is not a multiple of tick spacing %d", width, tickSpacing)
+	}
+
+	if width/tickSpacing+1 > MaxRangePositionTicks {
+		return sdkerrors.Wrapf(ErrInvalidRangePosition, "range cannot span more than %d ticks", MaxRangePositionTicks)
+	}
+
+	return nil
+}
+
+// ValidateDistributionShape checks that the shape is a known DistributionShape
+func ValidateDistributionShape(shape DistributionShape) error {
+	if _, ok := DistributionShape_name[int32(shape)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidRangePosition, "unknown distribution shape %d", shape)
+	}
+
+	return nil
+}
+
+// RangeTickIndexes returns the center tick of every pool in a range. The range must have been validated with ValidateRange.
+func RangeTickIndexes(lowerTickIndex, upperTickIndex int64, tickSpacing uint64) []int64 {
+	spacing := int64(tickSpacing) //nolint:gosec
+	tickIndexes := make([]int64, 0, (upperTickIndex-lowerTickIndex)/spacing+1)
+	for tick := lowerTickIndex; tick <= upperTickIndex; tick += spacing {
+		tickIndexes = append(tickIndexes, tick)
+	}
+
+	return tickIndexes
+}
+
+// DistributionWeights returns the relative weight of each of the n ticks of a range for the shape.
+// GAUSSIAN uses binomial coefficients rather than floating point math so that results are deterministic.
+func DistributionWeights(shape DistributionShape, n int) []math.Int {
+	weights := make([]math.Int, n)
+	switch shape {
+	case DistributionShape_LINEAR:
+		for i := range weights {
+			weights[i] = math.NewInt(int64(min(i+1, n-i)))
+		}
+	case DistributionShape_GAUSSIAN:
+		// C(n-1, i) computed iteratively as C(n-1, i+1) = C(n-1, i) * (n-1-i) / (i+1)
+		weight := math.OneInt()
+		for i := range weights {
+			weights[i] = weight
+			weight = weight.MulRaw(int64(n - 1 - i)).QuoRaw(int64(i + 1))
+		}
+	default:
+		for i := range weights {
+			weights[i] = math.OneInt()
+		}
+	}
+
+	return weights
+}
+
+// SplitAmount divides amount proportionally to weights. Rounding dust is added to the first tick with the highest weight
+// so that the parts always sum to amount.
+func SplitAmount(amount math.Int, weights []math.Int) []math.Int {
+	totalWeight := math.ZeroInt()
+	maxIdx := 0
+	for i, w := range weights {
+		totalWeight = totalWeight.Add(w)
+		if w.GT(weights[maxIdx]) {
+			maxIdx = i
+		}
+	}
+
+	parts := make([]math.Int, len(weights))
+	remaining := amount
+	for i, w := range weights {
+		parts[i] = amount.Mul(w).Quo(totalWeight)
+		remaining = remaining.Sub(parts[i])
+	}
+	parts[maxIdx] = parts[maxIdx].Add(remaining)
+
+	return parts
+}
@@ -0,0 +1,705 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/range_position.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DistributionShape defines how liquidity is spread over the ticks of a range position
+type DistributionShape int32
+
+const (
+	// Equal amounts at every tick
+	DistributionShape_UNIFORM DistributionShape = 0
+	// Amounts increase linearly towards the middle of the range
+	DistributionShape_LINEAR DistributionShape = 1
+	// Amounts follow a binomial approximation of a normal distribution centered on the middle of the range
+	DistributionShape_GAUSSIAN DistributionShape = 2
+)
+
+var DistributionShape_name = map[int32]string{
+	0: "UNIFORM",
+	1: "LINEAR",
+	2: "GAUSSIAN",
+}
+
+var DistributionShape_value = map[string]int32{
+	"UNIFORM":  0,
+	"LINEAR":   1,
+	"GAUSSIAN": 2,
+}
+
+func (x DistributionShape) String() string {
+	return proto.EnumName(DistributionShape_name, int32(x))
+}
+
+func (DistributionShape) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_da0aa08e1845eccd, []int{0}
+}
+
+// RangePosition is a group of pool deposits spread over a tick range that is managed as a single unit.
+// The pool shares are held by the dex module on behalf of the owner.
+type RangePosition struct {
+	Id     uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner  string  `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	PairId *PairID `protobuf:"bytes,3,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Lowest pool center tick in terms of token0
+	LowerTickIndex int64 `protobuf:"varint,4,opt,name=lower_tick_index,json=lowerTickIndex,proto3" json:"lower_tick_index,omitempty"`
+	// Highest pool center tick in terms of token0
+	UpperTickIndex int64                                    `protobuf:"varint,5,opt,name=upper_tick_index,json=upperTickIndex,proto3" json:"upper_tick_index,omitempty"`
+	TickSpacing    uint64                                   `protobuf:"varint,6,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty"`
+	Fee            uint64                                   `protobuf:"varint,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	Shape          DistributionShape                        `protobuf:"varint,8,opt,name=shape,proto3,enum=neutron.dex.DistributionShape" json:"shape,omitempty"`
+	Shares         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=shares,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shares"`
+}
+
+func (m *RangePosition) Reset()         { *m = RangePosition{} }
+func (m *RangePosition) String() string { return proto.CompactTextString(m) }
+func (*RangePosition) ProtoMessage()    {}
+func (*RangePosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da0aa08e1845eccd, []int{0}
+}
+func (m *RangePosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangePosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangePosition.Merge(m, src)
+}
+func (m *RangePosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangePosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangePosition proto.InternalMessageInfo
+
+func (m *RangePosition) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RangePosition) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *RangePosition) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *RangePosition) GetLowerTickIndex() int64 {
+	if m != nil {
+		return m.LowerTickIndex
+	}
+	return 0
+}
+
+func (m *RangePosition) GetUpperTickIndex() int64 {
+	if m != nil {
+		return m.UpperTickIndex
+	}
+	return 0
+}
+
+func (m *RangePosition) GetTickSpacing() uint64 {
+	if m != nil {
+		return m.TickSpacing
+	}
+	return 0
+}
+
+func (m *RangePosition) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *RangePosition) GetShape() DistributionShape {
+	if m != nil {
+		return m.Shape
+	}
+	return DistributionShape_UNIFORM
+}
+
+func (m *RangePosition) GetShares() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("neutron.dex.DistributionShape", DistributionShape_name, DistributionShape_value)
+	proto.RegisterType((*RangePosition)(nil), "neutron.dex.RangePosition")
+}
+
+func init() { proto.RegisterFile("neutron/dex/range_position.proto", fileDescriptor_da0aa08e1845eccd) }
+
+var fileDescriptor_da0aa08e1845eccd = []byte{
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4d, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc6, 0xe3, 0x66, 0x4d, 0x37, 0x67, 0x54, 0xc1, 0xec, 0x90, 0xed, 0x90, 0x05, 0x4e, 0x11,
+	0xa2, 0x31, 0x2b, 0x70, 0xe1, 0xd6, 0x31, 0x98, 0x22, 0x41, 0x99, 0x5c, 0x76, 0xe1, 0x52, 0xe5,
+	0xc5, 0xa4, 0x56, 0x59, 0x1c, 0xd9, 0xe9, 0x56, 0x3e, 0x01, 0x57, 0x3e, 0x07, 0x9f, 0x64, 0xc7,
+	0x1d, 0x39, 0x01, 0x6a, 0xbf, 0x08, 0xb2, 0x63, 0x44, 0x2b, 0x4e, 0x79, 0xf2, 0xf8, 0x17, 0xff,
+	0x9f, 0xf8, 0x31, 0x0c, 0x2b, 0xba, 0x68, 0x04, 0xaf, 0x70, 0x41, 0x97, 0x58, 0xa4, 0x55, 0x49,
+	0xa7, 0x35, 0x97, 0xac, 0x61, 0xbc, 0x8a, 0x6b, 0xc1, 0x1b, 0x8e, 0x5c, 0x43, 0xc4, 0x05, 0x5d,
+	0x1e, 0x05, 0x39, 0x97, 0x57, 0x5c, 0xe2, 0x2c, 0x95, 0x14, 0x5f, 0x9f, 0x64, 0xb4, 0x49, 0x4f,
+	0x70, 0xce, 0x99, 0x81, 0x8f, 0x0e, 0x4a, 0x5e, 0x72, 0x2d, 0xb1, 0x52, 0xc6, 0x3d, 0xdc, 0x1c,
+	0x52, 0xa7, 0x4c, 0x4c, 0x59, 0xd1, 0x2e, 0x3d, 0xfa, 0x6a, 0xc3, 0x7b, 0x44, 0x8d, 0xbd, 0x30,
+	0x53, 0x51, 0x1f, 0x76, 0x58, 0xe1, 0x83, 0x10, 0x44, 0x3b, 0xa4, 0xc3, 0x0a, 0x74, 0x00, 0xbb,
+	0xfc, 0xa6, 0xa2, 0xc2, 0xef, 0x84, 0x20, 0xda, 0x23, 0xed, 0x0b, 0x7a, 0x02, 0x7b, 0x66, 0x23,
+	0xdf, 0x0e, 0x41, 0xe4, 0x0e, 0x1f, 0xc4, 0x1b, 0x39, 0xe3, 0x8b, 0x94, 0x89, 0xe4, 0x8c, 0x38,
+	0x8a, 0x49, 0x0a, 0x14, 0x41, 0xef, 0x33, 0xbf, 0xa1, 0x62, 0xda, 0xb0, 0x7c, 0x3e, 0x65, 0x55,
+	0x41, 0x97, 0xfe, 0x4e, 0x08, 0x22, 0x9b, 0xf4, 0xb5, 0xff, 0x81, 0xe5, 0xf3, 0x44, 0xb9, 0x8a,
+	0x5c, 0xd4, 0xf5, 0x36, 0xd9, 0x6d, 0x49, 0xed, 0xff, 0x23, 0x1f, 0xc2, 0x7d, 0xcd, 0xc8, 0x3a,
+	0xcd, 0x59, 0x55, 0xfa, 0x8e, 0x4e, 0xec, 0x2a, 0x6f, 0xd2, 0x5a, 0xc8, 0x83, 0xf6, 0x27, 0x4a,
+	0xfd, 0x9e, 0x5e, 0x51, 0x12, 0x3d, 0x87, 0x5d, 0x39, 0x4b, 0x6b, 0xea, 0xef, 0x86, 0x20, 0xea,
+	0x0f, 0x83, 0xad, 0xd0, 0x67, 0x4c, 0x36, 0x82, 0x65, 0x0b, 0x75, 0x0c, 0x13, 0x45, 0x91, 0x16,
+	0x46, 0x39, 0x74, 0xe4, 0x2c, 0x15, 0x54, 0xfa, 0x7b, 0xa1, 0x1d, 0xb9, 0xc3, 0xc3, 0xb8, 0xad,
+	0x21, 0x56, 0x35, 0xc4, 0xa6, 0x86, 0xf8, 0x15, 0x67, 0xd5, 0xe9, 0xd3, 0xdb, 0x9f, 0xc7, 0xd6,
+	0xf7, 0x5f, 0xc7, 0x51, 0xc9, 0x9a, 0xd9, 0x22, 0x8b, 0x73, 0x7e, 0x85, 0x4d, 0x67, 0xed, 0x63,
+	0x20, 0x8b, 0x39, 0x6e, 0xbe, 0xd4, 0x54, 0xea, 0x0f, 0x24, 0x31, 0x5b, 0x3f, 0x7e, 0x09, 0xef,
+	0xff, 0x17, 0x00, 0xb9, 0xb0, 0x77, 0x39, 0x4e, 0xde, 0xbc, 0x27, 0xef, 0x3c, 0x0b, 0x41, 0xe8,
+	0xbc, 0x4d, 0xc6, 0xaf, 0x47, 0xc4, 0x03, 0x68, 0x1f, 0xee, 0x9e, 0x8f, 0x2e, 0x27, 0x93, 0x64,
+	0x34, 0xf6, 0x3a, 0xa7, 0xe7, 0xb7, 0xab, 0x00, 0xdc, 0xad, 0x02, 0xf0, 0x7b, 0x15, 0x80, 0x6f,
+	0xeb, 0xc0, 0xba, 0x5b, 0x07, 0xd6, 0x8f, 0x75, 0x60, 0x7d, 0x1c, 0x6c, 0xe4, 0x30, 0xff, 0x3a,
+	0xe0, 0xa2, 0xfc, 0xab, 0xf1, 0xf5, 0x0b, 0xbc, 0xd4, 0xd7, 0x42, 0x47, 0xca, 0x1c, 0x7d, 0x2b,
+	0x9e, 0xfd, 0x19, 0x00, 0x97, 0x6b, 0x7d, 0x69, 0x97, 0x02, 0x00, 0x00,
+}
+
+func (m *RangePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRangePosition(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Shape != 0 {
+		i = encodeVarintRangePosition(dAtA, i, uint64(m.Shape))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Fee != 0 {
+		i = encodeVarintRangePosition(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TickSpacing != 0 {
+		i = encodeVarintRangePosition(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UpperTickIndex != 0 {
+		i = encodeVarintRangePosition(dAtA, i, uint64(m.UpperTickIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LowerTickIndex != 0 {
+		i = encodeVarintRangePosition(dAtA, i, uint64(m.LowerTickIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRangePosition(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintRangePosition(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRangePosition(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRangePosition(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRangePosition(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RangePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRangePosition(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovRangePosition(uint64(l))
+	}
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovRangePosition(uint64(l))
+	}
+	if m.LowerTickIndex != 0 {
+		n += 1 + sovRangePosition(uint64(m.LowerTickIndex))
+	}
+	if m.UpperTickIndex != 0 {
+		n += 1 + sovRangePosition(uint64(m.UpperTickIndex))
+	}
+	if m.TickSpacing != 0 {
+		n += 1 + sovRangePosition(uint64(m.TickSpacing))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovRangePosition(uint64(m.Fee))
+	}
+	if m.Shape != 0 {
+		n += 1 + sovRangePosition(uint64(m.Shape))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovRangePosition(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRangePosition(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRangePosition(x uint64) (n int) {
+	return sovRangePosition(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RangePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRangePosition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangePosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangePosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRangePosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRangePosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangePosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRangePosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRangePosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTickIndex", wireType)
+			}
+			m.LowerTickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangePosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTickIndex", wireType)
+			}
+			m.UpperTickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangePosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangePosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangePosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shape", wireType)
+			}
+			m.Shape = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangePosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shape |= DistributionShape(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangePosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRangePosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRangePosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRangePosition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRangePosition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRangePosition(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRangePosition
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRangePosition
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRangePosition
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRangePosition
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRangePosition
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRangePosition
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRangePosition        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRangePosition          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRangePosition = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func TestDistributionWeights(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		shape    dextypes.DistributionShape
+		n        int
+		expected []int64
+	}{
+		{
+			desc:     "uniform",
+			shape:    dextypes.DistributionShape_UNIFORM,
+			n:        4,
+			expected: []int64{1, 1, 1, 1},
+		},
+		{
+			desc:     "linear odd",
+			shape:    dextypes.DistributionShape_LINEAR,
+			n:        5,
+			expected: []int64{1, 2, 3, 2, 1},
+		},
+		{
+			desc:     "linear even",
+			shape:    dextypes.DistributionShape_LINEAR,
+			n:        4,
+			expected: []int64{1, 2, 2, 1},
+		},
+		{
+			desc:     "gaussian",
+			shape:    dextypes.DistributionShape_GAUSSIAN,
+			n:        5,
+			expected: []int64{1, 4, 6, 4, 1},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			weights := dextypes.DistributionWeights(tc.shape, tc.n)
+			require.Len(t, weights, len(tc.expected))
+			for i, w := range tc.expected {
+				require.Equal(t, math.NewInt(w), weights[i])
+			}
+		})
+	}
+}
+
+func TestSplitAmount(t *testing.T) {
+	weights := dextypes.DistributionWeights(dextypes.DistributionShape_LINEAR, 3)
+	parts := dextypes.SplitAmount(math.NewInt(10), weights)
+
+	// 10 split 1:2:1 leaves a remainder of 2 on the heaviest tick
+	require.Equal(t, []math.Int{math.NewInt(2), math.NewInt(6), math.NewInt(2)}, parts)
+}
+
+func TestValidateRange(t *testing.T) {
+	require.NoError(t, dextypes.ValidateRange(-10, 10, 5, 1))
+	require.Error(t, dextypes.ValidateRange(10, -10, 5, 1))
+	require.Error(t, dextypes.ValidateRange(-10, 10, 0, 1))
+	require.Error(t, dextypes.ValidateRange(-10, 10, 3, 1))
+	require.Error(t, dextypes.ValidateRange(-1000, 1000, 1, 1))
+}
@@ -0,0 +1,1376 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/rebates.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TraderFeeTier is a step of the trader fee schedule. It applies to traders whose rolling volume is at least min_volume
+type TraderFeeTier struct {
+	MinVolume cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_volume,json=minVolume,proto3,customtype=cosmossdk.io/math.Int" json:"min_volume" yaml:"min_volume"`
+	// Surcharge paid by takers on the amount filled against limit orders, in basis points
+	TakerFee uint64 `protobuf:"varint,2,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	// Rebate paid to makers out of the taker surcharges, in basis points of the amount filled against their limit orders
+	MakerRebate uint64 `protobuf:"varint,3,opt,name=maker_rebate,json=makerRebate,proto3" json:"maker_rebate,omitempty"`
+}
+
+func (m *TraderFeeTier) Reset()         { *m = TraderFeeTier{} }
+func (m *TraderFeeTier) String() string { return proto.CompactTextString(m) }
+func (*TraderFeeTier) ProtoMessage()    {}
+func (*TraderFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_908c34de92edfb1f, []int{0}
+}
+func (m *TraderFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraderFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraderFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraderFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraderFeeTier.Merge(m, src)
+}
+func (m *TraderFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraderFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraderFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraderFeeTier proto.InternalMessageInfo
+
+func (m *TraderFeeTier) GetTakerFee() uint64 {
+	if m != nil {
+		return m.TakerFee
+	}
+	return 0
+}
+
+func (m *TraderFeeTier) GetMakerRebate() uint64 {
+	if m != nil {
+		return m.MakerRebate
+	}
+	return 0
+}
+
+// TraderFeeTierAssignment pins an address to a tier of the trader fee schedule regardless of its volume
+type TraderFeeTierAssignment struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tier    uint32 `protobuf:"varint,2,opt,name=tier,proto3" json:"tier,omitempty"`
+}
+
+func (m *TraderFeeTierAssignment) Reset()         { *m = TraderFeeTierAssignment{} }
+func (m *TraderFeeTierAssignment) String() string { return proto.CompactTextString(m) }
+func (*TraderFeeTierAssignment) ProtoMessage()    {}
+func (*TraderFeeTierAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_908c34de92edfb1f, []int{1}
+}
+func (m *TraderFeeTierAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraderFeeTierAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraderFeeTierAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraderFeeTierAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraderFeeTierAssignment.Merge(m, src)
+}
+func (m *TraderFeeTierAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraderFeeTierAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraderFeeTierAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraderFeeTierAssignment proto.InternalMessageInfo
+
+func (m *TraderFeeTierAssignment) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TraderFeeTierAssignment) GetTier() uint32 {
+	if m != nil {
+		return m.Tier
+	}
+	return 0
+}
+
+// TraderVolume is the volume traded by an address on a single day, in the trader volume denom
+type TraderVolume struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Days since the unix epoch
+	Day    uint64                `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume" yaml:"volume"`
+}
+
+func (m *TraderVolume) Reset()         { *m = TraderVolume{} }
+func (m *TraderVolume) String() string { return proto.CompactTextString(m) }
+func (*TraderVolume) ProtoMessage()    {}
+func (*TraderVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_908c34de92edfb1f, []int{2}
+}
+func (m *TraderVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraderVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraderVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraderVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraderVolume.Merge(m, src)
+}
+func (m *TraderVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraderVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraderVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraderVolume proto.InternalMessageInfo
+
+func (m *TraderVolume) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TraderVolume) GetDay() uint64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+// TrancheRebatePool holds the taker surcharges paid on fills of a limit order tranche until its makers withdraw the fills
+type TrancheRebatePool struct {
+	TrancheKey string     `protobuf:"bytes,1,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	Surcharges types.Coin `protobuf:"bytes,2,opt,name=surcharges,proto3" json:"surcharges"`
+	// Filled amount of the tranche that has not been withdrawn by its makers since surcharges were first paid
+	UnsettledFills cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=unsettled_fills,json=unsettledFills,proto3,customtype=cosmossdk.io/math.Int" json:"unsettled_fills" yaml:"unsettled_fills"`
+}
+
+func (m *TrancheRebatePool) Reset()         { *m = TrancheRebatePool{} }
+func (m *TrancheRebatePool) String() string { return proto.CompactTextString(m) }
+func (*TrancheRebatePool) ProtoMessage()    {}
+func (*TrancheRebatePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_908c34de92edfb1f, []int{3}
+}
+func (m *TrancheRebatePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrancheRebatePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrancheRebatePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrancheRebatePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrancheRebatePool.Merge(m, src)
+}
+func (m *TrancheRebatePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrancheRebatePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrancheRebatePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrancheRebatePool proto.InternalMessageInfo
+
+func (m *TrancheRebatePool) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return ""
+}
+
+func (m *TrancheRebatePool) GetSurcharges() types.Coin {
+	if m != nil {
+		return m.Surcharges
+	}
+	return types.Coin{}
+}
+
+// MakerRebates are the rebates accrued by an address that have not been claimed yet
+type MakerRebates struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Rebates github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rebates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rebates"`
+}
+
+func (m *MakerRebates) Reset()         { *m = MakerRebates{} }
+func (m *MakerRebates) String() string { return proto.CompactTextString(m) }
+func (*MakerRebates) ProtoMessage()    {}
+func (*MakerRebates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_908c34de92edfb1f, []int{4}
+}
+func (m *MakerRebates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MakerRebates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MakerRebates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MakerRebates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MakerRebates.Merge(m, src)
+}
+func (m *MakerRebates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MakerRebates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MakerRebates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MakerRebates proto.InternalMessageInfo
+
+func (m *MakerRebates) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MakerRebates) GetRebates() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rebates
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TraderFeeTier)(nil), "neutron.dex.TraderFeeTier")
+	proto.RegisterType((*TraderFeeTierAssignment)(nil), "neutron.dex.TraderFeeTierAssignment")
+	proto.RegisterType((*TraderVolume)(nil), "neutron.dex.TraderVolume")
+	proto.RegisterType((*TrancheRebatePool)(nil), "neutron.dex.TrancheRebatePool")
+	proto.RegisterType((*MakerRebates)(nil), "neutron.dex.MakerRebates")
+}
+
+func init() { proto.RegisterFile("neutron/dex/rebates.proto", fileDescriptor_908c34de92edfb1f) }
+
+var fileDescriptor_908c34de92edfb1f = []byte{
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x9b, 0x28, 0x25, 0x9b, 0x04, 0xe8, 0x8a, 0x8f, 0xb4, 0x48, 0x76, 0xf0, 0x29, 0x97,
+	0x78, 0x29, 0x08, 0x09, 0x71, 0x01, 0x82, 0x94, 0x0a, 0x10, 0x52, 0x65, 0x45, 0x1c, 0xb8, 0x44,
+	0x9b, 0x78, 0xea, 0x58, 0xb1, 0x77, 0xab, 0xdd, 0x4d, 0x94, 0xfc, 0x07, 0x24, 0xf8, 0x1d, 0x9c,
+	0xf8, 0x19, 0x3d, 0xf6, 0x88, 0x38, 0x04, 0x94, 0xdc, 0x7a, 0xec, 0x2f, 0x40, 0xde, 0xdd, 0xb4,
+	0xa1, 0x12, 0xed, 0xc9, 0xcf, 0x6f, 0x3c, 0xb3, 0xef, 0x3d, 0xef, 0xa0, 0x5d, 0x06, 0x13, 0x25,
+	0x38, 0x23, 0x11, 0xcc, 0x88, 0x80, 0x01, 0x55, 0x20, 0x83, 0x63, 0xc1, 0x15, 0xc7, 0x55, 0x5b,
+	0x0a, 0x22, 0x98, 0xed, 0xb9, 0x43, 0x2e, 0x33, 0x2e, 0xc9, 0x80, 0x4a, 0x20, 0xd3, 0xfd, 0x01,
+	0x28, 0xba, 0x4f, 0x86, 0x3c, 0x61, 0xe6, 0xe3, 0xbd, 0x7b, 0x31, 0x8f, 0xb9, 0x86, 0x24, 0x47,
+	0x86, 0xf5, 0x7f, 0x38, 0xa8, 0xde, 0x13, 0x34, 0x02, 0xd1, 0x05, 0xe8, 0x25, 0x20, 0x70, 0x1f,
+	0xa1, 0x2c, 0x61, 0xfd, 0x29, 0x4f, 0x27, 0x19, 0x34, 0x9c, 0xa6, 0xd3, 0xaa, 0x74, 0x5e, 0x9f,
+	0x2c, 0xbc, 0xc2, 0xaf, 0x85, 0x77, 0xdf, 0x9c, 0x21, 0xa3, 0x71, 0x90, 0x70, 0x92, 0x51, 0x35,
+	0x0a, 0xde, 0x31, 0x75, 0xb6, 0xf0, 0x36, 0x5a, 0xce, 0x17, 0xde, 0xce, 0x9c, 0x66, 0xe9, 0x4b,
+	0xff, 0x92, 0xf3, 0xc3, 0x4a, 0x96, 0xb0, 0x4f, 0x1a, 0xe3, 0x47, 0xa8, 0xa2, 0xe8, 0x18, 0x44,
+	0xff, 0x08, 0xa0, 0xb1, 0xd5, 0x74, 0x5a, 0xa5, 0xf0, 0x96, 0x26, 0xba, 0x00, 0xf8, 0x31, 0xaa,
+	0x65, 0xba, 0x68, 0x9c, 0x36, 0x8a, 0xba, 0x5e, 0xd5, 0x5c, 0xa8, 0x29, 0xff, 0x00, 0x3d, 0xfc,
+	0x47, 0xf1, 0x1b, 0x29, 0x93, 0x98, 0x65, 0xc0, 0x14, 0x6e, 0xa0, 0x6d, 0x1a, 0x45, 0x02, 0xa4,
+	0x34, 0xc2, 0xc3, 0xf5, 0x2b, 0xc6, 0xa8, 0xa4, 0x12, 0x10, 0xfa, 0xbc, 0x7a, 0xa8, 0xb1, 0xff,
+	0xc5, 0x41, 0x35, 0x33, 0xc9, 0x2a, 0xfb, 0x7f, 0xfb, 0x5d, 0x54, 0x8c, 0xe8, 0xdc, 0xaa, 0xcd,
+	0x21, 0x3e, 0x44, 0x65, 0x1b, 0x51, 0x51, 0x47, 0xf4, 0xe2, 0xa6, 0x88, 0xca, 0x17, 0xf1, 0xd4,
+	0x4d, 0x3c, 0xeb, 0x68, 0x6c, 0xc1, 0x3f, 0x73, 0xd0, 0x4e, 0x4f, 0x50, 0x36, 0x1c, 0x81, 0x71,
+	0x7a, 0xc8, 0x79, 0x8a, 0x3d, 0x54, 0x55, 0x86, 0xec, 0x8f, 0x61, 0x6e, 0x75, 0x21, 0x4b, 0x7d,
+	0x80, 0x39, 0x7e, 0x85, 0x90, 0x9c, 0x88, 0xe1, 0x88, 0x8a, 0x18, 0xa4, 0x56, 0x58, 0x7d, 0xba,
+	0x1b, 0x18, 0x15, 0x41, 0x7e, 0x19, 0x02, 0x7b, 0x19, 0x82, 0xb7, 0x3c, 0x61, 0x9d, 0x52, 0xae,
+	0x33, 0xdc, 0x68, 0xc1, 0x12, 0xdd, 0x99, 0x30, 0x09, 0x4a, 0xa5, 0x10, 0xf5, 0x8f, 0x92, 0x34,
+	0x95, 0xd6, 0xd2, 0xfb, 0x9b, 0x2c, 0x5d, 0xed, 0x3b, 0x5f, 0x78, 0x0f, 0x8c, 0xb7, 0x2b, 0x05,
+	0x3f, 0xbc, 0x7d, 0xc1, 0x74, 0x35, 0xf1, 0xd5, 0x41, 0xb5, 0x8f, 0x97, 0x3f, 0x55, 0x5e, 0x93,
+	0x3d, 0xa0, 0x6d, 0x7b, 0xed, 0x1b, 0x5b, 0xcd, 0xe2, 0xf5, 0xee, 0x9e, 0xe4, 0x92, 0xbf, 0xff,
+	0xf6, 0x5a, 0x71, 0xa2, 0x46, 0x93, 0x41, 0x30, 0xe4, 0x19, 0xb1, 0x7b, 0x61, 0x1e, 0x6d, 0x19,
+	0x8d, 0x89, 0x9a, 0x1f, 0x83, 0xd4, 0x0d, 0x32, 0x5c, 0xcf, 0xee, 0x1c, 0x9c, 0x2c, 0x5d, 0xe7,
+	0x74, 0xe9, 0x3a, 0x7f, 0x96, 0xae, 0xf3, 0x6d, 0xe5, 0x16, 0x4e, 0x57, 0x6e, 0xe1, 0xe7, 0xca,
+	0x2d, 0x7c, 0x6e, 0x6f, 0x0c, 0xb3, 0x1b, 0xd7, 0xe6, 0x22, 0x5e, 0x63, 0x32, 0x7d, 0x4e, 0x66,
+	0x7a, 0x3b, 0xf5, 0xdc, 0x41, 0x59, 0x6f, 0xd6, 0xb3, 0xbf, 0x03, 0x00, 0xeb, 0x36, 0xfe, 0xdd,
+	0xb9, 0x03, 0x00, 0x00,
+}
+
+func (m *TraderFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraderFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraderFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MakerRebate != 0 {
+		i = encodeVarintRebates(dAtA, i, uint64(m.MakerRebate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TakerFee != 0 {
+		i = encodeVarintRebates(dAtA, i, uint64(m.TakerFee))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinVolume.Size()
+		i -= size
+		if _, err := m.MinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRebates(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TraderFeeTierAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraderFeeTierAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraderFeeTierAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tier != 0 {
+		i = encodeVarintRebates(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRebates(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraderVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraderVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraderVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRebates(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Day != 0 {
+		i = encodeVarintRebates(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRebates(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrancheRebatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrancheRebatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrancheRebatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UnsettledFills.Size()
+		i -= size
+		if _, err := m.UnsettledFills.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRebates(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Surcharges.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRebates(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintRebates(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MakerRebates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MakerRebates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MakerRebates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rebates) > 0 {
+		for iNdEx := len(m.Rebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRebates(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRebates(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRebates(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRebates(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TraderFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinVolume.Size()
+	n += 1 + l + sovRebates(uint64(l))
+	if m.TakerFee != 0 {
+		n += 1 + sovRebates(uint64(m.TakerFee))
+	}
+	if m.MakerRebate != 0 {
+		n += 1 + sovRebates(uint64(m.MakerRebate))
+	}
+	return n
+}
+
+func (m *TraderFeeTierAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRebates(uint64(l))
+	}
+	if m.Tier != 0 {
+		n += 1 + sovRebates(uint64(m.Tier))
+	}
+	return n
+}
+
+func (m *TraderVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRebates(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovRebates(uint64(m.Day))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovRebates(uint64(l))
+	return n
+}
+
+func (m *TrancheRebatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovRebates(uint64(l))
+	}
+	l = m.Surcharges.Size()
+	n += 1 + l + sovRebates(uint64(l))
+	l = m.UnsettledFills.Size()
+	n += 1 + l + sovRebates(uint64(l))
+	return n
+}
+
+func (m *MakerRebates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRebates(uint64(l))
+	}
+	if len(m.Rebates) > 0 {
+		for _, e := range m.Rebates {
+			l = e.Size()
+			n += 1 + l + sovRebates(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRebates(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRebates(x uint64) (n int) {
+	return sovRebates(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TraderFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRebates
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraderFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraderFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			m.TakerFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebate", wireType)
+			}
+			m.MakerRebate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerRebate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRebates(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraderFeeTierAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRebates
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraderFeeTierAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraderFeeTierAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRebates(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraderVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRebates
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraderVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraderVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRebates(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrancheRebatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRebates
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrancheRebatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrancheRebatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Surcharges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Surcharges.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsettledFills", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnsettledFills.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRebates(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MakerRebates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRebates
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MakerRebates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MakerRebates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rebates = append(m.Rebates, types.Coin{})
+			if err := m.Rebates[len(m.Rebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRebates(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRebates(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRebates
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRebates
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRebates
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRebates
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRebates        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRebates          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRebates = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+func (s TradingStatus) Validate() error {
+	if _, ok := TradingStatus_name[int32(s)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidTradingStatus, "%d", s)
+	}
+	return nil
+}
+
+func (s TradingStatus) IsActive() bool {
+	return s == TradingStatus_ACTIVE
+}
+
+func (s TradingStatus) IsHalted() bool {
+	return s == TradingStatus_HALTED
+}
+
+// MostRestrictive returns the most restrictive of the supplied statuses.
+// TradingStatus values are ordered from least to most restrictive.
+func MostRestrictive(statuses ...TradingStatus) TradingStatus {
+	result := TradingStatus_ACTIVE
+	for _, s := range statuses {
+		if s > result {
+			result = s
+		}
+	}
+	return result
+}
+
+// CanAddLiquidity returns an error if deposits and maker limit orders are not allowed under the status
+func (s TradingStatus) CanAddLiquidity() error {
+	switch s {
+	case TradingStatus_ACTIVE:
+		return nil
+	case TradingStatus_WITHDRAW_ONLY:
+		return ErrPairWithdrawOnly
+	default:
+		return ErrPairHalted
+	}
+}
+
+// CanSwap returns an error if swapping against existing liquidity is not allowed under the status
+func (s TradingStatus) CanSwap() error {
+	if s.IsHalted() {
+		return ErrPairHalted
+	}
+	return nil
+}
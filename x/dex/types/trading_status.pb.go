@@ -0,0 +1,608 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/trading_status.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TradingStatus restricts the actions that can be performed against a pair or
+// against every pair containing a given denom. Cancelling limit orders,
+// withdrawing filled limit orders and withdrawing LP positions are always allowed.
+type TradingStatus int32
+
+const (
+	// All actions are allowed.
+	TradingStatus_ACTIVE TradingStatus = 0
+	// No new liquidity can be added (deposits and maker limit orders are rejected),
+	// but existing liquidity can still be swapped against or withdrawn.
+	TradingStatus_WITHDRAW_ONLY TradingStatus = 1
+	// No deposits, limit orders or swaps are allowed and the pair is skipped
+	// during multihop route selection. Existing liquidity can only be withdrawn.
+	TradingStatus_HALTED TradingStatus = 2
+)
+
+var TradingStatus_name = map[int32]string{
+	0: "ACTIVE",
+	1: "WITHDRAW_ONLY",
+	2: "HALTED",
+}
+
+var TradingStatus_value = map[string]int32{
+	"ACTIVE":        0,
+	"WITHDRAW_ONLY": 1,
+	"HALTED":        2,
+}
+
+func (x TradingStatus) String() string {
+	return proto.EnumName(TradingStatus_name, int32(x))
+}
+
+func (TradingStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6f9f1c0a9d834157, []int{0}
+}
+
+type PairTradingStatus struct {
+	PairId *PairID       `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Status TradingStatus `protobuf:"varint,2,opt,name=status,proto3,enum=neutron.dex.TradingStatus" json:"status,omitempty"`
+}
+
+func (m *PairTradingStatus) Reset()         { *m = PairTradingStatus{} }
+func (m *PairTradingStatus) String() string { return proto.CompactTextString(m) }
+func (*PairTradingStatus) ProtoMessage()    {}
+func (*PairTradingStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f9f1c0a9d834157, []int{0}
+}
+func (m *PairTradingStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairTradingStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairTradingStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairTradingStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairTradingStatus.Merge(m, src)
+}
+func (m *PairTradingStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairTradingStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairTradingStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairTradingStatus proto.InternalMessageInfo
+
+func (m *PairTradingStatus) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *PairTradingStatus) GetStatus() TradingStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TradingStatus_ACTIVE
+}
+
+type DenomTradingStatus struct {
+	Denom  string        `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Status TradingStatus `protobuf:"varint,2,opt,name=status,proto3,enum=neutron.dex.TradingStatus" json:"status,omitempty"`
+}
+
+func (m *DenomTradingStatus) Reset()         { *m = DenomTradingStatus{} }
+func (m *DenomTradingStatus) String() string { return proto.CompactTextString(m) }
+func (*DenomTradingStatus) ProtoMessage()    {}
+func (*DenomTradingStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f9f1c0a9d834157, []int{1}
+}
+func (m *DenomTradingStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTradingStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTradingStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTradingStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTradingStatus.Merge(m, src)
+}
+func (m *DenomTradingStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTradingStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTradingStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTradingStatus proto.InternalMessageInfo
+
+func (m *DenomTradingStatus) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomTradingStatus) GetStatus() TradingStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TradingStatus_ACTIVE
+}
+
+func init() {
+	proto.RegisterEnum("neutron.dex.TradingStatus", TradingStatus_name, TradingStatus_value)
+	proto.RegisterType((*PairTradingStatus)(nil), "neutron.dex.PairTradingStatus")
+	proto.RegisterType((*DenomTradingStatus)(nil), "neutron.dex.DenomTradingStatus")
+}
+
+func init() { proto.RegisterFile("neutron/dex/trading_status.proto", fileDescriptor_6f9f1c0a9d834157) }
+
+var fileDescriptor_6f9f1c0a9d834157 = []byte{
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x4b, 0x2d, 0x2d,
+	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0x49, 0xad, 0xd0, 0x2f, 0x29, 0x4a, 0x4c, 0xc9, 0xcc, 0x4b, 0x8f,
+	0x2f, 0x2e, 0x49, 0x2c, 0x29, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0xaa,
+	0xd0, 0x4b, 0x49, 0xad, 0x90, 0x92, 0x44, 0x56, 0x5e, 0x90, 0x98, 0x59, 0x14, 0x9f, 0x99, 0x02,
+	0x51, 0xa7, 0x54, 0xca, 0x25, 0x18, 0x90, 0x98, 0x59, 0x14, 0x02, 0x31, 0x23, 0x18, 0x6c, 0x84,
+	0x90, 0x0e, 0x17, 0x3b, 0x54, 0x95, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xb0, 0x1e, 0x92,
+	0x71, 0x7a, 0x20, 0x0d, 0x9e, 0x2e, 0x41, 0x6c, 0x20, 0x35, 0x9e, 0x29, 0x42, 0x46, 0x5c, 0x6c,
+	0x10, 0xab, 0x25, 0x98, 0x14, 0x18, 0x35, 0xf8, 0x8c, 0xa4, 0x50, 0x14, 0xa3, 0x98, 0x1c, 0x04,
+	0x55, 0xa9, 0x14, 0xc7, 0x25, 0xe4, 0x92, 0x9a, 0x97, 0x9f, 0x8b, 0x6a, 0xaf, 0x08, 0x17, 0x6b,
+	0x0a, 0x48, 0x14, 0x6c, 0x2b, 0x67, 0x10, 0x84, 0x43, 0x8e, 0xf9, 0x5a, 0x56, 0x5c, 0xbc, 0xa8,
+	0x46, 0x73, 0x71, 0xb1, 0x39, 0x3a, 0x87, 0x78, 0x86, 0xb9, 0x0a, 0x30, 0x08, 0x09, 0x72, 0xf1,
+	0x86, 0x7b, 0x86, 0x78, 0xb8, 0x04, 0x39, 0x86, 0xc7, 0xfb, 0xfb, 0xf9, 0x44, 0x0a, 0x30, 0x82,
+	0xa4, 0x3d, 0x1c, 0x7d, 0x42, 0x5c, 0x5d, 0x04, 0x98, 0x9c, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
+	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
+	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
+	0x57, 0x1f, 0xea, 0x06, 0xdd, 0xfc, 0xa2, 0x74, 0x18, 0x5b, 0xbf, 0xcc, 0x54, 0xbf, 0x02, 0x12,
+	0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x20, 0x36, 0x06, 0x0c, 0x00, 0x52, 0x92, 0x77,
+	0xe5, 0xae, 0x01, 0x00, 0x00,
+}
+
+func (m *PairTradingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairTradingStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairTradingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTradingStatus(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTradingStatus(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTradingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTradingStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTradingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTradingStatus(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTradingStatus(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTradingStatus(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTradingStatus(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PairTradingStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovTradingStatus(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTradingStatus(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *DenomTradingStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTradingStatus(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTradingStatus(uint64(m.Status))
+	}
+	return n
+}
+
+func sovTradingStatus(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTradingStatus(x uint64) (n int) {
+	return sovTradingStatus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PairTradingStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTradingStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairTradingStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairTradingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradingStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTradingStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradingStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradingStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTradingStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTradingStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTradingStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTradingStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTradingStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTradingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradingStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradingStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradingStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradingStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTradingStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTradingStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTradingStatus(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTradingStatus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTradingStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTradingStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTradingStatus
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTradingStatus
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTradingStatus
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTradingStatus        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTradingStatus          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTradingStatus = fmt.Errorf("proto: unexpected end of group")
+)
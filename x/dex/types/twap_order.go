@@ -0,0 +1,39 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// MaxTWAPOrderSlices is the maximum number of slices of a TWAP order
+const MaxTWAPOrderSlices uint64 = 10_000
+
+// MaxTWAPOrderInterval is the maximum number of seconds between two slices of a TWAP order
+const MaxTWAPOrderInterval uint64 = 30 * 24 * 60 * 60
+
+// NextSliceAmount returns the amount of token_in sold by the next slice. The unsold amount is spread evenly over the
+// remaining slices so that skipped slices are caught up by later ones.
+func (o TWAPOrder) NextSliceAmount() math.Int {
+	slicesLeft := o.Slices - o.SlicesExecuted
+	if slicesLeft <= 1 {
+		return o.RemainingIn
+	}
+
+	amount := o.RemainingIn.QuoRaw(int64(slicesLeft)) //nolint:gosec
+	if amount.IsZero() {
+		return o.RemainingIn
+	}
+
+	return amount
+}
+
+// IsComplete returns true once all slices have been executed or nothing is left to sell
+func (o TWAPOrder) IsComplete() bool {
+	return o.SlicesExecuted >= o.Slices || !o.RemainingIn.IsPositive()
+}
+
+// IntervalDuration returns the time between two slices
+func (o TWAPOrder) IntervalDuration() time.Duration {
+	return time.Duration(o.Interval) * time.Second //nolint:gosec
+}
@@ -0,0 +1,1216 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/twap_order.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	github_com_neutron_org_neutron_v5_utils_math "github.com/neutron-org/neutron/v5/utils/math"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TWAPOrder sells total_in of token_in for token_out in slices that the dex executes at the end of a block every
+// interval seconds. The unsold amount is held by the dex module until the order completes or is withdrawn.
+type TWAPOrder struct {
+	Id       uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator  string                `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenIn  string                `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut string                `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	TotalIn  cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_in,json=totalIn,proto3,customtype=cosmossdk.io/math.Int" json:"total_in" yaml:"total_in"`
+	Slices   uint64                `protobuf:"varint,6,opt,name=slices,proto3" json:"slices,omitempty"`
+	// Seconds between two slices
+	Interval uint64 `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Maximum price paid for token_out in terms of token_in. Liquidity above the price is not taken.
+	MaxPrice github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,8,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"max_price" yaml:"max_price"`
+	// Amount of token_in that has not been sold yet
+	RemainingIn cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=remaining_in,json=remainingIn,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_in" yaml:"remaining_in"`
+	// Amount of token_out received so far
+	TotalOut cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=total_out,json=totalOut,proto3,customtype=cosmossdk.io/math.Int" json:"total_out" yaml:"total_out"`
+	// Number of slices executed or skipped so far
+	SlicesExecuted    uint64    `protobuf:"varint,11,opt,name=slices_executed,json=slicesExecuted,proto3" json:"slices_executed,omitempty"`
+	NextExecutionTime time.Time `protobuf:"bytes,12,opt,name=next_execution_time,json=nextExecutionTime,proto3,stdtime" json:"next_execution_time"`
+}
+
+func (m *TWAPOrder) Reset()         { *m = TWAPOrder{} }
+func (m *TWAPOrder) String() string { return proto.CompactTextString(m) }
+func (*TWAPOrder) ProtoMessage()    {}
+func (*TWAPOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f85fb4ad08cd5caa, []int{0}
+}
+func (m *TWAPOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TWAPOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TWAPOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TWAPOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TWAPOrder.Merge(m, src)
+}
+func (m *TWAPOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TWAPOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TWAPOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TWAPOrder proto.InternalMessageInfo
+
+func (m *TWAPOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TWAPOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *TWAPOrder) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
+}
+
+func (m *TWAPOrder) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
+	}
+	return ""
+}
+
+func (m *TWAPOrder) GetSlices() uint64 {
+	if m != nil {
+		return m.Slices
+	}
+	return 0
+}
+
+func (m *TWAPOrder) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *TWAPOrder) GetSlicesExecuted() uint64 {
+	if m != nil {
+		return m.SlicesExecuted
+	}
+	return 0
+}
+
+func (m *TWAPOrder) GetNextExecutionTime() time.Time {
+	if m != nil {
+		return m.NextExecutionTime
+	}
+	return time.Time{}
+}
+
+// TWAPOrderFill is the result of a slice of a TWAPOrder
+type TWAPOrderFill struct {
+	OrderId   uint64                `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Slice     uint64                `protobuf:"varint,2,opt,name=slice,proto3" json:"slice,omitempty"`
+	Time      time.Time             `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	AmountIn  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	AmountOut cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount_out,json=amountOut,proto3,customtype=cosmossdk.io/math.Int" json:"amount_out" yaml:"amount_out"`
+	// True if there was no liquidity at or below the max price
+	Skipped bool `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (m *TWAPOrderFill) Reset()         { *m = TWAPOrderFill{} }
+func (m *TWAPOrderFill) String() string { return proto.CompactTextString(m) }
+func (*TWAPOrderFill) ProtoMessage()    {}
+func (*TWAPOrderFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f85fb4ad08cd5caa, []int{1}
+}
+func (m *TWAPOrderFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TWAPOrderFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TWAPOrderFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TWAPOrderFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TWAPOrderFill.Merge(m, src)
+}
+func (m *TWAPOrderFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *TWAPOrderFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_TWAPOrderFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TWAPOrderFill proto.InternalMessageInfo
+
+func (m *TWAPOrderFill) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *TWAPOrderFill) GetSlice() uint64 {
+	if m != nil {
+		return m.Slice
+	}
+	return 0
+}
+
+func (m *TWAPOrderFill) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *TWAPOrderFill) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*TWAPOrder)(nil), "neutron.dex.TWAPOrder")
+	proto.RegisterType((*TWAPOrderFill)(nil), "neutron.dex.TWAPOrderFill")
+}
+
+func init() { proto.RegisterFile("neutron/dex/twap_order.proto", fileDescriptor_f85fb4ad08cd5caa) }
+
+var fileDescriptor_f85fb4ad08cd5caa = []byte{
+	// 632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdc, 0x3c,
+	0x14, 0x9d, 0x0c, 0x03, 0x93, 0xf1, 0xf0, 0xc1, 0x87, 0xa1, 0x55, 0x3a, 0xad, 0x26, 0x28, 0x9b,
+	0xb2, 0x21, 0x91, 0xfa, 0x23, 0x55, 0x55, 0x55, 0xb5, 0x08, 0x5a, 0x65, 0x05, 0x8a, 0x90, 0x90,
+	0x5a, 0x55, 0x91, 0x49, 0xdc, 0x60, 0x91, 0xd8, 0x51, 0xe2, 0xd0, 0xd0, 0xa7, 0xe0, 0x7d, 0xfa,
+	0x02, 0x2c, 0x51, 0x57, 0x55, 0x17, 0x69, 0xc5, 0xec, 0x58, 0xce, 0x13, 0x54, 0xb6, 0x93, 0x0c,
+	0xac, 0xa6, 0xdd, 0xf9, 0x9e, 0xeb, 0x73, 0xef, 0xc9, 0xb9, 0xd7, 0x01, 0x8f, 0x28, 0x2e, 0x78,
+	0xc6, 0xa8, 0x13, 0xe2, 0xd2, 0xe1, 0x5f, 0x50, 0xea, 0xb3, 0x2c, 0xc4, 0x99, 0x9d, 0x66, 0x8c,
+	0x33, 0x38, 0xac, 0xb3, 0x76, 0x88, 0xcb, 0xd1, 0x46, 0xc4, 0x22, 0x26, 0x71, 0x47, 0x9c, 0xd4,
+	0x95, 0x91, 0x19, 0x31, 0x16, 0xc5, 0xd8, 0x91, 0xd1, 0x71, 0xf1, 0xd9, 0xe1, 0x24, 0xc1, 0x39,
+	0x47, 0x49, 0xaa, 0x2e, 0x58, 0xdf, 0x16, 0xc1, 0xe0, 0xf0, 0xe8, 0xed, 0xc1, 0xbe, 0xa8, 0x0b,
+	0x57, 0x40, 0x97, 0x84, 0x86, 0xb6, 0xa9, 0x6d, 0xf5, 0xbc, 0x2e, 0x09, 0xa1, 0x01, 0xfa, 0x41,
+	0x86, 0x11, 0x67, 0x99, 0xd1, 0xdd, 0xd4, 0xb6, 0x06, 0x5e, 0x13, 0xc2, 0x07, 0x40, 0xe7, 0xec,
+	0x14, 0x53, 0x9f, 0x50, 0x63, 0x41, 0xa5, 0x64, 0xec, 0x52, 0xf8, 0x10, 0x0c, 0x54, 0x8a, 0x15,
+	0xdc, 0xe8, 0xc9, 0x9c, 0xba, 0xbb, 0x5f, 0x70, 0x78, 0x24, 0x78, 0x1c, 0xc5, 0x82, 0xb7, 0x28,
+	0x72, 0x3b, 0xaf, 0x2e, 0x2b, 0xb3, 0xf3, 0xb3, 0x32, 0xef, 0x05, 0x2c, 0x4f, 0x58, 0x9e, 0x87,
+	0xa7, 0x36, 0x61, 0x4e, 0x82, 0xf8, 0x89, 0xed, 0x52, 0x7e, 0x53, 0x99, 0x2d, 0x61, 0x5a, 0x99,
+	0xab, 0xe7, 0x28, 0x89, 0x5f, 0x5a, 0x0d, 0x62, 0x89, 0xae, 0x1c, 0xc5, 0x2e, 0x85, 0xf7, 0xc1,
+	0x52, 0x1e, 0x93, 0x00, 0xe7, 0xc6, 0x92, 0x94, 0x5f, 0x47, 0x70, 0x04, 0x74, 0x42, 0x39, 0xce,
+	0xce, 0x50, 0x6c, 0xf4, 0x65, 0xa6, 0x8d, 0xe1, 0x57, 0x30, 0x48, 0x50, 0xe9, 0xa7, 0x19, 0x09,
+	0xb0, 0xa1, 0x4b, 0x35, 0x9f, 0x6a, 0x35, 0xcf, 0x22, 0xc2, 0x4f, 0x8a, 0x63, 0x3b, 0x60, 0x89,
+	0x53, 0xdb, 0xbc, 0xcd, 0xb2, 0xa8, 0x39, 0x3b, 0x67, 0xcf, 0x9d, 0x82, 0x93, 0x38, 0x57, 0x42,
+	0x0f, 0x32, 0x1c, 0xec, 0xe2, 0xe0, 0xa6, 0x32, 0x67, 0x05, 0xa7, 0x95, 0xf9, 0xbf, 0x52, 0xdb,
+	0x42, 0x96, 0xa7, 0x27, 0xa8, 0x3c, 0x10, 0x47, 0x18, 0x81, 0xe5, 0x0c, 0x27, 0x88, 0x50, 0x42,
+	0x23, 0x61, 0xc6, 0x40, 0xb6, 0xdf, 0x9d, 0x67, 0xc6, 0x1d, 0xd2, 0xb4, 0x32, 0xd7, 0x55, 0x8b,
+	0xdb, 0xa8, 0xe5, 0x0d, 0xdb, 0xd0, 0xa5, 0xf0, 0x23, 0x18, 0x28, 0xbb, 0xc4, 0x38, 0x80, 0xec,
+	0xf2, 0x7a, 0x5e, 0x97, 0x19, 0x63, 0xf6, 0x15, 0x2d, 0x64, 0x79, 0x6a, 0x22, 0x62, 0x9c, 0x8f,
+	0xc1, 0xaa, 0xf2, 0xd9, 0xc7, 0x25, 0x0e, 0x0a, 0x8e, 0x43, 0x63, 0x28, 0x4d, 0x5e, 0x51, 0xf0,
+	0x5e, 0x8d, 0xc2, 0x43, 0xb0, 0x4e, 0x71, 0xc9, 0xeb, 0x6b, 0x84, 0x51, 0x5f, 0x6c, 0xa2, 0xb1,
+	0xbc, 0xa9, 0x6d, 0x0d, 0x9f, 0x8c, 0x6c, 0xb5, 0xa6, 0x76, 0xb3, 0xa6, 0xf6, 0x61, 0xb3, 0xa6,
+	0x3b, 0xba, 0xd0, 0x7a, 0xf1, 0xcb, 0xd4, 0xbc, 0x35, 0x51, 0x60, 0xaf, 0xe1, 0x8b, 0x1b, 0xd6,
+	0xf7, 0x2e, 0xf8, 0xaf, 0xdd, 0xde, 0x77, 0x24, 0x8e, 0xc5, 0x5e, 0xca, 0x27, 0xe2, 0xb7, 0x7b,
+	0xdc, 0x97, 0xb1, 0x1b, 0xc2, 0x0d, 0xb0, 0x28, 0x45, 0xc9, 0x55, 0xee, 0x79, 0x2a, 0x80, 0x2f,
+	0x40, 0x4f, 0x2a, 0x59, 0xf8, 0x07, 0x25, 0x92, 0x21, 0x8c, 0x45, 0x09, 0x2b, 0x28, 0x17, 0xe3,
+	0xeb, 0xfd, 0xa5, 0xb1, 0x2d, 0x63, 0x66, 0x6c, 0x0b, 0x59, 0x9e, 0xae, 0xce, 0x2e, 0x85, 0x3e,
+	0x00, 0x35, 0x2e, 0xc6, 0xa6, 0x5e, 0xca, 0x9b, 0x79, 0xd5, 0x6f, 0x51, 0xa6, 0x95, 0xb9, 0x76,
+	0xa7, 0xbc, 0x1c, 0x5c, 0xdd, 0x5e, 0x4c, 0xce, 0x00, 0xfd, 0xfc, 0x94, 0xa4, 0x29, 0x0e, 0xe5,
+	0x83, 0xd1, 0xbd, 0x26, 0xdc, 0x79, 0x7f, 0x79, 0x3d, 0xd6, 0xae, 0xae, 0xc7, 0xda, 0xef, 0xeb,
+	0xb1, 0x76, 0x31, 0x19, 0x77, 0xae, 0x26, 0xe3, 0xce, 0x8f, 0xc9, 0xb8, 0xf3, 0x61, 0x7b, 0xfe,
+	0xa3, 0x28, 0xd5, 0xaf, 0xea, 0x3c, 0xc5, 0xf9, 0xf1, 0x92, 0x34, 0xf1, 0xe9, 0x9f, 0x01, 0x00,
+	0x6a, 0x2f, 0x31, 0x09, 0xc6, 0x04, 0x00, 0x00,
+}
+
+func (m *TWAPOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TWAPOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TWAPOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTwapOrder(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	if m.SlicesExecuted != 0 {
+		i = encodeVarintTwapOrder(dAtA, i, uint64(m.SlicesExecuted))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.TotalOut.Size()
+		i -= size
+		if _, err := m.TotalOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.RemainingIn.Size()
+		i -= size
+		if _, err := m.RemainingIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Interval != 0 {
+		i = encodeVarintTwapOrder(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Slices != 0 {
+		i = encodeVarintTwapOrder(dAtA, i, uint64(m.Slices))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TotalIn.Size()
+		i -= size
+		if _, err := m.TotalIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintTwapOrder(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintTwapOrder(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTwapOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTwapOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TWAPOrderFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TWAPOrderFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TWAPOrderFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Skipped {
+		i--
+		if m.Skipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.AmountOut.Size()
+		i -= size
+		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTwapOrder(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Slice != 0 {
+		i = encodeVarintTwapOrder(dAtA, i, uint64(m.Slice))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintTwapOrder(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTwapOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTwapOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TWAPOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTwapOrder(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTwapOrder(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovTwapOrder(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovTwapOrder(uint64(l))
+	}
+	l = m.TotalIn.Size()
+	n += 1 + l + sovTwapOrder(uint64(l))
+	if m.Slices != 0 {
+		n += 1 + sovTwapOrder(uint64(m.Slices))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTwapOrder(uint64(m.Interval))
+	}
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovTwapOrder(uint64(l))
+	l = m.RemainingIn.Size()
+	n += 1 + l + sovTwapOrder(uint64(l))
+	l = m.TotalOut.Size()
+	n += 1 + l + sovTwapOrder(uint64(l))
+	if m.SlicesExecuted != 0 {
+		n += 1 + sovTwapOrder(uint64(m.SlicesExecuted))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime)
+	n += 1 + l + sovTwapOrder(uint64(l))
+	return n
+}
+
+func (m *TWAPOrderFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTwapOrder(uint64(m.OrderId))
+	}
+	if m.Slice != 0 {
+		n += 1 + sovTwapOrder(uint64(m.Slice))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTwapOrder(uint64(l))
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTwapOrder(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovTwapOrder(uint64(l))
+	if m.Skipped {
+		n += 2
+	}
+	return n
+}
+
+func sovTwapOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTwapOrder(x uint64) (n int) {
+	return sovTwapOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TWAPOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TWAPOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TWAPOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slices", wireType)
+			}
+			m.Slices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlicesExecuted", wireType)
+			}
+			m.SlicesExecuted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlicesExecuted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TWAPOrderFill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TWAPOrderFill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TWAPOrderFill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slice", wireType)
+			}
+			m.Slice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTwapOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTwapOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwapOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTwapOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTwapOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTwapOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTwapOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTwapOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTwapOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -8,8 +8,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -459,6 +459,9 @@ type MsgPlaceLimitOrder struct {
 	// if the min_average_sell_price is not met the trade will fail.
 	// If min_average_sell_price is omitted limit_sell_price will be used instead
 	MinAverageSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,12,opt,name=min_average_sell_price,json=minAverageSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"min_average_sell_price" yaml:"min_average_sell_price"`
+	// If set, the proceeds of the maker portion of the order are sent to the receiver once the tranche is fully filled
+	// or expires, without having to withdraw them. A receiver that is a contract is notified with a sudo call.
+	AutoSettle bool `protobuf:"varint,13,opt,name=auto_settle,json=autoSettle,proto3" json:"auto_settle,omitempty"`
 }
 
 func (m *MsgPlaceLimitOrder) Reset()         { *m = MsgPlaceLimitOrder{} }
@@ -544,6 +547,13 @@ func (m *MsgPlaceLimitOrder) GetExpirationTime() *time.Time {
 	return nil
 }
 
+func (m *MsgPlaceLimitOrder) GetAutoSettle() bool {
+	if m != nil {
+		return m.AutoSettle
+	}
+	return false
+}
+
 type MsgPlaceLimitOrderResponse struct {
 	TrancheKey string `protobuf:"bytes,1,opt,name=trancheKey,proto3" json:"trancheKey,omitempty"`
 	// Total amount of coin used for the limit order
@@ -781,6 +791,266 @@ func (m *MsgCancelLimitOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelLimitOrderResponse proto.InternalMessageInfo
 
+type MsgBatchCancelLimitOrders struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Explicit list of tranche keys to cancel. Cannot be combined with pair_id.
+	TrancheKeys []string `protobuf:"bytes,2,rep,name=tranche_keys,json=trancheKeys,proto3" json:"tranche_keys,omitempty"`
+	// Cancel all of the creator's limit orders for the given pair (ie. "TokenA<>TokenB").
+	// Orders with nothing left to cancel or withdraw are skipped.
+	PairId string `protobuf:"bytes,3,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Optionally restrict pair_id cancellation to one side of the book; only
+	// limit orders selling token_in are canceled.
+	TokenIn string `protobuf:"bytes,4,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+}
+
+func (m *MsgBatchCancelLimitOrders) Reset()         { *m = MsgBatchCancelLimitOrders{} }
+func (m *MsgBatchCancelLimitOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelLimitOrders) ProtoMessage()    {}
+func (*MsgBatchCancelLimitOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{12}
+}
+func (m *MsgBatchCancelLimitOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchCancelLimitOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchCancelLimitOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchCancelLimitOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelLimitOrders.Merge(m, src)
+}
+func (m *MsgBatchCancelLimitOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchCancelLimitOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelLimitOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchCancelLimitOrders proto.InternalMessageInfo
+
+func (m *MsgBatchCancelLimitOrders) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgBatchCancelLimitOrders) GetTrancheKeys() []string {
+	if m != nil {
+		return m.TrancheKeys
+	}
+	return nil
+}
+
+func (m *MsgBatchCancelLimitOrders) GetPairId() string {
+	if m != nil {
+		return m.PairId
+	}
+	return ""
+}
+
+func (m *MsgBatchCancelLimitOrders) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
+}
+
+type MsgBatchCancelLimitOrdersResponse struct {
+	// Tranche keys of all the limit orders that were canceled
+	TrancheKeys []string `protobuf:"bytes,1,rep,name=tranche_keys,json=trancheKeys,proto3" json:"tranche_keys,omitempty"`
+	// Total amount of taker reserves that were withdrawn
+	TakerCoinsOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=taker_coins_out,json=takerCoinsOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taker_coins_out" yaml:"taker_coins_out"`
+	// Total amount of maker reserves that were canceled
+	MakerCoinsOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=maker_coins_out,json=makerCoinsOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"maker_coins_out" yaml:"maker_coins_out"`
+}
+
+func (m *MsgBatchCancelLimitOrdersResponse) Reset()         { *m = MsgBatchCancelLimitOrdersResponse{} }
+func (m *MsgBatchCancelLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelLimitOrdersResponse) ProtoMessage()    {}
+func (*MsgBatchCancelLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{13}
+}
+func (m *MsgBatchCancelLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchCancelLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchCancelLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchCancelLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelLimitOrdersResponse.Merge(m, src)
+}
+func (m *MsgBatchCancelLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchCancelLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelLimitOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchCancelLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *MsgBatchCancelLimitOrdersResponse) GetTrancheKeys() []string {
+	if m != nil {
+		return m.TrancheKeys
+	}
+	return nil
+}
+
+func (m *MsgBatchCancelLimitOrdersResponse) GetTakerCoinsOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TakerCoinsOut
+	}
+	return nil
+}
+
+func (m *MsgBatchCancelLimitOrdersResponse) GetMakerCoinsOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MakerCoinsOut
+	}
+	return nil
+}
+
+// MsgReplaceLimitOrder atomically cancels a limit order and places a new one
+// for the same pair, side and order type.
+type MsgReplaceLimitOrder struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TrancheKey string `protobuf:"bytes,2,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	// Amount of the new limit order. If omitted, the maker reserves returned by
+	// the cancellation are re-placed.
+	AmountIn *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	// Price of the new limit order. If omitted, the price of the canceled order is used.
+	LimitSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,4,opt,name=limit_sell_price,json=limitSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"limit_sell_price" yaml:"limit_sell_price"`
+	// New expiration time; only valid for GOOD_TIL_TIME orders. If omitted, the
+	// expiration time of the canceled order is used.
+	ExpirationTime *time.Time `protobuf:"bytes,5,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+}
+
+func (m *MsgReplaceLimitOrder) Reset()         { *m = MsgReplaceLimitOrder{} }
+func (m *MsgReplaceLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceLimitOrder) ProtoMessage()    {}
+func (*MsgReplaceLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{14}
+}
+func (m *MsgReplaceLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceLimitOrder.Merge(m, src)
+}
+func (m *MsgReplaceLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceLimitOrder proto.InternalMessageInfo
+
+func (m *MsgReplaceLimitOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgReplaceLimitOrder) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return ""
+}
+
+func (m *MsgReplaceLimitOrder) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
+type MsgReplaceLimitOrderResponse struct {
+	// Total amount of taker reserves withdrawn from the canceled limit order
+	CanceledTakerCoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=canceled_taker_coin_out,json=canceledTakerCoinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"canceled_taker_coin_out" yaml:"canceled_taker_coin_out"`
+	// Total amount of maker reserves returned from the canceled limit order
+	CanceledMakerCoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=canceled_maker_coin_out,json=canceledMakerCoinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"canceled_maker_coin_out" yaml:"canceled_maker_coin_out"`
+	// Tranche key of the new limit order
+	TrancheKey string `protobuf:"bytes,3,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	// Total amount of coin used for the new limit order
+	CoinIn github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=coin_in,json=coinIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_in" yaml:"coin_in"`
+	// Total amount of coin received from the taker portion of the new limit order
+	TakerCoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=taker_coin_out,json=takerCoinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"taker_coin_out" yaml:"taker_coin_out"`
+	// Total amount of the token in that was immediately swapped for taker_coin_out
+	TakerCoinIn github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=taker_coin_in,json=takerCoinIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"taker_coin_in" yaml:"taker_coin_in"`
+}
+
+func (m *MsgReplaceLimitOrderResponse) Reset()         { *m = MsgReplaceLimitOrderResponse{} }
+func (m *MsgReplaceLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceLimitOrderResponse) ProtoMessage()    {}
+func (*MsgReplaceLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{15}
+}
+func (m *MsgReplaceLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceLimitOrderResponse.Merge(m, src)
+}
+func (m *MsgReplaceLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceLimitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceLimitOrderResponse proto.InternalMessageInfo
+
+func (m *MsgReplaceLimitOrderResponse) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return ""
+}
+
 type MultiHopRoute struct {
 	Hops []string `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops,omitempty"`
 }
@@ -789,7 +1059,7 @@ func (m *MultiHopRoute) Reset()         { *m = MultiHopRoute{} }
 func (m *MultiHopRoute) String() string { return proto.CompactTextString(m) }
 func (*MultiHopRoute) ProtoMessage()    {}
 func (*MultiHopRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a489f6e187d5e074, []int{12}
+	return fileDescriptor_a489f6e187d5e074, []int{16}
 }
 func (m *MultiHopRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +1110,7 @@ func (m *MsgMultiHopSwap) Reset()         { *m = MsgMultiHopSwap{} }
 func (m *MsgMultiHopSwap) String() string { return proto.CompactTextString(m) }
 func (*MsgMultiHopSwap) ProtoMessage()    {}
 func (*MsgMultiHopSwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a489f6e187d5e074, []int{13}
+	return fileDescriptor_a489f6e187d5e074, []int{17}
 }
 func (m *MsgMultiHopSwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +1177,7 @@ func (m *MsgMultiHopSwapResponse) Reset()         { *m = MsgMultiHopSwapResponse
 func (m *MsgMultiHopSwapResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMultiHopSwapResponse) ProtoMessage()    {}
 func (*MsgMultiHopSwapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a489f6e187d5e074, []int{14}
+	return fileDescriptor_a489f6e187d5e074, []int{18}
 }
 func (m *MsgMultiHopSwapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -954,7 +1224,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a489f6e187d5e074, []int{15}
+	return fileDescriptor_a489f6e187d5e074, []int{19}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1008,7 +1278,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a489f6e187d5e074, []int{16}
+	return fileDescriptor_a489f6e187d5e074, []int{20}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1037,2016 +1307,10699 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("neutron.dex.LimitOrderType", LimitOrderType_name, LimitOrderType_value)
-	proto.RegisterType((*DepositOptions)(nil), "neutron.dex.DepositOptions")
-	proto.RegisterType((*MsgDeposit)(nil), "neutron.dex.MsgDeposit")
-	proto.RegisterType((*FailedDeposit)(nil), "neutron.dex.FailedDeposit")
-	proto.RegisterType((*MsgDepositResponse)(nil), "neutron.dex.MsgDepositResponse")
-	proto.RegisterType((*MsgWithdrawal)(nil), "neutron.dex.MsgWithdrawal")
-	proto.RegisterType((*MsgWithdrawalResponse)(nil), "neutron.dex.MsgWithdrawalResponse")
-	proto.RegisterType((*MsgPlaceLimitOrder)(nil), "neutron.dex.MsgPlaceLimitOrder")
-	proto.RegisterType((*MsgPlaceLimitOrderResponse)(nil), "neutron.dex.MsgPlaceLimitOrderResponse")
-	proto.RegisterType((*MsgWithdrawFilledLimitOrder)(nil), "neutron.dex.MsgWithdrawFilledLimitOrder")
-	proto.RegisterType((*MsgWithdrawFilledLimitOrderResponse)(nil), "neutron.dex.MsgWithdrawFilledLimitOrderResponse")
-	proto.RegisterType((*MsgCancelLimitOrder)(nil), "neutron.dex.MsgCancelLimitOrder")
-	proto.RegisterType((*MsgCancelLimitOrderResponse)(nil), "neutron.dex.MsgCancelLimitOrderResponse")
-	proto.RegisterType((*MultiHopRoute)(nil), "neutron.dex.MultiHopRoute")
-	proto.RegisterType((*MsgMultiHopSwap)(nil), "neutron.dex.MsgMultiHopSwap")
-	proto.RegisterType((*MsgMultiHopSwapResponse)(nil), "neutron.dex.MsgMultiHopSwapResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "neutron.dex.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "neutron.dex.MsgUpdateParamsResponse")
+type MsgSetPairTradingStatus struct {
+	// Authority is the address of the governance account.
+	Authority string        `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	TokenA    string        `protobuf:"bytes,2,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
+	TokenB    string        `protobuf:"bytes,3,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	Status    TradingStatus `protobuf:"varint,4,opt,name=status,proto3,enum=neutron.dex.TradingStatus" json:"status,omitempty"`
 }
 
-func init() { proto.RegisterFile("neutron/dex/tx.proto", fileDescriptor_a489f6e187d5e074) }
-
-var fileDescriptor_a489f6e187d5e074 = []byte{
-	// 1940 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x6f, 0x23, 0x49,
-	0x19, 0x4f, 0xdb, 0x79, 0xd8, 0x95, 0xc4, 0xf1, 0x74, 0x32, 0x93, 0x1e, 0x0f, 0xa4, 0xad, 0x9e,
-	0xd5, 0x8e, 0x19, 0x31, 0xf6, 0x78, 0x60, 0xf7, 0x90, 0x03, 0x92, 0x9d, 0xc7, 0xae, 0x59, 0x7b,
-	0x12, 0xf5, 0x78, 0x05, 0xda, 0x95, 0x68, 0xda, 0xee, 0x8a, 0xd3, 0x4a, 0x77, 0x97, 0xd5, 0x55,
-	0x76, 0x1c, 0x2e, 0xac, 0x10, 0xa7, 0x3d, 0xed, 0x05, 0x81, 0xc4, 0x3f, 0x00, 0x12, 0x87, 0x39,
-	0xec, 0x99, 0xf3, 0x70, 0x5b, 0x21, 0x21, 0x01, 0x07, 0x03, 0x33, 0x87, 0x91, 0xf6, 0x98, 0x03,
-	0x5c, 0x10, 0x42, 0xf5, 0x70, 0xbf, 0xec, 0x24, 0x93, 0xdd, 0x59, 0xc4, 0x61, 0x2f, 0x71, 0xd7,
-	0xef, 0xfb, 0xea, 0xab, 0x5f, 0xd5, 0xf7, 0xa8, 0xaf, 0x3b, 0x60, 0xc3, 0x83, 0x03, 0xe2, 0x23,
-	0xaf, 0x62, 0xc1, 0x51, 0x85, 0x8c, 0xca, 0x7d, 0x1f, 0x11, 0x24, 0x2f, 0x0b, 0xb4, 0x6c, 0xc1,
-	0x51, 0xe1, 0x86, 0xe9, 0xda, 0x1e, 0xaa, 0xb0, 0xbf, 0x5c, 0x5e, 0xd8, 0xea, 0x22, 0xec, 0x22,
-	0x5c, 0xe9, 0x98, 0x18, 0x56, 0x86, 0xd5, 0x0e, 0x24, 0x66, 0xb5, 0xd2, 0x45, 0xb6, 0x27, 0xe4,
-	0x9b, 0x42, 0xee, 0xe2, 0x5e, 0x65, 0x58, 0xa5, 0x3f, 0x42, 0x70, 0x9b, 0x0b, 0x0c, 0x36, 0xaa,
-	0xf0, 0x81, 0x10, 0x6d, 0xf4, 0x50, 0x0f, 0x71, 0x9c, 0x3e, 0x09, 0x54, 0xed, 0x21, 0xd4, 0x73,
-	0x60, 0x85, 0x8d, 0x3a, 0x83, 0xa3, 0x0a, 0xb1, 0x5d, 0x88, 0x89, 0xe9, 0xf6, 0x85, 0x82, 0x12,
-	0xdd, 0x40, 0xdf, 0xf4, 0x4d, 0x57, 0x18, 0xd4, 0x7e, 0x0c, 0x72, 0xbb, 0xb0, 0x8f, 0xb0, 0x4d,
-	0x0e, 0xfa, 0xc4, 0x46, 0x1e, 0x96, 0xbf, 0x05, 0xf2, 0x96, 0x8d, 0xcd, 0x8e, 0x03, 0x0d, 0x73,
-	0x40, 0x10, 0x3e, 0x35, 0xfb, 0x8a, 0x54, 0x94, 0x4a, 0x19, 0x7d, 0x4d, 0xe0, 0x35, 0x01, 0xcb,
-	0x77, 0x41, 0xee, 0xc8, 0xb4, 0x1d, 0x83, 0x8c, 0x0c, 0xe4, 0x19, 0x1d, 0xe8, 0x28, 0x29, 0xa6,
-	0xb8, 0x4c, 0xd1, 0xf6, 0xe8, 0xc0, 0xab, 0x43, 0x47, 0x7b, 0x96, 0x06, 0xa0, 0x85, 0x7b, 0x62,
-	0x15, 0x59, 0x01, 0x4b, 0x5d, 0x1f, 0x9a, 0x04, 0xf9, 0xcc, 0x6a, 0x56, 0x9f, 0x0c, 0xe5, 0x02,
-	0xc8, 0xf8, 0xb0, 0x0b, 0xed, 0x21, 0xf4, 0x99, 0x9d, 0xac, 0x1e, 0x8c, 0xe5, 0x4d, 0xb0, 0x44,
-	0xd0, 0x09, 0xf4, 0x0c, 0x53, 0x49, 0x33, 0xd1, 0x22, 0x1b, 0xd6, 0x42, 0x41, 0x47, 0x99, 0x8f,
-	0x08, 0xea, 0xf2, 0x87, 0x20, 0x6b, 0xba, 0x68, 0xe0, 0x11, 0x6c, 0x98, 0xca, 0x42, 0x31, 0x5d,
-	0xca, 0xd6, 0xbf, 0xf7, 0x6c, 0xac, 0xce, 0xfd, 0x75, 0xac, 0xde, 0xe4, 0x47, 0x8a, 0xad, 0x93,
-	0xb2, 0x8d, 0x2a, 0xae, 0x49, 0x8e, 0xcb, 0x0d, 0x8f, 0x7c, 0x3e, 0x56, 0xc3, 0x19, 0xe7, 0x63,
-	0x35, 0x7f, 0x66, 0xba, 0xce, 0xb6, 0x16, 0x40, 0x9a, 0x9e, 0x11, 0xcf, 0xb5, 0xa8, 0xf1, 0x8e,
-	0xb2, 0x78, 0x4d, 0xe3, 0x9d, 0x69, 0xe3, 0x9d, 0xd0, 0x78, 0x5d, 0xfe, 0x36, 0x58, 0x27, 0x76,
-	0xf7, 0xc4, 0xb0, 0x3d, 0x0b, 0x8e, 0x20, 0x36, 0x4c, 0x83, 0x20, 0xa3, 0xa3, 0x2c, 0x15, 0xd3,
-	0xa5, 0xb4, 0xbe, 0x46, 0x45, 0x0d, 0x2e, 0xa9, 0xb5, 0x51, 0x5d, 0x96, 0xc1, 0xfc, 0x11, 0x84,
-	0x58, 0xc9, 0x14, 0xd3, 0xa5, 0x79, 0x9d, 0x3d, 0xcb, 0x6f, 0x81, 0x25, 0xc4, 0xbd, 0xa9, 0x64,
-	0x8b, 0xe9, 0xd2, 0xf2, 0xa3, 0x3b, 0xe5, 0x48, 0xac, 0x96, 0xe3, 0x0e, 0xd7, 0x27, 0xba, 0xdb,
-	0xea, 0xcf, 0x5e, 0x3e, 0xbd, 0x3f, 0x71, 0xc7, 0xc7, 0x2f, 0x9f, 0xde, 0xcf, 0xd1, 0x70, 0x09,
-	0x7d, 0xa7, 0xed, 0x83, 0xd5, 0x7d, 0xd3, 0x76, 0xa0, 0x35, 0x71, 0xa6, 0x0a, 0x96, 0x2d, 0xfe,
-	0x68, 0xd8, 0xd6, 0x88, 0x39, 0x74, 0x5e, 0x07, 0x02, 0x6a, 0x58, 0x23, 0x79, 0x03, 0x2c, 0x40,
-	0xdf, 0x47, 0x13, 0x87, 0xf2, 0x81, 0xf6, 0xcf, 0x34, 0x90, 0x43, 0xb3, 0x3a, 0xc4, 0x7d, 0xe4,
-	0x61, 0x28, 0xff, 0x14, 0xc8, 0x3e, 0xc4, 0xd0, 0x1f, 0xc2, 0x87, 0x86, 0xb0, 0x01, 0x2d, 0x45,
-	0x62, 0xc7, 0x7b, 0x78, 0xd5, 0xf1, 0xce, 0x98, 0x7a, 0x3e, 0x56, 0x6f, 0xf3, 0x73, 0x9e, 0x96,
-	0x69, 0xfa, 0x8d, 0x09, 0xb8, 0x3b, 0xc1, 0x22, 0x04, 0xaa, 0x11, 0x02, 0xa9, 0xeb, 0x11, 0xa8,
-	0x5e, 0x42, 0xa0, 0x3a, 0x8b, 0x40, 0x35, 0x24, 0xb0, 0x03, 0xd6, 0x8e, 0xd8, 0x01, 0x4f, 0xf4,
-	0xb0, 0x92, 0x66, 0x0e, 0x2c, 0xc4, 0x1c, 0x18, 0x73, 0x82, 0x9e, 0x3b, 0x8a, 0x0e, 0xb1, 0xfc,
-	0x2b, 0x09, 0xac, 0xe2, 0x63, 0xd3, 0x87, 0xd8, 0xb0, 0x31, 0x1e, 0x40, 0x4b, 0x99, 0x67, 0x36,
-	0x6e, 0x97, 0x45, 0x29, 0xa1, 0x05, 0xa9, 0x2c, 0x0a, 0x52, 0x79, 0x07, 0xd9, 0x5e, 0xfd, 0x87,
-	0x62, 0x73, 0xf7, 0x7a, 0x36, 0x39, 0x1e, 0x74, 0xca, 0x5d, 0xe4, 0x8a, 0xba, 0x23, 0x7e, 0x1e,
-	0x60, 0xeb, 0xa4, 0x42, 0xce, 0xfa, 0x10, 0xb3, 0x09, 0x9f, 0x8f, 0xd5, 0xf8, 0x12, 0xe7, 0x63,
-	0x75, 0x83, 0xef, 0x34, 0x06, 0x6b, 0xfa, 0x0a, 0x1f, 0x37, 0xf8, 0xf0, 0x4f, 0x29, 0xb0, 0xda,
-	0xc2, 0xbd, 0x1f, 0xd8, 0xe4, 0xd8, 0xf2, 0xcd, 0x53, 0xd3, 0xf9, 0x9f, 0x95, 0x83, 0x21, 0xc8,
-	0x0b, 0x66, 0x04, 0x19, 0x3e, 0x74, 0xd1, 0x10, 0x8a, 0xaa, 0xd0, 0xbc, 0xca, 0xb1, 0x53, 0x13,
-	0xcf, 0xc7, 0xea, 0x66, 0x6c, 0xb3, 0x81, 0x44, 0xd3, 0x73, 0x1c, 0x6a, 0x23, 0x9d, 0x01, 0x17,
-	0x25, 0xf3, 0xe2, 0xe5, 0xc9, 0xbc, 0x14, 0x26, 0xf3, 0xb6, 0x96, 0xcc, 0xca, 0x1b, 0x22, 0x2b,
-	0xc3, 0x53, 0xd4, 0x3e, 0x4d, 0x83, 0x9b, 0x31, 0x64, 0x66, 0x4e, 0x9d, 0x0a, 0xb1, 0xc7, 0x8f,
-	0xfa, 0x3a, 0x39, 0x15, 0x4c, 0x9d, 0x91, 0x53, 0x81, 0x2c, 0x92, 0x53, 0x13, 0x26, 0x5e, 0x2c,
-	0xa7, 0x42, 0x02, 0xa9, 0xeb, 0x11, 0xa8, 0x5e, 0x42, 0xa0, 0x3a, 0x8b, 0x40, 0x35, 0x24, 0x10,
-	0x49, 0x87, 0xce, 0xc0, 0xf7, 0xa0, 0x25, 0x52, 0xea, 0xab, 0x49, 0x07, 0xbe, 0xc4, 0x54, 0x3a,
-	0x70, 0x38, 0x48, 0x87, 0x3a, 0x1f, 0xfe, 0x67, 0x91, 0xd5, 0xc1, 0x43, 0xc7, 0xec, 0xc2, 0xa6,
-	0xed, 0xda, 0xe4, 0xc0, 0xb7, 0xa0, 0xff, 0x05, 0x73, 0xe2, 0x36, 0xc8, 0xf0, 0xd0, 0xb7, 0x3d,
-	0x91, 0x14, 0x3c, 0x15, 0x1a, 0x9e, 0x7c, 0x07, 0x64, 0xb9, 0x08, 0x0d, 0x88, 0xc8, 0x0b, 0xae,
-	0x7b, 0x30, 0x20, 0xf2, 0x23, 0xb0, 0x11, 0x46, 0xa8, 0x61, 0x7b, 0x34, 0x40, 0xa9, 0xde, 0x42,
-	0x51, 0x2a, 0xa5, 0xeb, 0x29, 0x45, 0xd2, 0xf3, 0x41, 0x98, 0x36, 0xbc, 0x36, 0xa2, 0x73, 0x82,
-	0xfb, 0x8f, 0x2e, 0xb6, 0xc4, 0x7c, 0xf9, 0xaa, 0xf7, 0x9f, 0x61, 0x7b, 0xc9, 0xfb, 0xcf, 0xb0,
-	0xbd, 0xe0, 0xfe, 0x6b, 0x78, 0xf2, 0x36, 0x00, 0x88, 0x9e, 0x83, 0x41, 0x0f, 0x58, 0xc9, 0x14,
-	0xa5, 0x52, 0x2e, 0x71, 0x81, 0x85, 0x67, 0xd5, 0x3e, 0xeb, 0x43, 0x3d, 0x8b, 0x26, 0x8f, 0x72,
-	0x0b, 0xac, 0xc1, 0x51, 0xdf, 0xf6, 0x4d, 0x7a, 0xa3, 0x19, 0xb4, 0x0d, 0x52, 0xb2, 0x45, 0x89,
-	0x15, 0x50, 0xde, 0x23, 0x95, 0x27, 0x3d, 0x52, 0xb9, 0x3d, 0xe9, 0x91, 0xea, 0x99, 0x67, 0x63,
-	0x55, 0xfa, 0xe4, 0x6f, 0xaa, 0xa4, 0xe7, 0xc2, 0xc9, 0x54, 0x2c, 0x7b, 0x20, 0xe7, 0x9a, 0x23,
-	0x43, 0xd0, 0xa4, 0xa7, 0x02, 0xd8, 0x66, 0xdf, 0xa5, 0x33, 0x2e, 0xdb, 0x6c, 0x62, 0xda, 0xf9,
-	0x58, 0xbd, 0xc9, 0x77, 0x1c, 0xc7, 0x35, 0x7d, 0xc5, 0x35, 0x47, 0x35, 0x36, 0xa6, 0xe7, 0xfa,
-	0x0b, 0x09, 0xe4, 0x1d, 0xba, 0x39, 0x03, 0x43, 0xc7, 0x31, 0xfa, 0xbe, 0xdd, 0x85, 0xca, 0x32,
-	0x5b, 0xf2, 0x44, 0x2c, 0xf9, 0xdd, 0x48, 0x4c, 0x8a, 0x33, 0x79, 0x80, 0xfc, 0xde, 0xe4, 0xb9,
-	0x32, 0x7c, 0xab, 0x32, 0x20, 0xb6, 0x83, 0x39, 0x9b, 0x43, 0x1f, 0x76, 0x77, 0x61, 0x97, 0x56,
-	0xb1, 0xa4, 0xdd, 0xb0, 0x8a, 0x25, 0x25, 0x9a, 0x9e, 0x63, 0xd0, 0x13, 0xe8, 0x38, 0x87, 0x14,
-	0x90, 0x7f, 0x27, 0x81, 0x5b, 0xae, 0xed, 0x19, 0xe6, 0x10, 0xfa, 0x66, 0x0f, 0x46, 0xd9, 0xad,
-	0x30, 0x76, 0xa7, 0x5f, 0x92, 0xdd, 0x05, 0xd6, 0xcf, 0xc7, 0xea, 0x37, 0xc5, 0xb9, 0xcd, 0x94,
-	0x6b, 0xfa, 0xba, 0x6b, 0x7b, 0x35, 0x8e, 0x07, 0x74, 0xb7, 0xef, 0x25, 0x4b, 0xe6, 0x2d, 0x51,
-	0x32, 0x13, 0x99, 0xa6, 0xfd, 0x2b, 0x0d, 0x0a, 0xd3, 0x70, 0x50, 0x3c, 0xb7, 0x00, 0x20, 0xbe,
-	0xe9, 0x75, 0x8f, 0xe1, 0x7b, 0xf0, 0x4c, 0xe4, 0x62, 0x04, 0x91, 0x3f, 0x92, 0xc0, 0x12, 0x6d,
-	0xe8, 0x69, 0x16, 0xa4, 0x58, 0x98, 0x5d, 0x52, 0x54, 0x9a, 0xd7, 0x2f, 0x2a, 0x13, 0xe3, 0xe7,
-	0x63, 0x35, 0xc7, 0x8f, 0x41, 0x00, 0x9a, 0xbe, 0x48, 0x9f, 0x1a, 0x9e, 0xfc, 0x6b, 0x09, 0xe4,
-	0x88, 0x79, 0x02, 0x7d, 0x83, 0x89, 0x68, 0x88, 0xa6, 0xaf, 0x62, 0xf2, 0xc1, 0xf5, 0x99, 0x24,
-	0xd6, 0x08, 0xe3, 0x39, 0x8e, 0x6b, 0xfa, 0x0a, 0x03, 0xe8, 0x2c, 0x1a, 0xcf, 0xbf, 0x94, 0xc0,
-	0x6a, 0x44, 0xc3, 0xf6, 0x58, 0xf5, 0x79, 0xed, 0xb5, 0x37, 0xb6, 0x44, 0x58, 0x7b, 0x63, 0xb0,
-	0xa6, 0x2f, 0x07, 0xd4, 0x1a, 0x9e, 0xf6, 0xb1, 0x04, 0xee, 0x44, 0x6e, 0xcc, 0x7d, 0xdb, 0x71,
-	0xa0, 0xf5, 0x4a, 0x35, 0x58, 0x05, 0xcb, 0x22, 0x04, 0x8c, 0x13, 0x78, 0x26, 0xca, 0x70, 0x24,
-	0x2a, 0xb6, 0x1f, 0x26, 0xa3, 0x4f, 0x4d, 0x5c, 0xd8, 0xc9, 0xc5, 0xb4, 0x7f, 0xa4, 0xc0, 0xdd,
-	0x4b, 0xe4, 0x41, 0x3c, 0xce, 0x70, 0xb6, 0xf4, 0xff, 0xe3, 0x6c, 0xca, 0xce, 0x8d, 0xb3, 0x4b,
-	0x7d, 0x15, 0xec, 0xdc, 0x0b, 0xd8, 0xb9, 0x49, 0x76, 0x6e, 0x84, 0x9d, 0xf6, 0x13, 0xb0, 0xde,
-	0xc2, 0xbd, 0x1d, 0xd3, 0xeb, 0x42, 0xe7, 0xf5, 0xf8, 0xb9, 0x94, 0xf4, 0xf3, 0xa6, 0xf0, 0x73,
-	0x72, 0x11, 0xed, 0x2f, 0x29, 0x16, 0x6c, 0x49, 0xfc, 0x6b, 0xbf, 0xbe, 0x06, 0xbf, 0xde, 0x05,
-	0xab, 0xad, 0x81, 0x43, 0xec, 0x77, 0x51, 0x5f, 0x47, 0x03, 0x02, 0x69, 0x0f, 0x7d, 0x8c, 0xfa,
-	0x98, 0xbf, 0x37, 0xea, 0xec, 0x59, 0xfb, 0x7d, 0x1a, 0xac, 0xb5, 0x70, 0x6f, 0xa2, 0xf8, 0xe4,
-	0xd4, 0xec, 0x7f, 0xc1, 0x2e, 0xeb, 0x11, 0x58, 0xf4, 0xe9, 0x32, 0xb3, 0x5f, 0xcc, 0x62, 0x4c,
-	0x74, 0xa1, 0x19, 0xef, 0x96, 0xe6, 0x5f, 0x73, 0xb7, 0x44, 0x5b, 0x06, 0x38, 0xb2, 0x89, 0xc1,
-	0x6f, 0x71, 0x7e, 0x29, 0x2f, 0x04, 0x2d, 0xc3, 0xdc, 0x97, 0x69, 0x19, 0x92, 0x76, 0xc3, 0x96,
-	0x21, 0x29, 0xd1, 0x68, 0xeb, 0x64, 0x13, 0x16, 0xdb, 0xbc, 0x65, 0x78, 0x13, 0xac, 0xf5, 0x69,
-	0x5b, 0xd9, 0x81, 0x98, 0x18, 0xec, 0x20, 0x94, 0x45, 0xf6, 0x71, 0x68, 0x95, 0xc2, 0x75, 0x88,
-	0x09, 0x3b, 0xa4, 0xed, 0x37, 0x92, 0x59, 0xb4, 0x2e, 0xb2, 0x28, 0xea, 0x2c, 0xed, 0x0f, 0x29,
-	0xb0, 0x99, 0xc0, 0x82, 0xec, 0xf9, 0xb9, 0x04, 0x32, 0xaf, 0x9e, 0x37, 0x8f, 0xaf, 0x1f, 0x99,
-	0x99, 0x48, 0x4c, 0xae, 0x45, 0xee, 0x61, 0x16, 0x8d, 0xec, 0x8e, 0xa6, 0x69, 0xf2, 0x10, 0x2c,
-	0xf0, 0x6d, 0xa6, 0x44, 0xc3, 0x79, 0x71, 0x60, 0x70, 0x45, 0x79, 0x00, 0xe6, 0xad, 0x01, 0x26,
-	0x57, 0xbf, 0x8f, 0xec, 0x5f, 0x9f, 0x33, 0xb3, 0x7c, 0x3e, 0x56, 0x97, 0x39, 0x5f, 0x3a, 0xd2,
-	0x74, 0x06, 0x6a, 0xbf, 0x95, 0x58, 0x32, 0xbc, 0xdf, 0xb7, 0x4c, 0x02, 0x0f, 0xd9, 0xc7, 0x40,
-	0xf9, 0x6d, 0x90, 0x35, 0x07, 0xe4, 0x18, 0xf9, 0x36, 0x11, 0x8d, 0x4e, 0x5d, 0xf9, 0xe3, 0xa7,
-	0x0f, 0x36, 0x04, 0xa5, 0x9a, 0x65, 0xf9, 0x10, 0xe3, 0x27, 0xc4, 0xb7, 0xbd, 0x9e, 0x1e, 0xaa,
-	0xca, 0x6f, 0x83, 0x45, 0xfe, 0x39, 0x51, 0xec, 0x7a, 0x3d, 0xb6, 0x6b, 0x6e, 0xbc, 0x9e, 0xa5,
-	0xf4, 0x7f, 0xf3, 0xf2, 0xe9, 0x7d, 0x49, 0x17, 0xda, 0xdb, 0x6f, 0x52, 0xaf, 0x87, 0x76, 0xa2,
-	0x7e, 0x8f, 0xf2, 0xd2, 0x6e, 0x33, 0xb7, 0x47, 0xa1, 0x89, 0xdb, 0xef, 0x8f, 0x40, 0x2e, 0xfe,
-	0x1e, 0x20, 0xdf, 0x02, 0xf2, 0x3b, 0x07, 0x07, 0xbb, 0x46, 0xbb, 0xd1, 0x34, 0x76, 0x6a, 0x8f,
-	0x77, 0xf6, 0x9a, 0xcd, 0xbd, 0xdd, 0xfc, 0x9c, 0x9c, 0x07, 0x2b, 0xfb, 0x8d, 0x66, 0xd3, 0x38,
-	0xd0, 0x8d, 0xf7, 0x1a, 0xcd, 0x66, 0x5e, 0x92, 0x37, 0xc1, 0x7a, 0xa3, 0xd5, 0xda, 0xdb, 0x6d,
-	0xd4, 0xda, 0x7b, 0x14, 0xe6, 0xda, 0xf9, 0x14, 0x55, 0xfd, 0xfe, 0xfb, 0x4f, 0xda, 0x46, 0xe3,
-	0xb1, 0xd1, 0x6e, 0xb4, 0xf6, 0xf2, 0x69, 0xf9, 0x06, 0x58, 0x0d, 0x8c, 0x32, 0x68, 0xfe, 0xd1,
-	0xbf, 0xe7, 0x41, 0xba, 0x85, 0x7b, 0xf2, 0x0e, 0x58, 0x9a, 0x7c, 0x08, 0xdb, 0x8c, 0x7b, 0x3b,
-	0xf8, 0xb6, 0x55, 0x50, 0x2f, 0x10, 0x04, 0xd1, 0xdb, 0x04, 0x20, 0xf2, 0x39, 0xa4, 0x90, 0x54,
-	0x0f, 0x65, 0x05, 0xed, 0x62, 0x59, 0x60, 0xed, 0x43, 0xb0, 0x96, 0x7c, 0x9b, 0x9c, 0x62, 0x90,
-	0x50, 0x28, 0xdc, 0xbb, 0x42, 0x21, 0x30, 0x3e, 0x04, 0xca, 0x85, 0xfd, 0x52, 0xe9, 0x22, 0x72,
-	0x49, 0xcd, 0xc2, 0xc3, 0x57, 0xd5, 0x0c, 0xd6, 0xfd, 0x11, 0xc8, 0x4f, 0xdd, 0xdb, 0xc5, 0xa4,
-	0x95, 0xa4, 0x46, 0xa1, 0x74, 0x95, 0x46, 0x60, 0x5f, 0x07, 0x2b, 0xb1, 0x9b, 0xe1, 0x1b, 0xc9,
-	0x99, 0x51, 0x69, 0xe1, 0x8d, 0xcb, 0xa4, 0x51, 0x9b, 0xb1, 0x04, 0x9b, 0xb2, 0x19, 0x95, 0x4e,
-	0xdb, 0x9c, 0x15, 0xf1, 0x85, 0x85, 0x8f, 0x68, 0x0e, 0xd5, 0xdf, 0x79, 0xf6, 0x7c, 0x4b, 0xfa,
-	0xec, 0xf9, 0x96, 0xf4, 0xf7, 0xe7, 0x5b, 0xd2, 0x27, 0x2f, 0xb6, 0xe6, 0x3e, 0x7b, 0xb1, 0x35,
-	0xf7, 0xe7, 0x17, 0x5b, 0x73, 0x1f, 0x3c, 0xb8, 0xba, 0xd0, 0x8f, 0xf8, 0xff, 0x30, 0x68, 0xa9,
-	0xe8, 0x2c, 0xb2, 0x77, 0xe1, 0xef, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x95, 0x04, 0x7c, 0xe0,
-	0xdf, 0x18, 0x00, 0x00,
+func (m *MsgSetPairTradingStatus) Reset()         { *m = MsgSetPairTradingStatus{} }
+func (m *MsgSetPairTradingStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPairTradingStatus) ProtoMessage()    {}
+func (*MsgSetPairTradingStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{21}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
-	Withdrawal(ctx context.Context, in *MsgWithdrawal, opts ...grpc.CallOption) (*MsgWithdrawalResponse, error)
-	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
-	WithdrawFilledLimitOrder(ctx context.Context, in *MsgWithdrawFilledLimitOrder, opts ...grpc.CallOption) (*MsgWithdrawFilledLimitOrderResponse, error)
-	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
-	MultiHopSwap(ctx context.Context, in *MsgMultiHopSwap, opts ...grpc.CallOption) (*MsgMultiHopSwapResponse, error)
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgSetPairTradingStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetPairTradingStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPairTradingStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetPairTradingStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPairTradingStatus.Merge(m, src)
+}
+func (m *MsgSetPairTradingStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPairTradingStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPairTradingStatus.DiscardUnknown(m)
 }
 
-func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
-	out := new(MsgDepositResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetPairTradingStatus proto.InternalMessageInfo
+
+func (m *MsgSetPairTradingStatus) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Withdrawal(ctx context.Context, in *MsgWithdrawal, opts ...grpc.CallOption) (*MsgWithdrawalResponse, error) {
-	out := new(MsgWithdrawalResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Withdrawal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetPairTradingStatus) GetTokenA() string {
+	if m != nil {
+		return m.TokenA
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
-	out := new(MsgPlaceLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/PlaceLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetPairTradingStatus) GetTokenB() string {
+	if m != nil {
+		return m.TokenB
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) WithdrawFilledLimitOrder(ctx context.Context, in *MsgWithdrawFilledLimitOrder, opts ...grpc.CallOption) (*MsgWithdrawFilledLimitOrderResponse, error) {
-	out := new(MsgWithdrawFilledLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/WithdrawFilledLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetPairTradingStatus) GetStatus() TradingStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return TradingStatus_ACTIVE
 }
 
-func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
-	out := new(MsgCancelLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/CancelLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+type MsgSetPairTradingStatusResponse struct {
+}
+
+func (m *MsgSetPairTradingStatusResponse) Reset()         { *m = MsgSetPairTradingStatusResponse{} }
+func (m *MsgSetPairTradingStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPairTradingStatusResponse) ProtoMessage()    {}
+func (*MsgSetPairTradingStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{22}
+}
+func (m *MsgSetPairTradingStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPairTradingStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPairTradingStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetPairTradingStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPairTradingStatusResponse.Merge(m, src)
+}
+func (m *MsgSetPairTradingStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPairTradingStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPairTradingStatusResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) MultiHopSwap(ctx context.Context, in *MsgMultiHopSwap, opts ...grpc.CallOption) (*MsgMultiHopSwapResponse, error) {
-	out := new(MsgMultiHopSwapResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/MultiHopSwap", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetPairTradingStatusResponse proto.InternalMessageInfo
+
+type MsgSetDenomTradingStatus struct {
+	// Authority is the address of the governance account.
+	Authority string        `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Status    TradingStatus `protobuf:"varint,3,opt,name=status,proto3,enum=neutron.dex.TradingStatus" json:"status,omitempty"`
+}
+
+func (m *MsgSetDenomTradingStatus) Reset()         { *m = MsgSetDenomTradingStatus{} }
+func (m *MsgSetDenomTradingStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomTradingStatus) ProtoMessage()    {}
+func (*MsgSetDenomTradingStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{23}
+}
+func (m *MsgSetDenomTradingStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomTradingStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomTradingStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetDenomTradingStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomTradingStatus.Merge(m, src)
+}
+func (m *MsgSetDenomTradingStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomTradingStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomTradingStatus.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetDenomTradingStatus proto.InternalMessageInfo
+
+func (m *MsgSetDenomTradingStatus) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
-	Withdrawal(context.Context, *MsgWithdrawal) (*MsgWithdrawalResponse, error)
-	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
-	WithdrawFilledLimitOrder(context.Context, *MsgWithdrawFilledLimitOrder) (*MsgWithdrawFilledLimitOrderResponse, error)
-	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
-	MultiHopSwap(context.Context, *MsgMultiHopSwap) (*MsgMultiHopSwapResponse, error)
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgSetDenomTradingStatus) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetDenomTradingStatus) GetStatus() TradingStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TradingStatus_ACTIVE
 }
 
-func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+type MsgSetDenomTradingStatusResponse struct {
 }
-func (*UnimplementedMsgServer) Withdrawal(ctx context.Context, req *MsgWithdrawal) (*MsgWithdrawalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdrawal not implemented")
+
+func (m *MsgSetDenomTradingStatusResponse) Reset()         { *m = MsgSetDenomTradingStatusResponse{} }
+func (m *MsgSetDenomTradingStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomTradingStatusResponse) ProtoMessage()    {}
+func (*MsgSetDenomTradingStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{24}
 }
-func (*UnimplementedMsgServer) PlaceLimitOrder(ctx context.Context, req *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+func (m *MsgSetDenomTradingStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) WithdrawFilledLimitOrder(ctx context.Context, req *MsgWithdrawFilledLimitOrder) (*MsgWithdrawFilledLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFilledLimitOrder not implemented")
+func (m *MsgSetDenomTradingStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomTradingStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CancelLimitOrder(ctx context.Context, req *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+func (m *MsgSetDenomTradingStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomTradingStatusResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) MultiHopSwap(ctx context.Context, req *MsgMultiHopSwap) (*MsgMultiHopSwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiHopSwap not implemented")
+func (m *MsgSetDenomTradingStatusResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSetDenomTradingStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomTradingStatusResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetDenomTradingStatusResponse proto.InternalMessageInfo
+
+// MsgSetPairFeeTiers sets the fee tiers that can be used by the pools of a pair.
+// An empty list removes the override so the pair uses the fee tiers of the params.
+type MsgSetPairFeeTiers struct {
+	// Authority is the address of the governance account.
+	Authority string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	TokenA    string   `protobuf:"bytes,2,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
+	TokenB    string   `protobuf:"bytes,3,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	FeeTiers  []uint64 `protobuf:"varint,4,rep,packed,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers,omitempty"`
 }
 
-func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/Deposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+func (m *MsgSetPairFeeTiers) Reset()         { *m = MsgSetPairFeeTiers{} }
+func (m *MsgSetPairFeeTiers) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPairFeeTiers) ProtoMessage()    {}
+func (*MsgSetPairFeeTiers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{25}
+}
+func (m *MsgSetPairFeeTiers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPairFeeTiers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPairFeeTiers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetPairFeeTiers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPairFeeTiers.Merge(m, src)
+}
+func (m *MsgSetPairFeeTiers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPairFeeTiers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPairFeeTiers.DiscardUnknown(m)
 }
 
-func _Msg_Withdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdrawal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/Withdrawal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdrawal(ctx, req.(*MsgWithdrawal))
+var xxx_messageInfo_MsgSetPairFeeTiers proto.InternalMessageInfo
+
+func (m *MsgSetPairFeeTiers) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPlaceLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/PlaceLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
+func (m *MsgSetPairFeeTiers) GetTokenA() string {
+	if m != nil {
+		return m.TokenA
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_WithdrawFilledLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawFilledLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawFilledLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/WithdrawFilledLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawFilledLimitOrder(ctx, req.(*MsgWithdrawFilledLimitOrder))
+func (m *MsgSetPairFeeTiers) GetTokenB() string {
+	if m != nil {
+		return m.TokenB
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/CancelLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
+func (m *MsgSetPairFeeTiers) GetFeeTiers() []uint64 {
+	if m != nil {
+		return m.FeeTiers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_MultiHopSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMultiHopSwap)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MultiHopSwap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/MultiHopSwap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MultiHopSwap(ctx, req.(*MsgMultiHopSwap))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSetPairFeeTiersResponse struct {
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgSetPairFeeTiersResponse) Reset()         { *m = MsgSetPairFeeTiersResponse{} }
+func (m *MsgSetPairFeeTiersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPairFeeTiersResponse) ProtoMessage()    {}
+func (*MsgSetPairFeeTiersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{26}
+}
+func (m *MsgSetPairFeeTiersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPairFeeTiersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPairFeeTiersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetPairFeeTiersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPairFeeTiersResponse.Merge(m, src)
+}
+func (m *MsgSetPairFeeTiersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPairFeeTiersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPairFeeTiersResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "neutron.dex.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "Withdrawal",
-			Handler:    _Msg_Withdrawal_Handler,
-		},
-		{
-			MethodName: "PlaceLimitOrder",
-			Handler:    _Msg_PlaceLimitOrder_Handler,
-		},
-		{
-			MethodName: "WithdrawFilledLimitOrder",
-			Handler:    _Msg_WithdrawFilledLimitOrder_Handler,
-		},
-		{
-			MethodName: "CancelLimitOrder",
-			Handler:    _Msg_CancelLimitOrder_Handler,
-		},
-		{
-			MethodName: "MultiHopSwap",
-			Handler:    _Msg_MultiHopSwap_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "neutron/dex/tx.proto",
-}
+var xxx_messageInfo_MsgSetPairFeeTiersResponse proto.InternalMessageInfo
 
-func (m *DepositOptions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgSetTraderFeeTier pins an address to a tier of the trader fee schedule. If remove is set the assignment is
+// removed and the tier of the address is derived from its volume again.
+type MsgSetTraderFeeTier struct {
+	// Authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Tier      uint32 `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	Remove    bool   `protobuf:"varint,4,opt,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func (m *DepositOptions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetTraderFeeTier) Reset()         { *m = MsgSetTraderFeeTier{} }
+func (m *MsgSetTraderFeeTier) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTraderFeeTier) ProtoMessage()    {}
+func (*MsgSetTraderFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{27}
 }
-
-func (m *DepositOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FailTxOnBel {
-		i--
-		if m.FailTxOnBel {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.DisableAutoswap {
-		i--
-		if m.DisableAutoswap {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgSetTraderFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTraderFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTraderFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetTraderFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTraderFeeTier.Merge(m, src)
 }
-
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetTraderFeeTier) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.Fees) > 0 {
-		dAtA2 := make([]byte, len(m.Fees)*10)
-		var j1 int
-		for _, num := range m.Fees {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTx(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.TickIndexesAToB) > 0 {
-		dAtA4 := make([]byte, len(m.TickIndexesAToB)*10)
-		var j3 int
-		for _, num1 := range m.TickIndexesAToB {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.AmountsB) > 0 {
-		for iNdEx := len(m.AmountsB) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.AmountsB[iNdEx].Size()
-				i -= size
-				if _, err := m.AmountsB[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.AmountsA) > 0 {
-		for iNdEx := len(m.AmountsA) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.AmountsA[iNdEx].Size()
-				i -= size
-				if _, err := m.AmountsA[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.TokenB) > 0 {
-		i -= len(m.TokenB)
-		copy(dAtA[i:], m.TokenB)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TokenA) > 0 {
-		i -= len(m.TokenA)
-		copy(dAtA[i:], m.TokenA)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetTraderFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTraderFeeTier.DiscardUnknown(m)
 }
 
-func (m *FailedDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetTraderFeeTier proto.InternalMessageInfo
+
+func (m *MsgSetTraderFeeTier) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *FailedDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetTraderFeeTier) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *FailedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.DepositIdx != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DepositIdx))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgSetTraderFeeTier) GetTier() uint32 {
+	if m != nil {
+		return m.Tier
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetTraderFeeTier) GetRemove() bool {
+	if m != nil {
+		return m.Remove
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetTraderFeeTierResponse struct {
 }
 
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SharesIssued) > 0 {
-		for iNdEx := len(m.SharesIssued) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.SharesIssued[iNdEx].Size()
-				i -= size
-				if _, err := m.SharesIssued[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.FailedDeposits) > 0 {
-		for iNdEx := len(m.FailedDeposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FailedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Reserve1Deposited) > 0 {
-		for iNdEx := len(m.Reserve1Deposited) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Reserve1Deposited[iNdEx].Size()
-				i -= size
-				if _, err := m.Reserve1Deposited[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetTraderFeeTierResponse) Reset()         { *m = MsgSetTraderFeeTierResponse{} }
+func (m *MsgSetTraderFeeTierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTraderFeeTierResponse) ProtoMessage()    {}
+func (*MsgSetTraderFeeTierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{28}
+}
+func (m *MsgSetTraderFeeTierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTraderFeeTierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTraderFeeTierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Reserve0Deposited) > 0 {
-		for iNdEx := len(m.Reserve0Deposited) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Reserve0Deposited[iNdEx].Size()
-				i -= size
-				if _, err := m.Reserve0Deposited[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+func (m *MsgSetTraderFeeTierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTraderFeeTierResponse.Merge(m, src)
+}
+func (m *MsgSetTraderFeeTierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTraderFeeTierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTraderFeeTierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTraderFeeTierResponse proto.InternalMessageInfo
+
+// MsgClaimMakerRebates sends all maker rebates accrued by the creator to the creator
+type MsgClaimMakerRebates struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgClaimMakerRebates) Reset()         { *m = MsgClaimMakerRebates{} }
+func (m *MsgClaimMakerRebates) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimMakerRebates) ProtoMessage()    {}
+func (*MsgClaimMakerRebates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{29}
+}
+func (m *MsgClaimMakerRebates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimMakerRebates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimMakerRebates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimMakerRebates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimMakerRebates.Merge(m, src)
+}
+func (m *MsgClaimMakerRebates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimMakerRebates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimMakerRebates.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimMakerRebates proto.InternalMessageInfo
+
+func (m *MsgClaimMakerRebates) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgClaimMakerRebatesResponse struct {
+	Rebates github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rebates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rebates" yaml:"rebates"`
 }
 
-func (m *MsgWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fees) > 0 {
-		dAtA6 := make([]byte, len(m.Fees)*10)
-		var j5 int
-		for _, num := range m.Fees {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
+func (m *MsgClaimMakerRebatesResponse) Reset()         { *m = MsgClaimMakerRebatesResponse{} }
+func (m *MsgClaimMakerRebatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimMakerRebatesResponse) ProtoMessage()    {}
+func (*MsgClaimMakerRebatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{30}
+}
+func (m *MsgClaimMakerRebatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimMakerRebatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimMakerRebatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTx(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if len(m.TickIndexesAToB) > 0 {
-		dAtA8 := make([]byte, len(m.TickIndexesAToB)*10)
-		var j7 int
-		for _, num1 := range m.TickIndexesAToB {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintTx(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *MsgClaimMakerRebatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimMakerRebatesResponse.Merge(m, src)
+}
+func (m *MsgClaimMakerRebatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimMakerRebatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimMakerRebatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimMakerRebatesResponse proto.InternalMessageInfo
+
+func (m *MsgClaimMakerRebatesResponse) GetRebates() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rebates
 	}
-	if len(m.SharesToRemove) > 0 {
-		for iNdEx := len(m.SharesToRemove) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.SharesToRemove[iNdEx].Size()
-				i -= size
-				if _, err := m.SharesToRemove[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	return nil
+}
+
+// MsgSubscribeHooks subscribes the creator contract to dex hook sudo calls for a pair.
+// Only contracts listed in Params.whitelisted_hook_subscribers can subscribe.
+type MsgSubscribeHooks struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenA  string `protobuf:"bytes,2,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
+	TokenB  string `protobuf:"bytes,3,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+}
+
+func (m *MsgSubscribeHooks) Reset()         { *m = MsgSubscribeHooks{} }
+func (m *MsgSubscribeHooks) String() string { return proto.CompactTextString(m) }
+func (*MsgSubscribeHooks) ProtoMessage()    {}
+func (*MsgSubscribeHooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{31}
+}
+func (m *MsgSubscribeHooks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubscribeHooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubscribeHooks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TokenB) > 0 {
-		i -= len(m.TokenB)
-		copy(dAtA[i:], m.TokenB)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TokenA) > 0 {
-		i -= len(m.TokenA)
-		copy(dAtA[i:], m.TokenA)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgSubscribeHooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubscribeHooks.Merge(m, src)
+}
+func (m *MsgSubscribeHooks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubscribeHooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubscribeHooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubscribeHooks proto.InternalMessageInfo
+
+func (m *MsgSubscribeHooks) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSubscribeHooks) GetTokenA() string {
+	if m != nil {
+		return m.TokenA
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubscribeHooks) GetTokenB() string {
+	if m != nil {
+		return m.TokenB
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSubscribeHooksResponse struct {
 }
 
-func (m *MsgWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SharesBurned) > 0 {
-		for iNdEx := len(m.SharesBurned) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.SharesBurned[iNdEx].Size()
-				i -= size
-				if _, err := m.SharesBurned[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgSubscribeHooksResponse) Reset()         { *m = MsgSubscribeHooksResponse{} }
+func (m *MsgSubscribeHooksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubscribeHooksResponse) ProtoMessage()    {}
+func (*MsgSubscribeHooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{32}
+}
+func (m *MsgSubscribeHooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubscribeHooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubscribeHooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	{
-		size := m.Reserve1Withdrawn.Size()
-		i -= size
-		if _, err := m.Reserve1Withdrawn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *MsgSubscribeHooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubscribeHooksResponse.Merge(m, src)
+}
+func (m *MsgSubscribeHooksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubscribeHooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubscribeHooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubscribeHooksResponse proto.InternalMessageInfo
+
+type MsgUnsubscribeHooks struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenA  string `protobuf:"bytes,2,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
+	TokenB  string `protobuf:"bytes,3,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+}
+
+func (m *MsgUnsubscribeHooks) Reset()         { *m = MsgUnsubscribeHooks{} }
+func (m *MsgUnsubscribeHooks) String() string { return proto.CompactTextString(m) }
+func (*MsgUnsubscribeHooks) ProtoMessage()    {}
+func (*MsgUnsubscribeHooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{33}
+}
+func (m *MsgUnsubscribeHooks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnsubscribeHooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnsubscribeHooks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Reserve0Withdrawn.Size()
-		i -= size
-		if _, err := m.Reserve0Withdrawn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgUnsubscribeHooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnsubscribeHooks.Merge(m, src)
+}
+func (m *MsgUnsubscribeHooks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnsubscribeHooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnsubscribeHooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnsubscribeHooks proto.InternalMessageInfo
+
+func (m *MsgUnsubscribeHooks) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnsubscribeHooks) GetTokenA() string {
+	if m != nil {
+		return m.TokenA
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnsubscribeHooks) GetTokenB() string {
+	if m != nil {
+		return m.TokenB
+	}
+	return ""
 }
 
-func (m *MsgPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinAverageSellPrice != nil {
-		{
-			size := m.MinAverageSellPrice.Size()
-			i -= size
-			if _, err := m.MinAverageSellPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+type MsgUnsubscribeHooksResponse struct {
+}
+
+func (m *MsgUnsubscribeHooksResponse) Reset()         { *m = MsgUnsubscribeHooksResponse{} }
+func (m *MsgUnsubscribeHooksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnsubscribeHooksResponse) ProtoMessage()    {}
+func (*MsgUnsubscribeHooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{34}
+}
+func (m *MsgUnsubscribeHooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnsubscribeHooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnsubscribeHooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x62
+		return b[:n], nil
 	}
-	if m.LimitSellPrice != nil {
-		{
-			size := m.LimitSellPrice.Size()
-			i -= size
-			if _, err := m.LimitSellPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgUnsubscribeHooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnsubscribeHooksResponse.Merge(m, src)
+}
+func (m *MsgUnsubscribeHooksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnsubscribeHooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnsubscribeHooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnsubscribeHooksResponse proto.InternalMessageInfo
+
+// MsgDepositRange spreads amount_a and amount_b over the pools from lower_tick_index_a_to_b to
+// upper_tick_index_a_to_b (inclusive), tick_spacing apart, according to distribution_shape.
+// The resulting pool shares are held by the module as a RangePosition owned by receiver.
+type MsgDepositRange struct {
+	Creator            string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receiver           string                `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	TokenA             string                `protobuf:"bytes,3,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
+	TokenB             string                `protobuf:"bytes,4,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	AmountA            cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount_a,json=amountA,proto3,customtype=cosmossdk.io/math.Int" json:"amount_a" yaml:"amount_a"`
+	AmountB            cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount_b,json=amountB,proto3,customtype=cosmossdk.io/math.Int" json:"amount_b" yaml:"amount_b"`
+	LowerTickIndexAToB int64                 `protobuf:"varint,7,opt,name=lower_tick_index_a_to_b,json=lowerTickIndexAToB,proto3" json:"lower_tick_index_a_to_b,omitempty"`
+	UpperTickIndexAToB int64                 `protobuf:"varint,8,opt,name=upper_tick_index_a_to_b,json=upperTickIndexAToB,proto3" json:"upper_tick_index_a_to_b,omitempty"`
+	TickSpacing        uint64                `protobuf:"varint,9,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty"`
+	Fee                uint64                `protobuf:"varint,10,opt,name=fee,proto3" json:"fee,omitempty"`
+	DistributionShape  DistributionShape     `protobuf:"varint,11,opt,name=distribution_shape,json=distributionShape,proto3,enum=neutron.dex.DistributionShape" json:"distribution_shape,omitempty"`
+	Options            *DepositOptions       `protobuf:"bytes,12,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *MsgDepositRange) Reset()         { *m = MsgDepositRange{} }
+func (m *MsgDepositRange) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositRange) ProtoMessage()    {}
+func (*MsgDepositRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{35}
+}
+func (m *MsgDepositRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x5a
+		return b[:n], nil
 	}
-	if m.MaxAmountOut != nil {
-		{
-			size := m.MaxAmountOut.Size()
-			i -= size
-			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+}
+func (m *MsgDepositRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositRange.Merge(m, src)
+}
+func (m *MsgDepositRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositRange proto.InternalMessageInfo
+
+func (m *MsgDepositRange) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if m.ExpirationTime != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
-		if err9 != nil {
-			return 0, err9
-		}
-		i -= n9
-		i = encodeVarintTx(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *MsgDepositRange) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	if m.OrderType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
-		i--
-		dAtA[i] = 0x40
+	return ""
+}
+
+func (m *MsgDepositRange) GetTokenA() string {
+	if m != nil {
+		return m.TokenA
 	}
-	{
-		size := m.AmountIn.Size()
-		i -= size
-		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *MsgDepositRange) GetTokenB() string {
+	if m != nil {
+		return m.TokenB
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.TickIndexInToOut != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TickIndexInToOut))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *MsgDepositRange) GetLowerTickIndexAToB() int64 {
+	if m != nil {
+		return m.LowerTickIndexAToB
 	}
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *MsgDepositRange) GetUpperTickIndexAToB() int64 {
+	if m != nil {
+		return m.UpperTickIndexAToB
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *MsgDepositRange) GetTickSpacing() uint64 {
+	if m != nil {
+		return m.TickSpacing
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *MsgDepositRange) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgDepositRange) GetDistributionShape() DistributionShape {
+	if m != nil {
+		return m.DistributionShape
 	}
-	return len(dAtA) - i, nil
+	return DistributionShape_UNIFORM
 }
 
-func (m *MsgPlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDepositRange) GetOptions() *DepositOptions {
+	if m != nil {
+		return m.Options
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgPlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgDepositRangeResponse struct {
+	PositionId        uint64                                   `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Reserve0Deposited cosmossdk_io_math.Int                    `protobuf:"bytes,2,opt,name=reserve0_deposited,json=reserve0Deposited,proto3,customtype=cosmossdk.io/math.Int" json:"reserve0_deposited" yaml:"reserve0_deposited"`
+	Reserve1Deposited cosmossdk_io_math.Int                    `protobuf:"bytes,3,opt,name=reserve1_deposited,json=reserve1Deposited,proto3,customtype=cosmossdk.io/math.Int" json:"reserve1_deposited" yaml:"reserve1_deposited"`
+	SharesIssued      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=shares_issued,json=sharesIssued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shares_issued" yaml:"shares_issued"`
+	FailedDeposits    []*FailedDeposit                         `protobuf:"bytes,5,rep,name=failed_deposits,json=failedDeposits,proto3" json:"failed_deposits,omitempty"`
 }
 
-func (m *MsgPlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TakerCoinIn.Size()
-		i -= size
-		if _, err := m.TakerCoinIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.TakerCoinOut.Size()
-		i -= size
-		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.CoinIn.Size()
-		i -= size
-		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgDepositRangeResponse) Reset()         { *m = MsgDepositRangeResponse{} }
+func (m *MsgDepositRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositRangeResponse) ProtoMessage()    {}
+func (*MsgDepositRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{36}
+}
+func (m *MsgDepositRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.TrancheKey) > 0 {
-		i -= len(m.TrancheKey)
-		copy(dAtA[i:], m.TrancheKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgWithdrawFilledLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgDepositRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositRangeResponse.Merge(m, src)
 }
-
-func (m *MsgWithdrawFilledLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDepositRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositRangeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawFilledLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TrancheKey) > 0 {
-		i -= len(m.TrancheKey)
-		copy(dAtA[i:], m.TrancheKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgDepositRangeResponse proto.InternalMessageInfo
+
+func (m *MsgDepositRangeResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgDepositRangeResponse) GetSharesIssued() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SharesIssued
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgWithdrawFilledLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDepositRangeResponse) GetFailedDeposits() []*FailedDeposit {
+	if m != nil {
+		return m.FailedDeposits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgWithdrawFilledLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgWithdrawRangePosition withdraws all of the liquidity of a range position to receiver and closes the position
+type MsgWithdrawRangePosition struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receiver   string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	PositionId uint64 `protobuf:"varint,3,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
 }
 
-func (m *MsgWithdrawFilledLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MakerCoinOut.Size()
-		i -= size
-		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TakerCoinOut.Size()
-		i -= size
-		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgWithdrawRangePosition) Reset()         { *m = MsgWithdrawRangePosition{} }
+func (m *MsgWithdrawRangePosition) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRangePosition) ProtoMessage()    {}
+func (*MsgWithdrawRangePosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{37}
+}
+func (m *MsgWithdrawRangePosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRangePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRangePosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCancelLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgWithdrawRangePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRangePosition.Merge(m, src)
 }
-
-func (m *MsgCancelLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawRangePosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRangePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRangePosition.DiscardUnknown(m)
 }
 
-func (m *MsgCancelLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TrancheKey) > 0 {
-		i -= len(m.TrancheKey)
-		copy(dAtA[i:], m.TrancheKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgWithdrawRangePosition proto.InternalMessageInfo
+
+func (m *MsgWithdrawRangePosition) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgWithdrawRangePosition) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawRangePosition) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCancelLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgWithdrawRangePositionResponse struct {
+	Reserve0Withdrawn cosmossdk_io_math.Int                    `protobuf:"bytes,1,opt,name=reserve0_withdrawn,json=reserve0Withdrawn,proto3,customtype=cosmossdk.io/math.Int" json:"reserve0_withdrawn" yaml:"reserve0_withdrawn"`
+	Reserve1Withdrawn cosmossdk_io_math.Int                    `protobuf:"bytes,2,opt,name=reserve1_withdrawn,json=reserve1Withdrawn,proto3,customtype=cosmossdk.io/math.Int" json:"reserve1_withdrawn" yaml:"reserve1_withdrawn"`
+	SharesBurned      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=shares_burned,json=sharesBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shares_burned" yaml:"shares_burned"`
 }
 
-func (m *MsgCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MakerCoinOut.Size()
-		i -= size
-		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TakerCoinOut.Size()
-		i -= size
-		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgWithdrawRangePositionResponse) Reset()         { *m = MsgWithdrawRangePositionResponse{} }
+func (m *MsgWithdrawRangePositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRangePositionResponse) ProtoMessage()    {}
+func (*MsgWithdrawRangePositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{38}
+}
+func (m *MsgWithdrawRangePositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRangePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRangePositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawRangePositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRangePositionResponse.Merge(m, src)
+}
+func (m *MsgWithdrawRangePositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRangePositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRangePositionResponse.DiscardUnknown(m)
 }
 
-func (m *MultiHopRoute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawRangePositionResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawRangePositionResponse) GetSharesBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SharesBurned
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MultiHopRoute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRebalanceRangePosition withdraws all of the liquidity of a range position and deposits it again over a new range.
+// Tick indexes are in terms of the position's token0.
+type MsgRebalanceRangePosition struct {
+	Creator           string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PositionId        uint64            `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	LowerTickIndex    int64             `protobuf:"varint,3,opt,name=lower_tick_index,json=lowerTickIndex,proto3" json:"lower_tick_index,omitempty"`
+	UpperTickIndex    int64             `protobuf:"varint,4,opt,name=upper_tick_index,json=upperTickIndex,proto3" json:"upper_tick_index,omitempty"`
+	TickSpacing       uint64            `protobuf:"varint,5,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty"`
+	DistributionShape DistributionShape `protobuf:"varint,6,opt,name=distribution_shape,json=distributionShape,proto3,enum=neutron.dex.DistributionShape" json:"distribution_shape,omitempty"`
+	Options           *DepositOptions   `protobuf:"bytes,7,opt,name=options,proto3" json:"options,omitempty"`
 }
 
-func (m *MultiHopRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hops) > 0 {
-		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Hops[iNdEx])
-			copy(dAtA[i:], m.Hops[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Hops[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *MsgRebalanceRangePosition) Reset()         { *m = MsgRebalanceRangePosition{} }
+func (m *MsgRebalanceRangePosition) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceRangePosition) ProtoMessage()    {}
+func (*MsgRebalanceRangePosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{39}
+}
+func (m *MsgRebalanceRangePosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalanceRangePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalanceRangePosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRebalanceRangePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceRangePosition.Merge(m, src)
+}
+func (m *MsgRebalanceRangePosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalanceRangePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceRangePosition.DiscardUnknown(m)
 }
 
-func (m *MsgMultiHopSwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRebalanceRangePosition proto.InternalMessageInfo
+
+func (m *MsgRebalanceRangePosition) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMultiHopSwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRebalanceRangePosition) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
 }
 
-func (m *MsgMultiHopSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PickBestRoute {
-		i--
-		if m.PickBestRoute {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.ExitLimitPrice.Size()
-		i -= size
-		if _, err := m.ExitLimitPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.AmountIn.Size()
-		i -= size
-		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgRebalanceRangePosition) GetLowerTickIndex() int64 {
+	if m != nil {
+		return m.LowerTickIndex
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return 0
+}
+
+func (m *MsgRebalanceRangePosition) GetUpperTickIndex() int64 {
+	if m != nil {
+		return m.UpperTickIndex
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *MsgRebalanceRangePosition) GetTickSpacing() uint64 {
+	if m != nil {
+		return m.TickSpacing
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgRebalanceRangePosition) GetDistributionShape() DistributionShape {
+	if m != nil {
+		return m.DistributionShape
 	}
-	return len(dAtA) - i, nil
+	return DistributionShape_UNIFORM
 }
 
-func (m *MsgMultiHopSwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRebalanceRangePosition) GetOptions() *DepositOptions {
+	if m != nil {
+		return m.Options
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgMultiHopSwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRebalanceRangePositionResponse struct {
+	Reserve0Deposited cosmossdk_io_math.Int                    `protobuf:"bytes,1,opt,name=reserve0_deposited,json=reserve0Deposited,proto3,customtype=cosmossdk.io/math.Int" json:"reserve0_deposited" yaml:"reserve0_deposited"`
+	Reserve1Deposited cosmossdk_io_math.Int                    `protobuf:"bytes,2,opt,name=reserve1_deposited,json=reserve1Deposited,proto3,customtype=cosmossdk.io/math.Int" json:"reserve1_deposited" yaml:"reserve1_deposited"`
+	SharesIssued      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=shares_issued,json=sharesIssued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shares_issued" yaml:"shares_issued"`
+	FailedDeposits    []*FailedDeposit                         `protobuf:"bytes,4,rep,name=failed_deposits,json=failedDeposits,proto3" json:"failed_deposits,omitempty"`
 }
 
-func (m *MsgMultiHopSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Dust) > 0 {
-		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Dust[iNdEx].Size()
-				i -= size
-				if _, err := m.Dust[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Route != nil {
-		{
-			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgRebalanceRangePositionResponse) Reset()         { *m = MsgRebalanceRangePositionResponse{} }
+func (m *MsgRebalanceRangePositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceRangePositionResponse) ProtoMessage()    {}
+func (*MsgRebalanceRangePositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{40}
+}
+func (m *MsgRebalanceRangePositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalanceRangePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalanceRangePositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	{
-		size := m.CoinOut.Size()
-		i -= size
-		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgRebalanceRangePositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceRangePositionResponse.Merge(m, src)
+}
+func (m *MsgRebalanceRangePositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalanceRangePositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceRangePositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalanceRangePositionResponse proto.InternalMessageInfo
+
+func (m *MsgRebalanceRangePositionResponse) GetSharesIssued() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SharesIssued
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRebalanceRangePositionResponse) GetFailedDeposits() []*FailedDeposit {
+	if m != nil {
+		return m.FailedDeposits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgFlashSwap executes a multihop swap and sends the output to the creator before the input is paid. The creator
+// must be a contract: it is called back with a FlashSwapSudoMsg and must hold amount_in plus the flash swap fee
+// once the callback returns, otherwise the message fails and the swap is reverted.
+type MsgFlashSwap struct {
+	Creator        string                                               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Routes         []*MultiHopRoute                                     `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
+	AmountIn       cosmossdk_io_math.Int                                `protobuf:"bytes,3,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	ExitLimitPrice github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,4,opt,name=exit_limit_price,json=exitLimitPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"exit_limit_price" yaml:"exit_limit_price"`
+	PickBestRoute  bool                                                 `protobuf:"varint,5,opt,name=pick_best_route,json=pickBestRoute,proto3" json:"pick_best_route,omitempty"`
+	// Opaque data passed back to the contract in the callback
+	CallbackData []byte `protobuf:"bytes,6,opt,name=callback_data,json=callbackData,proto3" json:"callback_data,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgFlashSwap) Reset()         { *m = MsgFlashSwap{} }
+func (m *MsgFlashSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashSwap) ProtoMessage()    {}
+func (*MsgFlashSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{41}
+}
+func (m *MsgFlashSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgFlashSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashSwap.Merge(m, src)
+}
+func (m *MsgFlashSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashSwap.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFlashSwap proto.InternalMessageInfo
+
+func (m *MsgFlashSwap) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFlashSwap) GetRoutes() []*MultiHopRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgFlashSwap) GetPickBestRoute() bool {
+	if m != nil {
+		return m.PickBestRoute
+	}
+	return false
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgFlashSwap) GetCallbackData() []byte {
+	if m != nil {
+		return m.CallbackData
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *DepositOptions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DisableAutoswap {
-		n += 2
-	}
-	if m.FailTxOnBel {
-		n += 2
+
+type MsgFlashSwapResponse struct {
+	CoinOut github_com_cosmos_cosmos_sdk_types.Coin   `protobuf:"bytes,1,opt,name=coin_out,json=coinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_out" yaml:"coin_out"`
+	Route   *MultiHopRoute                            `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	Dust    []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,rep,name=dust,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"dust" yaml:"dust"`
+	// Amount of the input token repaid by the creator, including the fee
+	CoinIn github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=coin_in,json=coinIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_in" yaml:"coin_in"`
+	Fee    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"fee" yaml:"fee"`
+}
+
+func (m *MsgFlashSwapResponse) Reset()         { *m = MsgFlashSwapResponse{} }
+func (m *MsgFlashSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashSwapResponse) ProtoMessage()    {}
+func (*MsgFlashSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{42}
+}
+func (m *MsgFlashSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgFlashSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashSwapResponse.Merge(m, src)
+}
+func (m *MsgFlashSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashSwapResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgFlashSwapResponse proto.InternalMessageInfo
+
+func (m *MsgFlashSwapResponse) GetRoute() *MultiHopRoute {
+	if m != nil {
+		return m.Route
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgPlaceTWAPOrder sells total_in of token_in for token_out in slices of equal size, one every interval seconds,
+// starting at the end of the current block. Slices only take liquidity at or below max_price and are skipped if there
+// is none. The proceeds of each slice are sent to the creator.
+type MsgPlaceTWAPOrder struct {
+	Creator  string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenIn  string                `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut string                `protobuf:"bytes,3,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	TotalIn  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_in,json=totalIn,proto3,customtype=cosmossdk.io/math.Int" json:"total_in" yaml:"total_in"`
+	Slices   uint64                `protobuf:"varint,5,opt,name=slices,proto3" json:"slices,omitempty"`
+	// Seconds between two slices
+	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Maximum price paid for token_out in terms of token_in
+	MaxPrice github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,7,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"max_price" yaml:"max_price"`
+}
+
+func (m *MsgPlaceTWAPOrder) Reset()         { *m = MsgPlaceTWAPOrder{} }
+func (m *MsgPlaceTWAPOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceTWAPOrder) ProtoMessage()    {}
+func (*MsgPlaceTWAPOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{43}
+}
+func (m *MsgPlaceTWAPOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceTWAPOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceTWAPOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgPlaceTWAPOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceTWAPOrder.Merge(m, src)
+}
+func (m *MsgPlaceTWAPOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceTWAPOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceTWAPOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceTWAPOrder proto.InternalMessageInfo
+
+func (m *MsgPlaceTWAPOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = len(m.TokenA)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgPlaceTWAPOrder) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
 	}
-	l = len(m.TokenB)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgPlaceTWAPOrder) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
 	}
-	if len(m.AmountsA) > 0 {
-		for _, e := range m.AmountsA {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return ""
+}
+
+func (m *MsgPlaceTWAPOrder) GetSlices() uint64 {
+	if m != nil {
+		return m.Slices
 	}
-	if len(m.AmountsB) > 0 {
-		for _, e := range m.AmountsB {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return 0
+}
+
+func (m *MsgPlaceTWAPOrder) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	if len(m.TickIndexesAToB) > 0 {
-		l = 0
-		for _, e := range m.TickIndexesAToB {
-			l += sovTx(uint64(e))
+	return 0
+}
+
+type MsgPlaceTWAPOrderResponse struct {
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *MsgPlaceTWAPOrderResponse) Reset()         { *m = MsgPlaceTWAPOrderResponse{} }
+func (m *MsgPlaceTWAPOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceTWAPOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceTWAPOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{44}
+}
+func (m *MsgPlaceTWAPOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceTWAPOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceTWAPOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	if len(m.Fees) > 0 {
-		l = 0
-		for _, e := range m.Fees {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+}
+func (m *MsgPlaceTWAPOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceTWAPOrderResponse.Merge(m, src)
+}
+func (m *MsgPlaceTWAPOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceTWAPOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceTWAPOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceTWAPOrderResponse proto.InternalMessageInfo
+
+func (m *MsgPlaceTWAPOrderResponse) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	if len(m.Options) > 0 {
-		for _, e := range m.Options {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgWithdrawTWAPOrder stops a TWAP order and returns its unsold amount to the creator
+type MsgWithdrawTWAPOrder struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	OrderId uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *MsgWithdrawTWAPOrder) Reset()         { *m = MsgWithdrawTWAPOrder{} }
+func (m *MsgWithdrawTWAPOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTWAPOrder) ProtoMessage()    {}
+func (*MsgWithdrawTWAPOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{45}
+}
+func (m *MsgWithdrawTWAPOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTWAPOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTWAPOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgWithdrawTWAPOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTWAPOrder.Merge(m, src)
+}
+func (m *MsgWithdrawTWAPOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTWAPOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTWAPOrder.DiscardUnknown(m)
 }
 
-func (m *FailedDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DepositIdx != 0 {
-		n += 1 + sovTx(uint64(m.DepositIdx))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgWithdrawTWAPOrder proto.InternalMessageInfo
+
+func (m *MsgWithdrawTWAPOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return n
+	return ""
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Reserve0Deposited) > 0 {
-		for _, e := range m.Reserve0Deposited {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Reserve1Deposited) > 0 {
-		for _, e := range m.Reserve1Deposited {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgWithdrawTWAPOrder) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	if len(m.FailedDeposits) > 0 {
-		for _, e := range m.FailedDeposits {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+type MsgWithdrawTWAPOrderResponse struct {
+	CoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=coin_out,json=coinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_out" yaml:"coin_out"`
+}
+
+func (m *MsgWithdrawTWAPOrderResponse) Reset()         { *m = MsgWithdrawTWAPOrderResponse{} }
+func (m *MsgWithdrawTWAPOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTWAPOrderResponse) ProtoMessage()    {}
+func (*MsgWithdrawTWAPOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{46}
+}
+func (m *MsgWithdrawTWAPOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTWAPOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTWAPOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.SharesIssued) > 0 {
-		for _, e := range m.SharesIssued {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgWithdrawTWAPOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTWAPOrderResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTWAPOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTWAPOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTWAPOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTWAPOrderResponse proto.InternalMessageInfo
+
+// MsgPurgeExpiredOrders purges up to max expired GOOD_TIL_TIME limit orders that have not been purged at the start of
+// a block. The expiration deposits of the purged orders are paid to the creator.
+type MsgPurgeExpiredOrders struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Max     uint64 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *MsgPurgeExpiredOrders) Reset()         { *m = MsgPurgeExpiredOrders{} }
+func (m *MsgPurgeExpiredOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgPurgeExpiredOrders) ProtoMessage()    {}
+func (*MsgPurgeExpiredOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{47}
+}
+func (m *MsgPurgeExpiredOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurgeExpiredOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurgeExpiredOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgPurgeExpiredOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurgeExpiredOrders.Merge(m, src)
+}
+func (m *MsgPurgeExpiredOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurgeExpiredOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurgeExpiredOrders.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawal) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgPurgeExpiredOrders proto.InternalMessageInfo
+
+func (m *MsgPurgeExpiredOrders) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgPurgeExpiredOrders) GetMax() uint64 {
+	if m != nil {
+		return m.Max
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenA)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenB)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.SharesToRemove) > 0 {
-		for _, e := range m.SharesToRemove {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.TickIndexesAToB) > 0 {
-		l = 0
-		for _, e := range m.TickIndexesAToB {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.Fees) > 0 {
-		l = 0
-		for _, e := range m.Fees {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	return n
+	return 0
 }
 
-func (m *MsgWithdrawalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Reserve0Withdrawn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Reserve1Withdrawn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.SharesBurned) > 0 {
-		for _, e := range m.SharesBurned {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+type MsgPurgeExpiredOrdersResponse struct {
+	OrdersPurged uint64                                   `protobuf:"varint,1,opt,name=orders_purged,json=ordersPurged,proto3" json:"orders_purged,omitempty"`
+	Bounty       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=bounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bounty"`
+}
+
+func (m *MsgPurgeExpiredOrdersResponse) Reset()         { *m = MsgPurgeExpiredOrdersResponse{} }
+func (m *MsgPurgeExpiredOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPurgeExpiredOrdersResponse) ProtoMessage()    {}
+func (*MsgPurgeExpiredOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{48}
+}
+func (m *MsgPurgeExpiredOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurgeExpiredOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurgeExpiredOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgPurgeExpiredOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurgeExpiredOrdersResponse.Merge(m, src)
+}
+func (m *MsgPurgeExpiredOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurgeExpiredOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurgeExpiredOrdersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TickIndexInToOut != 0 {
-		n += 1 + sovTx(uint64(m.TickIndexInToOut))
-	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.OrderType != 0 {
-		n += 1 + sovTx(uint64(m.OrderType))
-	}
-	if m.ExpirationTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MaxAmountOut != nil {
-		l = m.MaxAmountOut.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.LimitSellPrice != nil {
-		l = m.LimitSellPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MinAverageSellPrice != nil {
-		l = m.MinAverageSellPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgPurgeExpiredOrdersResponse proto.InternalMessageInfo
+
+func (m *MsgPurgeExpiredOrdersResponse) GetOrdersPurged() uint64 {
+	if m != nil {
+		return m.OrdersPurged
 	}
-	return n
+	return 0
 }
 
-func (m *MsgPlaceLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TrancheKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgPurgeExpiredOrdersResponse) GetBounty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bounty
 	}
-	l = m.CoinIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TakerCoinIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MsgWithdrawFilledLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TrancheKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterEnum("neutron.dex.LimitOrderType", LimitOrderType_name, LimitOrderType_value)
+	proto.RegisterType((*DepositOptions)(nil), "neutron.dex.DepositOptions")
+	proto.RegisterType((*MsgDeposit)(nil), "neutron.dex.MsgDeposit")
+	proto.RegisterType((*FailedDeposit)(nil), "neutron.dex.FailedDeposit")
+	proto.RegisterType((*MsgDepositResponse)(nil), "neutron.dex.MsgDepositResponse")
+	proto.RegisterType((*MsgWithdrawal)(nil), "neutron.dex.MsgWithdrawal")
+	proto.RegisterType((*MsgWithdrawalResponse)(nil), "neutron.dex.MsgWithdrawalResponse")
+	proto.RegisterType((*MsgPlaceLimitOrder)(nil), "neutron.dex.MsgPlaceLimitOrder")
+	proto.RegisterType((*MsgPlaceLimitOrderResponse)(nil), "neutron.dex.MsgPlaceLimitOrderResponse")
+	proto.RegisterType((*MsgWithdrawFilledLimitOrder)(nil), "neutron.dex.MsgWithdrawFilledLimitOrder")
+	proto.RegisterType((*MsgWithdrawFilledLimitOrderResponse)(nil), "neutron.dex.MsgWithdrawFilledLimitOrderResponse")
+	proto.RegisterType((*MsgCancelLimitOrder)(nil), "neutron.dex.MsgCancelLimitOrder")
+	proto.RegisterType((*MsgCancelLimitOrderResponse)(nil), "neutron.dex.MsgCancelLimitOrderResponse")
+	proto.RegisterType((*MsgBatchCancelLimitOrders)(nil), "neutron.dex.MsgBatchCancelLimitOrders")
+	proto.RegisterType((*MsgBatchCancelLimitOrdersResponse)(nil), "neutron.dex.MsgBatchCancelLimitOrdersResponse")
+	proto.RegisterType((*MsgReplaceLimitOrder)(nil), "neutron.dex.MsgReplaceLimitOrder")
+	proto.RegisterType((*MsgReplaceLimitOrderResponse)(nil), "neutron.dex.MsgReplaceLimitOrderResponse")
+	proto.RegisterType((*MultiHopRoute)(nil), "neutron.dex.MultiHopRoute")
+	proto.RegisterType((*MsgMultiHopSwap)(nil), "neutron.dex.MsgMultiHopSwap")
+	proto.RegisterType((*MsgMultiHopSwapResponse)(nil), "neutron.dex.MsgMultiHopSwapResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "neutron.dex.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "neutron.dex.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetPairTradingStatus)(nil), "neutron.dex.MsgSetPairTradingStatus")
+	proto.RegisterType((*MsgSetPairTradingStatusResponse)(nil), "neutron.dex.MsgSetPairTradingStatusResponse")
+	proto.RegisterType((*MsgSetDenomTradingStatus)(nil), "neutron.dex.MsgSetDenomTradingStatus")
+	proto.RegisterType((*MsgSetDenomTradingStatusResponse)(nil), "neutron.dex.MsgSetDenomTradingStatusResponse")
+	proto.RegisterType((*MsgSetPairFeeTiers)(nil), "neutron.dex.MsgSetPairFeeTiers")
+	proto.RegisterType((*MsgSetPairFeeTiersResponse)(nil), "neutron.dex.MsgSetPairFeeTiersResponse")
+	proto.RegisterType((*MsgSetTraderFeeTier)(nil), "neutron.dex.MsgSetTraderFeeTier")
+	proto.RegisterType((*MsgSetTraderFeeTierResponse)(nil), "neutron.dex.MsgSetTraderFeeTierResponse")
+	proto.RegisterType((*MsgClaimMakerRebates)(nil), "neutron.dex.MsgClaimMakerRebates")
+	proto.RegisterType((*MsgClaimMakerRebatesResponse)(nil), "neutron.dex.MsgClaimMakerRebatesResponse")
+	proto.RegisterType((*MsgSubscribeHooks)(nil), "neutron.dex.MsgSubscribeHooks")
+	proto.RegisterType((*MsgSubscribeHooksResponse)(nil), "neutron.dex.MsgSubscribeHooksResponse")
+	proto.RegisterType((*MsgUnsubscribeHooks)(nil), "neutron.dex.MsgUnsubscribeHooks")
+	proto.RegisterType((*MsgUnsubscribeHooksResponse)(nil), "neutron.dex.MsgUnsubscribeHooksResponse")
+	proto.RegisterType((*MsgDepositRange)(nil), "neutron.dex.MsgDepositRange")
+	proto.RegisterType((*MsgDepositRangeResponse)(nil), "neutron.dex.MsgDepositRangeResponse")
+	proto.RegisterType((*MsgWithdrawRangePosition)(nil), "neutron.dex.MsgWithdrawRangePosition")
+	proto.RegisterType((*MsgWithdrawRangePositionResponse)(nil), "neutron.dex.MsgWithdrawRangePositionResponse")
+	proto.RegisterType((*MsgRebalanceRangePosition)(nil), "neutron.dex.MsgRebalanceRangePosition")
+	proto.RegisterType((*MsgRebalanceRangePositionResponse)(nil), "neutron.dex.MsgRebalanceRangePositionResponse")
+	proto.RegisterType((*MsgFlashSwap)(nil), "neutron.dex.MsgFlashSwap")
+	proto.RegisterType((*MsgFlashSwapResponse)(nil), "neutron.dex.MsgFlashSwapResponse")
+	proto.RegisterType((*MsgPlaceTWAPOrder)(nil), "neutron.dex.MsgPlaceTWAPOrder")
+	proto.RegisterType((*MsgPlaceTWAPOrderResponse)(nil), "neutron.dex.MsgPlaceTWAPOrderResponse")
+	proto.RegisterType((*MsgWithdrawTWAPOrder)(nil), "neutron.dex.MsgWithdrawTWAPOrder")
+	proto.RegisterType((*MsgWithdrawTWAPOrderResponse)(nil), "neutron.dex.MsgWithdrawTWAPOrderResponse")
+	proto.RegisterType((*MsgPurgeExpiredOrders)(nil), "neutron.dex.MsgPurgeExpiredOrders")
+	proto.RegisterType((*MsgPurgeExpiredOrdersResponse)(nil), "neutron.dex.MsgPurgeExpiredOrdersResponse")
 }
 
-func (m *MsgWithdrawFilledLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func init() { proto.RegisterFile("neutron/dex/tx.proto", fileDescriptor_a489f6e187d5e074) }
+
+var fileDescriptor_a489f6e187d5e074 = []byte{
+	// 3568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x6c, 0x1c, 0xc7,
+	0xb1, 0x9a, 0x9d, 0xe5, 0xaf, 0x48, 0x2e, 0xc9, 0x11, 0x25, 0x2e, 0x87, 0x12, 0x97, 0x1c, 0xc9,
+	0x12, 0x25, 0x58, 0xa4, 0x28, 0x3f, 0xfb, 0x40, 0x3c, 0x3c, 0x80, 0x14, 0x25, 0x7b, 0x9f, 0xb9,
+	0x16, 0x31, 0xa4, 0x21, 0x3f, 0x1b, 0x7e, 0xf3, 0x66, 0x77, 0x9a, 0xcb, 0x79, 0x9c, 0xcf, 0x66,
+	0x66, 0x96, 0x5a, 0xf9, 0x12, 0xc3, 0x09, 0x12, 0xc0, 0xc9, 0xc1, 0x97, 0x7c, 0x8c, 0x20, 0x88,
+	0x81, 0x20, 0x40, 0x1c, 0xe4, 0xe0, 0x83, 0x8f, 0x86, 0x93, 0x5c, 0x02, 0xe5, 0x10, 0xc0, 0x08,
+	0x10, 0x20, 0xc9, 0x61, 0x9d, 0xd8, 0x07, 0x01, 0x3e, 0xf2, 0xe0, 0x5c, 0x72, 0x08, 0xba, 0xa7,
+	0xe7, 0x3f, 0xb3, 0x1f, 0x4a, 0xb6, 0x79, 0xf0, 0x45, 0xda, 0xae, 0xaa, 0xae, 0xae, 0xee, 0xfa,
+	0x74, 0x4d, 0x57, 0x11, 0xa6, 0x0d, 0xd4, 0x74, 0x2c, 0xd3, 0x58, 0x51, 0x50, 0x6b, 0xc5, 0x69,
+	0x2d, 0x37, 0x2c, 0xd3, 0x31, 0xb9, 0x51, 0x0a, 0x5d, 0x56, 0x50, 0x8b, 0x9f, 0x92, 0x75, 0xd5,
+	0x30, 0x57, 0xc8, 0xbf, 0x2e, 0x9e, 0x9f, 0xaf, 0x99, 0xb6, 0x6e, 0xda, 0x2b, 0x55, 0xd9, 0x46,
+	0x2b, 0x87, 0xab, 0x55, 0xe4, 0xc8, 0xab, 0x2b, 0x35, 0x53, 0x35, 0x28, 0x7e, 0x86, 0xe2, 0x75,
+	0xbb, 0xbe, 0x72, 0xb8, 0x8a, 0xff, 0xa3, 0x88, 0x59, 0x17, 0x21, 0x91, 0xd1, 0x8a, 0x3b, 0xa0,
+	0xa8, 0xe9, 0xba, 0x59, 0x37, 0x5d, 0x38, 0xfe, 0x45, 0xa1, 0xa5, 0xba, 0x69, 0xd6, 0x35, 0xb4,
+	0x42, 0x46, 0xd5, 0xe6, 0xde, 0x8a, 0xa3, 0xea, 0xc8, 0x76, 0x64, 0xbd, 0x41, 0x09, 0x8a, 0xe1,
+	0x0d, 0x34, 0x64, 0x4b, 0xd6, 0x3d, 0x86, 0x0b, 0x61, 0x8c, 0x25, 0x1b, 0x75, 0x24, 0x35, 0x4c,
+	0x5b, 0x75, 0x54, 0xd3, 0x48, 0xa3, 0x70, 0x2c, 0x59, 0x51, 0x8d, 0xba, 0x64, 0x3b, 0xb2, 0xd3,
+	0xa4, 0x3c, 0x84, 0xff, 0x83, 0xc2, 0x26, 0x22, 0xb3, 0xee, 0x34, 0xf0, 0x44, 0x9b, 0xbb, 0x02,
+	0x93, 0x8a, 0x6a, 0xcb, 0x55, 0x0d, 0x49, 0x72, 0xd3, 0x31, 0xed, 0x7b, 0x72, 0xa3, 0xc8, 0x2c,
+	0x30, 0x4b, 0xc3, 0xe2, 0x04, 0x85, 0xaf, 0x53, 0x30, 0x77, 0x01, 0x0a, 0x7b, 0xb2, 0xaa, 0x49,
+	0x4e, 0x4b, 0x32, 0x0d, 0xa9, 0x8a, 0xb4, 0x62, 0x8e, 0x10, 0x8e, 0x62, 0xe8, 0x6e, 0xeb, 0x8e,
+	0xb1, 0x81, 0x34, 0xe1, 0x01, 0x0b, 0x50, 0xb1, 0xeb, 0x74, 0x15, 0xae, 0x08, 0x43, 0x35, 0x0b,
+	0xc9, 0x8e, 0x69, 0x11, 0xae, 0x23, 0xa2, 0x37, 0xe4, 0x78, 0x18, 0xb6, 0x50, 0x0d, 0xa9, 0x87,
+	0xc8, 0x22, 0x7c, 0x46, 0x44, 0x7f, 0xcc, 0xcd, 0xc0, 0x90, 0x63, 0x1e, 0x20, 0x43, 0x92, 0x8b,
+	0x2c, 0x41, 0x0d, 0x92, 0xe1, 0x7a, 0x80, 0xa8, 0x16, 0xf3, 0x21, 0xc4, 0x06, 0xf7, 0x0a, 0x8c,
+	0xc8, 0xba, 0xd9, 0x34, 0x1c, 0x5b, 0x92, 0x8b, 0x03, 0x0b, 0xec, 0xd2, 0xc8, 0xc6, 0x7f, 0x3d,
+	0x68, 0x97, 0x4e, 0xfd, 0xad, 0x5d, 0x3a, 0xe3, 0xaa, 0xc5, 0x56, 0x0e, 0x96, 0x55, 0x73, 0x45,
+	0x97, 0x9d, 0xfd, 0xe5, 0xb2, 0xe1, 0x7c, 0xd6, 0x2e, 0x05, 0x33, 0x8e, 0xda, 0xa5, 0xc9, 0xfb,
+	0xb2, 0xae, 0xad, 0x09, 0x3e, 0x48, 0x10, 0x87, 0xe9, 0xef, 0xf5, 0x30, 0xf3, 0x6a, 0x71, 0xb0,
+	0x4f, 0xe6, 0xd5, 0x24, 0xf3, 0x6a, 0xc0, 0x7c, 0x83, 0x7b, 0x12, 0x4e, 0x3b, 0x6a, 0xed, 0x40,
+	0x52, 0x0d, 0x05, 0xb5, 0x90, 0x2d, 0xc9, 0x92, 0x63, 0x4a, 0xd5, 0xe2, 0xd0, 0x02, 0xbb, 0xc4,
+	0x8a, 0x13, 0x18, 0x55, 0x76, 0x31, 0xeb, 0xbb, 0xe6, 0x06, 0xc7, 0x41, 0x7e, 0x0f, 0x21, 0xbb,
+	0x38, 0xbc, 0xc0, 0x2e, 0xe5, 0x45, 0xf2, 0x9b, 0x7b, 0x1a, 0x86, 0x4c, 0x57, 0x9b, 0xc5, 0x91,
+	0x05, 0x76, 0x69, 0xf4, 0xc6, 0xdc, 0x72, 0xc8, 0xde, 0x97, 0xa3, 0x0a, 0x17, 0x3d, 0xda, 0xb5,
+	0xd2, 0x1b, 0x0f, 0xdf, 0xbb, 0xea, 0xa9, 0xe3, 0xcd, 0x87, 0xef, 0x5d, 0x2d, 0x60, 0xb3, 0x09,
+	0x74, 0x27, 0xdc, 0x86, 0xf1, 0xdb, 0xb2, 0xaa, 0x21, 0xc5, 0x53, 0x66, 0x09, 0x46, 0x15, 0xf7,
+	0xa7, 0xa4, 0x2a, 0x2d, 0xa2, 0xd0, 0xbc, 0x08, 0x14, 0x54, 0x56, 0x5a, 0xdc, 0x34, 0x0c, 0x20,
+	0xcb, 0x32, 0x3d, 0x85, 0xba, 0x03, 0xe1, 0x73, 0x16, 0xb8, 0x80, 0xad, 0x88, 0xec, 0x86, 0x69,
+	0xd8, 0x88, 0xfb, 0x26, 0x70, 0x16, 0xb2, 0x91, 0x75, 0x88, 0xae, 0x4b, 0x94, 0x07, 0x52, 0x8a,
+	0x0c, 0x39, 0xde, 0xed, 0x6e, 0xc7, 0x9b, 0x32, 0xf5, 0xa8, 0x5d, 0x9a, 0x75, 0xcf, 0x39, 0x89,
+	0x13, 0xc4, 0x29, 0x0f, 0xb8, 0xe9, 0xc1, 0x42, 0x02, 0xac, 0x86, 0x04, 0xc8, 0xf5, 0x27, 0xc0,
+	0x6a, 0x07, 0x01, 0x56, 0xd3, 0x04, 0x58, 0x0d, 0x04, 0xb8, 0x09, 0x13, 0x7b, 0xe4, 0x80, 0x3d,
+	0x3a, 0xbb, 0xc8, 0x12, 0x05, 0xf2, 0x11, 0x05, 0x46, 0x94, 0x20, 0x16, 0xf6, 0xc2, 0x43, 0x9b,
+	0xfb, 0x31, 0x03, 0xe3, 0xf6, 0xbe, 0x6c, 0x21, 0x5b, 0x52, 0x6d, 0xbb, 0x89, 0x94, 0x62, 0x9e,
+	0xf0, 0x98, 0x5d, 0xa6, 0xe1, 0x08, 0x07, 0xb5, 0x65, 0x1a, 0xd4, 0x96, 0x6f, 0x9a, 0xaa, 0xb1,
+	0xf1, 0x12, 0xdd, 0xdc, 0xe5, 0xba, 0xea, 0xec, 0x37, 0xab, 0xcb, 0x35, 0x53, 0xa7, 0xb1, 0x8b,
+	0xfe, 0x77, 0xcd, 0x56, 0x0e, 0x56, 0x9c, 0xfb, 0x0d, 0x64, 0x93, 0x09, 0x9f, 0xb5, 0x4b, 0xd1,
+	0x25, 0x8e, 0xda, 0xa5, 0x69, 0x77, 0xa7, 0x11, 0xb0, 0x20, 0x8e, 0xb9, 0xe3, 0xb2, 0x3b, 0xfc,
+	0x73, 0x0e, 0xc6, 0x2b, 0x76, 0xfd, 0xae, 0xea, 0xec, 0x2b, 0x96, 0x7c, 0x4f, 0xd6, 0xbe, 0xb4,
+	0x70, 0x70, 0x08, 0x93, 0x54, 0x32, 0xc7, 0x94, 0x2c, 0xa4, 0x9b, 0x87, 0x88, 0x46, 0x85, 0xad,
+	0x6e, 0x8a, 0x4d, 0x4c, 0x3c, 0x6a, 0x97, 0x66, 0x22, 0x9b, 0xf5, 0x31, 0x82, 0x58, 0x70, 0x41,
+	0xbb, 0xa6, 0x48, 0x00, 0x59, 0xce, 0x3c, 0xd8, 0xd9, 0x99, 0x87, 0x02, 0x67, 0x5e, 0x13, 0xe2,
+	0x5e, 0x39, 0x45, 0xbd, 0x32, 0x38, 0x45, 0xe1, 0x7d, 0x16, 0xce, 0x44, 0x20, 0xa9, 0x3e, 0x75,
+	0x8f, 0xa2, 0x0d, 0xf7, 0xa8, 0xfb, 0xf1, 0x29, 0x7f, 0x6a, 0x8a, 0x4f, 0xf9, 0xb8, 0x90, 0x4f,
+	0x79, 0x92, 0x18, 0x11, 0x9f, 0x0a, 0x04, 0xc8, 0xf5, 0x27, 0xc0, 0x6a, 0x07, 0x01, 0x56, 0xd3,
+	0x04, 0x58, 0x0d, 0x04, 0x08, 0xb9, 0x43, 0xb5, 0x69, 0x19, 0x48, 0x29, 0xb2, 0x5f, 0xa0, 0x3b,
+	0xb8, 0x4b, 0x24, 0xdc, 0xc1, 0x05, 0xfb, 0xee, 0xb0, 0xe1, 0x0e, 0x7f, 0x36, 0x44, 0xe2, 0xe0,
+	0xb6, 0x26, 0xd7, 0xd0, 0x96, 0xaa, 0xab, 0xce, 0x1d, 0x4b, 0x41, 0xd6, 0x31, 0x7d, 0x62, 0x16,
+	0x86, 0x5d, 0xd3, 0x57, 0x0d, 0xea, 0x14, 0xae, 0x2b, 0x94, 0x0d, 0x6e, 0x0e, 0x46, 0x5c, 0x94,
+	0xd9, 0x74, 0xa8, 0x5f, 0xb8, 0xb4, 0x77, 0x9a, 0x0e, 0x77, 0x03, 0xa6, 0x03, 0x0b, 0x95, 0x54,
+	0x03, 0x1b, 0x28, 0xa6, 0x1b, 0x58, 0x60, 0x96, 0xd8, 0x8d, 0x5c, 0x91, 0x11, 0x27, 0x7d, 0x33,
+	0x2d, 0x1b, 0xbb, 0x26, 0x9e, 0xe3, 0xdf, 0x7f, 0x78, 0xb1, 0xa1, 0x05, 0xa6, 0x8f, 0xfb, 0x4f,
+	0x52, 0x8d, 0xf8, 0xfd, 0x27, 0xa9, 0x86, 0x7f, 0xff, 0x95, 0x0d, 0x6e, 0x0d, 0xc0, 0xc4, 0xe7,
+	0x20, 0xe1, 0x03, 0x2e, 0x0e, 0x2f, 0x30, 0x4b, 0x85, 0xd8, 0x05, 0x16, 0x9c, 0xd5, 0xee, 0xfd,
+	0x06, 0x12, 0x47, 0x4c, 0xef, 0x27, 0x57, 0x81, 0x09, 0xd4, 0x6a, 0xa8, 0x96, 0x8c, 0x6f, 0x34,
+	0x09, 0xa7, 0x52, 0xc5, 0x91, 0x05, 0x86, 0x04, 0x50, 0x37, 0xcf, 0x5a, 0xf6, 0xf2, 0xac, 0xe5,
+	0x5d, 0x2f, 0xcf, 0xda, 0x18, 0x7e, 0xd0, 0x2e, 0x31, 0x6f, 0x7d, 0x5c, 0x62, 0xc4, 0x42, 0x30,
+	0x19, 0xa3, 0x39, 0x03, 0x0a, 0xba, 0xdc, 0x92, 0xa8, 0x98, 0xf8, 0x54, 0x80, 0x6c, 0xf6, 0x39,
+	0x3c, 0xa3, 0xd3, 0x66, 0x63, 0xd3, 0x8e, 0xda, 0xa5, 0x33, 0xee, 0x8e, 0xa3, 0x70, 0x41, 0x1c,
+	0xd3, 0xe5, 0xd6, 0x3a, 0x19, 0xe3, 0x73, 0xfd, 0x01, 0x03, 0x93, 0x1a, 0xde, 0x9c, 0x64, 0x23,
+	0x4d, 0x93, 0x1a, 0x96, 0x5a, 0x43, 0xc5, 0x51, 0xb2, 0xe4, 0x01, 0x5d, 0xf2, 0x3f, 0x42, 0x36,
+	0x49, 0xcf, 0xe4, 0x9a, 0x69, 0xd5, 0xbd, 0xdf, 0x2b, 0x87, 0x4f, 0xaf, 0x34, 0x1d, 0x55, 0xb3,
+	0x5d, 0x69, 0xb6, 0x2d, 0x54, 0xdb, 0x44, 0x35, 0x1c, 0xc5, 0xe2, 0x7c, 0x83, 0x28, 0x16, 0xc7,
+	0x08, 0x62, 0x81, 0x80, 0x76, 0x90, 0xa6, 0x6d, 0x63, 0x00, 0xf7, 0x6b, 0x06, 0xce, 0xea, 0xaa,
+	0x21, 0xc9, 0x87, 0xc8, 0x92, 0xeb, 0x28, 0x2c, 0xdd, 0x18, 0x91, 0xee, 0xde, 0x23, 0x4a, 0x97,
+	0xc1, 0xfd, 0xa8, 0x5d, 0x3a, 0x4f, 0xcf, 0x2d, 0x15, 0x2f, 0x88, 0xa7, 0x75, 0xd5, 0x58, 0x77,
+	0xe1, 0x81, 0xb8, 0x25, 0x18, 0xc5, 0xa9, 0xab, 0x64, 0x23, 0xc7, 0xd1, 0x50, 0x71, 0x9c, 0x24,
+	0xa5, 0x80, 0x41, 0x3b, 0x04, 0xb2, 0x76, 0x39, 0x1e, 0x53, 0xcf, 0xd2, 0x98, 0x1a, 0x73, 0x45,
+	0xe1, 0x9f, 0x2c, 0xf0, 0x49, 0xb0, 0x1f, 0x5d, 0xe7, 0x01, 0x1c, 0x4b, 0x36, 0x6a, 0xfb, 0xe8,
+	0x79, 0x74, 0x9f, 0x3a, 0x6b, 0x08, 0xc2, 0xbd, 0xce, 0xc0, 0x10, 0xfe, 0x6a, 0xc0, 0x6e, 0x92,
+	0x5b, 0x60, 0x3a, 0x47, 0x9d, 0xad, 0xfe, 0xa3, 0x8e, 0xc7, 0xfc, 0xa8, 0x5d, 0x2a, 0xb8, 0xe7,
+	0x44, 0x01, 0x82, 0x38, 0x88, 0x7f, 0x95, 0x0d, 0xee, 0x27, 0x0c, 0x14, 0x1c, 0xf9, 0x00, 0x59,
+	0x12, 0x41, 0x61, 0x1b, 0x66, 0xbb, 0x49, 0xf2, 0x72, 0xff, 0x92, 0xc4, 0xd6, 0x08, 0x0c, 0x3e,
+	0x0a, 0x17, 0xc4, 0x31, 0x02, 0xc0, 0xb3, 0xb0, 0xc1, 0xff, 0x88, 0x81, 0xf1, 0x10, 0x85, 0x6a,
+	0x14, 0xf3, 0xdd, 0x84, 0x3b, 0x4e, 0x70, 0x8e, 0x2c, 0x11, 0x04, 0xe7, 0x08, 0x58, 0x10, 0x47,
+	0x7d, 0xd1, 0xca, 0x86, 0xf0, 0x26, 0x03, 0x73, 0xa1, 0x2b, 0xf5, 0xb6, 0xaa, 0x69, 0x48, 0xe9,
+	0x29, 0x48, 0x97, 0x60, 0x94, 0x9a, 0x80, 0x74, 0x80, 0xee, 0x17, 0x73, 0x71, 0xab, 0x58, 0xbb,
+	0x1e, 0xb7, 0xbe, 0x52, 0xec, 0x46, 0x8f, 0x2f, 0x26, 0xfc, 0x23, 0x07, 0x17, 0x3a, 0xe0, 0x7d,
+	0x7b, 0x4c, 0x51, 0x36, 0x73, 0x72, 0x94, 0x8d, 0xa5, 0xd3, 0xa3, 0xd2, 0xe5, 0xbe, 0x08, 0xe9,
+	0xf4, 0x0c, 0xe9, 0xf4, 0xb8, 0x74, 0x7a, 0x48, 0x3a, 0xe1, 0x35, 0x38, 0x5d, 0xb1, 0xeb, 0x37,
+	0x65, 0xa3, 0x86, 0xb4, 0xc7, 0xa3, 0xe7, 0xa5, 0xb8, 0x9e, 0x67, 0xa8, 0x9e, 0xe3, 0x8b, 0x08,
+	0x7f, 0xcd, 0xc1, 0x5c, 0x0a, 0xfc, 0x6b, 0xbd, 0x3e, 0x06, 0xbd, 0x7e, 0xc0, 0xc0, 0x6c, 0xc5,
+	0xae, 0x6f, 0xc8, 0x4e, 0x6d, 0x3f, 0x7e, 0xc0, 0x76, 0x07, 0xf5, 0x2e, 0xc2, 0x58, 0x48, 0xbd,
+	0xb6, 0xfb, 0x19, 0x28, 0x8e, 0x06, 0xfa, 0xb5, 0xf1, 0xd7, 0x46, 0x43, 0x56, 0x2d, 0x49, 0x55,
+	0xbc, 0xcf, 0x10, 0x3c, 0x2c, 0x2b, 0x91, 0x5c, 0x2c, 0x1f, 0xc9, 0xc5, 0xd6, 0x96, 0xe3, 0x46,
+	0x71, 0x9e, 0x1a, 0x45, 0xba, 0x80, 0xc2, 0xf7, 0x59, 0x58, 0xcc, 0xc4, 0xfa, 0x06, 0x12, 0x17,
+	0x96, 0x49, 0x0a, 0xfb, 0x0e, 0x03, 0x13, 0x81, 0x1e, 0x6d, 0xaa, 0xa6, 0x2e, 0x99, 0xf0, 0xab,
+	0x58, 0x4d, 0x9f, 0xb5, 0x4b, 0xf1, 0x99, 0x47, 0xed, 0xd2, 0xd9, 0xb8, 0x69, 0x10, 0x84, 0xf0,
+	0xab, 0x8f, 0x4b, 0x4b, 0x3d, 0xea, 0xd4, 0x16, 0xc7, 0x7d, 0x3b, 0xb2, 0xb1, 0x21, 0x61, 0x11,
+	0xf5, 0x98, 0x88, 0x6c, 0xcf, 0x22, 0xea, 0x59, 0x22, 0xea, 0x8f, 0x24, 0xa2, 0x1e, 0x16, 0x51,
+	0xf8, 0x2d, 0x0b, 0xd3, 0x15, 0xbb, 0x2e, 0xa2, 0x46, 0xcf, 0x49, 0x7b, 0xb7, 0x38, 0x11, 0xcd,
+	0xa6, 0x59, 0x3f, 0x9b, 0x66, 0x1e, 0x4b, 0x36, 0x9d, 0x9a, 0x52, 0xe6, 0xbf, 0xfa, 0x94, 0x32,
+	0x25, 0x53, 0x1f, 0x38, 0x7e, 0xa6, 0xbe, 0x76, 0x25, 0xee, 0x56, 0x45, 0xea, 0x56, 0x09, 0x4d,
+	0x09, 0xff, 0x1a, 0x84, 0x73, 0x69, 0x08, 0xdf, 0x99, 0x3e, 0x64, 0x60, 0xa6, 0x46, 0x5c, 0x0d,
+	0x29, 0x52, 0xbf, 0x61, 0x57, 0xeb, 0x3f, 0xb0, 0x65, 0x2d, 0x76, 0xd4, 0x2e, 0xcd, 0xd3, 0xac,
+	0x2e, 0x9d, 0x40, 0x10, 0xa7, 0x3d, 0xcc, 0x6e, 0x38, 0x20, 0x47, 0x36, 0xd0, 0x6f, 0x64, 0x7e,
+	0xa4, 0x0d, 0xe8, 0xdd, 0x36, 0xa0, 0x67, 0x6d, 0xa0, 0x12, 0xde, 0x40, 0xcc, 0x65, 0xd8, 0x8e,
+	0x89, 0x75, 0xfe, 0xc4, 0x24, 0xd6, 0x03, 0x27, 0x39, 0xb1, 0x1e, 0x3c, 0x21, 0x89, 0xf5, 0x05,
+	0x18, 0xaf, 0x34, 0x35, 0x47, 0x7d, 0xce, 0x6c, 0x88, 0x66, 0xd3, 0x41, 0xf8, 0xd1, 0x6b, 0xdf,
+	0x6c, 0x78, 0x77, 0x16, 0xf9, 0x2d, 0x7c, 0xc8, 0xc2, 0x44, 0xc5, 0xae, 0x7b, 0x84, 0x3b, 0xb8,
+	0xda, 0x70, 0xbc, 0x67, 0x91, 0x1b, 0x30, 0x68, 0xe1, 0x65, 0xd2, 0x5f, 0x52, 0x23, 0x92, 0x88,
+	0x94, 0x32, 0x1a, 0x90, 0xf3, 0x8f, 0xf9, 0x79, 0x03, 0x07, 0x64, 0xd4, 0x52, 0x1d, 0xc9, 0x8d,
+	0x91, 0x6e, 0x40, 0x1e, 0xf0, 0x03, 0xf2, 0xa9, 0x47, 0x09, 0xc8, 0x71, 0xbe, 0x41, 0x40, 0x8e,
+	0x63, 0x04, 0x1c, 0x41, 0x55, 0x87, 0x44, 0x3f, 0x37, 0x20, 0x5f, 0x82, 0x89, 0x06, 0x7e, 0x07,
+	0xaa, 0x22, 0xdb, 0x91, 0xc8, 0x41, 0x10, 0x93, 0x19, 0x16, 0xc7, 0x31, 0x78, 0x03, 0xd9, 0x0e,
+	0x39, 0xa4, 0xb5, 0x8b, 0xf1, 0x48, 0x7b, 0x9a, 0x46, 0xda, 0xb0, 0xb2, 0x84, 0x3f, 0xe4, 0x60,
+	0x26, 0x06, 0xf3, 0xe3, 0xeb, 0xb7, 0x19, 0x18, 0xee, 0x3d, 0xa0, 0xbe, 0xd0, 0xbf, 0x59, 0x0e,
+	0x87, 0xbc, 0x65, 0x22, 0xe4, 0xbe, 0xc4, 0x4f, 0x86, 0x6a, 0xd4, 0x45, 0xae, 0xc3, 0x80, 0xbb,
+	0xcd, 0x1c, 0xbd, 0x77, 0xb2, 0x0d, 0xc3, 0x25, 0xe4, 0x9a, 0x90, 0x57, 0x9a, 0x76, 0x0f, 0x39,
+	0xc9, 0xed, 0xfe, 0x65, 0x26, 0x9c, 0x8f, 0xda, 0xa5, 0x51, 0x57, 0x5e, 0x3c, 0x12, 0x44, 0x02,
+	0x14, 0xde, 0x65, 0x88, 0x33, 0xbc, 0xd8, 0x50, 0x64, 0x07, 0x6d, 0x93, 0x0a, 0x20, 0xf7, 0x0c,
+	0x8c, 0xc8, 0x4d, 0x67, 0xdf, 0xb4, 0x54, 0x87, 0x3e, 0x3c, 0x6c, 0x14, 0xff, 0xf4, 0xfe, 0xb5,
+	0x69, 0x2a, 0xd2, 0xba, 0xa2, 0x58, 0xc8, 0xb6, 0x77, 0x1c, 0x4b, 0x35, 0xea, 0x62, 0x40, 0xca,
+	0x3d, 0x03, 0x83, 0x6e, 0x0d, 0x91, 0xee, 0xfa, 0x74, 0x64, 0xd7, 0x2e, 0xf3, 0x8d, 0x11, 0x2c,
+	0xfe, 0x2f, 0x1f, 0xbe, 0x77, 0x95, 0x11, 0x29, 0xf5, 0xda, 0x25, 0xac, 0xf5, 0x80, 0x4f, 0x58,
+	0xef, 0x61, 0xb9, 0x84, 0x59, 0x98, 0x89, 0x81, 0x3c, 0xb5, 0x0b, 0x0f, 0x19, 0x82, 0xdb, 0x41,
+	0xce, 0xb6, 0xac, 0x5a, 0xbb, 0x6e, 0x35, 0x72, 0x87, 0x14, 0x23, 0x8f, 0xbd, 0x9d, 0x50, 0x21,
+	0x20, 0x97, 0x55, 0x08, 0x60, 0x23, 0x85, 0x80, 0x1b, 0x30, 0xe8, 0x16, 0x40, 0x89, 0x63, 0x17,
+	0x62, 0x6a, 0x8f, 0x48, 0x25, 0x52, 0x4a, 0x37, 0x67, 0x8f, 0x6e, 0x7e, 0x8e, 0x6e, 0x3e, 0x6d,
+	0x37, 0xc2, 0x22, 0x94, 0x32, 0x50, 0xfe, 0x61, 0xfc, 0x91, 0x81, 0xa2, 0x4b, 0xb3, 0x89, 0x0c,
+	0x53, 0x7f, 0x3c, 0xa7, 0x31, 0x0d, 0x03, 0x0a, 0xe6, 0xe6, 0x55, 0xdb, 0xc8, 0x20, 0xb4, 0x63,
+	0xb6, 0xe7, 0x1d, 0xaf, 0x24, 0x77, 0x7c, 0x2e, 0xd8, 0x71, 0x52, 0x64, 0x41, 0x80, 0x85, 0x2c,
+	0x9c, 0xbf, 0xe7, 0xdf, 0x33, 0xc0, 0x05, 0xe7, 0x72, 0x1b, 0xa1, 0x5d, 0x15, 0x59, 0xc7, 0xdf,
+	0x6d, 0xff, 0xba, 0x9f, 0x83, 0x91, 0x3d, 0x84, 0x24, 0x07, 0x2f, 0x4b, 0x8a, 0x62, 0x79, 0x71,
+	0x78, 0x8f, 0x8a, 0xe1, 0x66, 0x90, 0xd1, 0x2d, 0x9f, 0x8d, 0x2a, 0xd9, 0x93, 0x58, 0x38, 0x07,
+	0x7c, 0x12, 0xea, 0x6f, 0xf3, 0x37, 0x0c, 0x79, 0x49, 0xd8, 0x41, 0x0e, 0x3e, 0x06, 0xe4, 0x11,
+	0x1c, 0x7b, 0x9f, 0x45, 0x18, 0x92, 0x5d, 0x1c, 0xdd, 0xa7, 0x37, 0xc4, 0x37, 0x27, 0xde, 0x0b,
+	0xd9, 0xe5, 0xb8, 0x48, 0x7e, 0x73, 0x67, 0x61, 0x90, 0x96, 0xb7, 0xf2, 0x24, 0x7a, 0xd3, 0xd1,
+	0xda, 0xd5, 0xe4, 0xf6, 0x66, 0x82, 0xed, 0x45, 0x24, 0x15, 0xce, 0xc3, 0x5c, 0x0a, 0xd8, 0xdf,
+	0xe0, 0x2b, 0xe4, 0x13, 0xe8, 0xa6, 0x26, 0xab, 0x3a, 0xc9, 0xda, 0x44, 0x54, 0x95, 0xf1, 0xb5,
+	0x99, 0x79, 0x41, 0x67, 0x67, 0xe7, 0x09, 0x26, 0xc2, 0xcf, 0x19, 0x38, 0x97, 0x86, 0xf0, 0x6f,
+	0x8f, 0x37, 0x18, 0x18, 0xb2, 0x5c, 0x58, 0x91, 0xe9, 0x16, 0x88, 0x2b, 0xf4, 0xe3, 0xd0, 0x9b,
+	0x11, 0xe4, 0x73, 0x14, 0xd0, 0xdf, 0xc7, 0xa0, 0xc7, 0x46, 0xf8, 0x2e, 0x03, 0x53, 0xf8, 0x88,
+	0x9a, 0x55, 0xbb, 0x66, 0xa9, 0x55, 0xf4, 0x9c, 0x69, 0x1e, 0x74, 0x7a, 0x4c, 0xe8, 0xdb, 0x56,
+	0xdd, 0x80, 0x1b, 0x3e, 0xb2, 0x33, 0x9e, 0xb6, 0x22, 0x6b, 0x0a, 0x73, 0x30, 0x9b, 0x00, 0xfa,
+	0x9a, 0xfa, 0x9e, 0x6b, 0x8a, 0x2f, 0x1a, 0xf6, 0x17, 0x27, 0x68, 0xe6, 0x2b, 0x57, 0x7c, 0x55,
+	0x6a, 0x56, 0x71, 0xb0, 0x2f, 0xec, 0xe7, 0x79, 0x72, 0xcd, 0x79, 0x65, 0x6d, 0xdc, 0xcf, 0xf2,
+	0xa5, 0x95, 0x87, 0xef, 0x02, 0x4d, 0xd0, 0x48, 0xb3, 0x08, 0x76, 0xcd, 0xff, 0xec, 0x96, 0xf0,
+	0xf9, 0x13, 0x82, 0x24, 0xc3, 0x83, 0x08, 0xe2, 0x90, 0xfb, 0x73, 0x3d, 0xc4, 0xb8, 0x5a, 0x1c,
+	0xec, 0x8f, 0x71, 0x35, 0xc1, 0xb8, 0xea, 0x33, 0xde, 0xe0, 0x9e, 0x82, 0x19, 0xcd, 0xbc, 0x87,
+	0x2c, 0x29, 0x54, 0xbc, 0xf3, 0x3b, 0x45, 0x98, 0x25, 0x56, 0xe4, 0x08, 0x7a, 0xd7, 0x2b, 0xdd,
+	0x91, 0xfa, 0xf2, 0x53, 0x30, 0xd3, 0x6c, 0x34, 0x52, 0x27, 0x0d, 0xbb, 0x93, 0x08, 0x3a, 0x3a,
+	0x09, 0xbf, 0x2d, 0x61, 0x72, 0xbb, 0x21, 0xd7, 0x54, 0xa3, 0x4e, 0x0a, 0x6a, 0x79, 0x71, 0x14,
+	0xc3, 0x76, 0x5c, 0x10, 0x37, 0x09, 0xec, 0x1e, 0x42, 0xa4, 0x38, 0x96, 0x17, 0xf1, 0x4f, 0xae,
+	0x02, 0x9c, 0xa2, 0xda, 0x8e, 0xa5, 0x56, 0x9b, 0xe4, 0x03, 0xdf, 0xde, 0x97, 0x1b, 0x6e, 0x29,
+	0xab, 0x70, 0x63, 0x3e, 0xda, 0x8d, 0x12, 0x22, 0xdb, 0xc1, 0x54, 0xe2, 0x94, 0x12, 0x07, 0x85,
+	0x3b, 0x5a, 0xc6, 0x48, 0xde, 0xd2, 0x5b, 0x47, 0x4b, 0x66, 0xae, 0x1a, 0x36, 0x32, 0xe1, 0xa7,
+	0x79, 0x98, 0x89, 0xc1, 0xfc, 0x68, 0x53, 0x82, 0x51, 0xaf, 0xa7, 0x0a, 0x3f, 0xf3, 0xd1, 0x0e,
+	0x17, 0x0f, 0x54, 0x56, 0x32, 0x9a, 0x56, 0x72, 0xfd, 0x16, 0xd8, 0x1f, 0x77, 0xd3, 0x0a, 0xdb,
+	0x6f, 0x81, 0xfd, 0xf8, 0x4d, 0x2b, 0x6f, 0xf7, 0xdf, 0x6f, 0xf2, 0x3f, 0x34, 0x2c, 0xf7, 0xd6,
+	0x44, 0xd2, 0x57, 0x88, 0x8e, 0x34, 0x9c, 0xa4, 0x35, 0xd4, 0x0c, 0xf4, 0xdb, 0x50, 0x23, 0xbc,
+	0xe3, 0xe6, 0x6a, 0x5e, 0xf5, 0x85, 0x18, 0xc8, 0x36, 0xb5, 0x80, 0x63, 0x46, 0xa8, 0x98, 0x59,
+	0xb1, 0x71, 0xb3, 0x5a, 0xbb, 0x16, 0xb7, 0xdc, 0x73, 0xb1, 0x1a, 0x51, 0x44, 0x0a, 0xfc, 0x2c,
+	0xb9, 0x90, 0x85, 0xfc, 0xba, 0x17, 0xc4, 0x17, 0xe0, 0xed, 0xfe, 0x7b, 0x41, 0xe2, 0xa6, 0xda,
+	0xb9, 0xc1, 0xe3, 0x38, 0xa6, 0x4a, 0x9b, 0x41, 0xbe, 0xc5, 0x92, 0x9b, 0x1c, 0xa7, 0x3b, 0x1a,
+	0x7e, 0x13, 0xeb, 0xd5, 0xcc, 0x62, 0xa6, 0x94, 0x4b, 0x44, 0xa8, 0x25, 0x98, 0x8c, 0xdf, 0x14,
+	0xc4, 0xe0, 0x58, 0xb1, 0x10, 0xbd, 0x22, 0x30, 0x65, 0xfc, 0x7a, 0x20, 0xf7, 0x24, 0x2b, 0x16,
+	0xa2, 0xf7, 0x42, 0xe2, 0x4e, 0x18, 0x48, 0xde, 0x09, 0xe9, 0x37, 0xc0, 0xe0, 0x63, 0xb8, 0x01,
+	0x86, 0xfa, 0xb8, 0x01, 0x32, 0xcb, 0x2d, 0xe9, 0xe7, 0x2c, 0x7c, 0x27, 0x0f, 0x8b, 0x99, 0xd8,
+	0xae, 0x9d, 0x8a, 0xcc, 0x57, 0xdd, 0xa9, 0xf8, 0x15, 0x06, 0x7d, 0xf6, 0x24, 0x07, 0xfd, 0x7c,
+	0xdf, 0x41, 0xff, 0x77, 0x2c, 0x8c, 0x55, 0xec, 0xfa, 0x6d, 0x4d, 0xb6, 0xf7, 0xbb, 0x3c, 0x3f,
+	0x06, 0x4f, 0x8c, 0xb9, 0xe3, 0x3d, 0x31, 0xb2, 0x5f, 0xc6, 0x13, 0x63, 0xfe, 0x44, 0x3e, 0x31,
+	0x0e, 0xa4, 0x3c, 0x31, 0x72, 0x17, 0x60, 0xbc, 0x26, 0x6b, 0x5a, 0x55, 0xae, 0x1d, 0x48, 0x8a,
+	0xec, 0xc8, 0x24, 0x6a, 0x8c, 0x89, 0x63, 0x1e, 0x70, 0x53, 0x76, 0xe4, 0xb5, 0xc5, 0xb8, 0x67,
+	0x4f, 0x52, 0xcf, 0xf6, 0x55, 0x26, 0x7c, 0x90, 0x87, 0xe9, 0x30, 0xe0, 0xeb, 0x17, 0xc8, 0xe3,
+	0xbd, 0x40, 0x9e, 0x84, 0x72, 0xcb, 0x37, 0xdc, 0x4f, 0x8c, 0xae, 0x25, 0x96, 0xcd, 0xfe, 0x57,
+	0xc7, 0x8c, 0x8f, 0xda, 0x25, 0x70, 0x57, 0xde, 0x43, 0x48, 0x20, 0xdf, 0x30, 0xc2, 0x0f, 0x59,
+	0x98, 0xf2, 0x9a, 0xbf, 0x76, 0xef, 0xae, 0x6f, 0x77, 0x2b, 0xf4, 0x86, 0xab, 0xfe, 0xb9, 0x0e,
+	0x1d, 0x98, 0x6c, 0xac, 0x03, 0xf3, 0x2e, 0x9e, 0xe7, 0xc8, 0x5a, 0x50, 0x6d, 0xe8, 0xfe, 0x8d,
+	0xe8, 0x4d, 0x08, 0xec, 0xcb, 0x83, 0x08, 0x78, 0x55, 0x47, 0xd6, 0xca, 0x06, 0x7e, 0x0b, 0xb2,
+	0x35, 0xb5, 0x86, 0x6c, 0x7a, 0x3f, 0xd3, 0x11, 0xce, 0x4c, 0x55, 0xc3, 0x41, 0xd6, 0xa1, 0xac,
+	0x11, 0xd7, 0xca, 0x8b, 0xfe, 0x98, 0x7b, 0x0d, 0x46, 0x70, 0x8f, 0xa2, 0x1b, 0x33, 0xdc, 0xd6,
+	0xce, 0x57, 0x1f, 0x31, 0x66, 0x04, 0x0c, 0x83, 0xb8, 0xe5, 0x83, 0x04, 0x71, 0x58, 0x97, 0x5b,
+	0x24, 0x3e, 0x64, 0xbf, 0x79, 0x44, 0x55, 0x20, 0x3c, 0x03, 0xb3, 0x09, 0xa0, 0xef, 0xdb, 0xb3,
+	0x30, 0xec, 0xb6, 0x8f, 0xfa, 0x9f, 0x6b, 0x43, 0x64, 0x5c, 0x56, 0x84, 0x43, 0x12, 0x0e, 0xbc,
+	0x74, 0xb0, 0x47, 0x95, 0xfa, 0xcc, 0x72, 0x11, 0x66, 0xd9, 0x6f, 0x5a, 0x09, 0xfe, 0xc2, 0x2f,
+	0xdc, 0x37, 0xad, 0x04, 0xe2, 0x84, 0xc5, 0x23, 0xc1, 0x24, 0x5d, 0xe4, 0xdb, 0x4d, 0xab, 0x8e,
+	0x6e, 0xe1, 0xf2, 0x3a, 0x52, 0xba, 0x76, 0xc9, 0x4c, 0x02, 0xab, 0xcb, 0x2d, 0x7a, 0x36, 0xf8,
+	0xa7, 0xfb, 0xd0, 0x18, 0x3e, 0x97, 0x59, 0x4f, 0x89, 0x09, 0xbe, 0xb8, 0xb2, 0x71, 0x3e, 0x15,
+	0xe3, 0x9f, 0xcc, 0x05, 0x18, 0x27, 0x07, 0x6e, 0x4b, 0x0d, 0x4c, 0xe4, 0xa9, 0x74, 0xcc, 0x05,
+	0x92, 0x89, 0x0a, 0x57, 0x83, 0xc1, 0x2a, 0xbe, 0xfa, 0xee, 0x77, 0x6f, 0x68, 0xb9, 0x8e, 0xcf,
+	0xae, 0xaf, 0xdc, 0x82, 0xb2, 0xbe, 0xda, 0x82, 0x42, 0xb4, 0xef, 0x98, 0x3b, 0x0b, 0xdc, 0xb3,
+	0x77, 0xee, 0x6c, 0x4a, 0xbb, 0xe5, 0x2d, 0xe9, 0xe6, 0xfa, 0x0b, 0x37, 0x6f, 0x6d, 0x6d, 0xdd,
+	0xda, 0x9c, 0x3c, 0xc5, 0x4d, 0xc2, 0xd8, 0xed, 0xf2, 0xd6, 0x96, 0x74, 0x47, 0x94, 0x9e, 0x2f,
+	0x6f, 0x6d, 0x4d, 0x32, 0xdc, 0x0c, 0x9c, 0x2e, 0x57, 0x2a, 0xb7, 0x36, 0xcb, 0xeb, 0xbb, 0xb7,
+	0x30, 0xd8, 0xa5, 0x9e, 0xcc, 0x61, 0xd2, 0xff, 0x7e, 0x71, 0x67, 0x57, 0x2a, 0xbf, 0x20, 0xed,
+	0x96, 0x2b, 0xb7, 0x26, 0x59, 0x6e, 0x0a, 0xc6, 0x7d, 0xa6, 0x04, 0x94, 0xbf, 0xf1, 0xee, 0x14,
+	0xb0, 0x15, 0xbb, 0xce, 0xdd, 0x84, 0x21, 0xef, 0x0f, 0x6f, 0x66, 0xa2, 0x57, 0x85, 0xff, 0x78,
+	0xc1, 0x97, 0x32, 0x10, 0xfe, 0x81, 0x6e, 0x01, 0x84, 0xfe, 0xfc, 0x82, 0x8f, 0x93, 0x07, 0x38,
+	0x5e, 0xc8, 0xc6, 0xf9, 0xdc, 0x5e, 0x81, 0x89, 0x78, 0xf7, 0x7a, 0x42, 0x82, 0x18, 0x01, 0x7f,
+	0xb9, 0x0b, 0x81, 0xcf, 0xfc, 0x10, 0x8a, 0x99, 0xed, 0x97, 0x4b, 0x59, 0xc2, 0xc5, 0x29, 0xf9,
+	0xeb, 0xbd, 0x52, 0xfa, 0xeb, 0xfe, 0x2f, 0x4c, 0x26, 0xda, 0x00, 0x17, 0xe2, 0x5c, 0xe2, 0x14,
+	0xfc, 0x52, 0x37, 0x0a, 0x9f, 0x7f, 0x03, 0xce, 0x66, 0x74, 0xa3, 0x5d, 0x8a, 0xf3, 0x48, 0xa7,
+	0xe3, 0x97, 0x7b, 0xa3, 0xf3, 0x57, 0x94, 0x61, 0x2a, 0xd9, 0xb1, 0xb4, 0x18, 0x67, 0x92, 0x20,
+	0xe1, 0xaf, 0x74, 0x25, 0xf1, 0x97, 0x10, 0x61, 0x2c, 0x52, 0xad, 0x3f, 0x17, 0x9f, 0x1a, 0xc6,
+	0xf2, 0x17, 0x3b, 0x61, 0xc3, 0x3c, 0x23, 0x45, 0xcf, 0x04, 0xcf, 0x30, 0x96, 0xbf, 0xd8, 0x09,
+	0xeb, 0xf3, 0xfc, 0x7f, 0x98, 0x4e, 0xad, 0x40, 0x26, 0x66, 0xa7, 0x51, 0xf1, 0x4f, 0xf6, 0x42,
+	0xe5, 0xaf, 0xa5, 0xc3, 0x99, 0xf4, 0x02, 0xdf, 0x13, 0x29, 0x6c, 0x92, 0x64, 0xfc, 0xb5, 0x9e,
+	0xc8, 0xfc, 0xe5, 0x5e, 0x82, 0x42, 0xac, 0x20, 0x31, 0x9f, 0x60, 0x10, 0xc1, 0xf3, 0x97, 0x3a,
+	0xe3, 0xc3, 0x1e, 0x91, 0xa8, 0x21, 0x24, 0x3c, 0x22, 0x4e, 0xc1, 0x2f, 0x75, 0xa3, 0x08, 0x2b,
+	0x3a, 0xf2, 0xec, 0x7f, 0x2e, 0x2b, 0x8a, 0x61, 0x2c, 0x7f, 0xb1, 0x13, 0x36, 0x7c, 0xf8, 0xe9,
+	0x2f, 0x76, 0x4f, 0x64, 0x05, 0x84, 0x08, 0x19, 0x7f, 0xad, 0x27, 0xb2, 0xb0, 0x53, 0x67, 0x3c,
+	0xdd, 0x5c, 0x4a, 0x3a, 0x51, 0x1a, 0x1d, 0xbf, 0xdc, 0x1b, 0x9d, 0xbf, 0x62, 0x19, 0x46, 0x82,
+	0xaf, 0xd3, 0xd9, 0xf8, 0x64, 0x1f, 0xc5, 0x2f, 0x66, 0xa2, 0xc2, 0x61, 0x3c, 0x5e, 0x95, 0x2d,
+	0x65, 0x58, 0xba, 0x47, 0xc0, 0x5f, 0xee, 0x42, 0x10, 0x36, 0x9e, 0x44, 0x2d, 0x74, 0x21, 0x65,
+	0x72, 0x84, 0x82, 0x5f, 0xea, 0x46, 0x11, 0x0e, 0x6e, 0xc9, 0x5a, 0x64, 0x62, 0xd3, 0x09, 0x12,
+	0xfe, 0x4a, 0x57, 0x92, 0xb0, 0x67, 0xc5, 0xbe, 0x02, 0xe6, 0x53, 0x2f, 0x31, 0x1f, 0xcf, 0x5f,
+	0xea, 0x8c, 0x0f, 0x0b, 0x9f, 0xcc, 0x47, 0x17, 0xb3, 0x4c, 0x2f, 0xe0, 0x7f, 0xa5, 0x2b, 0x89,
+	0xbf, 0x84, 0x02, 0x5c, 0x4a, 0x4a, 0x97, 0xb8, 0xdd, 0x93, 0x34, 0xfc, 0xd5, 0xee, 0x34, 0xde,
+	0x2a, 0xfc, 0xc0, 0xeb, 0xb8, 0x5f, 0x64, 0xe3, 0xd9, 0x07, 0x9f, 0xcc, 0x33, 0x1f, 0x7d, 0x32,
+	0xcf, 0xfc, 0xfd, 0x93, 0x79, 0xe6, 0xad, 0x4f, 0xe7, 0x4f, 0x7d, 0xf4, 0xe9, 0xfc, 0xa9, 0xbf,
+	0x7c, 0x3a, 0x7f, 0xea, 0xe5, 0x6b, 0xdd, 0xbf, 0x1e, 0x5a, 0xee, 0xdf, 0xa9, 0xe3, 0xe4, 0xab,
+	0x3a, 0x48, 0xda, 0x3f, 0x9f, 0xfa, 0xf7, 0x00, 0x47, 0xb7, 0xc2, 0xc5, 0xc0, 0x3f, 0x00, 0x00,
 }
 
-func (m *MsgCancelLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TrancheKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	Withdrawal(ctx context.Context, in *MsgWithdrawal, opts ...grpc.CallOption) (*MsgWithdrawalResponse, error)
+	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
+	WithdrawFilledLimitOrder(ctx context.Context, in *MsgWithdrawFilledLimitOrder, opts ...grpc.CallOption) (*MsgWithdrawFilledLimitOrderResponse, error)
+	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
+	BatchCancelLimitOrders(ctx context.Context, in *MsgBatchCancelLimitOrders, opts ...grpc.CallOption) (*MsgBatchCancelLimitOrdersResponse, error)
+	ReplaceLimitOrder(ctx context.Context, in *MsgReplaceLimitOrder, opts ...grpc.CallOption) (*MsgReplaceLimitOrderResponse, error)
+	MultiHopSwap(ctx context.Context, in *MsgMultiHopSwap, opts ...grpc.CallOption) (*MsgMultiHopSwapResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	SetPairTradingStatus(ctx context.Context, in *MsgSetPairTradingStatus, opts ...grpc.CallOption) (*MsgSetPairTradingStatusResponse, error)
+	SetDenomTradingStatus(ctx context.Context, in *MsgSetDenomTradingStatus, opts ...grpc.CallOption) (*MsgSetDenomTradingStatusResponse, error)
+	SubscribeHooks(ctx context.Context, in *MsgSubscribeHooks, opts ...grpc.CallOption) (*MsgSubscribeHooksResponse, error)
+	UnsubscribeHooks(ctx context.Context, in *MsgUnsubscribeHooks, opts ...grpc.CallOption) (*MsgUnsubscribeHooksResponse, error)
+	DepositRange(ctx context.Context, in *MsgDepositRange, opts ...grpc.CallOption) (*MsgDepositRangeResponse, error)
+	WithdrawRangePosition(ctx context.Context, in *MsgWithdrawRangePosition, opts ...grpc.CallOption) (*MsgWithdrawRangePositionResponse, error)
+	RebalanceRangePosition(ctx context.Context, in *MsgRebalanceRangePosition, opts ...grpc.CallOption) (*MsgRebalanceRangePositionResponse, error)
+	FlashSwap(ctx context.Context, in *MsgFlashSwap, opts ...grpc.CallOption) (*MsgFlashSwapResponse, error)
+	SetPairFeeTiers(ctx context.Context, in *MsgSetPairFeeTiers, opts ...grpc.CallOption) (*MsgSetPairFeeTiersResponse, error)
+	SetTraderFeeTier(ctx context.Context, in *MsgSetTraderFeeTier, opts ...grpc.CallOption) (*MsgSetTraderFeeTierResponse, error)
+	ClaimMakerRebates(ctx context.Context, in *MsgClaimMakerRebates, opts ...grpc.CallOption) (*MsgClaimMakerRebatesResponse, error)
+	PlaceTWAPOrder(ctx context.Context, in *MsgPlaceTWAPOrder, opts ...grpc.CallOption) (*MsgPlaceTWAPOrderResponse, error)
+	WithdrawTWAPOrder(ctx context.Context, in *MsgWithdrawTWAPOrder, opts ...grpc.CallOption) (*MsgWithdrawTWAPOrderResponse, error)
+	PurgeExpiredOrders(ctx context.Context, in *MsgPurgeExpiredOrders, opts ...grpc.CallOption) (*MsgPurgeExpiredOrdersResponse, error)
 }
 
-func (m *MsgCancelLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MultiHopRoute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Hops) > 0 {
-		for _, s := range m.Hops {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgMultiHopSwap) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) Withdrawal(ctx context.Context, in *MsgWithdrawal, opts ...grpc.CallOption) (*MsgWithdrawalResponse, error) {
+	out := new(MsgWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Withdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
+	out := new(MsgPlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/PlaceLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) WithdrawFilledLimitOrder(ctx context.Context, in *MsgWithdrawFilledLimitOrder, opts ...grpc.CallOption) (*MsgWithdrawFilledLimitOrderResponse, error) {
+	out := new(MsgWithdrawFilledLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/WithdrawFilledLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
+	out := new(MsgCancelLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/CancelLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExitLimitPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.PickBestRoute {
-		n += 2
+	return out, nil
+}
+
+func (c *msgClient) BatchCancelLimitOrders(ctx context.Context, in *MsgBatchCancelLimitOrders, opts ...grpc.CallOption) (*MsgBatchCancelLimitOrdersResponse, error) {
+	out := new(MsgBatchCancelLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/BatchCancelLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgMultiHopSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ReplaceLimitOrder(ctx context.Context, in *MsgReplaceLimitOrder, opts ...grpc.CallOption) (*MsgReplaceLimitOrderResponse, error) {
+	out := new(MsgReplaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/ReplaceLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.CoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Route != nil {
-		l = m.Route.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) MultiHopSwap(ctx context.Context, in *MsgMultiHopSwap, opts ...grpc.CallOption) (*MsgMultiHopSwapResponse, error) {
+	out := new(MsgMultiHopSwapResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/MultiHopSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Dust) > 0 {
-		for _, e := range m.Dust {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetPairTradingStatus(ctx context.Context, in *MsgSetPairTradingStatus, opts ...grpc.CallOption) (*MsgSetPairTradingStatusResponse, error) {
+	out := new(MsgSetPairTradingStatusResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/SetPairTradingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetDenomTradingStatus(ctx context.Context, in *MsgSetDenomTradingStatus, opts ...grpc.CallOption) (*MsgSetDenomTradingStatusResponse, error) {
+	out := new(MsgSetDenomTradingStatusResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/SetDenomTradingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SubscribeHooks(ctx context.Context, in *MsgSubscribeHooks, opts ...grpc.CallOption) (*MsgSubscribeHooksResponse, error) {
+	out := new(MsgSubscribeHooksResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/SubscribeHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (c *msgClient) UnsubscribeHooks(ctx context.Context, in *MsgUnsubscribeHooks, opts ...grpc.CallOption) (*MsgUnsubscribeHooksResponse, error) {
+	out := new(MsgUnsubscribeHooksResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/UnsubscribeHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (c *msgClient) DepositRange(ctx context.Context, in *MsgDepositRange, opts ...grpc.CallOption) (*MsgDepositRangeResponse, error) {
+	out := new(MsgDepositRangeResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/DepositRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (m *DepositOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DepositOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoswap", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DisableAutoswap = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailTxOnBel", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FailTxOnBel = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
+
+func (c *msgClient) WithdrawRangePosition(ctx context.Context, in *MsgWithdrawRangePosition, opts ...grpc.CallOption) (*MsgWithdrawRangePositionResponse, error) {
+	out := new(MsgWithdrawRangePositionResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/WithdrawRangePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
+	return out, nil
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (c *msgClient) RebalanceRangePosition(ctx context.Context, in *MsgRebalanceRangePosition, opts ...grpc.CallOption) (*MsgRebalanceRangePositionResponse, error) {
+	out := new(MsgRebalanceRangePositionResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/RebalanceRangePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return out, nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+
+func (c *msgClient) FlashSwap(ctx context.Context, in *MsgFlashSwap, opts ...grpc.CallOption) (*MsgFlashSwapResponse, error) {
+	out := new(MsgFlashSwapResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/FlashSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPairFeeTiers(ctx context.Context, in *MsgSetPairFeeTiers, opts ...grpc.CallOption) (*MsgSetPairFeeTiersResponse, error) {
+	out := new(MsgSetPairFeeTiersResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/SetPairFeeTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTraderFeeTier(ctx context.Context, in *MsgSetTraderFeeTier, opts ...grpc.CallOption) (*MsgSetTraderFeeTierResponse, error) {
+	out := new(MsgSetTraderFeeTierResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/SetTraderFeeTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimMakerRebates(ctx context.Context, in *MsgClaimMakerRebates, opts ...grpc.CallOption) (*MsgClaimMakerRebatesResponse, error) {
+	out := new(MsgClaimMakerRebatesResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/ClaimMakerRebates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceTWAPOrder(ctx context.Context, in *MsgPlaceTWAPOrder, opts ...grpc.CallOption) (*MsgPlaceTWAPOrderResponse, error) {
+	out := new(MsgPlaceTWAPOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/PlaceTWAPOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawTWAPOrder(ctx context.Context, in *MsgWithdrawTWAPOrder, opts ...grpc.CallOption) (*MsgWithdrawTWAPOrderResponse, error) {
+	out := new(MsgWithdrawTWAPOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/WithdrawTWAPOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PurgeExpiredOrders(ctx context.Context, in *MsgPurgeExpiredOrders, opts ...grpc.CallOption) (*MsgPurgeExpiredOrdersResponse, error) {
+	out := new(MsgPurgeExpiredOrdersResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/PurgeExpiredOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	Withdrawal(context.Context, *MsgWithdrawal) (*MsgWithdrawalResponse, error)
+	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
+	WithdrawFilledLimitOrder(context.Context, *MsgWithdrawFilledLimitOrder) (*MsgWithdrawFilledLimitOrderResponse, error)
+	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
+	BatchCancelLimitOrders(context.Context, *MsgBatchCancelLimitOrders) (*MsgBatchCancelLimitOrdersResponse, error)
+	ReplaceLimitOrder(context.Context, *MsgReplaceLimitOrder) (*MsgReplaceLimitOrderResponse, error)
+	MultiHopSwap(context.Context, *MsgMultiHopSwap) (*MsgMultiHopSwapResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	SetPairTradingStatus(context.Context, *MsgSetPairTradingStatus) (*MsgSetPairTradingStatusResponse, error)
+	SetDenomTradingStatus(context.Context, *MsgSetDenomTradingStatus) (*MsgSetDenomTradingStatusResponse, error)
+	SubscribeHooks(context.Context, *MsgSubscribeHooks) (*MsgSubscribeHooksResponse, error)
+	UnsubscribeHooks(context.Context, *MsgUnsubscribeHooks) (*MsgUnsubscribeHooksResponse, error)
+	DepositRange(context.Context, *MsgDepositRange) (*MsgDepositRangeResponse, error)
+	WithdrawRangePosition(context.Context, *MsgWithdrawRangePosition) (*MsgWithdrawRangePositionResponse, error)
+	RebalanceRangePosition(context.Context, *MsgRebalanceRangePosition) (*MsgRebalanceRangePositionResponse, error)
+	FlashSwap(context.Context, *MsgFlashSwap) (*MsgFlashSwapResponse, error)
+	SetPairFeeTiers(context.Context, *MsgSetPairFeeTiers) (*MsgSetPairFeeTiersResponse, error)
+	SetTraderFeeTier(context.Context, *MsgSetTraderFeeTier) (*MsgSetTraderFeeTierResponse, error)
+	ClaimMakerRebates(context.Context, *MsgClaimMakerRebates) (*MsgClaimMakerRebatesResponse, error)
+	PlaceTWAPOrder(context.Context, *MsgPlaceTWAPOrder) (*MsgPlaceTWAPOrderResponse, error)
+	WithdrawTWAPOrder(context.Context, *MsgWithdrawTWAPOrder) (*MsgWithdrawTWAPOrderResponse, error)
+	PurgeExpiredOrders(context.Context, *MsgPurgeExpiredOrders) (*MsgPurgeExpiredOrdersResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedMsgServer) Withdrawal(ctx context.Context, req *MsgWithdrawal) (*MsgWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdrawal not implemented")
+}
+func (*UnimplementedMsgServer) PlaceLimitOrder(ctx context.Context, req *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFilledLimitOrder(ctx context.Context, req *MsgWithdrawFilledLimitOrder) (*MsgWithdrawFilledLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFilledLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelLimitOrder(ctx context.Context, req *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) BatchCancelLimitOrders(ctx context.Context, req *MsgBatchCancelLimitOrders) (*MsgBatchCancelLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelLimitOrders not implemented")
+}
+func (*UnimplementedMsgServer) ReplaceLimitOrder(ctx context.Context, req *MsgReplaceLimitOrder) (*MsgReplaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) MultiHopSwap(ctx context.Context, req *MsgMultiHopSwap) (*MsgMultiHopSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiHopSwap not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetPairTradingStatus(ctx context.Context, req *MsgSetPairTradingStatus) (*MsgSetPairTradingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPairTradingStatus not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomTradingStatus(ctx context.Context, req *MsgSetDenomTradingStatus) (*MsgSetDenomTradingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomTradingStatus not implemented")
+}
+func (*UnimplementedMsgServer) SubscribeHooks(ctx context.Context, req *MsgSubscribeHooks) (*MsgSubscribeHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeHooks not implemented")
+}
+func (*UnimplementedMsgServer) UnsubscribeHooks(ctx context.Context, req *MsgUnsubscribeHooks) (*MsgUnsubscribeHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeHooks not implemented")
+}
+func (*UnimplementedMsgServer) DepositRange(ctx context.Context, req *MsgDepositRange) (*MsgDepositRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositRange not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawRangePosition(ctx context.Context, req *MsgWithdrawRangePosition) (*MsgWithdrawRangePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRangePosition not implemented")
+}
+func (*UnimplementedMsgServer) RebalanceRangePosition(ctx context.Context, req *MsgRebalanceRangePosition) (*MsgRebalanceRangePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceRangePosition not implemented")
+}
+func (*UnimplementedMsgServer) FlashSwap(ctx context.Context, req *MsgFlashSwap) (*MsgFlashSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlashSwap not implemented")
+}
+func (*UnimplementedMsgServer) SetPairFeeTiers(ctx context.Context, req *MsgSetPairFeeTiers) (*MsgSetPairFeeTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPairFeeTiers not implemented")
+}
+func (*UnimplementedMsgServer) SetTraderFeeTier(ctx context.Context, req *MsgSetTraderFeeTier) (*MsgSetTraderFeeTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTraderFeeTier not implemented")
+}
+func (*UnimplementedMsgServer) ClaimMakerRebates(ctx context.Context, req *MsgClaimMakerRebates) (*MsgClaimMakerRebatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimMakerRebates not implemented")
+}
+func (*UnimplementedMsgServer) PlaceTWAPOrder(ctx context.Context, req *MsgPlaceTWAPOrder) (*MsgPlaceTWAPOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceTWAPOrder not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawTWAPOrder(ctx context.Context, req *MsgWithdrawTWAPOrder) (*MsgWithdrawTWAPOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTWAPOrder not implemented")
+}
+func (*UnimplementedMsgServer) PurgeExpiredOrders(ctx context.Context, req *MsgPurgeExpiredOrders) (*MsgPurgeExpiredOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeExpiredOrders not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Withdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Withdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/Withdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdrawal(ctx, req.(*MsgWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/PlaceLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFilledLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFilledLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFilledLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/WithdrawFilledLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFilledLimitOrder(ctx, req.(*MsgWithdrawFilledLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/CancelLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchCancelLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCancelLimitOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCancelLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/BatchCancelLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCancelLimitOrders(ctx, req.(*MsgBatchCancelLimitOrders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReplaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/ReplaceLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceLimitOrder(ctx, req.(*MsgReplaceLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MultiHopSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiHopSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiHopSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/MultiHopSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiHopSwap(ctx, req.(*MsgMultiHopSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPairTradingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPairTradingStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPairTradingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/SetPairTradingStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPairTradingStatus(ctx, req.(*MsgSetPairTradingStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomTradingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomTradingStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomTradingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/SetDenomTradingStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomTradingStatus(ctx, req.(*MsgSetDenomTradingStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubscribeHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubscribeHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubscribeHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/SubscribeHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubscribeHooks(ctx, req.(*MsgSubscribeHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnsubscribeHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnsubscribeHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnsubscribeHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/UnsubscribeHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnsubscribeHooks(ctx, req.(*MsgUnsubscribeHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/DepositRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositRange(ctx, req.(*MsgDepositRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawRangePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRangePosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRangePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/WithdrawRangePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRangePosition(ctx, req.(*MsgWithdrawRangePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RebalanceRangePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRebalanceRangePosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RebalanceRangePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/RebalanceRangePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RebalanceRangePosition(ctx, req.(*MsgRebalanceRangePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FlashSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlashSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlashSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/FlashSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlashSwap(ctx, req.(*MsgFlashSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPairFeeTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPairFeeTiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPairFeeTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/SetPairFeeTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPairFeeTiers(ctx, req.(*MsgSetPairFeeTiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTraderFeeTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTraderFeeTier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTraderFeeTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/SetTraderFeeTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTraderFeeTier(ctx, req.(*MsgSetTraderFeeTier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimMakerRebates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimMakerRebates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimMakerRebates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/ClaimMakerRebates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimMakerRebates(ctx, req.(*MsgClaimMakerRebates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceTWAPOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceTWAPOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceTWAPOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/PlaceTWAPOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceTWAPOrder(ctx, req.(*MsgPlaceTWAPOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawTWAPOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTWAPOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTWAPOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/WithdrawTWAPOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTWAPOrder(ctx, req.(*MsgWithdrawTWAPOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PurgeExpiredOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurgeExpiredOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurgeExpiredOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/PurgeExpiredOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurgeExpiredOrders(ctx, req.(*MsgPurgeExpiredOrders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "neutron.dex.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdrawal",
+			Handler:    _Msg_Withdrawal_Handler,
+		},
+		{
+			MethodName: "PlaceLimitOrder",
+			Handler:    _Msg_PlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "WithdrawFilledLimitOrder",
+			Handler:    _Msg_WithdrawFilledLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelLimitOrder",
+			Handler:    _Msg_CancelLimitOrder_Handler,
+		},
+		{
+			MethodName: "BatchCancelLimitOrders",
+			Handler:    _Msg_BatchCancelLimitOrders_Handler,
+		},
+		{
+			MethodName: "ReplaceLimitOrder",
+			Handler:    _Msg_ReplaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "MultiHopSwap",
+			Handler:    _Msg_MultiHopSwap_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetPairTradingStatus",
+			Handler:    _Msg_SetPairTradingStatus_Handler,
+		},
+		{
+			MethodName: "SetDenomTradingStatus",
+			Handler:    _Msg_SetDenomTradingStatus_Handler,
+		},
+		{
+			MethodName: "SubscribeHooks",
+			Handler:    _Msg_SubscribeHooks_Handler,
+		},
+		{
+			MethodName: "UnsubscribeHooks",
+			Handler:    _Msg_UnsubscribeHooks_Handler,
+		},
+		{
+			MethodName: "DepositRange",
+			Handler:    _Msg_DepositRange_Handler,
+		},
+		{
+			MethodName: "WithdrawRangePosition",
+			Handler:    _Msg_WithdrawRangePosition_Handler,
+		},
+		{
+			MethodName: "RebalanceRangePosition",
+			Handler:    _Msg_RebalanceRangePosition_Handler,
+		},
+		{
+			MethodName: "FlashSwap",
+			Handler:    _Msg_FlashSwap_Handler,
+		},
+		{
+			MethodName: "SetPairFeeTiers",
+			Handler:    _Msg_SetPairFeeTiers_Handler,
+		},
+		{
+			MethodName: "SetTraderFeeTier",
+			Handler:    _Msg_SetTraderFeeTier_Handler,
+		},
+		{
+			MethodName: "ClaimMakerRebates",
+			Handler:    _Msg_ClaimMakerRebates_Handler,
+		},
+		{
+			MethodName: "PlaceTWAPOrder",
+			Handler:    _Msg_PlaceTWAPOrder_Handler,
+		},
+		{
+			MethodName: "WithdrawTWAPOrder",
+			Handler:    _Msg_WithdrawTWAPOrder_Handler,
+		},
+		{
+			MethodName: "PurgeExpiredOrders",
+			Handler:    _Msg_PurgeExpiredOrders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "neutron/dex/tx.proto",
+}
+
+func (m *DepositOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailTxOnBel {
+		i--
+		if m.FailTxOnBel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DisableAutoswap {
+		i--
+		if m.DisableAutoswap {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Fees) > 0 {
+		dAtA2 := make([]byte, len(m.Fees)*10)
+		var j1 int
+		for _, num := range m.Fees {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TickIndexesAToB) > 0 {
+		dAtA4 := make([]byte, len(m.TickIndexesAToB)*10)
+		var j3 int
+		for _, num1 := range m.TickIndexesAToB {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AmountsB) > 0 {
+		for iNdEx := len(m.AmountsB) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.AmountsB[iNdEx].Size()
+				i -= size
+				if _, err := m.AmountsB[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AmountsA) > 0 {
+		for iNdEx := len(m.AmountsA) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.AmountsA[iNdEx].Size()
+				i -= size
+				if _, err := m.AmountsA[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenB) > 0 {
+		i -= len(m.TokenB)
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenA) > 0 {
+		i -= len(m.TokenA)
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DepositIdx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DepositIdx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SharesIssued) > 0 {
+		for iNdEx := len(m.SharesIssued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SharesIssued[iNdEx].Size()
+				i -= size
+				if _, err := m.SharesIssued[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FailedDeposits) > 0 {
+		for iNdEx := len(m.FailedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Reserve1Deposited) > 0 {
+		for iNdEx := len(m.Reserve1Deposited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Reserve1Deposited[iNdEx].Size()
+				i -= size
+				if _, err := m.Reserve1Deposited[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Reserve0Deposited) > 0 {
+		for iNdEx := len(m.Reserve0Deposited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Reserve0Deposited[iNdEx].Size()
+				i -= size
+				if _, err := m.Reserve0Deposited[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		dAtA6 := make([]byte, len(m.Fees)*10)
+		var j5 int
+		for _, num := range m.Fees {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TickIndexesAToB) > 0 {
+		dAtA8 := make([]byte, len(m.TickIndexesAToB)*10)
+		var j7 int
+		for _, num1 := range m.TickIndexesAToB {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SharesToRemove) > 0 {
+		for iNdEx := len(m.SharesToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SharesToRemove[iNdEx].Size()
+				i -= size
+				if _, err := m.SharesToRemove[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenB) > 0 {
+		i -= len(m.TokenB)
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenA) > 0 {
+		i -= len(m.TokenA)
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SharesBurned) > 0 {
+		for iNdEx := len(m.SharesBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SharesBurned[iNdEx].Size()
+				i -= size
+				if _, err := m.SharesBurned[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Reserve1Withdrawn.Size()
+		i -= size
+		if _, err := m.Reserve1Withdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Reserve0Withdrawn.Size()
+		i -= size
+		if _, err := m.Reserve0Withdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoSettle {
+		i--
+		if m.AutoSettle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MinAverageSellPrice != nil {
+		{
+			size := m.MinAverageSellPrice.Size()
+			i -= size
+			if _, err := m.MinAverageSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.LimitSellPrice != nil {
+		{
+			size := m.LimitSellPrice.Size()
+			i -= size
+			if _, err := m.LimitSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxAmountOut != nil {
+		{
+			size := m.MaxAmountOut.Size()
+			i -= size
+			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ExpirationTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.TickIndexInToOut != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TickIndexInToOut))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerCoinIn.Size()
+		i -= size
+		if _, err := m.TakerCoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TakerCoinOut.Size()
+		i -= size
+		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CoinIn.Size()
+		i -= size
+		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFilledLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFilledLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFilledLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFilledLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFilledLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFilledLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MakerCoinOut.Size()
+		i -= size
+		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TakerCoinOut.Size()
+		i -= size
+		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MakerCoinOut.Size()
+		i -= size
+		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TakerCoinOut.Size()
+		i -= size
+		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCancelLimitOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCancelLimitOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancelLimitOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TrancheKeys) > 0 {
+		for iNdEx := len(m.TrancheKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrancheKeys[iNdEx])
+			copy(dAtA[i:], m.TrancheKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCancelLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCancelLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancelLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MakerCoinsOut) > 0 {
+		for iNdEx := len(m.MakerCoinsOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MakerCoinsOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TakerCoinsOut) > 0 {
+		for iNdEx := len(m.TakerCoinsOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerCoinsOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TrancheKeys) > 0 {
+		for iNdEx := len(m.TrancheKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrancheKeys[iNdEx])
+			copy(dAtA[i:], m.TrancheKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintTx(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LimitSellPrice != nil {
+		{
+			size := m.LimitSellPrice.Size()
+			i -= size
+			if _, err := m.LimitSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AmountIn != nil {
+		{
+			size := m.AmountIn.Size()
+			i -= size
+			if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerCoinIn.Size()
+		i -= size
+		if _, err := m.TakerCoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TakerCoinOut.Size()
+		i -= size
+		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CoinIn.Size()
+		i -= size
+		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.CanceledMakerCoinOut.Size()
+		i -= size
+		if _, err := m.CanceledMakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CanceledTakerCoinOut.Size()
+		i -= size
+		if _, err := m.CanceledTakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiHopRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiHopRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiHopRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hops[iNdEx])
+			copy(dAtA[i:], m.Hops[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Hops[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiHopSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiHopSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiHopSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PickBestRoute {
+		i--
+		if m.PickBestRoute {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.ExitLimitPrice.Size()
+		i -= size
+		if _, err := m.ExitLimitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiHopSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiHopSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiHopSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Dust[iNdEx].Size()
+				i -= size
+				if _, err := m.Dust[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPairTradingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPairTradingStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPairTradingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TokenB) > 0 {
+		i -= len(m.TokenB)
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenA) > 0 {
+		i -= len(m.TokenA)
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPairTradingStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPairTradingStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPairTradingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomTradingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomTradingStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomTradingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomTradingStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomTradingStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomTradingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPairFeeTiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPairFeeTiers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPairFeeTiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeTiers) > 0 {
+		dAtA27 := make([]byte, len(m.FeeTiers)*10)
+		var j26 int
+		for _, num := range m.FeeTiers {
+			for num >= 1<<7 {
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j26++
+			}
+			dAtA27[j26] = uint8(num)
+			j26++
+		}
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintTx(dAtA, i, uint64(j26))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenB) > 0 {
+		i -= len(m.TokenB)
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenA) > 0 {
+		i -= len(m.TokenA)
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPairFeeTiersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPairFeeTiersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPairFeeTiersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTraderFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTraderFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTraderFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Tier != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTraderFeeTierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTraderFeeTierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTraderFeeTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimMakerRebates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimMakerRebates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimMakerRebates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimMakerRebatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimMakerRebatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimMakerRebatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rebates) > 0 {
+		for iNdEx := len(m.Rebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubscribeHooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubscribeHooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubscribeHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenB) > 0 {
+		i -= len(m.TokenB)
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenA) > 0 {
+		i -= len(m.TokenA)
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubscribeHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubscribeHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubscribeHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnsubscribeHooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnsubscribeHooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnsubscribeHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenB) > 0 {
+		i -= len(m.TokenB)
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenA) > 0 {
+		i -= len(m.TokenA)
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnsubscribeHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnsubscribeHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnsubscribeHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.DistributionShape != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DistributionShape))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Fee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TickSpacing != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.UpperTickIndexAToB != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTickIndexAToB))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LowerTickIndexAToB != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTickIndexAToB))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.AmountB.Size()
+		i -= size
+		if _, err := m.AmountB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AmountA.Size()
+		i -= size
+		if _, err := m.AmountA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenB) > 0 {
+		i -= len(m.TokenB)
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenA) > 0 {
+		i -= len(m.TokenA)
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedDeposits) > 0 {
+		for iNdEx := len(m.FailedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SharesIssued) > 0 {
+		for iNdEx := len(m.SharesIssued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SharesIssued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Reserve1Deposited.Size()
+		i -= size
+		if _, err := m.Reserve1Deposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Reserve0Deposited.Size()
+		i -= size
+		if _, err := m.Reserve0Deposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRangePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRangePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRangePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRangePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRangePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRangePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SharesBurned) > 0 {
+		for iNdEx := len(m.SharesBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SharesBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Reserve1Withdrawn.Size()
+		i -= size
+		if _, err := m.Reserve1Withdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Reserve0Withdrawn.Size()
+		i -= size
+		if _, err := m.Reserve0Withdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRebalanceRangePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebalanceRangePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceRangePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DistributionShape != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DistributionShape))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TickSpacing != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UpperTickIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTickIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTickIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTickIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRebalanceRangePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebalanceRangePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceRangePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedDeposits) > 0 {
+		for iNdEx := len(m.FailedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SharesIssued) > 0 {
+		for iNdEx := len(m.SharesIssued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SharesIssued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Reserve1Deposited.Size()
+		i -= size
+		if _, err := m.Reserve1Deposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Reserve0Deposited.Size()
+		i -= size
+		if _, err := m.Reserve0Deposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlashSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackData) > 0 {
+		i -= len(m.CallbackData)
+		copy(dAtA[i:], m.CallbackData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CallbackData)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PickBestRoute {
+		i--
+		if m.PickBestRoute {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ExitLimitPrice.Size()
+		i -= size
+		if _, err := m.ExitLimitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlashSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CoinIn.Size()
+		i -= size
+		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Dust[iNdEx].Size()
+				i -= size
+				if _, err := m.Dust[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceTWAPOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceTWAPOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceTWAPOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Slices != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Slices))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.TotalIn.Size()
+		i -= size
+		if _, err := m.TotalIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceTWAPOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceTWAPOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceTWAPOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTWAPOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTWAPOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTWAPOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTWAPOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTWAPOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTWAPOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurgeExpiredOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurgeExpiredOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurgeExpiredOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Max))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurgeExpiredOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurgeExpiredOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurgeExpiredOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bounty) > 0 {
+		for iNdEx := len(m.Bounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.OrdersPurged != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrdersPurged))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DepositOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisableAutoswap {
+		n += 2
+	}
+	if m.FailTxOnBel {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AmountsA) > 0 {
+		for _, e := range m.AmountsA {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AmountsB) > 0 {
+		for _, e := range m.AmountsB {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TickIndexesAToB) > 0 {
+		l = 0
+		for _, e := range m.TickIndexesAToB {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Fees) > 0 {
+		l = 0
+		for _, e := range m.Fees {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FailedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositIdx != 0 {
+		n += 1 + sovTx(uint64(m.DepositIdx))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reserve0Deposited) > 0 {
+		for _, e := range m.Reserve0Deposited {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Reserve1Deposited) > 0 {
+		for _, e := range m.Reserve1Deposited {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FailedDeposits) > 0 {
+		for _, e := range m.FailedDeposits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SharesIssued) > 0 {
+		for _, e := range m.SharesIssued {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SharesToRemove) > 0 {
+		for _, e := range m.SharesToRemove {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TickIndexesAToB) > 0 {
+		l = 0
+		for _, e := range m.TickIndexesAToB {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Fees) > 0 {
+		l = 0
+		for _, e := range m.Fees {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reserve0Withdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Reserve1Withdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SharesBurned) > 0 {
+		for _, e := range m.SharesBurned {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPlaceLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TickIndexInToOut != 0 {
+		n += 1 + sovTx(uint64(m.TickIndexInToOut))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovTx(uint64(m.OrderType))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxAmountOut != nil {
+		l = m.MaxAmountOut.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSellPrice != nil {
+		l = m.LimitSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinAverageSellPrice != nil {
+		l = m.MinAverageSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AutoSettle {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgPlaceLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerCoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawFilledLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFilledLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBatchCancelLimitOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TrancheKeys) > 0 {
+		for _, s := range m.TrancheKeys {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchCancelLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TrancheKeys) > 0 {
+		for _, s := range m.TrancheKeys {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TakerCoinsOut) > 0 {
+		for _, e := range m.TakerCoinsOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MakerCoinsOut) > 0 {
+		for _, e := range m.MakerCoinsOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReplaceLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AmountIn != nil {
+		l = m.AmountIn.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSellPrice != nil {
+		l = m.LimitSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReplaceLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CanceledTakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CanceledMakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerCoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MultiHopRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for _, s := range m.Hops {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiHopSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExitLimitPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PickBestRoute {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMultiHopSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPairTradingStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgSetPairTradingStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomTradingStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgSetDenomTradingStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPairFeeTiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeTiers) > 0 {
+		l = 0
+		for _, e := range m.FeeTiers {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgSetPairFeeTiersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTraderFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Tier != 0 {
+		n += 1 + sovTx(uint64(m.Tier))
+	}
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetTraderFeeTierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimMakerRebates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimMakerRebatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rebates) > 0 {
+		for _, e := range m.Rebates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubscribeHooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubscribeHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnsubscribeHooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnsubscribeHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AmountA.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AmountB.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LowerTickIndexAToB != 0 {
+		n += 1 + sovTx(uint64(m.LowerTickIndexAToB))
+	}
+	if m.UpperTickIndexAToB != 0 {
+		n += 1 + sovTx(uint64(m.UpperTickIndexAToB))
+	}
+	if m.TickSpacing != 0 {
+		n += 1 + sovTx(uint64(m.TickSpacing))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovTx(uint64(m.Fee))
+	}
+	if m.DistributionShape != 0 {
+		n += 1 + sovTx(uint64(m.DistributionShape))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Reserve0Deposited.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Reserve1Deposited.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SharesIssued) > 0 {
+		for _, e := range m.SharesIssued {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FailedDeposits) > 0 {
+		for _, e := range m.FailedDeposits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawRangePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRangePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reserve0Withdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Reserve1Withdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SharesBurned) > 0 {
+		for _, e := range m.SharesBurned {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRebalanceRangePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	if m.LowerTickIndex != 0 {
+		n += 1 + sovTx(uint64(m.LowerTickIndex))
+	}
+	if m.UpperTickIndex != 0 {
+		n += 1 + sovTx(uint64(m.UpperTickIndex))
+	}
+	if m.TickSpacing != 0 {
+		n += 1 + sovTx(uint64(m.TickSpacing))
+	}
+	if m.DistributionShape != 0 {
+		n += 1 + sovTx(uint64(m.DistributionShape))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRebalanceRangePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reserve0Deposited.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Reserve1Deposited.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SharesIssued) > 0 {
+		for _, e := range m.SharesIssued {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FailedDeposits) > 0 {
+		for _, e := range m.FailedDeposits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFlashSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExitLimitPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PickBestRoute {
+		n += 2
+	}
+	l = len(m.CallbackData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFlashSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.CoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceTWAPOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TotalIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Slices != 0 {
+		n += 1 + sovTx(uint64(m.Slices))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceTWAPOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTWAPOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTWAPOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPurgeExpiredOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Max != 0 {
+		n += 1 + sovTx(uint64(m.Max))
+	}
+	return n
+}
+
+func (m *MsgPurgeExpiredOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrdersPurged != 0 {
+		n += 1 + sovTx(uint64(m.OrdersPurged))
+	}
+	if len(m.Bounty) > 0 {
+		for _, e := range m.Bounty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DepositOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoswap", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoswap = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailTxOnBel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailTxOnBel = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountsA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.AmountsA = append(m.AmountsA, v)
+			if err := m.AmountsA[len(m.AmountsA)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountsB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.AmountsB = append(m.AmountsB, v)
+			if err := m.AmountsB[len(m.AmountsB)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TickIndexesAToB = append(m.TickIndexesAToB, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TickIndexesAToB) == 0 {
+					m.TickIndexesAToB = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TickIndexesAToB = append(m.TickIndexesAToB, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexesAToB", wireType)
+			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Fees = append(m.Fees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Fees) == 0 {
+					m.Fees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Fees = append(m.Fees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &DepositOptions{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositIdx", wireType)
+			}
+			m.DepositIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Deposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Reserve0Deposited = append(m.Reserve0Deposited, v)
+			if err := m.Reserve0Deposited[len(m.Reserve0Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Deposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Reserve1Deposited = append(m.Reserve1Deposited, v)
+			if err := m.Reserve1Deposited[len(m.Reserve1Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDeposits = append(m.FailedDeposits, &FailedDeposit{})
+			if err := m.FailedDeposits[len(m.FailedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesIssued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharesIssued = append(m.SharesIssued, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.SharesIssued[len(m.SharesIssued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.SharesToRemove = append(m.SharesToRemove, v)
+			if err := m.SharesToRemove[len(m.SharesToRemove)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TickIndexesAToB = append(m.TickIndexesAToB, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TickIndexesAToB) == 0 {
+					m.TickIndexesAToB = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TickIndexesAToB = append(m.TickIndexesAToB, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexesAToB", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Fees = append(m.Fees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Fees) == 0 {
+					m.Fees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Fees = append(m.Fees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Withdrawn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve0Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Withdrawn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve1Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharesBurned = append(m.SharesBurned, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.SharesBurned[len(m.SharesBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
+			}
+			m.TickIndexInToOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexInToOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= LimitOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxAmountOut = &v
+			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.LimitSellPrice = &v
+			if err := m.LimitSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.MinAverageSellPrice = &v
+			if err := m.MinAverageSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoSettle = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCancelLimitOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCancelLimitOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCancelLimitOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKeys = append(m.TrancheKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCancelLimitOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCancelLimitOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCancelLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKeys = append(m.TrancheKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinsOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerCoinsOut = append(m.TakerCoinsOut, types.Coin{})
+			if err := m.TakerCoinsOut[len(m.TakerCoinsOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinsOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MakerCoinsOut = append(m.MakerCoinsOut, types.Coin{})
+			if err := m.MakerCoinsOut[len(m.MakerCoinsOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.AmountIn = &v
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.LimitSellPrice = &v
+			if err := m.LimitSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledTakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CanceledTakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledMakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CanceledMakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiHopRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiHopRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiHopSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiHopSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, &MultiHopRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitLimitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitLimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PickBestRoute", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PickBestRoute = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiHopSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiHopSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Route == nil {
+				m.Route = &MultiHopRoute{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dust = append(m.Dust, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPairTradingStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPairTradingStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPairTradingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPairTradingStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPairTradingStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPairTradingStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomTradingStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomTradingStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomTradingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomTradingStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomTradingStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomTradingStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPairFeeTiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPairFeeTiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPairFeeTiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
@@ -3069,11 +12022,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3101,11 +12054,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.TokenA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3133,11 +12086,187 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenA = string(dAtA[iNdEx:postIndex])
+			m.TokenB = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FeeTiers = append(m.FeeTiers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FeeTiers) == 0 {
+					m.FeeTiers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FeeTiers = append(m.FeeTiers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTiers", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPairFeeTiersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPairFeeTiersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPairFeeTiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTraderFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTraderFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTraderFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3165,11 +12294,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenB = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountsA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3197,17 +12326,32 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.AmountsA = append(m.AmountsA, v)
-			if err := m.AmountsA[len(m.AmountsA)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountsB", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3217,183 +12361,197 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			m.Remove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.AmountsB = append(m.AmountsB, v)
-			if err := m.AmountsB[len(m.AmountsB)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTraderFeeTierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTraderFeeTierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTraderFeeTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.TickIndexesAToB = append(m.TickIndexesAToB, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.TickIndexesAToB) == 0 {
-					m.TickIndexesAToB = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.TickIndexesAToB = append(m.TickIndexesAToB, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexesAToB", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 8:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Fees = append(m.Fees, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Fees) == 0 {
-					m.Fees = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Fees = append(m.Fees, v)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimMakerRebates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimMakerRebates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimMakerRebates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimMakerRebatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimMakerRebatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimMakerRebatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3420,8 +12578,8 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, &DepositOptions{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rebates = append(m.Rebates, types.Coin{})
+			if err := m.Rebates[len(m.Rebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3446,7 +12604,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgSubscribeHooks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3469,17 +12627,17 @@ func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FailedDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubscribeHooks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FailedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubscribeHooks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositIdx", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			m.DepositIdx = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3489,14 +12647,27 @@ func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositIdx |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3524,7 +12695,39 @@ func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.TokenA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3547,7 +12750,7 @@ func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubscribeHooksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3570,15 +12773,65 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubscribeHooksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubscribeHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnsubscribeHooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnsubscribeHooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnsubscribeHooks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Deposited", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3606,15 +12859,11 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Reserve0Deposited = append(m.Reserve0Deposited, v)
-			if err := m.Reserve0Deposited[len(m.Reserve0Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Deposited", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3642,17 +12891,13 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Reserve1Deposited = append(m.Reserve1Deposited, v)
-			if err := m.Reserve1Deposited[len(m.Reserve1Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3662,60 +12907,74 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedDeposits = append(m.FailedDeposits, &FailedDeposit{})
-			if err := m.FailedDeposits[len(m.FailedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesIssued", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnsubscribeHooksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SharesIssued = append(m.SharesIssued, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.SharesIssued[len(m.SharesIssued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnsubscribeHooksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnsubscribeHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3737,7 +12996,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3760,10 +13019,10 @@ func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3896,7 +13155,7 @@ func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesToRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3924,219 +13183,68 @@ func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.SharesToRemove = append(m.SharesToRemove, v)
-			if err := m.SharesToRemove[len(m.SharesToRemove)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.TickIndexesAToB = append(m.TickIndexesAToB, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.TickIndexesAToB) == 0 {
-					m.TickIndexesAToB = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.TickIndexesAToB = append(m.TickIndexesAToB, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexesAToB", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountB", wireType)
 			}
-		case 7:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Fees = append(m.Fees, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Fees) == 0 {
-					m.Fees = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Fees = append(m.Fees, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.AmountB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTickIndexAToB", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Withdrawn", wireType)
+			m.LowerTickIndexAToB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTickIndexAToB |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTickIndexAToB", wireType)
+			}
+			m.UpperTickIndexAToB = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4146,31 +13254,16 @@ func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpperTickIndexAToB |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Reserve0Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Withdrawn", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
 			}
-			var stringLen uint64
+			m.TickSpacing = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4180,29 +13273,52 @@ func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TickSpacing |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionShape", wireType)
 			}
-			if err := m.Reserve1Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DistributionShape = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionShape |= DistributionShape(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesBurned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4229,8 +13345,10 @@ func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SharesBurned = append(m.SharesBurned, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.SharesBurned[len(m.SharesBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Options == nil {
+				m.Options = &DepositOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4255,7 +13373,7 @@ func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4278,17 +13396,17 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			var stringLen uint64
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4298,27 +13416,14 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Deposited", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4346,11 +13451,13 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			if err := m.Reserve0Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Deposited", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4378,13 +13485,15 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			if err := m.Reserve1Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesIssued", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4394,29 +13503,31 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			m.SharesIssued = append(m.SharesIssued, types.Coin{})
+			if err := m.SharesIssued[len(m.SharesIssued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeposits", wireType)
 			}
-			m.TickIndexInToOut = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4426,14 +13537,79 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TickIndexInToOut |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDeposits = append(m.FailedDeposits, &FailedDeposit{})
+			if err := m.FailedDeposits[len(m.FailedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRangePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRangePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRangePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4461,15 +13637,13 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			m.OrderType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4479,16 +13653,29 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderType |= LimitOrderType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4498,31 +13685,64 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRangePositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationTime == nil {
-				m.ExpirationTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRangePositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRangePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Withdrawn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4550,15 +13770,13 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.MaxAmountOut = &v
-			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reserve0Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Withdrawn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4586,17 +13804,15 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
-			m.LimitSellPrice = &v
-			if err := m.LimitSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reserve1Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageSellPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesBurned", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4606,25 +13822,23 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
-			m.MinAverageSellPrice = &v
-			if err := m.MinAverageSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SharesBurned = append(m.SharesBurned, types.Coin{})
+			if err := m.SharesBurned[len(m.SharesBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4649,7 +13863,7 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRebalanceRangePosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4672,15 +13886,15 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRebalanceRangePosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRebalanceRangePosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4708,13 +13922,13 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			var msglen int
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4724,30 +13938,35 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTickIndex", wireType)
 			}
-			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LowerTickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTickIndex", wireType)
 			}
-			var msglen int
+			m.UpperTickIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4757,28 +13976,52 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UpperTickIndex |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionShape", wireType)
 			}
-			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DistributionShape = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionShape |= DistributionShape(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4805,7 +14048,10 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerCoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Options == nil {
+				m.Options = &DepositOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4830,7 +14076,7 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgRebalanceRangePositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4853,15 +14099,15 @@ func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRebalanceRangePositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRebalanceRangePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Deposited", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4889,11 +14135,13 @@ func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.Reserve0Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Deposited", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4921,7 +14169,77 @@ func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			if err := m.Reserve1Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesIssued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharesIssued = append(m.SharesIssued, types.Coin{})
+			if err := m.SharesIssued[len(m.SharesIssued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDeposits = append(m.FailedDeposits, &FailedDeposit{})
+			if err := m.FailedDeposits[len(m.FailedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4944,7 +14262,7 @@ func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFlashSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4967,15 +14285,47 @@ func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlashSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlashSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5002,15 +14352,16 @@ func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, &MultiHopRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5020,78 +14371,29 @@ func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelLimitOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitLimitPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5119,13 +14421,35 @@ func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.ExitLimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PickBestRoute", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PickBestRoute = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5135,23 +14459,25 @@ func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			m.CallbackData = append(m.CallbackData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallbackData == nil {
+				m.CallbackData = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5174,7 +14500,7 @@ func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFlashSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5197,15 +14523,15 @@ func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlashSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlashSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5232,13 +14558,13 @@ func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5265,65 +14591,85 @@ func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Route == nil {
+				m.Route = &MultiHopRoute{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.Dust = append(m.Dust, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MultiHopRoute: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiHopRoute: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5333,23 +14679,24 @@ func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hops = append(m.Hops, string(dAtA[iNdEx:postIndex]))
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5372,7 +14719,7 @@ func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceTWAPOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5395,10 +14742,10 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiHopSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceTWAPOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiHopSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceTWAPOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5435,7 +14782,7 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5463,13 +14810,13 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5479,29 +14826,27 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, &MultiHopRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalIn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5529,13 +14874,51 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slices", wireType)
+			}
+			m.Slices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitLimitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5563,15 +14946,65 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExitLimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceTWAPOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceTWAPOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceTWAPOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PickBestRoute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var v int
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5581,12 +15014,11 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PickBestRoute = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5608,7 +15040,7 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawTWAPOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5631,17 +15063,17 @@ func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiHopSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawTWAPOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiHopSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawTWAPOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5651,30 +15083,29 @@ func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var msglen int
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5684,31 +15115,64 @@ func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Route == nil {
-				m.Route = &MultiHopRoute{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTWAPOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTWAPOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTWAPOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5735,8 +15199,7 @@ func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dust = append(m.Dust, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5761,7 +15224,7 @@ func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgPurgeExpiredOrders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5784,15 +15247,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurgeExpiredOrders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurgeExpiredOrders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5820,13 +15283,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			var msglen int
+			m.Max = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5836,25 +15299,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Max |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5876,7 +15325,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPurgeExpiredOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5899,12 +15348,65 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurgeExpiredOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurgeExpiredOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersPurged", wireType)
+			}
+			m.OrdersPurged = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersPurged |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounty = append(m.Bounty, types.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
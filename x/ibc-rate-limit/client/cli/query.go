@@ -23,9 +23,12 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetParams(),
+		GetCmdRateLimit(),
+		GetCmdRateLimits(),
+		GetCmdChannelQuotas(),
 	)
 
-	return nil
+	return cmd
 }
 
 // GetParams returns the params for the module
@@ -54,3 +57,95 @@ func GetParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdRateLimit returns the quotas of a (channel, denom) path together with their flows
+func GetCmdRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limit [channel-id] [denom] [flags]",
+		Short: "Get the quotas of a denom through a channel and the flows counted against them",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimit(cmd.Context(), &types.QueryRateLimitRequest{
+				ChannelId: args[0],
+				Denom:     args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRateLimits returns the quotas of every path
+func GetCmdRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits [flags]",
+		Short: "Get the quotas of every (channel, denom) path",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RateLimits(cmd.Context(), &types.QueryRateLimitsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+// GetCmdChannelQuotas returns the quota template of a channel
+func GetCmdChannelQuotas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-quotas [channel-id] [flags]",
+		Short: "Get the quota template applied to the denoms transferred through a channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelQuotas(cmd.Context(), &types.QueryChannelQuotasRequest{
+				ChannelId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
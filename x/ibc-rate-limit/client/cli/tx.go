@@ -0,0 +1,191 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/ibc-rate-limit/types"
+)
+
+// GetTxCmd returns the transaction commands for this module. The messages can only be executed by the module
+// authority, so they are usually generated with --generate-only and wrapped into a proposal.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewSetRateLimitCmd(),
+		NewRemoveRateLimitCmd(),
+		NewResetRateLimitQuotaCmd(),
+		NewSetChannelQuotasCmd(),
+	)
+
+	return cmd
+}
+
+// NewSetRateLimitCmd broadcast MsgSetRateLimit
+func NewSetRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit [channel-id] [denom] [name:duration-seconds:max-percent-send:max-percent-recv,...] [flags]",
+		Short: "Sets the quotas of a denom through a channel, or through every channel with the \"any\" channel. Must be the module authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			quotas, err := parseQuotas(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetRateLimit{
+				Authority: clientCtx.GetFromAddress().String(),
+				RateLimit: types.RateLimit{
+					ChannelId: args[0],
+					Denom:     args[1],
+					Quotas:    quotas,
+				},
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveRateLimitCmd broadcast MsgRemoveRateLimit
+func NewRemoveRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [channel-id] [denom] [flags]",
+		Short: "Removes the quotas of a denom through a channel. Must be the module authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveRateLimit{
+				Authority: clientCtx.GetFromAddress().String(),
+				ChannelId: args[0],
+				Denom:     args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewResetRateLimitQuotaCmd broadcast MsgResetRateLimitQuota
+func NewResetRateLimitQuotaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-rate-limit-quota [channel-id] [denom] [quota-name] [flags]",
+		Short: "Clears the flow counted against a quota of a denom through a channel. Must be the module authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgResetRateLimitQuota{
+				Authority: clientCtx.GetFromAddress().String(),
+				ChannelId: args[0],
+				Denom:     args[1],
+				QuotaName: args[2],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetChannelQuotasCmd broadcast MsgSetChannelQuotas
+func NewSetChannelQuotasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-channel-quotas [channel-id] [name:duration-seconds:max-percent-send:max-percent-recv,...] [flags]",
+		Short: "Sets the quota template applied to the denoms transferred through a channel, or stops applying one if no quotas are given. Must be the module authority to do so.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var quotas []types.Quota
+			if len(args) == 2 {
+				quotas, err = parseQuotas(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgSetChannelQuotas{
+				Authority: clientCtx.GetFromAddress().String(),
+				ChannelId: args[0],
+				Quotas:    quotas,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseQuotas(s string) ([]types.Quota, error) {
+	parts := strings.Split(s, ",")
+	quotas := make([]types.Quota, 0, len(parts))
+	for _, part := range parts {
+		fields := strings.Split(part, ":")
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("invalid quota, expected name:duration-seconds:max-percent-send:max-percent-recv: %s", part)
+		}
+
+		duration, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quota duration: %w", err)
+		}
+
+		maxPercentSend, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quota max percent send: %w", err)
+		}
+
+		maxPercentRecv, err := strconv.ParseUint(fields[3], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quota max percent recv: %w", err)
+		}
+
+		quotas = append(quotas, types.Quota{
+			Name:           fields[0],
+			Duration:       duration,
+			MaxPercentSend: uint32(maxPercentSend), //nolint:gosec
+			MaxPercentRecv: uint32(maxPercentRecv), //nolint:gosec
+		})
+	}
+
+	return quotas, nil
+}
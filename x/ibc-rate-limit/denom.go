@@ -0,0 +1,38 @@
+package ibcratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/neutron-org/neutron/v5/x/ibc-rate-limit/types"
+)
+
+// LocalSendDenom returns the denom of an outbound transfer as known on Neutron. The packet of a non-native denom holds
+// its full denom trace (i.e. transfer/channel-0/uatom) since it is built before the middleware is called.
+func LocalSendDenom(denom string) string {
+	return transfertypes.ParseDenomTrace(denom).IBCDenom()
+}
+
+// LocalRecvDenom returns the denom of an inbound transfer as known on Neutron. The prefix added by the counterparty is
+// removed if Neutron is the source of the denom, and Neutron's prefix is added otherwise.
+func LocalRecvDenom(packet exported.PacketI, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		unprefixed := denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+		return transfertypes.ParseDenomTrace(unprefixed).IBCDenom()
+	}
+
+	prefixed := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
+
+// IsNewRecvDenomTrace returns whether an inbound transfer brings a denom trace Neutron has never received before. It
+// has to be called before the transfer is handled by the transfer module, which stores the trace.
+func IsNewRecvDenomTrace(ctx sdk.Context, transferKeeper types.TransferKeeper, packet exported.PacketI, denom string) bool {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		return false
+	}
+
+	prefixed := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return !transferKeeper.HasDenomTrace(ctx, transfertypes.ParseDenomTrace(prefixed).Hash())
+}
@@ -7,17 +7,34 @@ import (
 )
 
 // InitGenesis initializes the x/ibc-rate-limit module's state from a provided genesis
-// state, which includes the parameter for the contract address.
+// state, which includes the parameter for the contract address and the native rate limits.
 func (i *ICS4Wrapper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	err := i.IbcratelimitKeeper.SetParams(ctx, genState.Params)
 	if err != nil {
 		panic(err)
 	}
+
+	for _, rateLimit := range genState.RateLimits {
+		i.IbcratelimitKeeper.SetRateLimit(ctx, rateLimit)
+	}
+	for _, flow := range genState.Flows {
+		i.IbcratelimitKeeper.SetFlow(ctx, flow)
+	}
+	for _, pendingSend := range genState.PendingSends {
+		i.IbcratelimitKeeper.SetPendingSend(ctx, pendingSend)
+	}
+	for _, channelQuotas := range genState.ChannelQuotas {
+		i.IbcratelimitKeeper.SetChannelQuotas(ctx, channelQuotas)
+	}
 }
 
 // ExportGenesis returns the x/ibc-rate-limit module's exported genesis.
 func (i *ICS4Wrapper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params: i.GetParams(ctx),
+		Params:        i.GetParams(ctx),
+		RateLimits:    i.IbcratelimitKeeper.GetAllRateLimits(ctx),
+		Flows:         i.IbcratelimitKeeper.GetAllFlows(ctx),
+		PendingSends:  i.IbcratelimitKeeper.GetAllPendingSends(ctx),
+		ChannelQuotas: i.IbcratelimitKeeper.GetAllChannelQuotas(ctx),
 	}
 }
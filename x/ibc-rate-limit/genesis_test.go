@@ -3,6 +3,7 @@ package ibcratelimit_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
@@ -30,9 +31,29 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 
 	initialGenesis := types.GenesisState{
 		Params: types.Params{
-			ContractAddress: testAddress,
+			ContractAddress:      testAddress,
+			DefaultChannelQuotas: []types.Quota{{Name: "weekly", MaxPercentSend: 30, MaxPercentRecv: 30, Duration: 604800}},
+			DefaultDenomQuotas:   []types.Quota{{Name: "daily", MaxPercentRecv: 50, Duration: 86400}},
 		},
+		RateLimits: []types.RateLimit{{
+			ChannelId: "channel-0",
+			Denom:     "untrn",
+			Quotas:    []types.Quota{{Name: "daily", MaxPercentSend: 10, MaxPercentRecv: 20, Duration: 86400}},
+		}},
+		Flows: []types.Flow{{
+			ChannelId:    "channel-0",
+			Denom:        "untrn",
+			QuotaName:    "daily",
+			ChannelValue: math.NewInt(1000),
+			Buckets:      []types.FlowBucket{{StartTime: 8640, Inflow: math.NewInt(10), Outflow: math.NewInt(30)}},
+		}},
+		PendingSends: []types.PendingSend{{ChannelId: "channel-0", Sequence: 7, SendTime: 8650}},
+		ChannelQuotas: []types.ChannelQuotas{{
+			ChannelId: "channel-1",
+			Quotas:    []types.Quota{{Name: "weekly", MaxPercentSend: 30, MaxPercentRecv: 30, Duration: 604800}},
+		}},
 	}
+	suite.Require().NoError(initialGenesis.Validate())
 
 	k.InitGenesis(suite.Ctx, initialGenesis)
 
@@ -42,3 +63,51 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 
 	suite.Require().Equal(initialGenesis, *exportedGenesis)
 }
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	genesis := types.DefaultGenesis()
+	genesis.RateLimits = []types.RateLimit{{
+		ChannelId: types.AnyChannel,
+		Denom:     "untrn",
+		Quotas:    []types.Quota{{Name: "daily", MaxPercentSend: 10, Duration: 86400}},
+	}}
+	suite.Require().NoError(genesis.Validate())
+
+	// Flows must belong to a quota
+	genesis.Flows = []types.Flow{types.NewFlow(types.AnyChannel, "untrn", "weekly")}
+	suite.Require().ErrorIs(genesis.Validate(), types.ErrRateLimitNotFound)
+	genesis.Flows = nil
+
+	// Quotas must have a window and limit something
+	genesis.RateLimits[0].Quotas = append(genesis.RateLimits[0].Quotas, types.Quota{Name: "weekly", MaxPercentSend: 10})
+	suite.Require().ErrorIs(genesis.Validate(), types.ErrInvalidRateLimit)
+	genesis.RateLimits[0].Quotas[1] = types.Quota{Name: "weekly", Duration: 604800}
+	suite.Require().ErrorIs(genesis.Validate(), types.ErrInvalidRateLimit)
+	genesis.RateLimits[0].Quotas[1] = types.Quota{Name: "daily", MaxPercentSend: 10, Duration: 604800}
+	suite.Require().ErrorIs(genesis.Validate(), types.ErrInvalidRateLimit)
+
+	// Quotas must have a bounded window and percents
+	genesis.RateLimits[0].Quotas[1] = types.Quota{Name: "weekly", MaxPercentSend: 10, Duration: types.MaxQuotaDuration + 1}
+	suite.Require().ErrorIs(genesis.Validate(), types.ErrInvalidRateLimit)
+	genesis.RateLimits[0].Quotas[1] = types.Quota{Name: "weekly", MaxPercentRecv: 101, Duration: 604800}
+	suite.Require().ErrorIs(genesis.Validate(), types.ErrInvalidRateLimit)
+
+	// Paths must be a channel or "any"
+	genesis.RateLimits[0].Quotas = genesis.RateLimits[0].Quotas[:1]
+	genesis.RateLimits[0].ChannelId = "every"
+	suite.Require().ErrorIs(genesis.Validate(), types.ErrInvalidRateLimit)
+	genesis.RateLimits[0].ChannelId = types.AnyChannel
+
+	// Quota templates only apply to channels
+	genesis.ChannelQuotas = []types.ChannelQuotas{{ChannelId: types.AnyChannel, Quotas: genesis.RateLimits[0].Quotas}}
+	suite.Require().ErrorIs(genesis.Validate(), types.ErrInvalidRateLimit)
+	genesis.ChannelQuotas[0].ChannelId = "channel-0"
+	suite.Require().NoError(genesis.Validate())
+	genesis.ChannelQuotas = append(genesis.ChannelQuotas, genesis.ChannelQuotas[0])
+	suite.Require().ErrorIs(genesis.Validate(), types.ErrInvalidRateLimit)
+	genesis.ChannelQuotas = nil
+
+	// Default quotas must be valid
+	genesis.Params.DefaultDenomQuotas = []types.Quota{{Name: "daily", Duration: 86400}}
+	suite.Require().ErrorIs(genesis.Validate(), types.ErrInvalidRateLimit)
+}
@@ -24,6 +24,7 @@ import (
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/neutron-org/neutron/v5/x/ibc-rate-limit/keeper"
 	"github.com/neutron-org/neutron/v5/x/ibc-rate-limit/types"
 )
 
@@ -685,6 +686,298 @@ func (suite *MiddlewareTestSuite) TestDenomRestrictionFlow() {
 	suite.Require().NoError(err, "Send on previously blocked channel should succeed after unsetting restriction")
 }
 
+func (suite *MiddlewareTestSuite) SetNativeRateLimit(channel, denom string, quotas ...types.Quota) {
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	_, err := keeper.NewMsgServerImpl(*app.RateLimitingICS4Wrapper.IbcratelimitKeeper).SetRateLimit(suite.ChainA.GetContext(), &types.MsgSetRateLimit{
+		Authority: app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetAuthority(),
+		RateLimit: types.RateLimit{ChannelId: channel, Denom: denom, Quotas: quotas},
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *MiddlewareTestSuite) fullNativeSendTest(native bool, channel string) {
+	suite.initializeEscrow()
+	denom := sdk.DefaultBondDenom
+	if !native {
+		denomTrace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", channelTest, denom))
+		denom = denomTrace.IBCDenom()
+	}
+
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	channelValue := CalculateChannelValue(suite.ChainA.GetContext(), denom, app.BankKeeper)
+	sendAmount := channelValue.MulRaw(24).QuoRaw(1000) // 2.4% (quota is 5%)
+
+	suite.SetNativeRateLimit(channel, denom, types.Quota{Name: "weekly", Duration: 604800, MaxPercentSend: 5, MaxPercentRecv: 5})
+
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Require().NoError(err)
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Require().NoError(err)
+
+	// Sending another 0.3% would exceed the quota. Funds are escrowed before the middleware is called, so the amount
+	// must stay below the balance of the sender
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(denom, channelValue.MulRaw(3).QuoRaw(1000)))
+	suite.Require().Error(err)
+
+	// The outflow is tracked in the flow of the quota
+	flow, found := app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetFlow(suite.ChainA.GetContext(), channel, denom, "weekly")
+	suite.Require().True(found)
+	suite.Require().Equal(sendAmount.MulRaw(2), flow.NetOutflow())
+}
+
+// Test native rate limiting on sends
+func (suite *MiddlewareTestSuite) TestNativeSendTransferWithRateLimitingNative() {
+	suite.ConfigureTransferChannel()
+	suite.fullNativeSendTest(true, suite.TransferPath.EndpointA.ChannelID)
+}
+
+// Test native rate limiting on sends of a denom received through IBC
+func (suite *MiddlewareTestSuite) TestNativeSendTransferWithRateLimitingNonNative() {
+	suite.ConfigureTransferChannel()
+	suite.fullNativeSendTest(false, suite.TransferPath.EndpointA.ChannelID)
+}
+
+// Test native quotas of the "any" channel apply to every channel
+func (suite *MiddlewareTestSuite) TestNativeSendTransferAnyChannel() {
+	suite.ConfigureTransferChannel()
+	suite.fullNativeSendTest(true, types.AnyChannel)
+}
+
+// Test native rate limiting on receives
+func (suite *MiddlewareTestSuite) TestNativeRecvTransferWithRateLimiting() {
+	suite.ConfigureTransferChannel()
+	suite.initializeEscrow()
+	// stake sent from B is received as an IBC denom on A
+	localDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", suite.TransferPath.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	channelValue := CalculateChannelValue(suite.ChainA.GetContext(), localDenom, app.BankKeeper)
+	sendAmount := channelValue.QuoRaw(100).MulRaw(3) // 3% (quota is 4%)
+
+	suite.SetNativeRateLimit(suite.TransferPath.EndpointA.ChannelID, localDenom, types.Quota{Name: "weekly", Duration: 604800, MaxPercentRecv: 4})
+
+	_, err := suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, sendAmount))
+	suite.Require().NoError(err)
+
+	// Receiving another 3% would exceed the quota
+	_, err = suite.AssertReceive(false, suite.MessageFromBToA(sdk.DefaultBondDenom, sendAmount))
+	suite.Require().NoError(err)
+
+	// Sending funds back frees up the inflow quota since limits apply to the net flow
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(localDenom, sendAmount))
+	suite.Require().NoError(err)
+	_, err = suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, sendAmount))
+	suite.Require().NoError(err)
+}
+
+// Test native rate limits are reverted if a "send" fails
+func (suite *MiddlewareTestSuite) TestNativeFailedSendTransfer() {
+	suite.ConfigureTransferChannel()
+	suite.initializeEscrow()
+	channel := suite.TransferPath.EndpointA.ChannelID
+	suite.SetNativeRateLimit(channel, sdk.DefaultBondDenom, types.Quota{Name: "weekly", Duration: 604800, MaxPercentSend: 1})
+
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	quota := app.BankKeeper.GetSupply(suite.ChainA.GetContext(), sdk.DefaultBondDenom).Amount.QuoRaw(100)
+
+	// Use the whole quota with a transfer that fails on chain B
+	coins := sdk.NewCoin(sdk.DefaultBondDenom, quota)
+	msg := transfertypes.NewMsgTransfer(suite.TransferPath.EndpointA.ChannelConfig.PortID, channel, coins, suite.ChainA.SenderAccount.GetAddress().String(), "INVALID", clienttypes.NewHeight(10, 100), 0, "")
+	res, err := suite.SendMsgsNoCheck(suite.ChainA, msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	_, found := app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetPendingSend(suite.ChainA.GetContext(), channel, packet.Sequence)
+	suite.Require().True(found)
+
+	// Sending again fails as the quota is filled
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, quota))
+	suite.Require().Error(err)
+
+	suite.ChainA.NextBlock()
+	suite.ChainA.Coordinator.IncrementTime()
+	err = suite.TransferPath.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+	err = suite.TransferPath.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	// Relay the error acknowledgement from chain B to chain A
+	newRes, err := suite.TransferPath.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(newRes.GetEvents())
+	suite.Require().NoError(err)
+	err = suite.TransferPath.EndpointA.AcknowledgePacket(packet, ack)
+	suite.Require().NoError(err)
+
+	// The failed transfer has been removed from the flow
+	_, found = app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetPendingSend(suite.ChainA.GetContext(), channel, packet.Sequence)
+	suite.Require().False(found)
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, sdkmath.NewInt(2)))
+	suite.Require().NoError(err)
+}
+
+// Test native quotas track flows over a rolling window
+func (suite *MiddlewareTestSuite) TestNativeRollingWindow() {
+	suite.ConfigureTransferChannel()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	rateLimitKeeper := app.RateLimitingICS4Wrapper.IbcratelimitKeeper
+	channel := suite.TransferPath.EndpointA.ChannelID
+	quota := types.Quota{Name: "hourly", Duration: 3600, MaxPercentSend: 10}
+	suite.SetNativeRateLimit(channel, sdk.DefaultBondDenom, quota)
+
+	ctx := suite.ChainA.GetContext()
+	start := quota.BucketStart(ctx.BlockTime().Unix())
+	amount := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount.QuoRaw(20) // 5% (quota is 10%)
+
+	limited, err := rateLimitKeeper.CheckAndUpdateFlows(ctx.WithBlockTime(time.Unix(start, 0)), channel, sdk.DefaultBondDenom, amount, true)
+	suite.Require().NoError(err)
+	suite.Require().True(limited)
+	_, err = rateLimitKeeper.CheckAndUpdateFlows(ctx.WithBlockTime(time.Unix(start+1800, 0)), channel, sdk.DefaultBondDenom, amount, true)
+	suite.Require().NoError(err)
+
+	// Both transfers are still in the window
+	_, err = rateLimitKeeper.CheckAndUpdateFlows(ctx.WithBlockTime(time.Unix(start+3599, 0)), channel, sdk.DefaultBondDenom, amount, true)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	// The first transfer leaves the window an hour after the end of its bucket, the second one is still counted
+	_, err = rateLimitKeeper.CheckAndUpdateFlows(ctx.WithBlockTime(time.Unix(start+quota.BucketLength()+3600, 0)), channel, sdk.DefaultBondDenom, amount, true)
+	suite.Require().NoError(err)
+	_, err = rateLimitKeeper.CheckAndUpdateFlows(ctx.WithBlockTime(time.Unix(start+quota.BucketLength()+3600, 0)), channel, sdk.DefaultBondDenom, sdkmath.NewInt(1), true)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	// Other denoms are not limited
+	limited, err = rateLimitKeeper.CheckAndUpdateFlows(ctx, channel, "untracked", amount, true)
+	suite.Require().NoError(err)
+	suite.Require().False(limited)
+}
+
+// Test the default channel quotas apply to the denoms transferred through a newly opened channel until the channel is
+// closed
+func (suite *MiddlewareTestSuite) TestNativeDefaultChannelQuotas() {
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	rateLimitKeeper := app.RateLimitingICS4Wrapper.IbcratelimitKeeper
+	quota := types.Quota{Name: "weekly", Duration: 604800, MaxPercentSend: 5}
+	params := types.DefaultParams()
+	params.DefaultChannelQuotas = []types.Quota{quota}
+	suite.Require().NoError(rateLimitKeeper.SetParams(suite.ChainA.GetContext(), params))
+
+	suite.ConfigureTransferChannel()
+	channel := suite.TransferPath.EndpointA.ChannelID
+	channelQuotas, found := rateLimitKeeper.GetChannelQuotas(suite.ChainA.GetContext(), channel)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.Quota{quota}, channelQuotas.Quotas)
+
+	// The first transfer of a denom creates its rate limit from the template
+	channelValue := CalculateChannelValue(suite.ChainA.GetContext(), sdk.DefaultBondDenom, app.BankKeeper)
+	sendAmount := channelValue.MulRaw(24).QuoRaw(1000) // 2.4% (quota is 5%)
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, sendAmount))
+	suite.Require().NoError(err)
+	rateLimit, found := rateLimitKeeper.GetRateLimit(suite.ChainA.GetContext(), channel, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.Quota{quota}, rateLimit.Quotas)
+
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, sendAmount))
+	suite.Require().NoError(err)
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, channelValue.MulRaw(3).QuoRaw(1000)))
+	suite.Require().Error(err)
+
+	// Governance can override the quotas of the denom
+	suite.SetNativeRateLimit(channel, sdk.DefaultBondDenom, types.Quota{Name: "weekly", Duration: 604800, MaxPercentSend: 6})
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, channelValue.MulRaw(3).QuoRaw(1000)))
+	suite.Require().NoError(err)
+
+	// or stop applying a template to the channel
+	_, err = keeper.NewMsgServerImpl(*rateLimitKeeper).SetChannelQuotas(suite.ChainA.GetContext(), &types.MsgSetChannelQuotas{
+		Authority: rateLimitKeeper.GetAuthority(),
+		ChannelId: channel,
+	})
+	suite.Require().NoError(err)
+	_, found = rateLimitKeeper.GetChannelQuotas(suite.ChainA.GetContext(), channel)
+	suite.Require().False(found)
+
+	// Closing the channel removes its quotas
+	ctx := suite.ChainA.GetContext()
+	rateLimitKeeper.SetChannelQuotas(ctx, channelQuotas)
+	suite.Require().NoError(app.TransferStack.OnChanCloseConfirm(ctx, suite.TransferPath.EndpointA.ChannelConfig.PortID, channel))
+	_, found = rateLimitKeeper.GetChannelQuotas(ctx, channel)
+	suite.Require().False(found)
+	_, found = rateLimitKeeper.GetRateLimit(ctx, channel, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+	suite.Require().Empty(rateLimitKeeper.GetFlows(ctx, channel, sdk.DefaultBondDenom))
+}
+
+// Test the default denom quotas apply to a denom from its first receipt
+func (suite *MiddlewareTestSuite) TestNativeDefaultDenomQuotas() {
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	rateLimitKeeper := app.RateLimitingICS4Wrapper.IbcratelimitKeeper
+	quota := types.Quota{Name: "daily", Duration: 86400, MaxPercentRecv: 50}
+	params := types.DefaultParams()
+	params.DefaultDenomQuotas = []types.Quota{quota}
+	suite.Require().NoError(rateLimitKeeper.SetParams(suite.ChainA.GetContext(), params))
+
+	suite.ConfigureTransferChannel()
+	localDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", suite.TransferPath.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+	// The first receipt can't be checked since the denom has no supply yet, but it is counted against the quota
+	amount := sdkmath.NewInt(1000)
+	_, err := suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, amount))
+	suite.Require().NoError(err)
+	rateLimit, found := rateLimitKeeper.GetRateLimit(suite.ChainA.GetContext(), types.AnyChannel, localDenom)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.Quota{quota}, rateLimit.Quotas)
+	_, err = suite.AssertReceive(false, suite.MessageFromBToA(sdk.DefaultBondDenom, sdkmath.OneInt()))
+	suite.Require().NoError(err)
+
+	// Governance can remove the quotas, they aren't applied again since the denom trace is known
+	_, err = keeper.NewMsgServerImpl(*rateLimitKeeper).RemoveRateLimit(suite.ChainA.GetContext(), &types.MsgRemoveRateLimit{
+		Authority: rateLimitKeeper.GetAuthority(),
+		ChannelId: types.AnyChannel,
+		Denom:     localDenom,
+	})
+	suite.Require().NoError(err)
+	_, err = suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, amount))
+	suite.Require().NoError(err)
+	_, found = rateLimitKeeper.GetRateLimit(suite.ChainA.GetContext(), types.AnyChannel, localDenom)
+	suite.Require().False(found)
+}
+
+// Test a denom without supply can be received and is then limited by the value of its first receipt, then by its
+// supply once the window of the first receipt is over
+func (suite *MiddlewareTestSuite) TestNativeQuotasValueDenomWithoutSupply() {
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	rateLimitKeeper := app.RateLimitingICS4Wrapper.IbcratelimitKeeper
+	quota := types.Quota{Name: "daily", Duration: 86400, MaxPercentRecv: 50}
+	params := types.DefaultParams()
+	params.DefaultDenomQuotas = []types.Quota{quota}
+	suite.Require().NoError(rateLimitKeeper.SetParams(suite.ChainA.GetContext(), params))
+
+	suite.ConfigureTransferChannel()
+	channel := suite.TransferPath.EndpointA.ChannelID
+	localDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", channel, sdk.DefaultBondDenom)).IBCDenom()
+
+	// The first receipt goes over the quota but arrives
+	amount := sdkmath.NewInt(1000)
+	_, err := suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, amount))
+	suite.Require().NoError(err)
+	ctx := suite.ChainA.GetContext()
+	suite.Require().Equal(amount, app.BankKeeper.GetSupply(ctx, localDenom).Amount)
+	flows := rateLimitKeeper.GetFlows(ctx, types.AnyChannel, localDenom)
+	suite.Require().Len(flows, 1)
+	suite.Require().Equal(amount, flows[0].ChannelValue)
+
+	// The next ones are limited for the rest of the window
+	_, err = suite.AssertReceive(false, suite.MessageFromBToA(sdk.DefaultBondDenom, sdkmath.OneInt()))
+	suite.Require().NoError(err)
+
+	// Then by the supply
+	ctx = ctx.WithBlockTime(time.Unix(quota.BucketStart(ctx.BlockTime().Unix())+quota.BucketLength()+86400, 0))
+	_, err = rateLimitKeeper.CheckAndUpdateFlows(ctx, channel, localDenom, amount.QuoRaw(100).MulRaw(40), false)
+	suite.Require().NoError(err)
+	_, err = rateLimitKeeper.CheckAndUpdateFlows(ctx, channel, localDenom, amount.QuoRaw(100).MulRaw(20), false)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+}
+
 func (suite *MiddlewareTestSuite) InstantiateRLContract(quotas string) sdk.AccAddress {
 	app := suite.GetNeutronZoneApp(suite.ChainA)
 	transferModule := app.AccountKeeper.GetModuleAddress(transfertypes.ModuleName)
@@ -7,10 +7,12 @@ import (
 	"github.com/neutron-org/neutron/v5/x/ibc-hooks/utils"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
@@ -75,8 +77,12 @@ func (im *IBCModule) OnChanOpenAck(
 	counterpartyChannelID string,
 	counterpartyVersion string,
 ) error {
-	// Here we can add initial limits when a new channel is open. For now, they can be added manually on the contract
-	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+	if err := im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion); err != nil {
+		return err
+	}
+
+	im.ics4Middleware.IbcratelimitKeeper.ApplyDefaultChannelQuotas(ctx, channelID)
+	return nil
 }
 
 // OnChanOpenConfirm implements the IBCModule interface
@@ -85,8 +91,12 @@ func (im *IBCModule) OnChanOpenConfirm(
 	portID,
 	channelID string,
 ) error {
-	// Here we can add initial limits when a new channel is open. For now, they can be added manually on the contract
-	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+	if err := im.app.OnChanOpenConfirm(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	im.ics4Middleware.IbcratelimitKeeper.ApplyDefaultChannelQuotas(ctx, channelID)
+	return nil
 }
 
 // OnChanCloseInit implements the IBCModule interface
@@ -95,8 +105,13 @@ func (im *IBCModule) OnChanCloseInit(
 	portID,
 	channelID string,
 ) error {
-	// Here we can remove the limits when a new channel is closed. For now, they can remove them  manually on the contract
-	return im.app.OnChanCloseInit(ctx, portID, channelID)
+	if err := im.app.OnChanCloseInit(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	// The limits of the contract still have to be removed manually
+	im.ics4Middleware.IbcratelimitKeeper.RemoveChannelRateLimits(ctx, channelID)
+	return nil
 }
 
 // OnChanCloseConfirm implements the IBCModule interface
@@ -105,8 +120,13 @@ func (im *IBCModule) OnChanCloseConfirm(
 	portID,
 	channelID string,
 ) error {
-	// Here we can remove the limits when a new channel is closed. For now, they can remove them  manually on the contract
-	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+	if err := im.app.OnChanCloseConfirm(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	// The limits of the contract still have to be removed manually
+	im.ics4Middleware.IbcratelimitKeeper.RemoveChannelRateLimits(ctx, channelID)
+	return nil
 }
 
 type receiverParser struct {
@@ -135,6 +155,10 @@ func (im *IBCModule) OnRecvPacket(
 		return utils.NewEmitErrorAcknowledgement(ctx, types.ErrBadMessage, err.Error())
 	}
 
+	if err := im.checkAndUpdateNativeRecv(ctx, packet); err != nil {
+		return utils.NewEmitErrorAcknowledgement(ctx, err)
+	}
+
 	contract := im.ics4Middleware.GetContractAddress(ctx)
 	if contract == "" {
 		// The contract has not been configured. Continue as usual
@@ -181,6 +205,8 @@ func (im *IBCModule) OnAcknowledgementPacket(
 				),
 			)
 		}
+	} else {
+		im.ics4Middleware.IbcratelimitKeeper.RemovePendingSend(ctx, packet.GetSourceChannel(), packet.GetSequence())
 	}
 
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
@@ -206,23 +232,61 @@ func (im *IBCModule) OnTimeoutPacket(
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
 
-// RevertSentPacket Notifies the contract that a sent packet wasn't properly received
+// checkAndUpdateNativeRecv counts a received transfer against the native quotas of its (channel+denom), applying the
+// default denom quotas first if it brings a new denom trace
+func (im *IBCModule) checkAndUpdateNativeRecv(
+	ctx sdk.Context,
+	packet exported.PacketI,
+) error {
+	var packetdata transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &packetdata); err != nil || packetdata.Denom == "" || packetdata.Amount == "" {
+		// Not a transfer. Continue as usual
+		return nil
+	}
+
+	amount, ok := math.NewIntFromString(packetdata.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrBadMessage, "invalid amount %s", packetdata.Amount)
+	}
+
+	denom := LocalRecvDenom(packet, packetdata.Denom)
+	if im.ics4Middleware.TransferKeeper != nil && IsNewRecvDenomTrace(ctx, im.ics4Middleware.TransferKeeper, packet, packetdata.Denom) {
+		im.ics4Middleware.IbcratelimitKeeper.ApplyDefaultDenomQuotas(ctx, denom)
+	}
+
+	_, err := im.ics4Middleware.IbcratelimitKeeper.CheckAndUpdateFlows(ctx, packet.GetDestChannel(), denom, amount, false)
+	return err
+}
+
+// RevertSentPacket removes a sent packet which wasn't properly received from the native flows and notifies the
+// contract about it. The contract is notified even if the packet can't be reverted natively
 func (im *IBCModule) RevertSentPacket(
 	ctx sdk.Context,
 	packet exported.PacketI,
 ) error {
+	var nativeErr error
+	var packetdata transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &packetdata); err != nil {
+		nativeErr = errorsmod.Wrap(err, "failed to RevertSentPacket")
+	} else if amount, ok := math.NewIntFromString(packetdata.Amount); ok {
+		im.ics4Middleware.IbcratelimitKeeper.UndoSend(ctx, packet.GetSourceChannel(), packet.GetSequence(), LocalSendDenom(packetdata.Denom), amount)
+	}
+
 	contract := im.ics4Middleware.GetContractAddress(ctx)
 	if contract == "" {
 		// The contract has not been configured. Continue as usual
-		return nil
+		return nativeErr
 	}
 
-	return UndoSendRateLimit(
+	if err := UndoSendRateLimit(
 		ctx,
 		im.ics4Middleware.ContractKeeper,
 		contract,
 		packet,
-	)
+	); err != nil {
+		return err
+	}
+	return nativeErr
 }
 
 // SendPacket implements the ICS4 Wrapper interface
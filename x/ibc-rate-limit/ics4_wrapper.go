@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +32,8 @@ type ICS4Wrapper struct {
 	bankKeeper         *bankkeeper.BaseKeeper
 	ContractKeeper     *wasmkeeper.PermissionedKeeper
 	IbcratelimitKeeper *keeper.Keeper
+	// TransferKeeper is set later, right after the transfer keeper wrapping this middleware is created
+	TransferKeeper types.TransferKeeper
 }
 
 func (i *ICS4Wrapper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
@@ -52,10 +55,10 @@ func NewICS4Middleware(
 }
 
 // SendPacket implements the ICS4 interface and is called when sending packets.
-// This method retrieves the contract from the middleware's parameters and checks if the limits have been exceeded for
-// the current transfer, in which case it returns an error preventing the IBC send from taking place.
-// If the contract param is not configured, or the contract doesn't have a configuration for the (channel+denom) being
-// used, transfers are not prevented and handled by the wrapped IBC app
+// This method counts the current transfer against the native quotas of its (channel+denom) and, if configured, asks
+// the contract from the middleware's parameters whether its limits have been exceeded. If any limit is exceeded, it
+// returns an error preventing the IBC send from taking place.
+// Transfers without quotas are not prevented and handled by the wrapped IBC app
 func (i *ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
 	var packetdata transfertypes.FungibleTokenPacketData
 	if err := json.Unmarshal(data, &packetdata); err != nil {
@@ -64,10 +67,20 @@ func (i *ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capab
 	if packetdata.Denom == "" || packetdata.Amount == "" {
 		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
+
+	amount, ok := math.NewIntFromString(packetdata.Amount)
+	if !ok {
+		return 0, errorsmod.Wrapf(types.ErrBadMessage, "invalid amount %s", packetdata.Amount)
+	}
+	limited, err := i.IbcratelimitKeeper.CheckAndUpdateFlows(ctx, sourceChannel, LocalSendDenom(packetdata.Denom), amount, true)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
+	}
+
 	contract := i.GetContractAddress(ctx)
 	if contract == "" {
 		// The contract has not been configured. Continue as usual
-		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+		return i.sendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data, limited)
 	}
 
 	// setting 0 as a default so it can be properly parsed by cosmwasm
@@ -82,12 +95,29 @@ func (i *ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capab
 		TimeoutHeight:      timeoutHeight,
 	}
 
-	err := CheckAndUpdateRateLimits(ctx, i.ContractKeeper, msgSend, contract, fullPacket)
+	err = CheckAndUpdateRateLimits(ctx, i.ContractKeeper, msgSend, contract, fullPacket)
 	if err != nil {
 		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
 	}
 
-	return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	return i.sendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data, limited)
+}
+
+// sendPacket sends a packet through the wrapped channel. Transfers counted against native quotas are remembered until
+// acknowledged or timed out so that failed ones can be removed from the flows.
+func (i *ICS4Wrapper) sendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte, limited bool) (uint64, error) {
+	sequence, err := i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil || !limited {
+		return sequence, err
+	}
+
+	i.IbcratelimitKeeper.SetPendingSend(ctx, types.PendingSend{
+		ChannelId: sourceChannel,
+		Sequence:  sequence,
+		SendTime:  ctx.BlockTime().Unix(),
+	})
+
+	return sequence, nil
 }
 
 func (i *ICS4Wrapper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/ibc-rate-limit/types"
+)
+
+func (k Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no rate limit for %s through %s", req.Denom, req.ChannelId)
+	}
+
+	return &types.QueryRateLimitResponse{RateLimit: rateLimit, Flows: k.GetFlows(ctx, req.ChannelId, req.Denom)}, nil
+}
+
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var rateLimits []types.RateLimit
+	pageRes, err := query.Paginate(k.GetRateLimitPrefixStore(ctx), req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: rateLimits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ChannelQuotas(c context.Context, req *types.QueryChannelQuotasRequest) (*types.QueryChannelQuotasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channelQuotas, found := k.GetChannelQuotas(ctx, req.ChannelId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no quota template for %s", req.ChannelId)
+	}
+
+	return &types.QueryChannelQuotasResponse{ChannelQuotas: channelQuotas}, nil
+}
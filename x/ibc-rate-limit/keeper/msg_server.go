@@ -39,3 +39,81 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetRateLimit sets the quotas of a (channel, denom) path
+func (k msgServer) SetRateLimit(goCtx context.Context, req *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetRateLimit")
+	}
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Keeper.SetRateLimit(ctx, req.RateLimit)
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit removes the quotas of a (channel, denom) path
+func (k msgServer) RemoveRateLimit(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRemoveRateLimit")
+	}
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom); !found {
+		return nil, errors.Wrapf(types.ErrRateLimitNotFound, "%s through %s", req.Denom, req.ChannelId)
+	}
+	k.Keeper.RemoveRateLimit(ctx, req.ChannelId, req.Denom)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimitQuota clears the flow counted against a quota
+func (k msgServer) ResetRateLimitQuota(goCtx context.Context, req *types.MsgResetRateLimitQuota) (*types.MsgResetRateLimitQuotaResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgResetRateLimitQuota")
+	}
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, errors.Wrapf(types.ErrRateLimitNotFound, "%s through %s", req.Denom, req.ChannelId)
+	}
+	if _, found := rateLimit.GetQuota(req.QuotaName); !found {
+		return nil, errors.Wrapf(types.ErrRateLimitNotFound, "quota %s of %s through %s", req.QuotaName, req.Denom, req.ChannelId)
+	}
+	k.removeFlow(ctx, req.ChannelId, req.Denom, req.QuotaName)
+
+	return &types.MsgResetRateLimitQuotaResponse{}, nil
+}
+
+// SetChannelQuotas sets the quota template of a channel, or stops applying one if the quotas are empty
+func (k msgServer) SetChannelQuotas(goCtx context.Context, req *types.MsgSetChannelQuotas) (*types.MsgSetChannelQuotasResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetChannelQuotas")
+	}
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if len(req.Quotas) == 0 {
+		k.Keeper.RemoveChannelQuotas(ctx, req.ChannelId)
+	} else {
+		k.Keeper.SetChannelQuotas(ctx, types.ChannelQuotas{ChannelId: req.ChannelId, Quotas: req.Quotas})
+	}
+
+	return &types.MsgSetChannelQuotasResponse{}, nil
+}
@@ -0,0 +1,349 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/ibc-rate-limit/types"
+)
+
+// GetRateLimitPrefixStore returns the substore of the rate limits by path
+func (k Keeper) GetRateLimitPrefixStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKey)
+}
+
+// GetFlowPrefixStore returns the substore of the flows by path and quota name
+func (k Keeper) GetFlowPrefixStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.FlowKey)
+}
+
+// GetPendingSendPrefixStore returns the substore of the pending sends by channel and sequence
+func (k Keeper) GetPendingSendPrefixStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendKey)
+}
+
+// GetChannelQuotasPrefixStore returns the substore of the quota templates by channel
+func (k Keeper) GetChannelQuotasPrefixStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelQuotasKey)
+}
+
+// GetRateLimit returns the quotas of a (channel, denom) path
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (rateLimit types.RateLimit, found bool) {
+	bz := k.GetRateLimitPrefixStore(ctx).Get(types.GetPathKey(channelID, denom))
+	if bz == nil {
+		return rateLimit, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// SetRateLimit sets the quotas of a path, dropping the flows of the quotas which are removed or get another duration
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	if previous, found := k.GetRateLimit(ctx, rateLimit.ChannelId, rateLimit.Denom); found {
+		for _, quota := range previous.Quotas {
+			if updated, ok := rateLimit.GetQuota(quota.Name); !ok || updated.Duration != quota.Duration {
+				k.removeFlow(ctx, rateLimit.ChannelId, rateLimit.Denom, quota.Name)
+			}
+		}
+	}
+
+	k.GetRateLimitPrefixStore(ctx).Set(types.GetPathKey(rateLimit.ChannelId, rateLimit.Denom), k.cdc.MustMarshal(&rateLimit))
+}
+
+// RemoveRateLimit removes the quotas of a path together with their flows
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, channelID, denom string) {
+	if rateLimit, found := k.GetRateLimit(ctx, channelID, denom); found {
+		for _, quota := range rateLimit.Quotas {
+			k.removeFlow(ctx, channelID, denom, quota.Name)
+		}
+	}
+
+	k.GetRateLimitPrefixStore(ctx).Delete(types.GetPathKey(channelID, denom))
+}
+
+// GetAllRateLimits returns the quotas of every path
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) (rateLimits []types.RateLimit) {
+	iterator := storetypes.KVStorePrefixIterator(k.GetRateLimitPrefixStore(ctx), []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// RemoveChannelRateLimits removes the quota template of a channel and the quotas of every path through it together
+// with their flows
+func (k Keeper) RemoveChannelRateLimits(ctx sdk.Context, channelID string) {
+	k.RemoveChannelQuotas(ctx, channelID)
+
+	iterator := storetypes.KVStorePrefixIterator(k.GetRateLimitPrefixStore(ctx), types.GetChannelKey(channelID))
+	var rateLimits []types.RateLimit
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+	iterator.Close()
+
+	for _, rateLimit := range rateLimits {
+		k.RemoveRateLimit(ctx, rateLimit.ChannelId, rateLimit.Denom)
+	}
+}
+
+// GetChannelQuotas returns the quota template of a channel
+func (k Keeper) GetChannelQuotas(ctx sdk.Context, channelID string) (channelQuotas types.ChannelQuotas, found bool) {
+	bz := k.GetChannelQuotasPrefixStore(ctx).Get(types.GetChannelKey(channelID))
+	if bz == nil {
+		return channelQuotas, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &channelQuotas)
+
+	return channelQuotas, true
+}
+
+// SetChannelQuotas sets the quota template of a channel. It doesn't change the rate limits already created from the
+// previous one.
+func (k Keeper) SetChannelQuotas(ctx sdk.Context, channelQuotas types.ChannelQuotas) {
+	k.GetChannelQuotasPrefixStore(ctx).Set(types.GetChannelKey(channelQuotas.ChannelId), k.cdc.MustMarshal(&channelQuotas))
+}
+
+// RemoveChannelQuotas stops applying a quota template to the denoms transferred through a channel
+func (k Keeper) RemoveChannelQuotas(ctx sdk.Context, channelID string) {
+	k.GetChannelQuotasPrefixStore(ctx).Delete(types.GetChannelKey(channelID))
+}
+
+// GetAllChannelQuotas returns the quota template of every channel
+func (k Keeper) GetAllChannelQuotas(ctx sdk.Context) (channelQuotas []types.ChannelQuotas) {
+	iterator := storetypes.KVStorePrefixIterator(k.GetChannelQuotasPrefixStore(ctx), []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var quotas types.ChannelQuotas
+		k.cdc.MustUnmarshal(iterator.Value(), &quotas)
+		channelQuotas = append(channelQuotas, quotas)
+	}
+
+	return channelQuotas
+}
+
+// ApplyDefaultChannelQuotas sets the default channel quotas from the params as the quota template of a newly opened
+// channel
+func (k Keeper) ApplyDefaultChannelQuotas(ctx sdk.Context, channelID string) {
+	quotas := k.GetParams(ctx).DefaultChannelQuotas
+	if len(quotas) == 0 {
+		return
+	}
+
+	k.SetChannelQuotas(ctx, types.ChannelQuotas{ChannelId: channelID, Quotas: quotas})
+	emitDefaultQuotasApplied(ctx, channelID, "")
+}
+
+// ApplyDefaultDenomQuotas sets the default denom quotas from the params as the quotas of a newly received denom through
+// AnyChannel, unless governance has already set some
+func (k Keeper) ApplyDefaultDenomQuotas(ctx sdk.Context, denom string) {
+	quotas := k.GetParams(ctx).DefaultDenomQuotas
+	if len(quotas) == 0 {
+		return
+	}
+	if _, found := k.GetRateLimit(ctx, types.AnyChannel, denom); found {
+		return
+	}
+
+	k.SetRateLimit(ctx, types.RateLimit{ChannelId: types.AnyChannel, Denom: denom, Quotas: quotas})
+	emitDefaultQuotasApplied(ctx, types.AnyChannel, denom)
+}
+
+// getOrCreateRateLimit returns the quotas of a path, creating them from the quota template of the channel if the path
+// has none yet
+func (k Keeper) getOrCreateRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	if rateLimit, found := k.GetRateLimit(ctx, channelID, denom); found {
+		return rateLimit, true
+	}
+
+	channelQuotas, found := k.GetChannelQuotas(ctx, channelID)
+	if !found {
+		return types.RateLimit{}, false
+	}
+
+	rateLimit := types.RateLimit{ChannelId: channelID, Denom: denom, Quotas: channelQuotas.Quotas}
+	k.SetRateLimit(ctx, rateLimit)
+	emitDefaultQuotasApplied(ctx, channelID, denom)
+
+	return rateLimit, true
+}
+
+func emitDefaultQuotasApplied(ctx sdk.Context, channelID, denom string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventDefaultQuotasApplied,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+}
+
+// GetFlow returns the flow counted against a quota of a path
+func (k Keeper) GetFlow(ctx sdk.Context, channelID, denom, quotaName string) (flow types.Flow, found bool) {
+	bz := k.GetFlowPrefixStore(ctx).Get(types.GetFlowKey(channelID, denom, quotaName))
+	if bz == nil {
+		return flow, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &flow)
+
+	return flow, true
+}
+
+// SetFlow sets the flow counted against a quota of a path
+func (k Keeper) SetFlow(ctx sdk.Context, flow types.Flow) {
+	k.GetFlowPrefixStore(ctx).Set(types.GetFlowKey(flow.ChannelId, flow.Denom, flow.QuotaName), k.cdc.MustMarshal(&flow))
+}
+
+func (k Keeper) removeFlow(ctx sdk.Context, channelID, denom, quotaName string) {
+	k.GetFlowPrefixStore(ctx).Delete(types.GetFlowKey(channelID, denom, quotaName))
+}
+
+// GetFlows returns the flows counted against the quotas of a path
+func (k Keeper) GetFlows(ctx sdk.Context, channelID, denom string) []types.Flow {
+	return k.getFlowsWithPrefix(ctx, types.GetPathKey(channelID, denom))
+}
+
+// GetAllFlows returns the flows counted against the quotas of every path
+func (k Keeper) GetAllFlows(ctx sdk.Context) []types.Flow {
+	return k.getFlowsWithPrefix(ctx, []byte{})
+}
+
+func (k Keeper) getFlowsWithPrefix(ctx sdk.Context, keyPrefix []byte) (flows []types.Flow) {
+	iterator := storetypes.KVStorePrefixIterator(k.GetFlowPrefixStore(ctx), keyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.Flow
+		k.cdc.MustUnmarshal(iterator.Value(), &flow)
+		flows = append(flows, flow)
+	}
+
+	return flows
+}
+
+// GetPendingSend returns an outbound transfer counted against quotas which hasn't been acknowledged or timed out yet
+func (k Keeper) GetPendingSend(ctx sdk.Context, channelID string, sequence uint64) (pendingSend types.PendingSend, found bool) {
+	bz := k.GetPendingSendPrefixStore(ctx).Get(types.GetPendingSendKey(channelID, sequence))
+	if bz == nil {
+		return pendingSend, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &pendingSend)
+
+	return pendingSend, true
+}
+
+// SetPendingSend records an outbound transfer counted against quotas
+func (k Keeper) SetPendingSend(ctx sdk.Context, pendingSend types.PendingSend) {
+	k.GetPendingSendPrefixStore(ctx).Set(types.GetPendingSendKey(pendingSend.ChannelId, pendingSend.Sequence), k.cdc.MustMarshal(&pendingSend))
+}
+
+// RemovePendingSend forgets an outbound transfer once it has been acknowledged or has timed out
+func (k Keeper) RemovePendingSend(ctx sdk.Context, channelID string, sequence uint64) {
+	k.GetPendingSendPrefixStore(ctx).Delete(types.GetPendingSendKey(channelID, sequence))
+}
+
+// GetAllPendingSends returns every outbound transfer counted against quotas which hasn't been acknowledged or timed out
+func (k Keeper) GetAllPendingSends(ctx sdk.Context) (pendingSends []types.PendingSend) {
+	iterator := storetypes.KVStorePrefixIterator(k.GetPendingSendPrefixStore(ctx), []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingSend types.PendingSend
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingSend)
+		pendingSends = append(pendingSends, pendingSend)
+	}
+
+	return pendingSends
+}
+
+// CheckAndUpdateFlows counts a transfer of a denom through a channel against the quotas of the channel and of
+// AnyChannel, creating the quotas of the channel path from the channel quota template if needed. It returns
+// ErrRateLimitExceeded without updating any flow if the transfer goes over a quota, and whether the transfer has been
+// counted against any quota otherwise.
+func (k Keeper) CheckAndUpdateFlows(ctx sdk.Context, channelID, denom string, amount math.Int, send bool) (bool, error) {
+	blockTime := ctx.BlockTime().Unix()
+
+	var flows []types.Flow
+	for _, path := range []string{channelID, types.AnyChannel} {
+		rateLimit, found := k.getOrCreateRateLimit(ctx, path, denom)
+		if !found {
+			continue
+		}
+
+		for _, quota := range rateLimit.Quotas {
+			flow, found := k.GetFlow(ctx, path, denom, quota.Name)
+			if !found {
+				flow = types.NewFlow(path, denom, quota.Name)
+			}
+
+			flow.Prune(quota, blockTime)
+			unvalued := false
+			if len(flow.Buckets) == 0 {
+				flow.ChannelValue = k.bankKeeper.GetSupply(ctx, denom).Amount
+				if flow.ChannelValue.IsZero() {
+					// Percentages of a denom without supply, e.g. received for the first time, can't be evaluated. Its
+					// first transfer is counted without being checked and values the denom for the rest of the window.
+					flow.ChannelValue = amount
+					unvalued = true
+				}
+			}
+			flow.Add(quota, blockTime, amount, send)
+
+			if !unvalued {
+				if err := quota.Check(flow, send); err != nil {
+					return false, err
+				}
+			}
+			flows = append(flows, flow)
+		}
+	}
+
+	for _, flow := range flows {
+		k.SetFlow(ctx, flow)
+	}
+
+	return len(flows) > 0, nil
+}
+
+// UndoSend removes a failed outbound transfer from the flows it has been counted in, unless their window has moved
+// past the time of the transfer
+func (k Keeper) UndoSend(ctx sdk.Context, channelID string, sequence uint64, denom string, amount math.Int) {
+	pendingSend, found := k.GetPendingSend(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+	k.RemovePendingSend(ctx, channelID, sequence)
+
+	for _, path := range []string{channelID, types.AnyChannel} {
+		rateLimit, found := k.GetRateLimit(ctx, path, denom)
+		if !found {
+			continue
+		}
+
+		for _, quota := range rateLimit.Quotas {
+			flow, found := k.GetFlow(ctx, path, denom, quota.Name)
+			if !found {
+				continue
+			}
+
+			flow.UndoSend(quota, pendingSend.SendTime, amount)
+			k.SetFlow(ctx, flow)
+		}
+	}
+}
@@ -9,12 +9,20 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "neutron/ibc-rate-limit/update-params", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "neutron/ibc-rate-limit/MsgSetRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "neutron/ibc-rate-limit/MsgRemoveRateLimit", nil)
+	cdc.RegisterConcrete(&MsgResetRateLimitQuota{}, "neutron/ibc-rate-limit/MsgResetRateLimitQuota", nil)
+	cdc.RegisterConcrete(&MsgSetChannelQuotas{}, "neutron/ibc-rate-limit/MsgSetChannelQuotas", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgResetRateLimitQuota{},
+		&MsgSetChannelQuotas{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -5,4 +5,8 @@ const (
 	AttributeKeyPacket      = "packet"
 	AttributeKeyAck         = "acknowledgement"
 	AttributeKeyFailureType = "failure_type"
+
+	EventDefaultQuotasApplied = "default_quotas_applied"
+	AttributeKeyChannelID     = "channel_id"
+	AttributeKeyDenom         = "denom"
 )
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"context"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected interface needed to read the supply of a denom
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
+
+// TransferKeeper defines the expected interface needed to find out whether a denom trace has been received before
+type TransferKeeper interface {
+	HasDenomTrace(ctx sdk.Context, denomTraceHash cmtbytes.HexBytes) bool
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -13,5 +17,39 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	rateLimits := make(map[string]RateLimit, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetPathKey(rateLimit.ChannelId, rateLimit.Denom))
+		if _, ok := rateLimits[key]; ok {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate rate limit for %s through %s", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		rateLimits[key] = rateLimit
+	}
+
+	for _, flow := range gs.Flows {
+		rateLimit, ok := rateLimits[string(GetPathKey(flow.ChannelId, flow.Denom))]
+		if !ok {
+			return errorsmod.Wrapf(ErrRateLimitNotFound, "flow of %s through %s", flow.Denom, flow.ChannelId)
+		}
+		if _, ok := rateLimit.GetQuota(flow.QuotaName); !ok {
+			return errorsmod.Wrapf(ErrRateLimitNotFound, "flow of quota %s of %s through %s", flow.QuotaName, flow.Denom, flow.ChannelId)
+		}
+	}
+
+	channels := make(map[string]bool, len(gs.ChannelQuotas))
+	for _, channelQuotas := range gs.ChannelQuotas {
+		if err := channelQuotas.Validate(); err != nil {
+			return err
+		}
+		if channels[channelQuotas.ChannelId] {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate quota template for %s", channelQuotas.ChannelId)
+		}
+		channels[channelQuotas.ChannelId] = true
+	}
 	return nil
 }
@@ -28,7 +28,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the ibc-rate-limit module's genesis state.
 type GenesisState struct {
 	// params are all the parameters of the module
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params        Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	RateLimits    []RateLimit     `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	Flows         []Flow          `protobuf:"bytes,3,rep,name=flows,proto3" json:"flows"`
+	PendingSends  []PendingSend   `protobuf:"bytes,4,rep,name=pending_sends,json=pendingSends,proto3" json:"pending_sends"`
+	ChannelQuotas []ChannelQuotas `protobuf:"bytes,5,rep,name=channel_quotas,json=channelQuotas,proto3" json:"channel_quotas"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +75,34 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFlows() []Flow {
+	if m != nil {
+		return m.Flows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSends() []PendingSend {
+	if m != nil {
+		return m.PendingSends
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelQuotas() []ChannelQuotas {
+	if m != nil {
+		return m.ChannelQuotas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "neutron.ibcratelimit.v1beta1.GenesisState")
 }
@@ -80,23 +112,31 @@ func init() {
 }
 
 var fileDescriptor_4a6a285b43c9c3fe = []byte{
-	// 250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9, 0xcc, 0xcd, 0x2c,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x99, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x1c, 0x0f, 0x56, 0xab, 0x0f, 0xe1, 0x40, 0x34,
-	0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x43, 0xc4, 0x41, 0x2c, 0xa8, 0xa8, 0x64, 0x7a, 0x7e, 0x7e,
-	0x7a, 0x4e, 0xaa, 0x3e, 0x98, 0x97, 0x54, 0x9a, 0xa6, 0x9f, 0x98, 0x57, 0x09, 0x95, 0xd2, 0xc4,
-	0xeb, 0xaa, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xd9, 0x4a, 0x41, 0x5c, 0x3c, 0xee, 0x10, 0x57,
-	0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x39, 0x71, 0xb1, 0x41, 0xe4, 0x25, 0x18, 0x15, 0x18, 0x35,
-	0xb8, 0x8d, 0x54, 0xf4, 0xf0, 0xb9, 0x5a, 0x2f, 0x00, 0xac, 0xd6, 0x89, 0xe5, 0xc4, 0x3d, 0x79,
-	0x86, 0x20, 0xa8, 0x4e, 0xa7, 0xa0, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
-	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88,
-	0xb2, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x9a, 0xab, 0x9b,
-	0x5f, 0x94, 0x0e, 0x63, 0xeb, 0x97, 0x99, 0xea, 0x57, 0x80, 0x1c, 0xad, 0x0b, 0xb2, 0x49, 0x17,
-	0xe2, 0xec, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x73, 0x8d, 0x01, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xc8, 0xd2, 0x7b, 0x68, 0x71, 0x01, 0x00, 0x00,
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x3f, 0x6f, 0xe2, 0x30,
+	0x18, 0xc6, 0x93, 0xe3, 0xcf, 0x60, 0xe0, 0x86, 0xe8, 0x86, 0x80, 0x4e, 0x39, 0x84, 0x4e, 0x3a,
+	0xb8, 0x53, 0x62, 0xc1, 0xe9, 0xa4, 0x9b, 0x3a, 0x50, 0xa9, 0x5d, 0xaa, 0xaa, 0x85, 0x0e, 0x55,
+	0x97, 0xc8, 0x09, 0xc6, 0x44, 0x4a, 0xec, 0x34, 0x76, 0xa0, 0x7c, 0x8b, 0xaa, 0x9f, 0x8a, 0x91,
+	0xb1, 0x53, 0x55, 0xc1, 0x17, 0xa9, 0x62, 0x1b, 0xd1, 0x2e, 0xe9, 0xe6, 0xf7, 0xf1, 0xf3, 0xfc,
+	0xde, 0xf7, 0xd5, 0x0b, 0x7e, 0x53, 0x9c, 0x8b, 0x8c, 0x51, 0x18, 0x05, 0x61, 0x86, 0x04, 0x8e,
+	0xa3, 0x24, 0x12, 0x70, 0x39, 0x0c, 0xb0, 0x40, 0x43, 0x48, 0x30, 0xc5, 0x3c, 0xe2, 0x5e, 0x9a,
+	0x31, 0xc1, 0xac, 0xef, 0xda, 0xeb, 0xbd, 0xf7, 0x7a, 0xda, 0xdb, 0x69, 0x87, 0x8c, 0x27, 0x8c,
+	0xfb, 0xd2, 0x0b, 0x55, 0xa1, 0x82, 0x9d, 0x6f, 0x84, 0x11, 0xa6, 0xf4, 0xe2, 0xa5, 0xd5, 0x36,
+	0x61, 0x8c, 0xc4, 0x18, 0xca, 0x2a, 0xc8, 0xe7, 0x10, 0xd1, 0xb5, 0xfe, 0x1a, 0x94, 0x4e, 0x95,
+	0xa2, 0x0c, 0x25, 0x07, 0xb6, 0x5b, 0x6a, 0x2d, 0x14, 0x5f, 0xcd, 0x29, 0xed, 0xbd, 0xa7, 0x0a,
+	0x68, 0x9e, 0xab, 0xad, 0xa6, 0x02, 0x09, 0x6c, 0x8d, 0x41, 0x5d, 0xf1, 0x6c, 0xb3, 0x6b, 0xf6,
+	0x1b, 0xa3, 0x9f, 0x5e, 0xd9, 0x96, 0xde, 0x95, 0xf4, 0x8e, 0xab, 0x9b, 0x97, 0x1f, 0xc6, 0x44,
+	0x27, 0xad, 0x4b, 0xd0, 0x38, 0x36, 0xe2, 0xf6, 0x97, 0x6e, 0xa5, 0xdf, 0x18, 0xfd, 0x2a, 0x07,
+	0x4d, 0x90, 0xc0, 0x17, 0x85, 0xa2, 0x59, 0x20, 0x3b, 0x08, 0xdc, 0x3a, 0x01, 0xb5, 0x79, 0xcc,
+	0x56, 0xdc, 0xae, 0x48, 0x52, 0xaf, 0x9c, 0x74, 0x16, 0xb3, 0x95, 0x86, 0xa8, 0x98, 0x75, 0x03,
+	0x5a, 0x29, 0xa6, 0xb3, 0x88, 0x12, 0x9f, 0x63, 0x3a, 0xe3, 0x76, 0x55, 0x72, 0x06, 0x9f, 0xac,
+	0xa6, 0x22, 0x53, 0x4c, 0x67, 0x1a, 0xd7, 0x4c, 0x8f, 0x12, 0xb7, 0x6e, 0xc1, 0xd7, 0x70, 0x81,
+	0x28, 0xc5, 0xb1, 0x7f, 0x9f, 0x33, 0x81, 0xb8, 0x5d, 0x93, 0xd8, 0x3f, 0xe5, 0xd8, 0x53, 0x95,
+	0xb9, 0x96, 0x11, 0x0d, 0x6e, 0x85, 0x1f, 0xc4, 0xc9, 0x66, 0xe7, 0x98, 0xdb, 0x9d, 0x63, 0xbe,
+	0xee, 0x1c, 0xf3, 0x71, 0xef, 0x18, 0xdb, 0xbd, 0x63, 0x3c, 0xef, 0x1d, 0xe3, 0xee, 0x3f, 0x89,
+	0xc4, 0x22, 0x0f, 0xbc, 0x90, 0x25, 0x50, 0x77, 0x71, 0x59, 0x46, 0x0e, 0x6f, 0xb8, 0xfc, 0x07,
+	0x1f, 0x8a, 0xcb, 0xbb, 0x45, 0x5f, 0x57, 0xdd, 0x5e, 0xac, 0x53, 0xcc, 0x83, 0xba, 0xbc, 0xf7,
+	0xdf, 0xb7, 0x01, 0x00, 0xb6, 0x60, 0xbd, 0x7b, 0xe1, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -119,6 +159,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelQuotas) > 0 {
+		for iNdEx := len(m.ChannelQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingSends) > 0 {
+		for iNdEx := len(m.PendingSends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Flows) > 0 {
+		for iNdEx := len(m.Flows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -151,6 +247,30 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Flows) > 0 {
+		for _, e := range m.Flows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSends) > 0 {
+		for _, e := range m.PendingSends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelQuotas) > 0 {
+		for _, e := range m.ChannelQuotas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -222,6 +342,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flows = append(m.Flows, Flow{})
+			if err := m.Flows[len(m.Flows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSends = append(m.PendingSends, PendingSend{})
+			if err := m.PendingSends[len(m.PendingSends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelQuotas = append(m.ChannelQuotas, ChannelQuotas{})
+			if err := m.ChannelQuotas[len(m.ChannelQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,9 +1,16 @@
 package types
 
-import "strings"
+import (
+	"encoding/binary"
+	"strings"
+)
 
 const (
 	prefixParamsKey = iota + 1
+	prefixRateLimitKey
+	prefixFlowKey
+	prefixPendingSendKey
+	prefixChannelQuotasKey
 )
 
 const (
@@ -11,8 +18,38 @@ const (
 
 )
 
-var ParamsKey = []byte{prefixParamsKey}
+var (
+	ParamsKey        = []byte{prefixParamsKey}
+	RateLimitKey     = []byte{prefixRateLimitKey}
+	FlowKey          = []byte{prefixFlowKey}
+	PendingSendKey   = []byte{prefixPendingSendKey}
+	ChannelQuotasKey = []byte{prefixChannelQuotasKey}
+)
 
 // RouterKey is the message route. Can only contain
 // alphanumeric characters.
 var RouterKey = strings.ReplaceAll(ModuleName, "-", "")
+
+// keySeparator separates the parts of a key. Channel ids, denoms and quota names can't contain it.
+const keySeparator = "\x00"
+
+// GetChannelKey returns the key of a channel, relative to the channel quotas prefix. It also prefixes the keys of the
+// paths through the channel.
+func GetChannelKey(channelID string) []byte {
+	return []byte(channelID + keySeparator)
+}
+
+// GetPathKey returns the key of a (channel, denom) path, relative to the rate limit and flow prefixes
+func GetPathKey(channelID, denom string) []byte {
+	return append(GetChannelKey(channelID), []byte(denom+keySeparator)...)
+}
+
+// GetFlowKey returns the key of the flow counted against a quota of a path, relative to the flow prefix
+func GetFlowKey(channelID, denom, quotaName string) []byte {
+	return append(GetPathKey(channelID, denom), []byte(quotaName)...)
+}
+
+// GetPendingSendKey returns the key of a pending send, relative to the pending send prefix
+func GetPendingSendKey(channelID string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(channelID+keySeparator), sequence)
+}
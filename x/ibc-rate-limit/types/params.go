@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -37,6 +38,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateQuotas(p.DefaultChannelQuotas); err != nil {
+		return errorsmod.Wrap(err, "invalid default_channel_quotas")
+	}
+
+	if err := ValidateQuotas(p.DefaultDenomQuotas); err != nil {
+		return errorsmod.Wrap(err, "invalid default_denom_quotas")
+	}
+
 	return nil
 }
 
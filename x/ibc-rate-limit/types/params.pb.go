@@ -26,6 +26,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the ibc-rate-limit module.
 type Params struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// Quotas applied to the transfers of every denom through a transfer channel
+	// opened after they are set. Empty leaves new channels unlimited.
+	DefaultChannelQuotas []Quota `protobuf:"bytes,2,rep,name=default_channel_quotas,json=defaultChannelQuotas,proto3" json:"default_channel_quotas"`
+	// Quotas applied to the transfers of an IBC denom through any channel from
+	// the first receipt of its denom trace. Empty leaves new denoms unlimited.
+	DefaultDenomQuotas []Quota `protobuf:"bytes,3,rep,name=default_denom_quotas,json=defaultDenomQuotas,proto3" json:"default_denom_quotas"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,6 +74,20 @@ func (m *Params) GetContractAddress() string {
 	return ""
 }
 
+func (m *Params) GetDefaultChannelQuotas() []Quota {
+	if m != nil {
+		return m.DefaultChannelQuotas
+	}
+	return nil
+}
+
+func (m *Params) GetDefaultDenomQuotas() []Quota {
+	if m != nil {
+		return m.DefaultDenomQuotas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "neutron.ibcratelimit.v1beta1.Params")
 }
@@ -77,21 +97,27 @@ func init() {
 }
 
 var fileDescriptor_96b2a3ecd8a27c06 = []byte{
-	// 222 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9, 0xcc, 0xcd, 0x2c,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x2a, 0xd5, 0x43, 0x56, 0xaa, 0x07, 0x55, 0x2a,
-	0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x28, 0x85, 0x70, 0xb1,
-	0x05, 0x80, 0xcd, 0x10, 0xf2, 0xe2, 0x12, 0x48, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x89,
-	0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0x3f,
-	0x71, 0x4f, 0x9e, 0xf1, 0xd3, 0x3d, 0x79, 0xf1, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0x74, 0x55,
-	0x4a, 0x41, 0xfc, 0x30, 0x21, 0x47, 0x88, 0x88, 0x53, 0xd0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x43, 0x9d, 0xab, 0x9b, 0x5f, 0x94, 0x0e, 0x63, 0xeb, 0x97, 0x99, 0xea, 0x57, 0x80, 0xbc, 0xaa,
-	0x0b, 0xf2, 0x80, 0x2e, 0xc4, 0xb3, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x07, 0x1b,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x65, 0xd8, 0x69, 0x11, 0x01, 0x00, 0x00,
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0x41, 0x4b, 0xf3, 0x30,
+	0x1c, 0xc6, 0x9b, 0xed, 0x65, 0xf0, 0xd6, 0x83, 0x52, 0x86, 0x8e, 0x21, 0xe9, 0x98, 0x97, 0x79,
+	0x68, 0xc2, 0x14, 0x41, 0xbc, 0x39, 0x3d, 0x79, 0xd2, 0x1d, 0xf5, 0x10, 0xd2, 0x36, 0x76, 0x85,
+	0x36, 0x99, 0x49, 0x3a, 0xdc, 0xb7, 0x10, 0x3f, 0xd5, 0x8e, 0x3b, 0x7a, 0x1a, 0xb2, 0x7e, 0x03,
+	0x3f, 0x81, 0xa4, 0x69, 0x41, 0x3c, 0x0c, 0xbc, 0xfd, 0x79, 0xfa, 0xeb, 0xef, 0x21, 0x8f, 0x7b,
+	0xca, 0x59, 0xa1, 0xa5, 0xe0, 0x38, 0x0d, 0x23, 0x49, 0x35, 0xcb, 0xd2, 0x3c, 0xd5, 0x78, 0x31,
+	0x0e, 0x99, 0xa6, 0x63, 0x3c, 0xa7, 0x92, 0xe6, 0x0a, 0xcd, 0xa5, 0xd0, 0xc2, 0x3b, 0xae, 0x51,
+	0xf4, 0x13, 0x45, 0x35, 0xda, 0xef, 0x26, 0x22, 0x11, 0x15, 0x88, 0xcd, 0x65, 0xff, 0xe9, 0x07,
+	0x3b, 0xf5, 0x26, 0x21, 0x56, 0x53, 0xe1, 0xc3, 0xf7, 0x96, 0xdb, 0xb9, 0xaf, 0x3a, 0xbd, 0x3b,
+	0xf7, 0x20, 0x12, 0x5c, 0x4b, 0x1a, 0x69, 0x42, 0xe3, 0x58, 0x32, 0xa5, 0x7a, 0x60, 0x00, 0x46,
+	0xff, 0x27, 0xfe, 0x6a, 0xe3, 0x83, 0xaf, 0x8d, 0x7f, 0xb4, 0xa4, 0x79, 0x76, 0x35, 0xfc, 0x4d,
+	0x0d, 0xa7, 0xfb, 0x4d, 0x74, 0x6d, 0x13, 0x8f, 0xb8, 0x87, 0x31, 0x7b, 0xa6, 0x45, 0xa6, 0x49,
+	0x34, 0xa3, 0x9c, 0xb3, 0x8c, 0xbc, 0x14, 0x42, 0x53, 0xd5, 0x6b, 0x0d, 0xda, 0xa3, 0xbd, 0xb3,
+	0x13, 0xb4, 0xeb, 0x69, 0xe8, 0xc1, 0xb0, 0x93, 0x7f, 0xab, 0x8d, 0xef, 0x4c, 0xbb, 0xb5, 0xe8,
+	0xc6, 0x7a, 0xaa, 0x4f, 0xca, 0x7b, 0x72, 0x9b, 0x9c, 0xc4, 0x8c, 0x8b, 0xbc, 0xd1, 0xb7, 0xff,
+	0xaa, 0xf7, 0x6a, 0xcd, 0xad, 0xb1, 0x58, 0xf9, 0x64, 0xba, 0xda, 0x42, 0xb0, 0xde, 0x42, 0xf0,
+	0xb9, 0x85, 0xe0, 0xad, 0x84, 0xce, 0xba, 0x84, 0xce, 0x47, 0x09, 0x9d, 0xc7, 0xcb, 0x24, 0xd5,
+	0xb3, 0x22, 0x44, 0x91, 0xc8, 0x71, 0x5d, 0x11, 0x08, 0x99, 0x34, 0x37, 0x5e, 0x5c, 0xe0, 0x57,
+	0xb3, 0x7c, 0x60, 0x4a, 0x03, 0xbb, 0xbd, 0x5e, 0xce, 0x99, 0x0a, 0x3b, 0xd5, 0xde, 0xe7, 0xdf,
+	0x03, 0x00, 0x3b, 0xd5, 0x89, 0x79, 0xff, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -114,6 +140,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DefaultDenomQuotas) > 0 {
+		for iNdEx := len(m.DefaultDenomQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DefaultDenomQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DefaultChannelQuotas) > 0 {
+		for iNdEx := len(m.DefaultChannelQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DefaultChannelQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ContractAddress) > 0 {
 		i -= len(m.ContractAddress)
 		copy(dAtA[i:], m.ContractAddress)
@@ -145,6 +199,18 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.DefaultChannelQuotas) > 0 {
+		for _, e := range m.DefaultChannelQuotas {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DefaultDenomQuotas) > 0 {
+		for _, e := range m.DefaultDenomQuotas {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +281,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultChannelQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultChannelQuotas = append(m.DefaultChannelQuotas, Quota{})
+			if err := m.DefaultChannelQuotas[len(m.DefaultChannelQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDenomQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultDenomQuotas = append(m.DefaultDenomQuotas, Quota{})
+			if err := m.DefaultDenomQuotas[len(m.DefaultDenomQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
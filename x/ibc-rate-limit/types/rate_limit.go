@@ -0,0 +1,200 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+const (
+	// AnyChannel is the channel of the rate limits applied to the transfers through every channel
+	AnyChannel = "any"
+	// QuotaBuckets is the number of buckets the rolling window of a quota is tracked in
+	QuotaBuckets = 10
+	// MaxQuotaNameLength is the max length of the name of a quota
+	MaxQuotaNameLength = 64
+	// MaxQuotaDuration is the max length in seconds of the rolling window of a quota
+	MaxQuotaDuration = 365 * 24 * 60 * 60
+)
+
+// ValidatePath returns an error unless channelID is a channel identifier or AnyChannel and denom is a valid denom
+func ValidatePath(channelID, denom string) error {
+	if channelID != AnyChannel {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+		}
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	return nil
+}
+
+// Validate returns an error if the rate limit has an invalid path or invalid quotas
+func (r RateLimit) Validate() error {
+	if err := ValidatePath(r.ChannelId, r.Denom); err != nil {
+		return err
+	}
+
+	if len(r.Quotas) == 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "no quotas")
+	}
+
+	return ValidateQuotas(r.Quotas)
+}
+
+// ValidateQuotas returns an error if a quota is invalid or two quotas have the same name
+func ValidateQuotas(quotas []Quota) error {
+	names := make(map[string]bool, len(quotas))
+	for _, quota := range quotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+		if names[quota.Name] {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate quota %s", quota.Name)
+		}
+		names[quota.Name] = true
+	}
+
+	return nil
+}
+
+// Validate returns an error if the channel quotas have an invalid channel or invalid quotas
+func (c ChannelQuotas) Validate() error {
+	if err := host.ChannelIdentifierValidator(c.ChannelId); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	if len(c.Quotas) == 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "no quotas")
+	}
+
+	return ValidateQuotas(c.Quotas)
+}
+
+// GetQuota returns the quota with the given name
+func (r RateLimit) GetQuota(name string) (Quota, bool) {
+	for _, quota := range r.Quotas {
+		if quota.Name == name {
+			return quota, true
+		}
+	}
+	return Quota{}, false
+}
+
+// Validate returns an error if the quota has an invalid name, no window or a window over MaxQuotaDuration, limits
+// nothing or limits more than 100 percent
+func (q Quota) Validate() error {
+	if q.Name == "" || len(q.Name) > MaxQuotaNameLength || strings.Contains(q.Name, keySeparator) {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid quota name %q", q.Name)
+	}
+
+	if q.Duration == 0 || q.Duration > MaxQuotaDuration {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "quota %s duration must be between 1 and %d seconds", q.Name, MaxQuotaDuration)
+	}
+
+	if q.MaxPercentSend > 100 || q.MaxPercentRecv > 100 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "quota %s cannot limit more than 100 percent", q.Name)
+	}
+
+	if q.MaxPercentSend == 0 && q.MaxPercentRecv == 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "quota %s limits neither sends nor receives", q.Name)
+	}
+
+	return nil
+}
+
+// BucketLength returns the length in seconds of the buckets the window of the quota is tracked in
+func (q Quota) BucketLength() int64 {
+	length := int64(q.Duration / QuotaBuckets) //nolint:gosec
+	if length == 0 {
+		return 1
+	}
+	return length
+}
+
+// BucketStart returns the start time of the bucket blockTime falls in
+func (q Quota) BucketStart(blockTime int64) int64 {
+	return blockTime - blockTime%q.BucketLength()
+}
+
+// Check returns ErrRateLimitExceeded if the net flow in the direction of a transfer goes over the quota
+func (q Quota) Check(flow Flow, send bool) error {
+	percent, net, direction := q.MaxPercentRecv, flow.NetOutflow().Neg(), "inflow"
+	if send {
+		percent, net, direction = q.MaxPercentSend, flow.NetOutflow(), "outflow"
+	}
+	if percent == 0 {
+		return nil
+	}
+
+	maxFlow := flow.ChannelValue.MulRaw(int64(percent)).QuoRaw(100)
+	if net.GT(maxFlow) {
+		return errorsmod.Wrapf(ErrRateLimitExceeded, "net %s of %s through %s would be %s over quota %s, max is %s",
+			direction, flow.Denom, flow.ChannelId, net, q.Name, maxFlow)
+	}
+
+	return nil
+}
+
+// NewFlow returns an empty flow counted against a quota of a path
+func NewFlow(channelID, denom, quotaName string) Flow {
+	return Flow{
+		ChannelId:    channelID,
+		Denom:        denom,
+		QuotaName:    quotaName,
+		ChannelValue: math.ZeroInt(),
+	}
+}
+
+// Prune drops the buckets which ended before the start of the quota window at blockTime
+func (f *Flow) Prune(quota Quota, blockTime int64) {
+	windowStart := blockTime - int64(quota.Duration) //nolint:gosec
+	length := quota.BucketLength()
+
+	i := 0
+	for i < len(f.Buckets) && f.Buckets[i].StartTime+length <= windowStart {
+		i++
+	}
+	f.Buckets = f.Buckets[i:]
+}
+
+// Add counts a transfer made at blockTime in the flow
+func (f *Flow) Add(quota Quota, blockTime int64, amount math.Int, send bool) {
+	start := quota.BucketStart(blockTime)
+	if n := len(f.Buckets); n == 0 || f.Buckets[n-1].StartTime != start {
+		f.Buckets = append(f.Buckets, FlowBucket{StartTime: start, Inflow: math.ZeroInt(), Outflow: math.ZeroInt()})
+	}
+
+	bucket := &f.Buckets[len(f.Buckets)-1]
+	if send {
+		bucket.Outflow = bucket.Outflow.Add(amount)
+	} else {
+		bucket.Inflow = bucket.Inflow.Add(amount)
+	}
+}
+
+// UndoSend removes an outbound transfer made at sendTime from the flow if its bucket is still in the window
+func (f *Flow) UndoSend(quota Quota, sendTime int64, amount math.Int) {
+	start := quota.BucketStart(sendTime)
+	for i := range f.Buckets {
+		if f.Buckets[i].StartTime == start {
+			f.Buckets[i].Outflow = math.MaxInt(f.Buckets[i].Outflow.Sub(amount), math.ZeroInt())
+			return
+		}
+	}
+}
+
+// NetOutflow returns the outflow minus the inflow over the window, negative for a net inflow
+func (f Flow) NetOutflow() math.Int {
+	net := math.ZeroInt()
+	for _, bucket := range f.Buckets {
+		net = net.Add(bucket.Outflow).Sub(bucket.Inflow)
+	}
+	return net
+}
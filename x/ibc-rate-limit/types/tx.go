@@ -3,9 +3,16 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgResetRateLimitQuota{}
+	_ sdk.Msg = &MsgSetChannelQuotas{}
+)
 
 func (msg *MsgUpdateParams) Route() string {
 	return RouterKey
@@ -33,12 +40,140 @@ func (msg *MsgUpdateParams) Validate() error {
 	}
 
 	// we allow unsetting the contract
-	if msg.Params.ContractAddress == "" {
-		return nil
+	if msg.Params.ContractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Params.ContractAddress); err != nil {
+			return errorsmod.Wrap(err, "contract_address is invalid")
+		}
+	}
+
+	if err := ValidateQuotas(msg.Params.DefaultChannelQuotas); err != nil {
+		return errorsmod.Wrap(err, "default_channel_quotas are invalid")
 	}
-	if _, err := sdk.AccAddressFromBech32(msg.Params.ContractAddress); err != nil {
-		return errorsmod.Wrap(err, "contract_address is invalid")
+
+	if err := ValidateQuotas(msg.Params.DefaultDenomQuotas); err != nil {
+		return errorsmod.Wrap(err, "default_denom_quotas are invalid")
 	}
 
 	return nil
 }
+
+func (msg *MsgSetRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRateLimit) Type() string {
+	return "set-rate-limit"
+}
+
+func (msg *MsgSetRateLimit) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetRateLimit) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgSetRateLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	return msg.RateLimit.Validate()
+}
+
+func (msg *MsgRemoveRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveRateLimit) Type() string {
+	return "remove-rate-limit"
+}
+
+func (msg *MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRemoveRateLimit) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRemoveRateLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	return ValidatePath(msg.ChannelId, msg.Denom)
+}
+
+func (msg *MsgResetRateLimitQuota) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResetRateLimitQuota) Type() string {
+	return "reset-rate-limit-quota"
+}
+
+func (msg *MsgResetRateLimitQuota) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgResetRateLimitQuota) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgResetRateLimitQuota) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if msg.QuotaName == "" {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "quota_name is empty")
+	}
+
+	return ValidatePath(msg.ChannelId, msg.Denom)
+}
+
+func (msg *MsgSetChannelQuotas) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetChannelQuotas) Type() string {
+	return "set-channel-quotas"
+}
+
+func (msg *MsgSetChannelQuotas) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetChannelQuotas) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgSetChannelQuotas) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	// empty quotas stop applying a template to the channel
+	return ValidateQuotas(msg.Quotas)
+}
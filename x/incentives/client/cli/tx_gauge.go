@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+func CmdCreateGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-gauge [token-a] [token-b] [start-tick] [end-tick] [pricing-tick] [coins] [num-blocks]",
+		Short:   "Broadcast message CreateGauge",
+		Example: "create-gauge tokenA tokenB [-100] 100 10 1000untrn 1000 --from alice",
+		Args:    cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTick, err := strconv.ParseInt(parseNegative(args[2]), 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endTick, err := strconv.ParseInt(parseNegative(args[3]), 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pricingTick, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[5])
+			if err != nil {
+				return err
+			}
+
+			numBlocks, err := strconv.ParseUint(args[6], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateGauge{
+				Creator:     clientCtx.GetFromAddress().String(),
+				TokenA:      args[0],
+				TokenB:      args[1],
+				StartTick:   startTick,
+				EndTick:     endTick,
+				PricingTick: pricingTick,
+				Coins:       coins,
+				NumBlocks:   numBlocks,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseNegative allows negative numbers to be passed as [-N] so they are not parsed as flags
+func parseNegative(s string) string {
+	return strings.NewReplacer("[", "", "]", "").Replace(s)
+}
@@ -0,0 +1,45 @@
+package incentives
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/incentives/keeper"
+	"github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, gauge := range genState.Gauges {
+		k.SetGauge(ctx, gauge)
+	}
+
+	// Stakes are indexed by the pairs of their pool shares, so dex genesis must be initialized first
+	for _, stake := range genState.Stakes {
+		k.SetStake(ctx, stake)
+	}
+
+	for _, rewards := range genState.AccountRewards {
+		k.SetAccountRewards(ctx, sdk.MustAccAddressFromBech32(rewards.Owner), rewards.Coins)
+	}
+
+	k.SetNextGaugeID(ctx, genState.NextGaugeId)
+	k.SetNextStakeID(ctx, genState.NextStakeId)
+
+	err := k.SetParams(ctx, genState.Params)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	genesis.Gauges = k.GetAllGauges(ctx)
+	genesis.Stakes = k.GetAllStakes(ctx)
+	genesis.AccountRewards = k.GetAllAccountRewards(ctx)
+	genesis.NextGaugeId = k.GetNextGaugeID(ctx)
+	genesis.NextStakeId = k.GetNextStakeID(ctx)
+
+	return genesis
+}
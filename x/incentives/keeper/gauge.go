@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+	"github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+// CreateGauge funds a new gauge from the creator's account. The gauge starts distributing rewards
+// in the first block after startTime.
+func (k Keeper) CreateGauge(
+	ctx sdk.Context,
+	creator sdk.AccAddress,
+	pairID *dextypes.PairID,
+	startTick, endTick int64,
+	pricingTick uint64,
+	coins sdk.Coins,
+	startTime time.Time,
+	numBlocks uint64,
+) (types.Gauge, error) {
+	params := k.GetParams(ctx)
+	if numBlocks > params.MaxGaugeDuration {
+		return types.Gauge{}, types.ErrGaugeTooLong.Wrapf("%d > %d", numBlocks, params.MaxGaugeDuration)
+	}
+
+	if !params.MinGaugeFunding.IsZero() && !coins.IsAnyGTE(params.MinGaugeFunding) {
+		return types.Gauge{}, types.ErrGaugeUnderfunded.Wrapf("%s, min is any of %s", coins, params.MinGaugeFunding)
+	}
+
+	if startTime.Before(ctx.BlockTime()) {
+		return types.Gauge{}, types.ErrStartTimeInThePast
+	}
+
+	if uint64(len(k.GetActiveGauges(ctx))) >= params.MaxActiveGauges {
+		return types.Gauge{}, types.ErrMaxActiveGauges
+	}
+
+	gauge := types.Gauge{
+		Id:               k.GetNextGaugeID(ctx),
+		Creator:          creator.String(),
+		PairId:           pairID,
+		StartTick:        startTick,
+		EndTick:          endTick,
+		PricingTick:      pricingTick,
+		Coins:            coins,
+		DistributedCoins: sdk.Coins{},
+		StartTime:        startTime,
+		NumBlocks:        numBlocks,
+	}
+	if err := gauge.Validate(); err != nil {
+		return types.Gauge{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, coins); err != nil {
+		return types.Gauge{}, err
+	}
+
+	k.SetNextGaugeID(ctx, gauge.Id+1)
+	k.SetGauge(ctx, gauge)
+
+	return gauge, nil
+}
+
+// SetGauge stores a gauge and keeps the active gauge index up to date
+func (k Keeper) SetGauge(ctx sdk.Context, gauge types.Gauge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GaugeKey)
+	store.Set(types.GetGaugeKey(gauge.Id), k.cdc.MustMarshal(&gauge))
+
+	activeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ActiveGaugeKey)
+	if gauge.IsFinished() {
+		activeStore.Delete(types.GetGaugeKey(gauge.Id))
+	} else {
+		activeStore.Set(types.GetGaugeKey(gauge.Id), []byte{})
+	}
+}
+
+// GetGauge returns a gauge by id
+func (k Keeper) GetGauge(ctx sdk.Context, id uint64) (gauge types.Gauge, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GaugeKey)
+	bz := store.Get(types.GetGaugeKey(id))
+	if bz == nil {
+		return gauge, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &gauge)
+	return gauge, true
+}
+
+// GetAllGauges returns all gauges including finished ones
+func (k Keeper) GetAllGauges(ctx sdk.Context) (list []types.Gauge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GaugeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var gauge types.Gauge
+		k.cdc.MustUnmarshal(iterator.Value(), &gauge)
+		list = append(list, gauge)
+	}
+
+	return
+}
+
+// GetActiveGauges returns all gauges that are upcoming or still distributing rewards
+func (k Keeper) GetActiveGauges(ctx sdk.Context) (list []types.Gauge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ActiveGaugeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		gauge, found := k.GetGauge(ctx, sdk.BigEndianToUint64(iterator.Key()))
+		if !found {
+			// should never happen
+			panic("active gauge not found")
+		}
+		list = append(list, gauge)
+	}
+
+	return
+}
+
+// GetNextGaugeID returns the id of the next gauge to be created
+func (k Keeper) GetNextGaugeID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextGaugeIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextGaugeID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextGaugeIDKey, sdk.Uint64ToBigEndian(id))
+}
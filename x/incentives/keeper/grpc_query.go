@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+var _ types.QueryServer = Keeper{}
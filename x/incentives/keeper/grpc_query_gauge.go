@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+func (k Keeper) Gauges(c context.Context, req *types.QueryGaugesRequest) (*types.QueryGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var gauges []types.Gauge
+	ctx := sdk.UnwrapSDKContext(c)
+
+	gaugeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GaugeKey)
+
+	pageRes, err := query.Paginate(gaugeStore, req.Pagination, func(_, value []byte) error {
+		var gauge types.Gauge
+		k.cdc.MustUnmarshal(value, &gauge)
+
+		gauges = append(gauges, gauge)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGaugesResponse{Gauges: gauges, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Gauge(c context.Context, req *types.QueryGetGaugeRequest) (*types.QueryGetGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetGauge(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "gauge not found")
+	}
+
+	return &types.QueryGetGaugeResponse{Gauge: val}, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+func (k Keeper) Stake(c context.Context, req *types.QueryGetStakeRequest) (*types.QueryGetStakeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetStake(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "stake not found")
+	}
+
+	return &types.QueryGetStakeResponse{Stake: val}, nil
+}
+
+func (k Keeper) StakesByOwner(c context.Context, req *types.QueryStakesByOwnerRequest) (*types.QueryStakesByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var stakes []types.Stake
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetStakeByOwnerPrefix(owner))
+
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(key, _ []byte) error {
+		stake, found := k.GetStake(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return types.ErrStakeNotFound
+		}
+
+		stakes = append(stakes, stake)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStakesByOwnerResponse{Stakes: stakes, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PendingRewards(c context.Context, req *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPendingRewardsResponse{Coins: k.GetAccountRewards(ctx, owner)}, nil
+}
@@ -0,0 +1,350 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v5/testutil/apptesting"
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+	"github.com/neutron-org/neutron/v5/x/incentives/keeper"
+	"github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+type IncentivesTestSuite struct {
+	apptesting.KeeperTestHelper
+	msgServer types.MsgServer
+	alice     sdk.AccAddress
+	bob       sdk.AccAddress
+	carol     sdk.AccAddress
+}
+
+var defaultPairID = &dextypes.PairID{Token0: "TokenA", Token1: "TokenB"}
+
+func TestIncentivesTestSuite(t *testing.T) {
+	suite.Run(t, new(IncentivesTestSuite))
+}
+
+func (s *IncentivesTestSuite) SetupTest() {
+	s.Setup()
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	s.alice = []byte("alice")
+	s.bob = []byte("bob")
+	s.carol = []byte("carol")
+
+	s.msgServer = keeper.NewMsgServerImpl(s.App.IncentivesKeeper)
+
+	params := types.DefaultParams()
+	params.MinGaugeFunding = sdk.NewCoins(sdk.NewInt64Coin("untrn", 10))
+	s.Require().NoError(s.App.IncentivesKeeper.SetParams(s.Ctx, params))
+}
+
+// deposit adds equal amounts of TokenA and TokenB to the pool at tickIndex and returns the shares issued
+func (s *IncentivesTestSuite) deposit(account sdk.AccAddress, amount int64, tickIndex int64, fee uint64) sdk.Coin {
+	return s.depositAmounts(account, amount, amount, tickIndex, fee)
+}
+
+func (s *IncentivesTestSuite) depositAmounts(account sdk.AccAddress, amountA, amountB int64, tickIndex int64, fee uint64) sdk.Coin {
+	amountAInt := sdkmath.NewInt(amountA)
+	amountBInt := sdkmath.NewInt(amountB)
+	s.FundAcc(account, sdk.NewCoins(sdk.NewCoin("TokenA", amountAInt), sdk.NewCoin("TokenB", amountBInt)))
+
+	_, _, shares, _, err := s.App.DexKeeper.DepositCore(
+		s.Ctx,
+		defaultPairID,
+		account,
+		account,
+		[]sdkmath.Int{amountAInt},
+		[]sdkmath.Int{amountBInt},
+		[]int64{tickIndex},
+		[]uint64{fee},
+		[]*dextypes.DepositOptions{{DisableAutoswap: true}},
+	)
+	s.Require().NoError(err)
+	s.Require().Len(shares, 1)
+
+	return shares[0]
+}
+
+func (s *IncentivesTestSuite) stake(account sdk.AccAddress, coins ...sdk.Coin) uint64 {
+	resp, err := s.msgServer.Stake(s.Ctx, &types.MsgStake{
+		Owner: account.String(),
+		Coins: sdk.NewCoins(coins...),
+	})
+	s.Require().NoError(err)
+
+	return resp.StakeId
+}
+
+func (s *IncentivesTestSuite) createGauge(startTick, endTick int64, pricingTick uint64, amount int64, numBlocks uint64) uint64 {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("untrn", amount))
+	s.FundAcc(s.carol, coins)
+
+	resp, err := s.msgServer.CreateGauge(s.Ctx, &types.MsgCreateGauge{
+		Creator:     s.carol.String(),
+		TokenA:      "TokenA",
+		TokenB:      "TokenB",
+		StartTick:   startTick,
+		EndTick:     endTick,
+		PricingTick: pricingTick,
+		Coins:       coins,
+		NumBlocks:   numBlocks,
+	})
+	s.Require().NoError(err)
+
+	return resp.GaugeId
+}
+
+func (s *IncentivesTestSuite) distributeBlocks(n int) {
+	for i := 0; i < n; i++ {
+		s.Require().NoError(s.App.IncentivesKeeper.DistributeRewards(s.Ctx))
+	}
+}
+
+func (s *IncentivesTestSuite) assertRewards(account sdk.AccAddress, amount int64) {
+	rewards := s.App.IncentivesKeeper.GetAccountRewards(s.Ctx, account)
+	s.Require().Equal(sdkmath.NewInt(amount), rewards.AmountOf("untrn"), "rewards of %s", account)
+}
+
+func (s *IncentivesTestSuite) TestCreateGauge() {
+	gaugeID := s.createGauge(-10, 10, 5, 100, 10)
+
+	gauge, found := s.App.IncentivesKeeper.GetGauge(s.Ctx, gaugeID)
+	s.Require().True(found)
+	s.Require().Equal(s.carol.String(), gauge.Creator)
+	s.Require().Equal(s.Ctx.BlockTime(), gauge.StartTime)
+	s.Require().Len(s.App.IncentivesKeeper.GetActiveGauges(s.Ctx), 1)
+
+	moduleAddr := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.Require().Equal(int64(100), s.App.BankKeeper.GetBalance(s.Ctx, moduleAddr, "untrn").Amount.Int64())
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, s.carol, "untrn").IsZero())
+}
+
+func (s *IncentivesTestSuite) TestCreateGaugeFails() {
+	past := s.Ctx.BlockTime().Add(-time.Second)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("untrn", 100))
+	s.FundAcc(s.carol, coins)
+	msg := &types.MsgCreateGauge{
+		Creator:   s.carol.String(),
+		TokenA:    "TokenA",
+		TokenB:    "TokenB",
+		Coins:     coins,
+		StartTime: &past,
+		NumBlocks: 10,
+	}
+
+	_, err := s.msgServer.CreateGauge(s.Ctx, msg)
+	s.Require().ErrorIs(err, types.ErrStartTimeInThePast)
+
+	msg.StartTime = nil
+	msg.NumBlocks = types.DefaultMaxGaugeDuration + 1
+	_, err = s.msgServer.CreateGauge(s.Ctx, msg)
+	s.Require().ErrorIs(err, types.ErrGaugeTooLong)
+
+	params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+	params.MaxActiveGauges = 1
+	s.Require().NoError(s.App.IncentivesKeeper.SetParams(s.Ctx, params))
+	s.createGauge(-10, 10, 5, 100, 10)
+
+	msg.NumBlocks = 10
+	_, err = s.msgServer.CreateGauge(s.Ctx, msg)
+	s.Require().ErrorIs(err, types.ErrMaxActiveGauges)
+
+	// dust gauges are rejected
+	params.MaxActiveGauges = types.DefaultMaxActiveGauges
+	s.Require().NoError(s.App.IncentivesKeeper.SetParams(s.Ctx, params))
+	msg.Coins = sdk.NewCoins(sdk.NewInt64Coin("untrn", 9))
+	_, err = s.msgServer.CreateGauge(s.Ctx, msg)
+	s.Require().ErrorIs(err, types.ErrGaugeUnderfunded)
+	msg.Coins = sdk.NewCoins(sdk.NewInt64Coin("TokenA", 100))
+	_, err = s.msgServer.CreateGauge(s.Ctx, msg)
+	s.Require().ErrorIs(err, types.ErrGaugeUnderfunded)
+}
+
+func (s *IncentivesTestSuite) TestStakeAndUnstake() {
+	shares := s.deposit(s.alice, 100, 0, 1)
+
+	stakeID := s.stake(s.alice, shares)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, s.alice, shares.Denom).IsZero())
+	s.Require().Len(s.App.IncentivesKeeper.GetStakesByPair(s.Ctx, defaultPairID.CanonicalString()), 1)
+
+	_, err := s.msgServer.Unstake(s.Ctx, &types.MsgUnstake{Owner: s.bob.String(), StakeId: stakeID})
+	s.Require().ErrorIs(err, types.ErrNotStakeOwner)
+
+	resp, err := s.msgServer.Unstake(s.Ctx, &types.MsgUnstake{Owner: s.alice.String(), StakeId: stakeID})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(shares), resp.Coins)
+	s.Require().Equal(shares, s.App.BankKeeper.GetBalance(s.Ctx, s.alice, shares.Denom))
+	s.Require().Empty(s.App.IncentivesKeeper.GetStakesByPair(s.Ctx, defaultPairID.CanonicalString()))
+
+	_, err = s.msgServer.Unstake(s.Ctx, &types.MsgUnstake{Owner: s.alice.String(), StakeId: stakeID})
+	s.Require().ErrorIs(err, types.ErrStakeNotFound)
+}
+
+func (s *IncentivesTestSuite) TestStakeNonexistentPoolFails() {
+	shares := sdk.NewInt64Coin(dextypes.NewPoolDenom(5), 100)
+	s.FundAcc(s.alice, sdk.NewCoins(shares))
+
+	_, err := s.msgServer.Stake(s.Ctx, &types.MsgStake{Owner: s.alice.String(), Coins: sdk.NewCoins(shares)})
+	s.Require().ErrorIs(err, types.ErrInvalidPoolShares)
+}
+
+func (s *IncentivesTestSuite) TestGetCurrentTick() {
+	_, found := s.App.IncentivesKeeper.GetCurrentTick(s.Ctx, defaultPairID)
+	s.Require().False(found)
+
+	s.deposit(s.alice, 100, 4, 2)
+
+	tick, found := s.App.IncentivesKeeper.GetCurrentTick(s.Ctx, defaultPairID)
+	s.Require().True(found)
+	s.Require().Equal(int64(4), tick)
+}
+
+func (s *IncentivesTestSuite) TestDistributeOnlyToQualifyingPools() {
+	inRange := s.deposit(s.alice, 100, 0, 1)
+	outOfRange := s.depositAmounts(s.bob, 0, 100, 50, 1)
+	s.stake(s.alice, inRange)
+	s.stake(s.bob, outOfRange)
+
+	gaugeID := s.createGauge(-10, 10, 5, 100, 10)
+	s.distributeBlocks(3)
+
+	s.assertRewards(s.alice, 30)
+	s.assertRewards(s.bob, 0)
+
+	gauge, _ := s.App.IncentivesKeeper.GetGauge(s.Ctx, gaugeID)
+	s.Require().Equal(uint64(3), gauge.BlocksDistributed)
+	s.Require().Equal(int64(30), gauge.DistributedCoins.AmountOf("untrn").Int64())
+}
+
+func (s *IncentivesTestSuite) TestDistributeProRata() {
+	aliceShares := s.deposit(s.alice, 200, 0, 1)
+	bobShares := s.deposit(s.bob, 100, 0, 1)
+	s.stake(s.alice, aliceShares)
+	s.stake(s.bob, bobShares)
+
+	s.createGauge(-10, 10, 5, 90, 3)
+	s.distributeBlocks(1)
+
+	s.assertRewards(s.alice, 20)
+	s.assertRewards(s.bob, 10)
+}
+
+func (s *IncentivesTestSuite) TestGaugeNotStarted() {
+	shares := s.deposit(s.alice, 100, 0, 1)
+	s.stake(s.alice, shares)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("untrn", 100))
+	s.FundAcc(s.carol, coins)
+	startTime := s.Ctx.BlockTime().Add(time.Hour)
+	_, err := s.msgServer.CreateGauge(s.Ctx, &types.MsgCreateGauge{
+		Creator:     s.carol.String(),
+		TokenA:      "TokenA",
+		TokenB:      "TokenB",
+		StartTick:   -10,
+		EndTick:     10,
+		PricingTick: 5,
+		Coins:       coins,
+		StartTime:   &startTime,
+		NumBlocks:   10,
+	})
+	s.Require().NoError(err)
+
+	s.distributeBlocks(1)
+	s.assertRewards(s.alice, 0)
+
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	s.distributeBlocks(1)
+	s.assertRewards(s.alice, 10)
+}
+
+func (s *IncentivesTestSuite) TestUndistributedRewardsCarryOverAndRefund() {
+	gaugeID := s.createGauge(-10, 10, 5, 100, 4)
+
+	// no liquidity, nothing is distributed
+	s.distributeBlocks(2)
+
+	shares := s.deposit(s.alice, 100, 0, 1)
+	s.stake(s.alice, shares)
+
+	// the remaining 100 are spread over the last 2 blocks
+	s.distributeBlocks(1)
+	s.assertRewards(s.alice, 50)
+
+	// remove the stake so that the last block is refunded
+	stakes := s.App.IncentivesKeeper.GetAllStakes(s.Ctx)
+	s.Require().Len(stakes, 1)
+	_, err := s.msgServer.Unstake(s.Ctx, &types.MsgUnstake{Owner: s.alice.String(), StakeId: stakes[0].Id})
+	s.Require().NoError(err)
+
+	s.distributeBlocks(1)
+
+	gauge, _ := s.App.IncentivesKeeper.GetGauge(s.Ctx, gaugeID)
+	s.Require().True(gauge.IsFinished())
+	s.Require().Empty(s.App.IncentivesKeeper.GetActiveGauges(s.Ctx))
+	s.Require().Equal(int64(50), s.App.BankKeeper.GetBalance(s.Ctx, s.carol, "untrn").Amount.Int64())
+
+	// finished gauges are ignored
+	s.distributeBlocks(1)
+	s.assertRewards(s.alice, 50)
+}
+
+func (s *IncentivesTestSuite) TestMaxStakesPerPair() {
+	params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+	params.MaxStakesPerPair = 2
+	s.Require().NoError(s.App.IncentivesKeeper.SetParams(s.Ctx, params))
+
+	shares := s.deposit(s.alice, 100, 0, 1)
+	s.stake(s.alice, shares.SubAmount(sdkmath.NewInt(2)))
+	s.stake(s.alice, sdk.NewCoin(shares.Denom, sdkmath.OneInt()))
+
+	_, err := s.msgServer.Stake(s.Ctx, &types.MsgStake{Owner: s.alice.String(), Coins: sdk.NewCoins(sdk.NewCoin(shares.Denom, sdkmath.OneInt()))})
+	s.Require().ErrorIs(err, types.ErrMaxStakesPerPair)
+}
+
+func (s *IncentivesTestSuite) TestFailedRefundIsLeftToClaim() {
+	// module accounts are blocked recipients, so refunding a gauge created by one fails
+	creator := s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("untrn", 100))
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, dextypes.ModuleName, coins))
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToModule(s.Ctx, dextypes.ModuleName, authtypes.FeeCollectorName, coins))
+	gauge, err := s.App.IncentivesKeeper.CreateGauge(s.Ctx, creator, defaultPairID, -10, 10, 5, coins, s.Ctx.BlockTime(), 2)
+	s.Require().NoError(err)
+
+	s.distributeBlocks(2)
+
+	gauge, _ = s.App.IncentivesKeeper.GetGauge(s.Ctx, gauge.Id)
+	s.Require().True(gauge.IsFinished())
+	s.Require().Empty(s.App.IncentivesKeeper.GetActiveGauges(s.Ctx))
+	s.Require().Equal(coins, s.App.IncentivesKeeper.GetAccountRewards(s.Ctx, creator))
+}
+
+func (s *IncentivesTestSuite) TestClaimRewards() {
+	shares := s.deposit(s.alice, 100, 0, 1)
+	s.stake(s.alice, shares)
+	s.createGauge(-10, 10, 5, 100, 4)
+
+	_, err := s.msgServer.ClaimRewards(s.Ctx, &types.MsgClaimRewards{Owner: s.alice.String()})
+	s.Require().ErrorIs(err, types.ErrNoRewardsToClaim)
+
+	s.distributeBlocks(4)
+
+	resp, err := s.msgServer.ClaimRewards(s.Ctx, &types.MsgClaimRewards{Owner: s.alice.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("untrn", 100)), resp.Coins)
+	s.Require().Equal(int64(100), s.App.BankKeeper.GetBalance(s.Ctx, s.alice, "untrn").Amount.Int64())
+	s.assertRewards(s.alice, 0)
+}
+
+func (s *IncentivesTestSuite) TestUpdateParamsInvalidAuthority() {
+	_, err := s.msgServer.UpdateParams(s.Ctx, &types.MsgUpdateParams{
+		Authority: s.alice.String(),
+		Params:    types.DefaultParams(),
+	})
+	s.Require().ErrorContains(err, "invalid authority")
+}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+	"github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+type msgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateGauge creates a new gauge funded by the creator
+func (k msgServer) CreateGauge(goCtx context.Context, req *types.MsgCreateGauge) (*types.MsgCreateGaugeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgCreateGauge")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	creator := sdk.MustAccAddressFromBech32(req.Creator)
+	pairID := dextypes.MustNewPairID(req.TokenA, req.TokenB)
+
+	startTime := ctx.BlockTime()
+	if req.StartTime != nil {
+		startTime = *req.StartTime
+	}
+
+	gauge, err := k.keeper.CreateGauge(
+		ctx,
+		creator,
+		pairID,
+		req.StartTick,
+		req.EndTick,
+		req.PricingTick,
+		req.Coins,
+		startTime,
+		req.NumBlocks,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create gauge")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateGauge,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyGaugeID, strconv.FormatUint(gauge.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyCreator, req.Creator),
+		sdk.NewAttribute(types.AttributeKeyPairID, pairID.CanonicalString()),
+		sdk.NewAttribute(types.AttributeKeyAmount, req.Coins.String()),
+	))
+
+	return &types.MsgCreateGaugeResponse{GaugeId: gauge.Id}, nil
+}
+
+// Stake locks pool shares so that they earn rewards from gauges
+func (k msgServer) Stake(goCtx context.Context, req *types.MsgStake) (*types.MsgStakeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgStake")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	stake, err := k.keeper.CreateStake(ctx, sdk.MustAccAddressFromBech32(req.Owner), req.Coins)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to stake")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeStake,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyStakeID, strconv.FormatUint(stake.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyOwner, req.Owner),
+		sdk.NewAttribute(types.AttributeKeyAmount, req.Coins.String()),
+	))
+
+	return &types.MsgStakeResponse{StakeId: stake.Id}, nil
+}
+
+// Unstake returns staked pool shares to their owner
+func (k msgServer) Unstake(goCtx context.Context, req *types.MsgUnstake) (*types.MsgUnstakeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUnstake")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	coins, err := k.keeper.Unstake(ctx, sdk.MustAccAddressFromBech32(req.Owner), req.StakeId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unstake")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnstake,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyStakeID, strconv.FormatUint(req.StakeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOwner, req.Owner),
+		sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+	))
+
+	return &types.MsgUnstakeResponse{Coins: coins}, nil
+}
+
+// ClaimRewards sends all rewards accrued by the owner's stakes
+func (k msgServer) ClaimRewards(goCtx context.Context, req *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgClaimRewards")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	coins, err := k.keeper.ClaimRewards(ctx, sdk.MustAccAddressFromBech32(req.Owner))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to claim rewards")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClaimRewards,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyOwner, req.Owner),
+		sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+	))
+
+	return &types.MsgClaimRewardsResponse{Coins: coins}, nil
+}
+
+// UpdateParams updates the module parameters
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUpdateParams")
+	}
+
+	authority := k.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.keeper.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
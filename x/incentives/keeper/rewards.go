@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+	"github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+// GetAccountRewards returns the unclaimed rewards of an account
+func (k Keeper) GetAccountRewards(ctx sdk.Context, owner sdk.AccAddress) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountRewardsKey)
+	bz := store.Get(types.GetAccountRewardsKey(owner))
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	var rewards types.AccountRewards
+	k.cdc.MustUnmarshal(bz, &rewards)
+	return rewards.Coins
+}
+
+// SetAccountRewards sets the unclaimed rewards of an account
+func (k Keeper) SetAccountRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountRewardsKey)
+	if coins.IsZero() {
+		store.Delete(types.GetAccountRewardsKey(owner))
+		return
+	}
+
+	rewards := types.AccountRewards{Owner: owner.String(), Coins: coins}
+	store.Set(types.GetAccountRewardsKey(owner), k.cdc.MustMarshal(&rewards))
+}
+
+// GetAllAccountRewards returns the unclaimed rewards of all accounts
+func (k Keeper) GetAllAccountRewards(ctx sdk.Context) (list []types.AccountRewards) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountRewardsKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards types.AccountRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		list = append(list, rewards)
+	}
+
+	return
+}
+
+// ClaimRewards sends all unclaimed rewards to the owner
+func (k Keeper) ClaimRewards(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	rewards := k.GetAccountRewards(ctx, owner)
+	if rewards.IsZero() {
+		return nil, types.ErrNoRewardsToClaim
+	}
+
+	k.SetAccountRewards(ctx, owner, sdk.Coins{})
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, rewards); err != nil {
+		return nil, err
+	}
+
+	return rewards, nil
+}
+
+// DistributeRewards streams one block of rewards from every started gauge to qualifying stakes.
+// Gauges that have distributed for all of their blocks are closed and any leftover rewards are
+// returned to the gauge creator. If the refund can't be sent, e.g. because the reward denom is
+// paused, it is credited to the creator's unclaimed rewards instead.
+func (k Keeper) DistributeRewards(ctx sdk.Context) error {
+	for _, gauge := range k.GetActiveGauges(ctx) {
+		if !gauge.IsStarted(ctx.BlockTime()) {
+			continue
+		}
+
+		distributed := k.distributeGauge(ctx, gauge)
+		gauge.DistributedCoins = gauge.DistributedCoins.Add(distributed...)
+		gauge.BlocksDistributed++
+
+		if gauge.IsFinished() {
+			refund := gauge.RemainingCoins()
+			if !refund.IsZero() {
+				k.refundGauge(ctx, gauge, refund)
+			}
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeGaugeFinished,
+				sdk.NewAttribute(types.AttributeKeyGaugeID, strconv.FormatUint(gauge.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyAmount, gauge.DistributedCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyRefundAmount, refund.String()),
+			))
+		}
+
+		k.SetGauge(ctx, gauge)
+	}
+
+	return nil
+}
+
+// refundGauge returns the leftover rewards of a finished gauge to its creator. The send can fail
+// because of the before send hooks of the reward denoms, so it must not halt the chain: the leftover
+// is then left for the creator to claim.
+func (k Keeper) refundGauge(ctx sdk.Context, gauge types.Gauge, refund sdk.Coins) {
+	creator := sdk.MustAccAddressFromBech32(gauge.Creator)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, creator, refund)
+	if err == nil {
+		writeCache()
+		return
+	}
+
+	k.Logger(ctx).Error("failed to refund gauge, leaving the leftover to claim", "gauge_id", gauge.Id, "error", err)
+	k.SetAccountRewards(ctx, creator, k.GetAccountRewards(ctx, creator).Add(refund...))
+}
+
+// distributeGauge credits the gauge's rewards for this block to stakes in proportion to the amount of
+// qualifying pool shares they hold. It returns the total amount credited.
+func (k Keeper) distributeGauge(ctx sdk.Context, gauge types.Gauge) sdk.Coins {
+	rewards := gauge.RewardsForBlock()
+	if rewards.IsZero() {
+		return sdk.Coins{}
+	}
+
+	currentTick, found := k.GetCurrentTick(ctx, gauge.PairId)
+	if !found {
+		return sdk.Coins{}
+	}
+
+	stakes := k.GetStakesByPair(ctx, gauge.PairId.CanonicalString())
+	weights := make([]math.Int, len(stakes))
+	totalWeight := math.ZeroInt()
+	poolCache := make(map[string]dextypes.PoolMetadata)
+	for i, stake := range stakes {
+		weights[i] = math.ZeroInt()
+		for _, coin := range stake.Coins {
+			pool, ok := poolCache[coin.Denom]
+			if !ok {
+				var err error
+				pool, err = k.dexKeeper.GetPoolMetadataByDenom(ctx, coin.Denom)
+				if err != nil {
+					// should never happen since pools are validated when staking
+					panic(err)
+				}
+				poolCache[coin.Denom] = pool
+			}
+			if gauge.PoolQualifies(pool, currentTick) {
+				weights[i] = weights[i].Add(coin.Amount)
+			}
+		}
+		totalWeight = totalWeight.Add(weights[i])
+	}
+
+	if totalWeight.IsZero() {
+		return sdk.Coins{}
+	}
+
+	distributed := sdk.Coins{}
+	for i, stake := range stakes {
+		if weights[i].IsZero() {
+			continue
+		}
+
+		stakeRewards := sdk.Coins{}
+		for _, coin := range rewards {
+			amount := coin.Amount.Mul(weights[i]).Quo(totalWeight)
+			if amount.IsPositive() {
+				stakeRewards = stakeRewards.Add(sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+		if stakeRewards.IsZero() {
+			continue
+		}
+
+		owner := sdk.MustAccAddressFromBech32(stake.Owner)
+		k.SetAccountRewards(ctx, owner, k.GetAccountRewards(ctx, owner).Add(stakeRewards...))
+		distributed = distributed.Add(stakeRewards...)
+	}
+
+	return distributed
+}
+
+// GetCurrentTick returns the tick halfway between the best token0 and token1 liquidity of the pair.
+// If only one side has liquidity its tick is used.
+func (k Keeper) GetCurrentTick(ctx sdk.Context, pairID *dextypes.PairID) (int64, bool) {
+	tick0, found0 := k.dexKeeper.GetCurrTickIndexTakerToMakerNormalized(
+		ctx,
+		&dextypes.TradePairID{MakerDenom: pairID.Token0, TakerDenom: pairID.Token1},
+	)
+	tick1, found1 := k.dexKeeper.GetCurrTickIndexTakerToMakerNormalized(
+		ctx,
+		&dextypes.TradePairID{MakerDenom: pairID.Token1, TakerDenom: pairID.Token0},
+	)
+
+	switch {
+	case found0 && found1:
+		return (tick0 + tick1) / 2, true
+	case found0:
+		return tick0, true
+	case found1:
+		return tick1, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+// CreateStake locks the owner's pool shares in the module
+func (k Keeper) CreateStake(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins) (types.Stake, error) {
+	// ensure that the pools exist before indexing the stake
+	pairIDs, err := k.getStakePairIDs(ctx, coins)
+	if err != nil {
+		return types.Stake{}, err
+	}
+
+	// every stake of a pair is walked for each of its gauges in EndBlock
+	maxStakes := k.GetParams(ctx).MaxStakesPerPair
+	for _, pairID := range pairIDs {
+		if k.countStakesByPair(ctx, pairID, maxStakes) >= maxStakes {
+			return types.Stake{}, types.ErrMaxStakesPerPair.Wrapf("%s", pairID)
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins); err != nil {
+		return types.Stake{}, err
+	}
+
+	stake := types.Stake{
+		Id:        k.GetNextStakeID(ctx),
+		Owner:     owner.String(),
+		Coins:     coins,
+		StartTime: ctx.BlockTime(),
+	}
+	k.SetNextStakeID(ctx, stake.Id+1)
+	k.SetStake(ctx, stake)
+
+	return stake, nil
+}
+
+// Unstake returns a stake's pool shares to its owner
+func (k Keeper) Unstake(ctx sdk.Context, owner sdk.AccAddress, stakeID uint64) (sdk.Coins, error) {
+	stake, found := k.GetStake(ctx, stakeID)
+	if !found {
+		return nil, types.ErrStakeNotFound.Wrapf("%d", stakeID)
+	}
+
+	if stake.Owner != owner.String() {
+		return nil, types.ErrNotStakeOwner
+	}
+
+	k.RemoveStake(ctx, stake)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, stake.Coins); err != nil {
+		return nil, err
+	}
+
+	return stake.Coins, nil
+}
+
+// SetStake stores a stake and indexes it by owner and by the pairs of its pool shares
+func (k Keeper) SetStake(ctx sdk.Context, stake types.Stake) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.StakeKey).Set(types.GetStakeKey(stake.Id), k.cdc.MustMarshal(&stake))
+
+	owner := sdk.MustAccAddressFromBech32(stake.Owner)
+	store.Set(types.GetStakeByOwnerKey(owner, stake.Id), []byte{})
+
+	pairIDs, err := k.getStakePairIDs(ctx, stake.Coins)
+	if err != nil {
+		// should never happen since pools are validated when staking
+		panic(err)
+	}
+	for _, pairID := range pairIDs {
+		store.Set(types.GetStakeByPairKey(pairID, stake.Id), []byte{})
+	}
+}
+
+// RemoveStake deletes a stake and its indexes
+func (k Keeper) RemoveStake(ctx sdk.Context, stake types.Stake) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.StakeKey).Delete(types.GetStakeKey(stake.Id))
+
+	owner := sdk.MustAccAddressFromBech32(stake.Owner)
+	store.Delete(types.GetStakeByOwnerKey(owner, stake.Id))
+
+	pairIDs, err := k.getStakePairIDs(ctx, stake.Coins)
+	if err != nil {
+		// should never happen since pools are validated when staking
+		panic(err)
+	}
+	for _, pairID := range pairIDs {
+		store.Delete(types.GetStakeByPairKey(pairID, stake.Id))
+	}
+}
+
+// GetStake returns a stake by id
+func (k Keeper) GetStake(ctx sdk.Context, id uint64) (stake types.Stake, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StakeKey)
+	bz := store.Get(types.GetStakeKey(id))
+	if bz == nil {
+		return stake, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &stake)
+	return stake, true
+}
+
+// GetAllStakes returns all stakes
+func (k Keeper) GetAllStakes(ctx sdk.Context) (list []types.Stake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StakeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stake types.Stake
+		k.cdc.MustUnmarshal(iterator.Value(), &stake)
+		list = append(list, stake)
+	}
+
+	return
+}
+
+// GetStakesByPair returns all stakes holding shares of at least one pool of the pair
+func (k Keeper) GetStakesByPair(ctx sdk.Context, pairID string) (list []types.Stake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetStakeByPairPrefix(pairID))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		stake, found := k.GetStake(ctx, sdk.BigEndianToUint64(iterator.Key()))
+		if !found {
+			// should never happen
+			panic("indexed stake not found")
+		}
+		list = append(list, stake)
+	}
+
+	return
+}
+
+// countStakesByPair returns the number of stakes holding shares of the pair, counting up to limit
+func (k Keeper) countStakesByPair(ctx sdk.Context, pairID string, limit uint64) (count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetStakeByPairPrefix(pairID))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid() && count < limit; iterator.Next() {
+		count++
+	}
+
+	return count
+}
+
+// GetNextStakeID returns the id of the next stake to be created
+func (k Keeper) GetNextStakeID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextStakeIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextStakeID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextStakeIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// getStakePairIDs returns the canonical ids of all the pairs that the coins are pool shares of
+func (k Keeper) getStakePairIDs(ctx sdk.Context, coins sdk.Coins) ([]string, error) {
+	seen := make(map[string]bool)
+	var pairIDs []string
+	for _, coin := range coins {
+		pool, err := k.dexKeeper.GetPoolMetadataByDenom(ctx, coin.Denom)
+		if err != nil {
+			return nil, types.ErrInvalidPoolShares.Wrapf("%s: %s", coin.Denom, err)
+		}
+		pairID := pool.PairId.CanonicalString()
+		if !seen[pairID] {
+			seen[pairID] = true
+			pairIDs = append(pairIDs, pairID)
+		}
+	}
+
+	return pairIDs, nil
+}
@@ -0,0 +1,3 @@
+package types
+
+const ConsensusVersion = 1
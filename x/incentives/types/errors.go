@@ -0,0 +1,23 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/incentives module sentinel errors
+var (
+	ErrInvalidGauge       = errors.Register(ModuleName, 1100, "invalid gauge")
+	ErrGaugeNotFound      = errors.Register(ModuleName, 1101, "gauge not found")
+	ErrMaxActiveGauges    = errors.Register(ModuleName, 1102, "maximum number of active gauges reached")
+	ErrInvalidStake       = errors.Register(ModuleName, 1103, "invalid stake")
+	ErrStakeNotFound      = errors.Register(ModuleName, 1104, "stake not found")
+	ErrNotStakeOwner      = errors.Register(ModuleName, 1105, "sender is not the owner of the stake")
+	ErrNoRewardsToClaim   = errors.Register(ModuleName, 1106, "no rewards to claim")
+	ErrInvalidPoolShares  = errors.Register(ModuleName, 1107, "coins are not dex pool shares")
+	ErrGaugeTooLong       = errors.Register(ModuleName, 1108, "gauge duration exceeds the maximum")
+	ErrStartTimeInThePast = errors.Register(ModuleName, 1109, "gauge start time is in the past")
+	ErrGaugeUnderfunded   = errors.Register(ModuleName, 1110, "gauge funding is below the minimum")
+	ErrMaxStakesPerPair   = errors.Register(ModuleName, 1111, "maximum number of stakes for the pair reached")
+)
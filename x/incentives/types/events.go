@@ -0,0 +1,16 @@
+package types
+
+const (
+	EventTypeCreateGauge     = "create_gauge"
+	EventTypeStake           = "stake"
+	EventTypeUnstake         = "unstake"
+	EventTypeClaimRewards    = "claim_rewards"
+	EventTypeGaugeFinished   = "gauge_finished"
+	AttributeKeyGaugeID      = "gauge_id"
+	AttributeKeyStakeID      = "stake_id"
+	AttributeKeyOwner        = "owner"
+	AttributeKeyCreator      = "creator"
+	AttributeKeyPairID       = "pair_id"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyRefundAmount = "refund_amount"
+)
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// BankKeeper defines the expected interface needed to transfer gauge funds, stakes and rewards.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// DexKeeper defines the expected interface needed to look up pools and the current price of a pair.
+type DexKeeper interface {
+	GetPoolMetadataByDenom(ctx sdk.Context, denom string) (dextypes.PoolMetadata, error)
+	GetCurrTickIndexTakerToMakerNormalized(ctx sdk.Context, tradePairID *dextypes.TradePairID) (int64, bool)
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// IsStarted returns true if the gauge start time has passed
+func (g Gauge) IsStarted(now time.Time) bool {
+	return !now.Before(g.StartTime)
+}
+
+// IsFinished returns true if the gauge has distributed rewards for all of its blocks
+func (g Gauge) IsFinished() bool {
+	return g.BlocksDistributed >= g.NumBlocks
+}
+
+// RemainingCoins returns the rewards that have not been distributed yet
+func (g Gauge) RemainingCoins() sdk.Coins {
+	return g.Coins.Sub(g.DistributedCoins...)
+}
+
+// RewardsForBlock returns the rewards to distribute in the current block. Rewards that could not be
+// distributed in previous blocks are spread over the remaining blocks.
+func (g Gauge) RewardsForBlock() sdk.Coins {
+	if g.IsFinished() {
+		return sdk.Coins{}
+	}
+
+	blocksLeft := math.NewIntFromUint64(g.NumBlocks - g.BlocksDistributed)
+	rewards := sdk.Coins{}
+	for _, coin := range g.RemainingCoins() {
+		amount := coin.Amount.Quo(blocksLeft)
+		if amount.IsPositive() {
+			rewards = rewards.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return rewards
+}
+
+// PoolQualifies returns true if the pool's tick range [tick - fee, tick + fee] is inside the gauge tick range
+// and overlaps the range of ticks within PricingTick of the current tick.
+func (g Gauge) PoolQualifies(pool dextypes.PoolMetadata, currentTick int64) bool {
+	if *pool.PairId != *g.PairId {
+		return false
+	}
+
+	fee := int64(pool.Fee) //nolint:gosec
+	lowerTick := pool.Tick - fee
+	upperTick := pool.Tick + fee
+	if lowerTick < g.StartTick || upperTick > g.EndTick {
+		return false
+	}
+
+	pricingTick := int64(g.PricingTick) //nolint:gosec
+	return upperTick >= currentTick-pricingTick && lowerTick <= currentTick+pricingTick
+}
+
+func (g Gauge) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(g.Creator); err != nil {
+		return ErrInvalidGauge.Wrapf("invalid creator: %s", err)
+	}
+
+	if g.PairId == nil {
+		return ErrInvalidGauge.Wrap("missing pair")
+	}
+
+	if _, err := dextypes.NewPairID(g.PairId.Token0, g.PairId.Token1); err != nil {
+		return ErrInvalidGauge.Wrap(err.Error())
+	}
+
+	if g.StartTick > g.EndTick {
+		return ErrInvalidGauge.Wrap("start tick must be less than or equal to end tick")
+	}
+
+	if g.NumBlocks == 0 {
+		return ErrInvalidGauge.Wrap("num blocks must be positive")
+	}
+
+	if !g.Coins.IsValid() || g.Coins.IsZero() {
+		return ErrInvalidGauge.Wrapf("invalid coins: %s", g.Coins)
+	}
+
+	if !g.DistributedCoins.IsValid() || !g.Coins.IsAllGTE(g.DistributedCoins) {
+		return ErrInvalidGauge.Wrapf("invalid distributed coins: %s", g.DistributedCoins)
+	}
+
+	return nil
+}
@@ -0,0 +1,800 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/incentives/gauge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/neutron-org/neutron/v5/x/dex/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Gauge streams rewards to stakers of dex pool shares for a single pair.
+// A pool qualifies for rewards in a block if its tick range [tick - fee, tick + fee]
+// is fully contained in [start_tick, end_tick] and overlaps
+// [current_tick - pricing_tick, current_tick + pricing_tick].
+type Gauge struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Address that created and funded the gauge. Undistributed rewards are returned to it.
+	Creator string        `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	PairId  *types.PairID `protobuf:"bytes,3,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Lower bound of the tick range of pools that can receive rewards
+	StartTick int64 `protobuf:"varint,4,opt,name=start_tick,json=startTick,proto3" json:"start_tick,omitempty"`
+	// Upper bound of the tick range of pools that can receive rewards
+	EndTick int64 `protobuf:"varint,5,opt,name=end_tick,json=endTick,proto3" json:"end_tick,omitempty"`
+	// Maximum distance in ticks from the current price for a pool to receive rewards
+	PricingTick uint64 `protobuf:"varint,6,opt,name=pricing_tick,json=pricingTick,proto3" json:"pricing_tick,omitempty"`
+	// Total rewards funded to the gauge
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// Rewards already distributed to stakers
+	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// Time after which the gauge starts distributing rewards
+	StartTime time.Time `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// Number of blocks the rewards are distributed over
+	NumBlocks uint64 `protobuf:"varint,10,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+	// Number of blocks the gauge has already distributed rewards for
+	BlocksDistributed uint64 `protobuf:"varint,11,opt,name=blocks_distributed,json=blocksDistributed,proto3" json:"blocks_distributed,omitempty"`
+}
+
+func (m *Gauge) Reset()         { *m = Gauge{} }
+func (m *Gauge) String() string { return proto.CompactTextString(m) }
+func (*Gauge) ProtoMessage()    {}
+func (*Gauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2467fab98b594cb6, []int{0}
+}
+func (m *Gauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Gauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Gauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Gauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gauge.Merge(m, src)
+}
+func (m *Gauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Gauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gauge proto.InternalMessageInfo
+
+func (m *Gauge) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Gauge) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Gauge) GetPairId() *types.PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *Gauge) GetStartTick() int64 {
+	if m != nil {
+		return m.StartTick
+	}
+	return 0
+}
+
+func (m *Gauge) GetEndTick() int64 {
+	if m != nil {
+		return m.EndTick
+	}
+	return 0
+}
+
+func (m *Gauge) GetPricingTick() uint64 {
+	if m != nil {
+		return m.PricingTick
+	}
+	return 0
+}
+
+func (m *Gauge) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DistributedCoins
+	}
+	return nil
+}
+
+func (m *Gauge) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Gauge) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+func (m *Gauge) GetBlocksDistributed() uint64 {
+	if m != nil {
+		return m.BlocksDistributed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Gauge)(nil), "neutron.incentives.Gauge")
+}
+
+func init() { proto.RegisterFile("neutron/incentives/gauge.proto", fileDescriptor_2467fab98b594cb6) }
+
+var fileDescriptor_2467fab98b594cb6 = []byte{
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x8e, 0xd3, 0x30,
+	0x14, 0xc6, 0x9b, 0xfe, 0xaf, 0x8b, 0x10, 0x63, 0x58, 0xa4, 0x95, 0x48, 0x0b, 0xab, 0x2c, 0xa8,
+	0xcd, 0x0c, 0x70, 0x81, 0xce, 0x48, 0x68, 0x36, 0x08, 0x45, 0xb3, 0x62, 0x13, 0x25, 0xb6, 0x31,
+	0x56, 0x27, 0x76, 0x64, 0x3b, 0x55, 0xb9, 0xc5, 0x9c, 0x03, 0x71, 0x90, 0x59, 0xce, 0x92, 0x15,
+	0x83, 0xda, 0x8b, 0xa0, 0xd8, 0x09, 0xed, 0x01, 0x58, 0xf5, 0xf9, 0x7d, 0xcf, 0xfe, 0xde, 0xfb,
+	0xbd, 0x06, 0x44, 0x92, 0x55, 0x56, 0x2b, 0x89, 0x85, 0x24, 0x4c, 0x5a, 0xb1, 0x65, 0x06, 0xf3,
+	0xac, 0xe2, 0x0c, 0x95, 0x5a, 0x59, 0x05, 0x61, 0xa3, 0xa3, 0xa3, 0x3e, 0x8f, 0x88, 0x32, 0x85,
+	0x32, 0x38, 0xcf, 0x0c, 0xc3, 0xdb, 0xf3, 0x9c, 0xd9, 0xec, 0x1c, 0x13, 0x25, 0xa4, 0xbf, 0x33,
+	0x7f, 0xc1, 0x15, 0x57, 0x2e, 0xc4, 0x75, 0xd4, 0x64, 0x17, 0x5c, 0x29, 0x7e, 0xcb, 0xb0, 0x3b,
+	0xe5, 0xd5, 0x57, 0x6c, 0x45, 0xc1, 0x8c, 0xcd, 0x8a, 0xb2, 0x29, 0x98, 0xb5, 0xad, 0x50, 0xb6,
+	0xc3, 0x65, 0x26, 0x74, 0x2a, 0xa8, 0x97, 0x5e, 0xff, 0xec, 0x83, 0xc1, 0xc7, 0xba, 0x2b, 0xf8,
+	0x14, 0x74, 0x05, 0x0d, 0x83, 0x65, 0x10, 0xf7, 0x93, 0xae, 0xa0, 0x30, 0x04, 0x23, 0xa2, 0x59,
+	0x66, 0x95, 0x0e, 0xbb, 0xcb, 0x20, 0x9e, 0x24, 0xed, 0x11, 0xbe, 0x01, 0xa3, 0xe6, 0x91, 0xb0,
+	0xb7, 0x0c, 0xe2, 0xe9, 0xc5, 0x73, 0xd4, 0xce, 0x42, 0xd9, 0x0e, 0x7d, 0xce, 0x84, 0xbe, 0xbe,
+	0x4a, 0x86, 0x75, 0xcd, 0x35, 0x85, 0x2f, 0x01, 0x30, 0x36, 0xd3, 0x36, 0xb5, 0x82, 0x6c, 0xc2,
+	0xfe, 0x32, 0x88, 0x7b, 0xc9, 0xc4, 0x65, 0x6e, 0x04, 0xd9, 0xc0, 0x19, 0x18, 0x33, 0x49, 0xbd,
+	0x38, 0x70, 0xe2, 0x88, 0x49, 0xea, 0xa4, 0x57, 0xe0, 0x49, 0xa9, 0x05, 0x11, 0x92, 0x7b, 0x79,
+	0xe8, 0x7a, 0x9b, 0x36, 0x39, 0x57, 0x92, 0x81, 0x41, 0x8d, 0xc7, 0x84, 0xa3, 0x65, 0x2f, 0x9e,
+	0x5e, 0xcc, 0x90, 0x07, 0x88, 0x6a, 0x80, 0xa8, 0x01, 0x88, 0x2e, 0x95, 0x90, 0xeb, 0xb7, 0xf7,
+	0xbf, 0x17, 0x9d, 0x1f, 0x8f, 0x8b, 0x98, 0x0b, 0xfb, 0xad, 0xca, 0x11, 0x51, 0x05, 0x6e, 0x68,
+	0xfb, 0x9f, 0x95, 0xa1, 0x1b, 0x6c, 0xbf, 0x97, 0xcc, 0xb8, 0x0b, 0x26, 0xf1, 0x2f, 0xc3, 0x1d,
+	0x38, 0xa3, 0xc2, 0x58, 0x2d, 0xf2, 0xca, 0x32, 0x9a, 0x7a, 0xbb, 0xf1, 0xff, 0xb7, 0x7b, 0x76,
+	0xe2, 0xe2, 0x32, 0xf0, 0xf2, 0x48, 0xae, 0x60, 0xe1, 0xc4, 0xa1, 0x9e, 0x23, 0xbf, 0x6c, 0xd4,
+	0x2e, 0x1b, 0xdd, 0xb4, 0xcb, 0x5e, 0x8f, 0x6b, 0xcf, 0xbb, 0xc7, 0x45, 0xf0, 0x8f, 0x6f, 0xc1,
+	0x6a, 0xfc, 0xb2, 0x2a, 0xd2, 0xfc, 0x56, 0x91, 0x8d, 0x09, 0x81, 0x43, 0x38, 0x91, 0x55, 0xb1,
+	0x76, 0x09, 0xb8, 0x02, 0xd0, 0x4b, 0xe9, 0x89, 0x7d, 0x38, 0x75, 0x65, 0x67, 0x5e, 0xb9, 0x3a,
+	0x0a, 0xeb, 0x4f, 0xf7, 0xfb, 0x28, 0x78, 0xd8, 0x47, 0xc1, 0x9f, 0x7d, 0x14, 0xdc, 0x1d, 0xa2,
+	0xce, 0xc3, 0x21, 0xea, 0xfc, 0x3a, 0x44, 0x9d, 0x2f, 0xef, 0x4f, 0x06, 0x6d, 0xfe, 0x0d, 0x2b,
+	0xa5, 0x79, 0x1b, 0xe3, 0xed, 0x07, 0xbc, 0x3b, 0xfd, 0x14, 0xdc, 0xe8, 0xf9, 0xd0, 0x8d, 0xf1,
+	0xee, 0xef, 0x00, 0x4c, 0xd4, 0x22, 0xbe, 0x2d, 0x03, 0x00, 0x00,
+}
+
+func (m *Gauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksDistributed != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.BlocksDistributed))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.NumBlocks != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGauge(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.DistributedCoins) > 0 {
+		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PricingTick != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.PricingTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTick != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.EndTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTick != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.StartTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGauge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGauge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGauge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Gauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGauge(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if m.StartTick != 0 {
+		n += 1 + sovGauge(uint64(m.StartTick))
+	}
+	if m.EndTick != 0 {
+		n += 1 + sovGauge(uint64(m.EndTick))
+	}
+	if m.PricingTick != 0 {
+		n += 1 + sovGauge(uint64(m.PricingTick))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovGauge(uint64(l))
+	if m.NumBlocks != 0 {
+		n += 1 + sovGauge(uint64(m.NumBlocks))
+	}
+	if m.BlocksDistributed != 0 {
+		n += 1 + sovGauge(uint64(m.BlocksDistributed))
+	}
+	return n
+}
+
+func sovGauge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGauge(x uint64) (n int) {
+	return sovGauge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &types.PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
+			}
+			m.StartTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTick", wireType)
+			}
+			m.EndTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingTick", wireType)
+			}
+			m.PricingTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricingTick |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types1.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksDistributed", wireType)
+			}
+			m.BlocksDistributed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksDistributed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGauge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGauge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGauge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGauge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGauge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGauge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGauge = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func TestGaugeRewardsForBlock(t *testing.T) {
+	gauge := validGauge(1)
+	gauge.Coins = sdk.NewCoins(sdk.NewInt64Coin("untrn", 105), sdk.NewInt64Coin("uatom", 5))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("untrn", 10)), gauge.RewardsForBlock())
+
+	// undistributed rewards are spread over the remaining blocks
+	gauge.BlocksDistributed = 5
+	gauge.DistributedCoins = sdk.NewCoins(sdk.NewInt64Coin("untrn", 5))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("untrn", 20), sdk.NewInt64Coin("uatom", 1)), gauge.RewardsForBlock())
+
+	gauge.BlocksDistributed = 9
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("untrn", 100), sdk.NewInt64Coin("uatom", 5)), gauge.RewardsForBlock())
+
+	gauge.BlocksDistributed = 10
+	require.True(t, gauge.RewardsForBlock().IsZero())
+}
+
+func TestGaugePoolQualifies(t *testing.T) {
+	gauge := validGauge(1)
+	gauge.PricingTick = 5
+	pairID := &dextypes.PairID{Token0: "TokenA", Token1: "TokenB"}
+
+	for _, tc := range []struct {
+		desc        string
+		pool        dextypes.PoolMetadata
+		currentTick int64
+		qualifies   bool
+	}{
+		{
+			desc:      "in range at current tick",
+			pool:      dextypes.PoolMetadata{PairId: pairID, Tick: 0, Fee: 1},
+			qualifies: true,
+		},
+		{
+			desc:      "at gauge range edge but far from current tick",
+			pool:      dextypes.PoolMetadata{PairId: pairID, Tick: 9, Fee: 1},
+			qualifies: false,
+		},
+		{
+			desc:        "at gauge range edge near current tick",
+			pool:        dextypes.PoolMetadata{PairId: pairID, Tick: 9, Fee: 1},
+			currentTick: 8,
+			qualifies:   true,
+		},
+		{
+			desc:      "outside gauge range",
+			pool:      dextypes.PoolMetadata{PairId: pairID, Tick: 10, Fee: 1},
+			qualifies: false,
+		},
+		{
+			desc:      "different pair",
+			pool:      dextypes.PoolMetadata{PairId: &dextypes.PairID{Token0: "TokenA", Token1: "TokenC"}, Tick: 0, Fee: 1},
+			qualifies: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.qualifies, gauge.PoolQualifies(tc.pool, tc.currentTick))
+		})
+	}
+}
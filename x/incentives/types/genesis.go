@@ -0,0 +1,60 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:         DefaultParams(),
+		Gauges:         []Gauge{},
+		Stakes:         []Stake{},
+		AccountRewards: []AccountRewards{},
+		NextGaugeId:    1,
+		NextStakeId:    1,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	gaugeIDs := make(map[uint64]struct{})
+	for _, gauge := range gs.Gauges {
+		if _, ok := gaugeIDs[gauge.Id]; ok {
+			return fmt.Errorf("duplicated id for gauge")
+		}
+		if gauge.Id >= gs.NextGaugeId {
+			return fmt.Errorf("gauge id %d must be less than next gauge id", gauge.Id)
+		}
+		if err := gauge.Validate(); err != nil {
+			return err
+		}
+		gaugeIDs[gauge.Id] = struct{}{}
+	}
+
+	stakeIDs := make(map[uint64]struct{})
+	for _, stake := range gs.Stakes {
+		if _, ok := stakeIDs[stake.Id]; ok {
+			return fmt.Errorf("duplicated id for stake")
+		}
+		if stake.Id >= gs.NextStakeId {
+			return fmt.Errorf("stake id %d must be less than next stake id", stake.Id)
+		}
+		if err := stake.Validate(); err != nil {
+			return err
+		}
+		stakeIDs[stake.Id] = struct{}{}
+	}
+
+	owners := make(map[string]struct{})
+	for _, rewards := range gs.AccountRewards {
+		if _, ok := owners[rewards.Owner]; ok {
+			return fmt.Errorf("duplicated owner for account rewards")
+		}
+		if !rewards.Coins.IsValid() {
+			return fmt.Errorf("invalid account rewards for %s", rewards.Owner)
+		}
+		owners[rewards.Owner] = struct{}{}
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,140 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/app/config"
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+	"github.com/neutron-org/neutron/v5/x/incentives/types"
+)
+
+const testAddress = "neutron17dtl0mjt3t77kpuhg2edqzjpszulwhgzcdvagh"
+
+func validGauge(id uint64) types.Gauge {
+	return types.Gauge{
+		Id:        id,
+		Creator:   testAddress,
+		PairId:    &dextypes.PairID{Token0: "TokenA", Token1: "TokenB"},
+		StartTick: -10,
+		EndTick:   10,
+		Coins:     sdk.NewCoins(sdk.NewInt64Coin("untrn", 100)),
+		StartTime: time.Unix(0, 0),
+		NumBlocks: 10,
+	}
+}
+
+func validStake(id uint64) types.Stake {
+	return types.Stake{
+		Id:        id,
+		Owner:     testAddress,
+		Coins:     sdk.NewCoins(sdk.NewInt64Coin(dextypes.NewPoolDenom(0), 100)),
+		StartTime: time.Unix(0, 0),
+	}
+}
+
+func TestGenesisState_Validate(t *testing.T) {
+	config.GetDefaultConfig()
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				Gauges:         []types.Gauge{validGauge(1), validGauge(2)},
+				Stakes:         []types.Stake{validStake(1)},
+				AccountRewards: []types.AccountRewards{{Owner: testAddress, Coins: sdk.NewCoins(sdk.NewInt64Coin("untrn", 1))}},
+				NextGaugeId:    3,
+				NextStakeId:    2,
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated gauge",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Gauges:      []types.Gauge{validGauge(1), validGauge(1)},
+				NextGaugeId: 2,
+				NextStakeId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "gauge id not below next gauge id",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Gauges:      []types.Gauge{validGauge(1)},
+				NextGaugeId: 1,
+				NextStakeId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated stake",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Stakes:      []types.Stake{validStake(1), validStake(1)},
+				NextGaugeId: 1,
+				NextStakeId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "stake with invalid pool shares",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Stakes: []types.Stake{{
+					Id:    1,
+					Owner: testAddress,
+					Coins: sdk.NewCoins(sdk.NewInt64Coin("untrn", 100)),
+				}},
+				NextGaugeId: 1,
+				NextStakeId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated account rewards",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountRewards: []types.AccountRewards{
+					{Owner: testAddress, Coins: sdk.NewCoins(sdk.NewInt64Coin("untrn", 1))},
+					{Owner: testAddress, Coins: sdk.NewCoins(sdk.NewInt64Coin("untrn", 1))},
+				},
+				NextGaugeId: 1,
+				NextStakeId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid params",
+			genState: &types.GenesisState{
+				Params:      types.Params{},
+				NextGaugeId: 1,
+				NextStakeId: 1,
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
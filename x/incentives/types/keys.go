@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "incentives"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_incentives"
+)
+
+const (
+	prefixParamsKey = iota + 1
+	prefixGaugeKey
+	prefixActiveGaugeKey
+	prefixNextGaugeIDKey
+	prefixStakeKey
+	prefixStakeByOwnerKey
+	prefixStakeByPairKey
+	prefixNextStakeIDKey
+	prefixAccountRewardsKey
+)
+
+var (
+	ParamsKey         = []byte{prefixParamsKey}
+	GaugeKey          = []byte{prefixGaugeKey}
+	ActiveGaugeKey    = []byte{prefixActiveGaugeKey}
+	NextGaugeIDKey    = []byte{prefixNextGaugeIDKey}
+	StakeKey          = []byte{prefixStakeKey}
+	StakeByOwnerKey   = []byte{prefixStakeByOwnerKey}
+	StakeByPairKey    = []byte{prefixStakeByPairKey}
+	NextStakeIDKey    = []byte{prefixNextStakeIDKey}
+	AccountRewardsKey = []byte{prefixAccountRewardsKey}
+)
+
+func GetGaugeKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+func GetStakeKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// GetStakeByOwnerPrefix returns the index prefix for all stakes of an owner
+func GetStakeByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(StakeByOwnerKey, lengthPrefix(owner)...)
+}
+
+func GetStakeByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetStakeByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetStakeByPairPrefix returns the index prefix for all stakes holding shares of a pair
+func GetStakeByPairPrefix(pairID string) []byte {
+	return append(StakeByPairKey, lengthPrefix([]byte(pairID))...)
+}
+
+func GetStakeByPairKey(pairID string, id uint64) []byte {
+	return append(GetStakeByPairPrefix(pairID), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetAccountRewardsKey(owner sdk.AccAddress) []byte {
+	return owner
+}
+
+func lengthPrefix(bz []byte) []byte {
+	res := make([]byte, 0, len(bz)+1)
+	res = append(res, byte(len(bz)))
+	return append(res, bz...)
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	DefaultMaxActiveGauges  = uint64(100)
+	DefaultMaxGaugeDuration = uint64(5_000_000)
+	DefaultMinGaugeFunding  = sdk.NewCoins(sdk.NewInt64Coin("untrn", 1_000_000))
+	DefaultMaxStakesPerPair = uint64(1_000)
+)
+
+// NewParams creates a new Params instance
+func NewParams(maxActiveGauges, maxGaugeDuration uint64, minGaugeFunding sdk.Coins, maxStakesPerPair uint64) Params {
+	return Params{
+		MaxActiveGauges:  maxActiveGauges,
+		MaxGaugeDuration: maxGaugeDuration,
+		MinGaugeFunding:  minGaugeFunding,
+		MaxStakesPerPair: maxStakesPerPair,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultMaxActiveGauges, DefaultMaxGaugeDuration, DefaultMinGaugeFunding, DefaultMaxStakesPerPair)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.MaxActiveGauges == 0 {
+		return fmt.Errorf("max active gauges cannot be zero")
+	}
+
+	if p.MaxGaugeDuration == 0 {
+		return fmt.Errorf("max gauge duration cannot be zero")
+	}
+
+	if err := p.MinGaugeFunding.Validate(); err != nil {
+		return fmt.Errorf("invalid min gauge funding: %w", err)
+	}
+
+	if p.MaxStakesPerPair == 0 {
+		return fmt.Errorf("max stakes per pair cannot be zero")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
@@ -0,0 +1,452 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/incentives/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Defines the parameters for the module.
+type Params struct {
+	// Maximum number of gauges that can be active (upcoming or distributing) at the same time.
+	// Limits the amount of work done in EndBlock.
+	MaxActiveGauges uint64 `protobuf:"varint,1,opt,name=max_active_gauges,json=maxActiveGauges,proto3" json:"max_active_gauges,omitempty"`
+	// Maximum number of blocks a gauge can distribute rewards for
+	MaxGaugeDuration uint64 `protobuf:"varint,2,opt,name=max_gauge_duration,json=maxGaugeDuration,proto3" json:"max_gauge_duration,omitempty"`
+	// Minimum funding of a gauge. A gauge must fund at least the amount of one of these coins, so that
+	// dust gauges can't take up the active gauge slots. Empty means no minimum.
+	MinGaugeFunding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_gauge_funding,json=minGaugeFunding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_gauge_funding"`
+	// Maximum number of stakes holding shares of a pair. Limits the amount of work done for every
+	// gauge of the pair in EndBlock.
+	MaxStakesPerPair uint64 `protobuf:"varint,4,opt,name=max_stakes_per_pair,json=maxStakesPerPair,proto3" json:"max_stakes_per_pair,omitempty"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26b1e31ea29bccbb, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxActiveGauges() uint64 {
+	if m != nil {
+		return m.MaxActiveGauges
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGaugeDuration() uint64 {
+	if m != nil {
+		return m.MaxGaugeDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMinGaugeFunding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinGaugeFunding
+	}
+	return nil
+}
+
+func (m *Params) GetMaxStakesPerPair() uint64 {
+	if m != nil {
+		return m.MaxStakesPerPair
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "neutron.incentives.Params")
+}
+
+func init() { proto.RegisterFile("neutron/incentives/params.proto", fileDescriptor_26b1e31ea29bccbb) }
+
+var fileDescriptor_26b1e31ea29bccbb = []byte{
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbf, 0x4e, 0xeb, 0x30,
+	0x14, 0x87, 0x93, 0xb6, 0xea, 0x90, 0x3b, 0xf4, 0xd6, 0xf7, 0x0e, 0xb9, 0x1d, 0x9c, 0xea, 0x4e,
+	0x15, 0xa2, 0x36, 0xe5, 0xcf, 0xc2, 0x46, 0x41, 0xb0, 0xa1, 0xaa, 0x6c, 0x2c, 0x91, 0x93, 0x1a,
+	0x63, 0x55, 0xb6, 0x23, 0xdb, 0x29, 0xe1, 0x2d, 0x3a, 0x32, 0x32, 0xf3, 0x24, 0x1d, 0x3b, 0x32,
+	0x01, 0x6a, 0x5f, 0x04, 0xc5, 0x09, 0xd0, 0x29, 0x47, 0xbf, 0x7c, 0xe7, 0x9c, 0x2f, 0x39, 0x41,
+	0x24, 0x69, 0x6e, 0xb5, 0x92, 0x98, 0xcb, 0x94, 0x4a, 0xcb, 0x17, 0xd4, 0xe0, 0x8c, 0x68, 0x22,
+	0x0c, 0xca, 0xb4, 0xb2, 0x0a, 0x80, 0x1a, 0x40, 0x3f, 0x40, 0x0f, 0xa6, 0xca, 0x08, 0x65, 0x70,
+	0x42, 0x0c, 0xc5, 0x8b, 0x51, 0x42, 0x2d, 0x19, 0xe1, 0x54, 0x71, 0x59, 0xf5, 0xf4, 0xfe, 0x32,
+	0xc5, 0x94, 0x2b, 0x71, 0x59, 0x55, 0xe9, 0xff, 0x65, 0x23, 0x68, 0x4f, 0xdc, 0x68, 0xb0, 0x17,
+	0x74, 0x05, 0x29, 0x62, 0x92, 0x96, 0xf3, 0x62, 0x46, 0x72, 0x46, 0x4d, 0xe8, 0xf7, 0xfd, 0x41,
+	0x6b, 0xda, 0x11, 0xa4, 0x38, 0x73, 0xf9, 0x95, 0x8b, 0xc1, 0x7e, 0x00, 0x4a, 0xd6, 0x41, 0xf1,
+	0x2c, 0xd7, 0xc4, 0x72, 0x25, 0xc3, 0x86, 0x83, 0x7f, 0x0b, 0x52, 0x38, 0xec, 0xa2, 0xce, 0xc1,
+	0x43, 0xd0, 0x15, 0x5c, 0xd6, 0xf4, 0x5d, 0x2e, 0x67, 0x5c, 0xb2, 0xb0, 0xd9, 0x6f, 0x0e, 0x7e,
+	0x1d, 0xfe, 0x43, 0x95, 0x36, 0x2a, 0xb5, 0x51, 0xad, 0x8d, 0xce, 0x15, 0x97, 0xe3, 0x83, 0xd5,
+	0x5b, 0xe4, 0xbd, 0xbc, 0x47, 0x03, 0xc6, 0xed, 0x7d, 0x9e, 0xa0, 0x54, 0x09, 0x5c, 0x7f, 0x63,
+	0xf5, 0x18, 0x9a, 0xd9, 0x1c, 0xdb, 0xc7, 0x8c, 0x1a, 0xd7, 0x60, 0xa6, 0x1d, 0xc1, 0xa5, 0xdb,
+	0x7c, 0x59, 0xed, 0x00, 0xc3, 0xe0, 0x4f, 0xa9, 0x69, 0x2c, 0x99, 0x53, 0x13, 0x67, 0x54, 0xc7,
+	0x19, 0xe1, 0x3a, 0x6c, 0x7d, 0x7b, 0xde, 0xb8, 0x37, 0x13, 0xaa, 0x27, 0x84, 0xeb, 0xd3, 0xd6,
+	0xd3, 0x73, 0xe4, 0x8d, 0xaf, 0x57, 0x1b, 0xe8, 0xaf, 0x37, 0xd0, 0xff, 0xd8, 0x40, 0x7f, 0xb9,
+	0x85, 0xde, 0x7a, 0x0b, 0xbd, 0xd7, 0x2d, 0xf4, 0x6e, 0x8f, 0x77, 0x4c, 0xea, 0x0b, 0x0c, 0x95,
+	0x66, 0x5f, 0x35, 0x5e, 0x9c, 0xe0, 0x62, 0xf7, 0x66, 0xce, 0x2d, 0x69, 0xbb, 0x3f, 0x7d, 0xf4,
+	0x39, 0x00, 0x92, 0x7e, 0xa5, 0xe9, 0xd6, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxStakesPerPair != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStakesPerPair))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MinGaugeFunding) > 0 {
+		for iNdEx := len(m.MinGaugeFunding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGaugeFunding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxGaugeDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGaugeDuration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxActiveGauges != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxActiveGauges))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxActiveGauges != 0 {
+		n += 1 + sovParams(uint64(m.MaxActiveGauges))
+	}
+	if m.MaxGaugeDuration != 0 {
+		n += 1 + sovParams(uint64(m.MaxGaugeDuration))
+	}
+	if len(m.MinGaugeFunding) > 0 {
+		for _, e := range m.MinGaugeFunding {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxStakesPerPair != 0 {
+		n += 1 + sovParams(uint64(m.MaxStakesPerPair))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveGauges", wireType)
+			}
+			m.MaxActiveGauges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveGauges |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGaugeDuration", wireType)
+			}
+			m.MaxGaugeDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGaugeDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGaugeFunding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGaugeFunding = append(m.MinGaugeFunding, types.Coin{})
+			if err := m.MinGaugeFunding[len(m.MinGaugeFunding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakesPerPair", wireType)
+			}
+			m.MaxStakesPerPair = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakesPerPair |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
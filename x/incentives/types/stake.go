@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// ValidatePoolShares ensures that all coins are dex pool shares
+func ValidatePoolShares(coins sdk.Coins) error {
+	if !coins.IsValid() || coins.IsZero() {
+		return ErrInvalidStake.Wrapf("invalid coins: %s", coins)
+	}
+
+	for _, coin := range coins {
+		if err := dextypes.ValidatePoolDenom(coin.Denom); err != nil {
+			return ErrInvalidPoolShares.Wrapf("%s", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+func (s Stake) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Owner); err != nil {
+		return ErrInvalidStake.Wrapf("invalid owner: %s", err)
+	}
+
+	return ValidatePoolShares(s.Coins)
+}
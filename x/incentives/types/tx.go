@@ -0,0 +1,183 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+var _ sdk.Msg = &MsgCreateGauge{}
+
+func (msg *MsgCreateGauge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateGauge) Type() string {
+	return "create-gauge"
+}
+
+func (msg *MsgCreateGauge) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateGauge) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgCreateGauge) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrap(err, "creator is invalid")
+	}
+
+	if _, err := dextypes.NewPairID(msg.TokenA, msg.TokenB); err != nil {
+		return err
+	}
+
+	if msg.StartTick > msg.EndTick {
+		return errors.Wrap(ErrInvalidGauge, "start tick must be less than or equal to end tick")
+	}
+
+	if msg.NumBlocks == 0 {
+		return errors.Wrap(ErrInvalidGauge, "num blocks must be positive")
+	}
+
+	if !msg.Coins.IsValid() || msg.Coins.IsZero() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "%s", msg.Coins)
+	}
+
+	return nil
+}
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgStake{}
+
+func (msg *MsgStake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgStake) Type() string {
+	return "stake"
+}
+
+func (msg *MsgStake) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgStake) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgStake) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrap(err, "owner is invalid")
+	}
+
+	return ValidatePoolShares(msg.Coins)
+}
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgUnstake{}
+
+func (msg *MsgUnstake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnstake) Type() string {
+	return "unstake"
+}
+
+func (msg *MsgUnstake) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgUnstake) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgUnstake) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrap(err, "owner is invalid")
+	}
+
+	return nil
+}
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+func (msg *MsgClaimRewards) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimRewards) Type() string {
+	return "claim-rewards"
+}
+
+func (msg *MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgClaimRewards) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgClaimRewards) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrap(err, "owner is invalid")
+	}
+
+	return nil
+}
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+func (msg *MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateParams) Type() string {
+	return "update-params"
+}
+
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgUpdateParams) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "authority is invalid")
+	}
+
+	return msg.Params.Validate()
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -28,6 +29,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
 		GetCmdBeforeSendHook(),
+		GetCmdDenomFreezeStatus(),
+		GetCmdVestingSchedule(),
+		GetCmdVestingSchedules(),
+		GetCmdDenomRegistryEntry(),
+		GetCmdDenomRegistry(),
 	)
 
 	return cmd
@@ -160,3 +166,173 @@ func GetCmdBeforeSendHook() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdDenomFreezeStatus returns whether a queried denom is paused and which addresses are frozen for it
+func GetCmdDenomFreezeStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-freeze-status [denom] [flags]",
+		Short: "Get whether a specific denom is paused and the addresses frozen for it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			creator, subdenom, err := types.DeconstructDenom(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomFreezeStatus(cmd.Context(), &types.QueryDenomFreezeStatusRequest{
+				Creator:    creator,
+				Subdenom:   subdenom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+// GetCmdVestingSchedule returns a vesting schedule and the amount that can be claimed from it
+func GetCmdVestingSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-schedule [schedule-id] [flags]",
+		Short: "Get a vesting schedule and the amounts vested and claimable from it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid schedule id: %w", err)
+			}
+
+			res, err := queryClient.VestingSchedule(cmd.Context(), &types.QueryVestingScheduleRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdVestingSchedules returns all the vesting schedules of a recipient
+func GetCmdVestingSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-schedules [recipient] [flags]",
+		Short: "Get all the vesting schedules of a recipient",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VestingSchedules(cmd.Context(), &types.QueryVestingSchedulesRequest{
+				Recipient:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+// GetCmdDenomRegistryEntry returns the registry entry of a denom
+func GetCmdDenomRegistryEntry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-registry-entry [denom] [flags]",
+		Short: "Get the governance curated registry entry of a factory, IBC or native denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomRegistryEntry(cmd.Context(), &types.QueryDenomRegistryEntryRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomRegistry returns all the entries of the denom registry
+func GetCmdDenomRegistry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-registry [flags]",
+		Short: "Get all the entries of the governance curated denom registry",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomRegistry(cmd.Context(), &types.QueryDenomRegistryRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
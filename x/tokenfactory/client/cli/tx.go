@@ -4,6 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/spf13/cobra"
@@ -17,6 +21,11 @@ import (
 	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
 )
 
+const (
+	FlagTimelock = "timelock"
+	FlagExpiry   = "expiry"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -33,8 +42,22 @@ func GetTxCmd() *cobra.Command {
 		NewBurnCmd(),
 		NewForceTransferCmd(),
 		NewChangeAdminCmd(),
+		NewProposeAdminCmd(),
+		NewAcceptAdminCmd(),
+		NewCancelAdminTransferCmd(),
+		NewRenounceAdminCmd(),
 		NewSetBeforeSendHook(),
+		NewSetBeforeSendHooksCmd(),
 		NewSetDenomMetadataCmd(),
+		NewSetMintLimitsCmd(),
+		NewGrantRoleCmd(),
+		NewRevokeRoleCmd(),
+		NewSetMinterAllowanceCmd(),
+		NewRenounceForceTransferCmd(),
+		NewMintVestedCmd(),
+		NewClaimVestedCmd(),
+		NewSetDenomPausedCmd(),
+		NewSetAddressFrozenCmd(),
 	)
 
 	return cmd
@@ -221,7 +244,7 @@ func NewSetDenomMetadataCmd() *cobra.Command {
 func NewChangeAdminCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "change-admin [denom] [new-admin-address] [flags]",
-		Short: "Changes the admin address for a factory-created denom. Must have admin authority to do so.",
+		Short: "Proposes a new admin address for a factory-created denom, which it can accept right away. Must have admin authority to do so.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -278,3 +301,592 @@ func NewSetBeforeSendHook() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetBeforeSendHooksCmd broadcast MsgSetBeforeSendHooks
+func NewSetBeforeSendHooksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-before-send-hooks [denom] [contract-addr:mode:gas-limit[:ibc]]... [flags]",
+		Short: "Replaces the before send hooks of a factory-created denom, called in the given order. Mode is HOOK_MODE_BLOCK or HOOK_MODE_TRACK. The ibc option passes the outbound IBC transfer to the hook on sends to escrow addresses. No hooks removes all of them. Must have admin authority to do so.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			hooks := make([]types.BeforeSendHook, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				hook, err := parseBeforeSendHook(arg)
+				if err != nil {
+					return err
+				}
+				hooks = append(hooks, hook)
+			}
+
+			msg := types.NewMsgSetBeforeSendHooks(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				hooks,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMintLimitsCmd broadcast MsgSetMintLimits
+func NewSetMintLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-limits [denom] [max-supply] [mint-rate-limit] [mint-window-seconds] [flags]",
+		Short: "Sets the max supply and mint rate limit for a factory-created denom. Zero disables a limit. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			maxSupply, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", args[1])
+			}
+
+			mintRateLimit, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid mint rate limit: %s", args[2])
+			}
+
+			mintWindow, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintLimits(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				types.MintLimits{
+					MaxSupply:     maxSupply,
+					MintRateLimit: mintRateLimit,
+					MintWindow:    mintWindow,
+				},
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewGrantRoleCmd broadcast MsgGrantRole
+func NewGrantRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [denom] [address] [role] [flags]",
+		Short: "Grants a role (e.g. ROLE_MINTER) over a factory-created denom to an address. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			role, err := parseRole(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				role,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeRoleCmd broadcast MsgRevokeRole
+func NewRevokeRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [denom] [address] [role] [flags]",
+		Short: "Revokes a role (e.g. ROLE_MINTER) over a factory-created denom from an address. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			role, err := parseRole(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				role,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMinterAllowanceCmd broadcast MsgSetMinterAllowance
+func NewSetMinterAllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-minter-allowance [denom] [minter] [allowance|unlimited] [flags]",
+		Short: "Sets the amount a minter of a factory-created denom can still mint. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveMinterAllowance(clientCtx.GetFromAddress().String(), args[0], args[1])
+			if args[2] != "unlimited" {
+				allowance, ok := math.NewIntFromString(args[2])
+				if !ok {
+					return fmt.Errorf("invalid allowance: %s", args[2])
+				}
+				msg = types.NewMsgSetMinterAllowance(clientCtx.GetFromAddress().String(), args[0], args[1], allowance)
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRenounceForceTransferCmd broadcast MsgRenounceForceTransfer
+func NewRenounceForceTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renounce-force-transfer [denom] [flags]",
+		Short: "Permanently disables force transfers of a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenounceForceTransfer(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetDenomPausedCmd broadcast MsgSetDenomPaused
+func NewSetDenomPausedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-paused [denom] [true|false] [flags]",
+		Short: "Pauses or resumes all transfers of a factory-created denom. Must have the pauser role to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomPaused(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				paused,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetAddressFrozenCmd broadcast MsgSetAddressFrozen
+func NewSetAddressFrozenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-address-frozen [denom] [address] [true|false] [flags]",
+		Short: "Freezes or unfreezes transfers of a factory-created denom from and to an address. Must have the pauser role to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			frozen, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAddressFrozen(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				frozen,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseRole(s string) (types.Role, error) {
+	role, ok := types.Role_value[s]
+	if !ok {
+		return types.ROLE_UNSPECIFIED, fmt.Errorf("invalid role: %s", s)
+	}
+
+	return types.Role(role), nil
+}
+
+func parseBeforeSendHook(s string) (types.BeforeSendHook, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 && len(parts) != 4 {
+		return types.BeforeSendHook{}, fmt.Errorf("invalid hook, expected contract-addr:mode:gas-limit[:ibc]: %s", s)
+	}
+	if len(parts) == 4 && parts[3] != "ibc" {
+		return types.BeforeSendHook{}, fmt.Errorf("invalid hook option: %s", parts[3])
+	}
+
+	mode, ok := types.HookMode_value[parts[1]]
+	if !ok {
+		return types.BeforeSendHook{}, fmt.Errorf("invalid hook mode: %s", parts[1])
+	}
+
+	gasLimit, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return types.BeforeSendHook{}, fmt.Errorf("invalid hook gas limit: %w", err)
+	}
+
+	return types.BeforeSendHook{
+		ContractAddr:       parts[0],
+		Mode:               types.HookMode(mode),
+		GasLimit:           gasLimit,
+		IbcTransferContext: len(parts) == 4,
+	}, nil
+}
+
+// NewMintVestedCmd broadcast MsgMintVested
+func NewMintVestedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-vested [amount] [recipient] [start-time] [end-time | period-seconds:amount,...] [flags]",
+		Short: "Mint a denom into a vesting schedule of the recipient, vesting continuously until end-time or over the given periods. Times are unix seconds. Must have the minter role to do so.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start time: %w", err)
+			}
+
+			var (
+				endTime int64
+				periods []types.VestingPeriod
+			)
+			if strings.Contains(args[3], ":") {
+				periods, err = parseVestingPeriods(args[3])
+			} else {
+				endTime, err = strconv.ParseInt(args[3], 10, 64)
+			}
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintVested(
+				clientCtx.GetFromAddress().String(),
+				amount,
+				args[1],
+				startTime,
+				endTime,
+				periods,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimVestedCmd broadcast MsgClaimVested
+func NewClaimVestedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-vested [schedule-id] [flags]",
+		Short: "Claim the tokens vested so far in a vesting schedule. Must be the recipient of the schedule to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			scheduleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid schedule id: %w", err)
+			}
+
+			msg := types.NewMsgClaimVested(
+				clientCtx.GetFromAddress().String(),
+				scheduleID,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseVestingPeriods(s string) ([]types.VestingPeriod, error) {
+	parts := strings.Split(s, ",")
+	periods := make([]types.VestingPeriod, 0, len(parts))
+	for _, part := range parts {
+		lengthAndAmount := strings.Split(part, ":")
+		if len(lengthAndAmount) != 2 {
+			return nil, fmt.Errorf("invalid period, expected period-seconds:amount: %s", part)
+		}
+
+		length, err := strconv.ParseInt(lengthAndAmount[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid period length: %w", err)
+		}
+
+		amount, ok := math.NewIntFromString(lengthAndAmount[1])
+		if !ok {
+			return nil, fmt.Errorf("invalid period amount: %s", lengthAndAmount[1])
+		}
+
+		periods = append(periods, types.VestingPeriod{Length: length, Amount: amount})
+	}
+
+	return periods, nil
+}
+
+// NewProposeAdminCmd broadcast MsgProposeAdmin
+func NewProposeAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-admin [denom] [new-admin-address] [flags]",
+		Short: "Proposes handing a factory-created denom over to a new admin, who has to accept it after the timelock and before the expiry. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			timelock, err := cmd.Flags().GetUint64(FlagTimelock)
+			if err != nil {
+				return err
+			}
+
+			expiry, err := cmd.Flags().GetUint64(FlagExpiry)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProposeAdmin(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				timelock,
+				expiry,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagTimelock, 0, "Seconds before the new admin can accept the transfer")
+	cmd.Flags().Uint64(FlagExpiry, 0, "Seconds after the timelock during which the new admin can accept the transfer, 0 for no expiry")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAcceptAdminCmd broadcast MsgAcceptAdmin
+func NewAcceptAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-admin [denom] [flags]",
+		Short: "Accepts the admin transfer of a factory-created denom proposed to the sender.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptAdmin(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelAdminTransferCmd broadcast MsgCancelAdminTransfer
+func NewCancelAdminTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-admin-transfer [denom] [flags]",
+		Short: "Cancels the proposed admin transfer of a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAdminTransfer(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRenounceAdminCmd broadcast MsgRenounceAdmin
+func NewRenounceAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renounce-admin [denom] [flags]",
+		Short: "Permanently leaves a factory-created denom without admin. Roles granted to other accounts are kept. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenounceAdmin(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
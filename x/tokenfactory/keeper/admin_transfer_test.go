@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestProposeAcceptAdmin() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	admin, newAdmin, other := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	tokenFactoryKeeper := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper
+	start := ctx.BlockTime().Unix()
+
+	// Only the admin can propose a transfer
+	_, err := suite.msgServer.ProposeAdmin(ctx, types.NewMsgProposeAdmin(other.String(), suite.defaultDenom, newAdmin.String(), 0, 0))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.ProposeAdmin(ctx, types.NewMsgProposeAdmin(admin.String(), suite.defaultDenom, newAdmin.String(), 100, 50))
+	suite.Require().NoError(err)
+
+	metadata, err := tokenFactoryKeeper.GetAuthorityMetadata(ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(admin.String(), metadata.Admin)
+	suite.Require().Equal(&types.PendingAdminTransfer{NewAdmin: newAdmin.String(), AcceptTime: start + 100, ExpireTime: start + 150}, metadata.PendingAdminTransfer)
+
+	// Only the proposed admin can accept, and only once the timelock has passed
+	_, err = suite.msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(other.String(), suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrNoPendingAdminTransfer)
+	_, err = suite.msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(newAdmin.String(), suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrAdminTransferNotAcceptable)
+
+	ctx = ctx.WithBlockTime(time.Unix(start+100, 0))
+	_, err = suite.msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(newAdmin.String(), suite.defaultDenom))
+	suite.Require().NoError(err)
+
+	metadata, err = tokenFactoryKeeper.GetAuthorityMetadata(ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(newAdmin.String(), metadata.Admin)
+	suite.Require().Nil(metadata.PendingAdminTransfer)
+	for _, role := range types.AllRoles {
+		suite.Require().True(metadata.HasRole(newAdmin.String(), role))
+		suite.Require().False(metadata.HasRole(admin.String(), role))
+	}
+}
+
+func (suite *KeeperTestSuite) TestAdminTransferExpiryAndCancel() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	admin, newAdmin, other := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	tokenFactoryKeeper := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper
+	start := ctx.BlockTime().Unix()
+
+	// A transfer to a typo'd address can simply expire
+	_, err := suite.msgServer.ProposeAdmin(ctx, types.NewMsgProposeAdmin(admin.String(), suite.defaultDenom, newAdmin.String(), 0, 10))
+	suite.Require().NoError(err)
+
+	expiredCtx := ctx.WithBlockTime(time.Unix(start+10, 0))
+	_, err = suite.msgServer.AcceptAdmin(expiredCtx, types.NewMsgAcceptAdmin(newAdmin.String(), suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrAdminTransferNotAcceptable)
+
+	// Or be cancelled by the admin
+	_, err = suite.msgServer.CancelAdminTransfer(ctx, types.NewMsgCancelAdminTransfer(newAdmin.String(), suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.CancelAdminTransfer(ctx, types.NewMsgCancelAdminTransfer(admin.String(), suite.defaultDenom))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.CancelAdminTransfer(ctx, types.NewMsgCancelAdminTransfer(admin.String(), suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrNoPendingAdminTransfer)
+	_, err = suite.msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(newAdmin.String(), suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrNoPendingAdminTransfer)
+
+	// ChangeAdmin replaces the pending transfer with one the new admin can accept right away
+	_, err = suite.msgServer.ProposeAdmin(ctx, types.NewMsgProposeAdmin(admin.String(), suite.defaultDenom, newAdmin.String(), 100, 0))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(admin.String(), suite.defaultDenom, other.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(newAdmin.String(), suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrNoPendingAdminTransfer)
+
+	metadata, err := tokenFactoryKeeper.GetAuthorityMetadata(ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(admin.String(), metadata.Admin)
+
+	_, err = suite.msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(other.String(), suite.defaultDenom))
+	suite.Require().NoError(err)
+	metadata, err = tokenFactoryKeeper.GetAuthorityMetadata(ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(other.String(), metadata.Admin)
+}
+
+func (suite *KeeperTestSuite) TestRenounceAdmin() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	admin, minter := suite.TestAccs[0], suite.TestAccs[1]
+	tokenFactoryKeeper := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper
+	suite.grantRole(ctx, minter, types.ROLE_MINTER)
+
+	_, err := suite.msgServer.RenounceAdmin(ctx, types.NewMsgRenounceAdmin(minter.String(), suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.RenounceAdmin(ctx, types.NewMsgRenounceAdmin(admin.String(), suite.defaultDenom))
+	suite.Require().NoError(err)
+
+	metadata, err := tokenFactoryKeeper.GetAuthorityMetadata(ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Empty(metadata.Admin)
+	for _, role := range types.AllRoles {
+		suite.Require().False(metadata.HasRole(admin.String(), role))
+	}
+
+	// Roles granted to other accounts are kept
+	suite.Require().True(metadata.HasRole(minter.String(), types.ROLE_MINTER))
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(minter.String(), sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+
+	// Nobody can take the denom over anymore
+	_, err = suite.msgServer.ProposeAdmin(ctx, types.NewMsgProposeAdmin(admin.String(), suite.defaultDenom, minter.String(), 0, 0))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
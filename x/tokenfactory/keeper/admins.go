@@ -2,12 +2,14 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
 )
 
-// GetAuthorityMetadata returns the authority metadata for a specific denom
+// GetAuthorityMetadata returns the authority metadata for a specific denom. Pending mint limits whose timelock has
+// passed are returned as the current ones.
 func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, error) {
 	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomAuthorityMetadataKey))
 
@@ -16,6 +18,8 @@ func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.Denom
 	if err != nil {
 		return types.DenomAuthorityMetadata{}, err
 	}
+	metadata.PromotePendingMintLimits(ctx.BlockTime())
+
 	return metadata, nil
 }
 
@@ -37,13 +41,100 @@ func (k Keeper) setAuthorityMetadata(ctx sdk.Context, denom string, metadata typ
 	return nil
 }
 
+// setAdmin hands the denom over to a new admin, together with the roles held by the previous one
 func (k Keeper) setAdmin(ctx sdk.Context, denom, admin string) error {
 	metadata, err := k.GetAuthorityMetadata(ctx, denom)
 	if err != nil {
 		return err
 	}
 
+	metadata.TransferRoles(metadata.Admin, admin)
 	metadata.Admin = admin
+	metadata.PendingAdminTransfer = nil
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// proposeAdmin records an admin transfer the new admin can accept once timelock seconds have passed, and until expiry
+// more seconds have passed unless expiry is zero. Any previous proposal is replaced.
+func (k Keeper) proposeAdmin(ctx sdk.Context, denom, newAdmin string, timelock, expiry uint64) (types.PendingAdminTransfer, error) {
+	// bounding both keeps the times below from overflowing
+	if timelock > types.MaxAdminTransferTimelock || expiry > types.MaxAdminTransferTimelock {
+		return types.PendingAdminTransfer{}, sdkerrors.ErrInvalidRequest.Wrapf(
+			"timelock and expiry cannot exceed %d", types.MaxAdminTransferTimelock,
+		)
+	}
+
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return types.PendingAdminTransfer{}, err
+	}
+
+	pending := types.PendingAdminTransfer{
+		NewAdmin:   newAdmin,
+		AcceptTime: ctx.BlockTime().Unix() + int64(timelock), //nolint:gosec
+	}
+	if expiry != 0 {
+		pending.ExpireTime = pending.AcceptTime + int64(expiry) //nolint:gosec
+	}
+	metadata.PendingAdminTransfer = &pending
+
+	return pending, k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// acceptAdmin hands the denom over to the sender if an admin transfer to it is pending and acceptable at the current
+// block time
+func (k Keeper) acceptAdmin(ctx sdk.Context, denom, sender string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	pending := metadata.PendingAdminTransfer
+	if pending == nil || pending.NewAdmin != sender {
+		return types.ErrNoPendingAdminTransfer.Wrapf("no admin transfer of %s to %s", denom, sender)
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	if blockTime < pending.AcceptTime {
+		return types.ErrAdminTransferNotAcceptable.Wrapf("timelocked until %d", pending.AcceptTime)
+	}
+
+	if pending.ExpireTime != 0 && blockTime >= pending.ExpireTime {
+		return types.ErrAdminTransferNotAcceptable.Wrapf("expired at %d", pending.ExpireTime)
+	}
+
+	return k.setAdmin(ctx, denom, sender)
+}
+
+// cancelAdminTransfer removes the pending admin transfer of a denom
+func (k Keeper) cancelAdminTransfer(ctx sdk.Context, denom string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.PendingAdminTransfer == nil {
+		return types.ErrNoPendingAdminTransfer.Wrapf("denom: %s", denom)
+	}
+	metadata.PendingAdminTransfer = nil
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// renounceAdmin leaves a denom without admin. The roles held by the admin are revoked while the roles granted to other
+// accounts are kept.
+func (k Keeper) renounceAdmin(ctx sdk.Context, denom string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range types.AllRoles {
+		metadata.RevokeRole(metadata.Admin, role)
+	}
+	metadata.Admin = ""
+	metadata.PendingAdminTransfer = nil
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
@@ -38,9 +38,11 @@ func (suite *KeeperTestSuite) TestAdminMsgs() {
 	suite.Require().True(suite.GetNeutronZoneApp(suite.ChainA).BankKeeper.GetBalance(suite.ChainA.GetContext(), suite.TestAccs[0], suite.defaultDenom).Amount.Int64() == addr0bal, suite.GetNeutronZoneApp(suite.ChainA).BankKeeper.GetBalance(suite.ChainA.GetContext(), suite.TestAccs[0], suite.defaultDenom))
 	suite.Require().True(suite.GetNeutronZoneApp(suite.ChainA).BankKeeper.GetBalance(suite.ChainA.GetContext(), suite.TestAccs[1], suite.defaultDenom).Amount.Int64() == addr1bal)
 
-	// Test Change Admin
+	// Test Change Admin, which the new admin has to accept
 	_, err = suite.msgServer.ChangeAdmin(suite.ChainA.GetContext(), types.NewMsgChangeAdmin(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[1].String()))
 	suite.Require().NoError(err)
+	_, err = suite.msgServer.AcceptAdmin(suite.ChainA.GetContext(), types.NewMsgAcceptAdmin(suite.TestAccs[1].String(), suite.defaultDenom))
+	suite.Require().NoError(err)
 	denom = strings.Split(suite.defaultDenom, "/")
 	queryRes, err = suite.queryClient.DenomAuthorityMetadata(suite.ChainA.GetContext().Context(), &types.QueryDenomAuthorityMetadataRequest{
 		Creator:  denom[1],
@@ -319,9 +321,12 @@ func (suite *KeeperTestSuite) TestChangeAdminDenom() {
 			_, err = suite.msgServer.Mint(suite.ChainA.GetContext(), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(testDenom, 10)))
 			suite.Require().NoError(err)
 
-			_, err = suite.msgServer.ChangeAdmin(suite.ChainA.GetContext(), tc.msgChangeAdmin(testDenom))
+			msgChangeAdmin := tc.msgChangeAdmin(testDenom)
+			_, err = suite.msgServer.ChangeAdmin(suite.ChainA.GetContext(), msgChangeAdmin)
 			if tc.expectedChangeAdminPass {
 				suite.Require().NoError(err)
+				_, err = suite.msgServer.AcceptAdmin(suite.ChainA.GetContext(), types.NewMsgAcceptAdmin(msgChangeAdmin.NewAdmin, testDenom))
+				suite.Require().NoError(err)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -20,12 +20,14 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
-	if k.isModuleAccount(ctx, mintToAcc) {
-		return status.Errorf(codes.Internal, "minting to module accounts is forbidden")
+	err = k.assertCanMintTo(ctx, mintToAcc)
+	if err != nil {
+		return err
 	}
 
-	if k.IsEscrowAddress(ctx, mintToAcc) {
-		return status.Errorf(codes.Internal, "minting to IBC escrow accounts is forbidden")
+	err = k.checkMintLimits(ctx, amount)
+	if err != nil {
+		return err
 	}
 
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
@@ -38,6 +40,19 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		sdk.NewCoins(amount))
 }
 
+// assertCanMintTo returns an error if tokens cannot be minted to an address
+func (k Keeper) assertCanMintTo(ctx sdk.Context, mintToAcc sdk.AccAddress) error {
+	if k.isModuleAccount(ctx, mintToAcc) {
+		return status.Errorf(codes.Internal, "minting to module accounts is forbidden")
+	}
+
+	if k.IsEscrowAddress(ctx, mintToAcc) {
+		return status.Errorf(codes.Internal, "minting to IBC escrow accounts is forbidden")
+	}
+
+	return nil
+}
+
 func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(amount.Denom)
@@ -58,7 +73,8 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 		return status.Errorf(codes.Internal, "burning from IBC escrow accounts is forbidden")
 	}
 
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx,
+	// The tokens of frozen addresses and paused denoms can still be burnt
+	err = k.bankKeeper.SendCoinsFromAccountToModule(withAdminTransfer(ctx),
 		burnFromAcc,
 		types.ModuleName,
 		sdk.NewCoins(amount))
@@ -102,7 +118,8 @@ func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr, toAddr
 		return status.Errorf(codes.Internal, "force transfer to IBC escrow accounts is forbidden")
 	}
 
-	return k.bankKeeper.SendCoins(ctx, transferFromAcc, transferToAcc, sdk.NewCoins(amount))
+	// The tokens of frozen addresses and paused denoms can still be seized
+	return k.bankKeeper.SendCoins(withAdminTransfer(ctx), transferFromAcc, transferToAcc, sdk.NewCoins(amount))
 }
 
 func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
@@ -13,7 +13,7 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 )
 
-func (k Keeper) setBeforeSendHook(ctx sdk.Context, denom, contractAddr string) error {
+func (k Keeper) setBeforeSendHooks(ctx sdk.Context, denom string, hooks []types.BeforeSendHook) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(denom)
 	if err != nil {
@@ -22,31 +22,49 @@ func (k Keeper) setBeforeSendHook(ctx sdk.Context, denom, contractAddr string) e
 
 	store := k.GetDenomPrefixStore(ctx, denom)
 
-	// delete the store for denom prefix store when cosmwasm address is nil
-	if contractAddr == "" {
-		store.Delete([]byte(types.BeforeSendHookAddressPrefixKey))
+	// delete the hooks from the denom prefix store when the list is empty
+	if len(hooks) == 0 {
+		store.Delete([]byte(types.BeforeSendHooksPrefixKey))
 		return nil
 	}
 
-	_, err = sdk.AccAddressFromBech32(contractAddr)
+	if err := types.ValidateBeforeSendHooks(hooks); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&types.BeforeSendHooks{Hooks: hooks})
 	if err != nil {
 		return err
 	}
 
-	store.Set([]byte(types.BeforeSendHookAddressPrefixKey), []byte(contractAddr))
+	store.Set([]byte(types.BeforeSendHooksPrefixKey), bz)
 
 	return nil
 }
 
-func (k Keeper) GetBeforeSendHook(ctx context.Context, denom string) string {
+// GetBeforeSendHooks returns the before send hooks of a denom in the order they are called
+func (k Keeper) GetBeforeSendHooks(ctx context.Context, denom string) []types.BeforeSendHook {
 	store := k.GetDenomPrefixStore(ctx, denom)
 
-	bz := store.Get([]byte(types.BeforeSendHookAddressPrefixKey))
+	bz := store.Get([]byte(types.BeforeSendHooksPrefixKey))
 	if bz == nil {
+		return nil
+	}
+
+	var hooks types.BeforeSendHooks
+	k.cdc.MustUnmarshal(bz, &hooks)
+
+	return hooks.Hooks
+}
+
+// GetBeforeSendHook returns the address of the first before send hook of a denom
+func (k Keeper) GetBeforeSendHook(ctx context.Context, denom string) string {
+	hooks := k.GetBeforeSendHooks(ctx, denom)
+	if len(hooks) == 0 {
 		return ""
 	}
 
-	return string(bz)
+	return hooks[0].ContractAddr
 }
 
 func CWCoinFromSDKCoin(in sdk.Coin) wasmvmtypes.Coin {
@@ -89,33 +107,38 @@ func (k Keeper) AssertIsHookWhitelisted(ctx sdk.Context, denom string, contractA
 	return types.ErrBeforeSendHookNotWhitelisted.Wrapf("no whitelist for contract with codeID (%d) and denomCreator (%s) ", codeID, denomCreator)
 }
 
-// TrackBeforeSend calls the before send listener contract suppresses any errors
+// TrackBeforeSend calls the before send listener contract suppresses any errors. Pauses and freezes are not enforced
+// here since this hook cannot fail, which exempts module to module sends that only call this hook.
 func (h Hooks) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) {
 	_ = h.k.callBeforeSendListener(ctx, from, to, amount, false)
 }
 
-// TrackBeforeSend calls the before send listener contract returns any errors
+// BlockBeforeSend rejects transfers of paused denoms and of frozen addresses, then calls the before send listener
+// contract and returns any errors
 func (h Hooks) BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if err := h.k.assertTransferAllowed(ctx, from, to, amount); err != nil {
+		return err
+	}
+
 	return h.k.callBeforeSendListener(ctx, from, to, amount, true)
 }
 
-// callBeforeSendListener iterates over each coin and sends corresponding sudo msg to the contract address stored in state.
-// If blockBeforeSend is true, sudoMsg wraps BlockBeforeSendMsg, otherwise sudoMsg wraps TrackBeforeSendMsg.
+// callBeforeSendListener iterates over each coin and sends corresponding sudo msgs to the hook contracts of its denom in
+// their order. Sends to IBC escrow addresses carry the outbound ICS-20 transfer for the hooks asking for it. If blockBeforeSend is true, sudoMsg wraps BlockBeforeSendMsg and is only sent to blocking hooks, the first
+// error of which is returned. Otherwise sudoMsg wraps TrackBeforeSendMsg, is sent to every hook and errors are only logged.
 // Note that we gas meter trackBeforeSend to prevent infinite contract calls.
 // CONTRACT: this should not be called in beginBlock or endBlock since out of gas will cause this method to panic.
-func (k Keeper) callBeforeSendListener(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins, blockBeforeSend bool) (err error) {
+func (k Keeper) callBeforeSendListener(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins, blockBeforeSend bool) error {
 	c := sdk.UnwrapSDKContext(ctx)
-
-	defer func() {
-		if r := recover(); r != nil {
-			err = types.ErrTrackBeforeSendOutOfGas
-		}
-	}()
+	ibcTransfer := k.GetOutboundIBCTransfer(c, to)
 
 	for _, coin := range amount {
-		contractAddr := k.GetBeforeSendHook(ctx, coin.Denom)
-		if contractAddr != "" {
-			cwAddr, err := sdk.AccAddressFromBech32(contractAddr)
+		for _, hook := range k.GetBeforeSendHooks(ctx, coin.Denom) {
+			if blockBeforeSend && hook.Mode != types.HOOK_MODE_BLOCK {
+				continue
+			}
+
+			cwAddr, err := sdk.AccAddressFromBech32(hook.ContractAddr)
 			if err != nil {
 				return err
 			}
@@ -140,46 +163,81 @@ func (k Keeper) callBeforeSendListener(ctx context.Context, from, to sdk.AccAddr
 			// Note that for trackBeforeSend, we need to gas meter computations to prevent infinite loop
 			// specifically because module to module sends are not gas metered.
 			// We don't need to do this for blockBeforeSend since blockBeforeSend is not called during module to module sends.
+			gasLimit := hook.GasLimit
+			var hookIBCTransfer *types.IBCTransfer
+			if hook.IbcTransferContext {
+				hookIBCTransfer = ibcTransfer
+			}
 			if blockBeforeSend {
 				msg := types.BlockBeforeSendSudoMsg{
 					BlockBeforeSend: types.BlockBeforeSendMsg{
-						From:   from.String(),
-						To:     to.String(),
-						Amount: CWCoinFromSDKCoin(coin),
+						From:        from.String(),
+						To:          to.String(),
+						Amount:      CWCoinFromSDKCoin(coin),
+						IBCTransfer: hookIBCTransfer,
 					},
 				}
 				msgBz, err = json.Marshal(msg)
 			} else {
 				msg := types.TrackBeforeSendSudoMsg{
 					TrackBeforeSend: types.TrackBeforeSendMsg{
-						From:   from.String(),
-						To:     to.String(),
-						Amount: CWCoinFromSDKCoin(coin),
+						From:        from.String(),
+						To:          to.String(),
+						Amount:      CWCoinFromSDKCoin(coin),
+						IBCTransfer: hookIBCTransfer,
 					},
 				}
 				msgBz, err = json.Marshal(msg)
+				if gasLimit == 0 {
+					gasLimit = types.TrackBeforeSendGasLimit
+				}
 			}
 			if err != nil {
 				return err
 			}
 
-			// if its track before send, apply gas meter to prevent infinite loop
-			if blockBeforeSend {
-				_, err = k.contractKeeper.Sudo(c, cwAddr, msgBz)
-				if err != nil {
-					return errorsmod.Wrapf(err, "failed to call before send hook for denom %s", coin.Denom)
-				}
-			} else {
-				childCtx := c.WithGasMeter(types2.NewGasMeter(types.TrackBeforeSendGasLimit))
-				_, err = k.contractKeeper.Sudo(childCtx, cwAddr, msgBz)
-				if err != nil {
-					return errorsmod.Wrapf(err, "failed to call before send hook for denom %s", coin.Denom)
-				}
+			err = k.callBeforeSendHook(c, cwAddr, msgBz, gasLimit)
+			if err == nil {
+				continue
+			}
 
-				// consume gas used for calling contract to the parent ctx
-				c.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumed(), "track before send gas")
+			if blockBeforeSend {
+				return errorsmod.Wrapf(err, "failed to call before send hook for denom %s", coin.Denom)
 			}
+
+			c.Logger().Error(
+				"Failed to call track before send hook",
+				"err", err,
+				"denom", coin.Denom,
+				"contract", cwAddr.String(),
+			)
 		}
 	}
 	return nil
 }
+
+// callBeforeSendHook sends a sudo msg to a hook contract. If gasLimit is not zero the call is gas metered separately and
+// the gas it used is consumed from the parent ctx.
+func (k Keeper) callBeforeSendHook(ctx sdk.Context, contractAddr sdk.AccAddress, msgBz []byte, gasLimit uint64) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = types.ErrTrackBeforeSendOutOfGas
+		}
+	}()
+
+	if gasLimit == 0 {
+		_, err = k.contractKeeper.Sudo(ctx, contractAddr, msgBz)
+		return err
+	}
+
+	childCtx := ctx.WithGasMeter(types2.NewGasMeter(gasLimit))
+	_, err = k.contractKeeper.Sudo(childCtx, contractAddr, msgBz)
+	if err != nil {
+		return err
+	}
+
+	// consume gas used for calling contract to the parent ctx
+	ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumed(), "track before send gas")
+
+	return nil
+}
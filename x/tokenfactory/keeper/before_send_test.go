@@ -10,6 +10,7 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
 	icqtypes "github.com/neutron-org/neutron/v5/x/interchainqueries/types"
@@ -337,3 +338,102 @@ func (suite *KeeperTestSuite) TestInfiniteTrackBeforeSend() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestChainedBeforeSendHooks() {
+	suite.Setup()
+
+	trackAddress, trackCodeID, factoryDenom := suite.initBalanceTrackContract("testdenom")
+
+	// https://github.com/neutron-org/neutron-dev-contracts/tree/chore/additional-tf-test-contracts/contracts/no100
+	wasmCode, err := os.ReadFile("./testdata/no100.wasm")
+	suite.Require().NoError(err)
+	blockCodeID, _, err := suite.contractKeeper.Create(suite.ChainA.GetContext(), suite.TestAccs[0], wasmCode, nil)
+	suite.Require().NoError(err)
+	blockAddress, _, err := suite.contractKeeper.Instantiate(suite.ChainA.GetContext(), blockCodeID, suite.TestAccs[0], suite.TestAccs[0], []byte("{}"), "", sdk.NewCoins())
+	suite.Require().NoError(err)
+
+	// Whitelist both hooks
+	params := types.DefaultParams()
+	params.WhitelistedHooks = []*types.WhitelistedHook{
+		{DenomCreator: suite.TestAccs[0].String(), CodeID: trackCodeID},
+		{DenomCreator: suite.TestAccs[0].String(), CodeID: blockCodeID},
+	}
+	err = suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper.SetParams(suite.ChainA.GetContext(), params)
+	suite.Require().NoError(err)
+
+	trackedSupply := func() string {
+		queryResp, err := suite.GetNeutronZoneApp(suite.ChainA).WasmKeeper.QuerySmart(suite.ChainA.GetContext(), trackAddress, []byte(`{"total_supply_at":{}}`))
+		suite.Require().NoError(err)
+		return string(queryResp)
+	}
+
+	// The balance tracker counts the block_before_send messages it receives
+	hooks := []types.BeforeSendHook{
+		{ContractAddr: blockAddress.String(), Mode: types.HOOK_MODE_BLOCK},
+		{ContractAddr: trackAddress.String(), Mode: types.HOOK_MODE_BLOCK, GasLimit: 200_000},
+	}
+
+	// A contract cannot be added twice
+	_, err = suite.msgServer.SetBeforeSendHooks(suite.ChainA.GetContext(), types.NewMsgSetBeforeSendHooks(suite.TestAccs[0].String(), factoryDenom, append(hooks, hooks[0])))
+	suite.Require().ErrorIs(err, types.ErrInvalidBeforeSendHooks)
+
+	_, err = suite.msgServer.SetBeforeSendHooks(suite.ChainA.GetContext(), types.NewMsgSetBeforeSendHooks(suite.TestAccs[0].String(), factoryDenom, hooks))
+	suite.Require().NoError(err)
+
+	// The query returns every hook in order
+	creator, subdenom, err := types.DeconstructDenom(factoryDenom)
+	suite.Require().NoError(err)
+	queryRes, err := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper.BeforeSendHookAddress(suite.ChainA.GetContext(), &types.QueryBeforeSendHookAddressRequest{Creator: creator, Subdenom: subdenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(blockAddress.String(), queryRes.ContractAddr)
+	suite.Require().Equal(hooks, queryRes.Hooks)
+
+	// Both hooks are called on mint
+	_, err = suite.msgServer.Mint(suite.ChainA.GetContext(), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(factoryDenom, 99)))
+	suite.Require().NoError(err)
+	suite.Require().Equal("\"99\"", trackedSupply())
+
+	// The first hook rejects mints of 100, so the second one is not called
+	_, err = suite.msgServer.Mint(suite.ChainA.GetContext(), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(factoryDenom, 100)))
+	suite.Require().Error(err)
+	suite.Require().Equal("\"99\"", trackedSupply())
+
+	// Tracking hooks are not sent block_before_send messages and cannot reject transfers
+	hooks[0].Mode = types.HOOK_MODE_TRACK
+	hooks[1].Mode = types.HOOK_MODE_TRACK
+	_, err = suite.msgServer.SetBeforeSendHooks(suite.ChainA.GetContext(), types.NewMsgSetBeforeSendHooks(suite.TestAccs[0].String(), factoryDenom, hooks))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(suite.ChainA.GetContext(), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(factoryDenom, 100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal("\"99\"", trackedSupply())
+
+	// Setting no hooks removes them all
+	_, err = suite.msgServer.SetBeforeSendHooks(suite.ChainA.GetContext(), types.NewMsgSetBeforeSendHooks(suite.TestAccs[0].String(), factoryDenom, nil))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper.GetBeforeSendHooks(suite.ChainA.GetContext(), factoryDenom))
+}
+
+func (suite *KeeperTestSuite) TestOutboundIBCTransfer() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	tokenFactoryKeeper := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-7")
+	transfer := types.IBCTransfer{
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: "channel-7",
+		Receiver:      "cosmos1receiver",
+	}
+
+	// Sends to regular addresses carry no transfer
+	suite.Require().Nil(tokenFactoryKeeper.GetOutboundIBCTransfer(types.WithIBCTransfer(ctx, transfer), escrowAddress))
+
+	// Escrow moves are flagged even if the transfer is unknown
+	tokenFactoryKeeper.StoreEscrowAddress(ctx, escrowAddress)
+	suite.Require().Equal(&types.IBCTransfer{}, tokenFactoryKeeper.GetOutboundIBCTransfer(ctx, escrowAddress))
+
+	// The transfer is only passed for the escrow address of its channel
+	suite.Require().Equal(&transfer, tokenFactoryKeeper.GetOutboundIBCTransfer(types.WithIBCTransfer(ctx, transfer), escrowAddress))
+	otherTransfer := transfer
+	otherTransfer.SourceChannel = "channel-8"
+	suite.Require().Equal(&types.IBCTransfer{}, tokenFactoryKeeper.GetOutboundIBCTransfer(types.WithIBCTransfer(ctx, otherTransfer), escrowAddress))
+}
@@ -43,9 +43,7 @@ func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr, denom s
 		k.bankKeeper.SetDenomMetaData(ctx, denomMetaData)
 	}
 
-	authorityMetadata := types.DenomAuthorityMetadata{
-		Admin: creatorAddr,
-	}
+	authorityMetadata := types.NewDenomAuthorityMetadata(creatorAddr)
 	err = k.setAuthorityMetadata(ctx, denom, authorityMetadata)
 	if err != nil {
 		return err
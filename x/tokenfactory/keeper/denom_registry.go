@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+// GetDenomRegistryPrefixStore returns the substore of the denom registry entries by denom
+func (k Keeper) GetDenomRegistryPrefixStore(ctx context.Context) storetypes.KVStore {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+	return prefix.NewStore(store, types.DenomRegistryKey)
+}
+
+// GetDenomRegistryEntry returns the registry entry of a factory, IBC or native denom
+func (k Keeper) GetDenomRegistryEntry(ctx context.Context, denom string) (entry types.DenomRegistryEntry, found bool) {
+	bz := k.GetDenomRegistryPrefixStore(ctx).Get([]byte(denom))
+	if bz == nil {
+		return entry, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &entry)
+
+	return entry, true
+}
+
+func (k Keeper) setDenomRegistryEntry(ctx sdk.Context, entry types.DenomRegistryEntry) {
+	k.GetDenomRegistryPrefixStore(ctx).Set([]byte(entry.Denom), k.cdc.MustMarshal(&entry))
+}
+
+func (k Keeper) removeDenomRegistryEntry(ctx sdk.Context, denom string) {
+	k.GetDenomRegistryPrefixStore(ctx).Delete([]byte(denom))
+}
+
+// GetAllDenomRegistryEntries returns every entry of the denom registry
+func (k Keeper) GetAllDenomRegistryEntries(ctx sdk.Context) (entries []types.DenomRegistryEntry) {
+	iterator := storetypes.KVStorePrefixIterator(k.GetDenomRegistryPrefixStore(ctx), []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.DenomRegistryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// GetDenomMetadata returns the bank metadata of any denom
+func (k Keeper) GetDenomMetadata(ctx context.Context, denom string) (banktypes.Metadata, bool) {
+	return k.bankKeeper.GetDenomMetaData(ctx, denom)
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestDenomRegistry() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	tokenFactoryKeeper := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper
+	entry := types.DenomRegistryEntry{
+		Denom:    "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		Display:  "atom",
+		Decimals: 6,
+		Symbol:   "ATOM",
+		Verified: true,
+	}
+
+	// Only governance can set registry entries
+	msg := &types.MsgSetDenomRegistryEntry{
+		Authority: suite.TestAccs[0].String(),
+		Entry:     entry,
+	}
+	_, err := tokenFactoryKeeper.SetDenomRegistryEntry(ctx, msg)
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	msg.Authority = tokenFactoryKeeper.GetAuthority()
+	_, err = tokenFactoryKeeper.SetDenomRegistryEntry(ctx, msg)
+	suite.Require().NoError(err)
+
+	res, err := tokenFactoryKeeper.DenomRegistryEntry(ctx, &types.QueryDenomRegistryEntryRequest{Denom: entry.Denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(entry, res.Entry)
+
+	allRes, err := tokenFactoryKeeper.DenomRegistry(ctx, &types.QueryDenomRegistryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomRegistryEntry{entry}, allRes.Entries)
+
+	// Removed entries can no longer be queried
+	_, err = tokenFactoryKeeper.RemoveDenomRegistryEntry(ctx, &types.MsgRemoveDenomRegistryEntry{
+		Authority: tokenFactoryKeeper.GetAuthority(),
+		Denom:     entry.Denom,
+	})
+	suite.Require().NoError(err)
+	_, err = tokenFactoryKeeper.DenomRegistryEntry(ctx, &types.QueryDenomRegistryEntryRequest{Denom: entry.Denom})
+	suite.Require().ErrorContains(err, types.ErrDenomRegistryEntryNotFound.Error())
+	_, err = tokenFactoryKeeper.RemoveDenomRegistryEntry(ctx, &types.MsgRemoveDenomRegistryEntry{
+		Authority: tokenFactoryKeeper.GetAuthority(),
+		Denom:     entry.Denom,
+	})
+	suite.Require().ErrorIs(err, types.ErrDenomRegistryEntryNotFound)
+}
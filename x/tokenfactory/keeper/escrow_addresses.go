@@ -3,6 +3,7 @@ package keeper
 import (
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
 )
@@ -20,3 +21,19 @@ func (k Keeper) IsEscrowAddress(ctx sdk.Context, address sdk.AccAddress) bool {
 
 	return len(bz) != 0
 }
+
+// GetOutboundIBCTransfer returns the ICS-20 transfer escrowing tokens to the address, or nil if the address is not an
+// IBC escrow address. The fields of the transfer are empty if the context does not carry a transfer from the channel of
+// the escrow address.
+func (k Keeper) GetOutboundIBCTransfer(ctx sdk.Context, to sdk.AccAddress) *types.IBCTransfer {
+	if !k.IsEscrowAddress(ctx, to) {
+		return nil
+	}
+
+	transfer, ok := types.GetIBCTransfer(ctx)
+	if !ok || !transfertypes.GetEscrowAddress(transfer.SourcePort, transfer.SourceChannel).Equals(to) {
+		return &types.IBCTransfer{}
+	}
+
+	return &transfer
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+// GetFrozenAddressesPrefixStore returns the substore of the addresses frozen for a specific denom
+func (k Keeper) GetFrozenAddressesPrefixStore(ctx context.Context, denom string) storetypes.KVStore {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+	return prefix.NewStore(store, types.GetFrozenAddressesPrefix(denom))
+}
+
+// IsDenomPaused returns true if all transfers of a denom are paused
+func (k Keeper) IsDenomPaused(ctx context.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has([]byte(types.DenomPausedKey))
+}
+
+func (k Keeper) setDenomPaused(ctx sdk.Context, denom string, paused bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if paused {
+		store.Set([]byte(types.DenomPausedKey), []byte{1})
+	} else {
+		store.Delete([]byte(types.DenomPausedKey))
+	}
+}
+
+// IsAddressFrozen returns true if transfers of a denom from and to an address are frozen
+func (k Keeper) IsAddressFrozen(ctx context.Context, denom string, address sdk.AccAddress) bool {
+	return k.GetFrozenAddressesPrefixStore(ctx, denom).Has(address)
+}
+
+func (k Keeper) setAddressFrozen(ctx sdk.Context, denom string, address sdk.AccAddress, frozen bool) {
+	store := k.GetFrozenAddressesPrefixStore(ctx, denom)
+	if frozen {
+		store.Set(address, []byte{1})
+	} else {
+		store.Delete(address)
+	}
+}
+
+// GetFrozenAddresses returns all addresses frozen for a denom
+func (k Keeper) GetFrozenAddresses(ctx sdk.Context, denom string) (addresses []string) {
+	iterator := storetypes.KVStorePrefixIterator(k.GetFrozenAddressesPrefixStore(ctx, denom), []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, sdk.AccAddress(iterator.Key()).String())
+	}
+
+	return addresses
+}
+
+type adminTransferContextKey struct{}
+
+// withAdminTransfer returns a context marking the transfers made from it as force transfers or burns by a denom's
+// roles, which are allowed from and to frozen addresses and while the denom is paused
+func withAdminTransfer(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(adminTransferContextKey{}, true)
+}
+
+// assertTransferAllowed returns an error if any of the tokenfactory denoms sent is paused, or frozen for either side
+// of the transfer, unless the transfer is a force transfer or a burn
+func (k Keeper) assertTransferAllowed(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if adminTransfer, _ := ctx.Value(adminTransferContextKey{}).(bool); adminTransfer {
+		return nil
+	}
+
+	for _, coin := range amount {
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+
+		if k.IsDenomPaused(ctx, coin.Denom) {
+			return types.ErrDenomPaused.Wrapf("denom: %s", coin.Denom)
+		}
+
+		if k.IsAddressFrozen(ctx, coin.Denom, from) {
+			return types.ErrAddressFrozen.Wrapf("denom: %s, address: %s", coin.Denom, from)
+		}
+
+		if k.IsAddressFrozen(ctx, coin.Denom, to) {
+			return types.ErrAddressFrozen.Wrapf("denom: %s, address: %s", coin.Denom, to)
+		}
+	}
+
+	return nil
+}
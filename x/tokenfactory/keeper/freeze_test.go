@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestPauseBlocksTransfers() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	admin, pauser, receiver := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	bankKeeper := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
+
+	_, err := suite.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	// Only a pauser can pause the denom
+	_, err = suite.msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(pauser.String(), suite.defaultDenom, true))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	suite.grantRole(ctx, pauser, types.ROLE_PAUSER)
+	_, err = suite.msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(pauser.String(), suite.defaultDenom, true))
+	suite.Require().NoError(err)
+
+	// Transfers are blocked while the denom is paused
+	err = bankKeeper.SendCoins(ctx, admin, receiver, coins)
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+
+	// Transfers resume once the denom is unpaused
+	_, err = suite.msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(pauser.String(), suite.defaultDenom, false))
+	suite.Require().NoError(err)
+	err = bankKeeper.SendCoins(ctx, admin, receiver, coins)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestPauseExemptsModuleToModuleTransfers() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	admin, pauser := suite.TestAccs[0], suite.TestAccs[1]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	bankKeeper := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
+
+	_, err := suite.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+	err = bankKeeper.SendCoinsFromAccountToModule(ctx, admin, types.ModuleName, coins)
+	suite.Require().NoError(err)
+
+	suite.grantRole(ctx, pauser, types.ROLE_PAUSER)
+	_, err = suite.msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(pauser.String(), suite.defaultDenom, true))
+	suite.Require().NoError(err)
+
+	// Module to module transfers only call the track before send hooks, which cannot fail, so they are not blocked
+	err = bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins)
+	suite.Require().NoError(err)
+
+	// Transfers involving accounts are still blocked
+	err = bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, admin, coins)
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+}
+
+func (suite *KeeperTestSuite) TestFreezeBlocksTransfers() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	admin, frozen, receiver := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	bankKeeper := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
+
+	_, err := suite.msgServer.Mint(ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100), frozen.String()))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.SetAddressFrozen(ctx, types.NewMsgSetAddressFrozen(admin.String(), suite.defaultDenom, frozen.String(), true))
+	suite.Require().NoError(err)
+
+	// The frozen address can neither send nor receive the denom
+	err = bankKeeper.SendCoins(ctx, frozen, receiver, coins)
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMintTo(admin.String(), coins[0], frozen.String()))
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+
+	// Other denoms are not affected
+	suite.FundAcc(frozen, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)))
+	err = bankKeeper.SendCoins(ctx, frozen, receiver, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)))
+	suite.Require().NoError(err)
+
+	res, err := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper.DenomFreezeStatus(ctx, &types.QueryDenomFreezeStatusRequest{
+		Creator:  admin.String(),
+		Subdenom: "bitcoin",
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Paused)
+	suite.Require().Equal([]string{frozen.String()}, res.FrozenAddresses)
+
+	// Unfrozen addresses can transfer again
+	_, err = suite.msgServer.SetAddressFrozen(ctx, types.NewMsgSetAddressFrozen(admin.String(), suite.defaultDenom, frozen.String(), false))
+	suite.Require().NoError(err)
+	err = bankKeeper.SendCoins(ctx, frozen, receiver, coins)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestFreezeAllowsForceTransfersAndBurns() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	admin, frozen, receiver := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	bankKeeper := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
+
+	_, err := suite.msgServer.Mint(ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100), frozen.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetAddressFrozen(ctx, types.NewMsgSetAddressFrozen(admin.String(), suite.defaultDenom, frozen.String(), true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(admin.String(), suite.defaultDenom, true))
+	suite.Require().NoError(err)
+
+	// The balance of a frozen address can be seized and burnt while the denom is paused
+	_, err = suite.msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 60), frozen.String(), receiver.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 40), frozen.String()))
+	suite.Require().NoError(err)
+	suite.Require().True(bankKeeper.GetBalance(ctx, frozen, suite.defaultDenom).IsZero())
+	suite.Require().Equal(int64(60), bankKeeper.GetBalance(ctx, receiver, suite.defaultDenom).Amount.Int64())
+
+	// Regular transfers are still blocked
+	err = bankKeeper.SendCoins(ctx, receiver, frozen, sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+}
+
+func (suite *KeeperTestSuite) TestForceUnfreeze() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	admin, frozen := suite.TestAccs[0], suite.TestAccs[1]
+	tokenFactoryKeeper := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper
+
+	_, err := suite.msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(admin.String(), suite.defaultDenom, true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetAddressFrozen(ctx, types.NewMsgSetAddressFrozen(admin.String(), suite.defaultDenom, frozen.String(), true))
+	suite.Require().NoError(err)
+
+	msg := &types.MsgForceUnfreeze{
+		Authority: admin.String(),
+		Denom:     suite.defaultDenom,
+		Addresses: []string{frozen.String()},
+		Unpause:   true,
+	}
+	_, err = tokenFactoryKeeper.ForceUnfreeze(ctx, msg)
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	msg.Authority = tokenFactoryKeeper.GetAuthority()
+	_, err = tokenFactoryKeeper.ForceUnfreeze(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().False(tokenFactoryKeeper.IsDenomPaused(ctx, suite.defaultDenom))
+	suite.Require().False(tokenFactoryKeeper.IsAddressFrozen(ctx, suite.defaultDenom, frozen))
+}
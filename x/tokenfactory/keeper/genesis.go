@@ -39,11 +39,34 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 			panic(err)
 		}
 
-		if genDenom.HookContractAddress != "" {
-			if err := k.setBeforeSendHook(ctx, genDenom.Denom, genDenom.HookContractAddress); err != nil {
+		hooks := genDenom.BeforeSendHooks
+		if len(hooks) == 0 && genDenom.HookContractAddress != "" {
+			hooks = []types.BeforeSendHook{types.NewBlockBeforeSendHook(genDenom.HookContractAddress)}
+		}
+
+		if err := k.setBeforeSendHooks(ctx, genDenom.Denom, hooks); err != nil {
+			panic(err)
+		}
+
+		if genDenom.MintWindow != nil {
+			if err := k.setMintWindow(ctx, genDenom.Denom, *genDenom.MintWindow); err != nil {
 				panic(err)
 			}
 		}
+
+		k.setDenomPaused(ctx, genDenom.Denom, genDenom.Paused)
+		for _, address := range genDenom.FrozenAddresses {
+			k.setAddressFrozen(ctx, genDenom.Denom, sdk.MustAccAddressFromBech32(address), true)
+		}
+	}
+
+	for _, schedule := range genState.GetVestingSchedules() {
+		k.setVestingSchedule(ctx, schedule)
+	}
+	k.setNextVestingScheduleID(ctx, genState.NextVestingScheduleId)
+
+	for _, entry := range genState.GetDenomRegistry() {
+		k.setDenomRegistryEntry(ctx, entry)
 	}
 }
 
@@ -55,22 +78,30 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	for ; iterator.Valid(); iterator.Next() {
 		denom := string(iterator.Value())
 
-		contractHook := k.GetBeforeSendHook(ctx, denom)
-
 		authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
 		if err != nil {
 			panic(err)
 		}
 
-		genDenoms = append(genDenoms, types.GenesisDenom{
-			Denom:               denom,
-			AuthorityMetadata:   authorityMetadata,
-			HookContractAddress: contractHook,
-		})
+		genDenom := types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: authorityMetadata,
+			Paused:            k.IsDenomPaused(ctx, denom),
+			FrozenAddresses:   k.GetFrozenAddresses(ctx, denom),
+			BeforeSendHooks:   k.GetBeforeSendHooks(ctx, denom),
+		}
+		if mintWindow, found := k.GetMintWindow(ctx, denom); found {
+			genDenom.MintWindow = &mintWindow
+		}
+
+		genDenoms = append(genDenoms, genDenom)
 	}
 
 	return &types.GenesisState{
-		FactoryDenoms: genDenoms,
-		Params:        k.GetParams(ctx),
+		FactoryDenoms:         genDenoms,
+		Params:                k.GetParams(ctx),
+		VestingSchedules:      k.GetAllVestingSchedules(ctx),
+		NextVestingScheduleId: k.GetNextVestingScheduleID(ctx),
+		DenomRegistry:         k.GetAllDenomRegistryEntries(ctx),
 	}
 }
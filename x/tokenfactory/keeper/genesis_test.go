@@ -14,7 +14,6 @@ func (suite *KeeperTestSuite) TestGenesis() {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2",
 				},
-				HookContractAddress: "",
 			},
 			{
 				Denom: "factory/neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2/diff-admin",
@@ -27,7 +26,10 @@ func (suite *KeeperTestSuite) TestGenesis() {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2",
 				},
-				HookContractAddress: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2",
+				BeforeSendHooks: []types.BeforeSendHook{
+					{ContractAddr: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2", Mode: types.HOOK_MODE_BLOCK},
+					{ContractAddr: "neutron17dtl0mjt3t77kpuhg2edqzjpszulwhgzcdvagh", Mode: types.HOOK_MODE_TRACK, GasLimit: 100_000},
+				},
 			},
 		},
 	}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
 )
@@ -40,9 +43,14 @@ func (k Keeper) BeforeSendHookAddress(ctx context.Context, req *types.QueryBefor
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	denom := fmt.Sprintf("factory/%s/%s", req.GetCreator(), req.GetSubdenom())
-	contractAddr := k.GetBeforeSendHook(sdkCtx, denom)
+	hooks := k.GetBeforeSendHooks(sdkCtx, denom)
 
-	return &types.QueryBeforeSendHookAddressResponse{ContractAddr: contractAddr}, nil
+	res := &types.QueryBeforeSendHookAddressResponse{Hooks: hooks}
+	if len(hooks) > 0 {
+		res.ContractAddr = hooks[0].ContractAddr
+	}
+
+	return res, nil
 }
 
 func (k Keeper) FullDenom(_ context.Context, req *types.QueryFullDenomRequest) (*types.QueryFullDenomResponse, error) {
@@ -57,3 +65,86 @@ func (k Keeper) FullDenom(_ context.Context, req *types.QueryFullDenomRequest) (
 
 	return &types.QueryFullDenomResponse{FullDenom: fullDenom}, nil
 }
+
+func (k Keeper) DenomFreezeStatus(ctx context.Context, req *types.QueryDenomFreezeStatusRequest) (*types.QueryDenomFreezeStatusResponse, error) {
+	denom := fmt.Sprintf("factory/%s/%s", req.GetCreator(), req.GetSubdenom())
+
+	var frozenAddresses []string
+	pageRes, err := query.Paginate(k.GetFrozenAddressesPrefixStore(ctx, denom), req.Pagination, func(key, _ []byte) error {
+		frozenAddresses = append(frozenAddresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomFreezeStatusResponse{
+		Paused:          k.IsDenomPaused(ctx, denom),
+		FrozenAddresses: frozenAddresses,
+		Pagination:      pageRes,
+	}, nil
+}
+
+func (k Keeper) VestingSchedule(ctx context.Context, req *types.QueryVestingScheduleRequest) (*types.QueryVestingScheduleResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	schedule, found := k.GetVestingSchedule(sdkCtx, req.GetId())
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrVestingScheduleNotFound.Wrapf("id: %d", req.GetId()).Error())
+	}
+
+	return &types.QueryVestingScheduleResponse{VestingSchedule: k.GetVestingScheduleStatus(sdkCtx, schedule)}, nil
+}
+
+func (k Keeper) VestingSchedules(ctx context.Context, req *types.QueryVestingSchedulesRequest) (*types.QueryVestingSchedulesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var schedules []types.VestingScheduleStatus
+	pageRes, err := query.Paginate(k.GetVestingRecipientPrefixStore(sdkCtx, req.GetRecipient()), req.Pagination, func(key, _ []byte) error {
+		schedule, found := k.GetVestingSchedule(sdkCtx, sdk.BigEndianToUint64(key))
+		if !found {
+			return types.ErrVestingScheduleNotFound.Wrapf("id: %d", sdk.BigEndianToUint64(key))
+		}
+
+		schedules = append(schedules, k.GetVestingScheduleStatus(sdkCtx, schedule))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVestingSchedulesResponse{
+		VestingSchedules: schedules,
+		Pagination:       pageRes,
+	}, nil
+}
+
+func (k Keeper) DenomRegistryEntry(ctx context.Context, req *types.QueryDenomRegistryEntryRequest) (*types.QueryDenomRegistryEntryResponse, error) {
+	entry, found := k.GetDenomRegistryEntry(ctx, req.GetDenom())
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrDenomRegistryEntryNotFound.Wrapf("denom: %s", req.GetDenom()).Error())
+	}
+
+	return &types.QueryDenomRegistryEntryResponse{Entry: entry}, nil
+}
+
+func (k Keeper) DenomRegistry(ctx context.Context, req *types.QueryDenomRegistryRequest) (*types.QueryDenomRegistryResponse, error) {
+	var entries []types.DenomRegistryEntry
+	pageRes, err := query.Paginate(k.GetDenomRegistryPrefixStore(ctx), req.Pagination, func(_, value []byte) error {
+		var entry types.DenomRegistryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomRegistryResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
@@ -65,6 +65,7 @@ func (suite *KeeperTestSuite) Setup() {
 		0,
 		FeeCollectorAddress,
 		types.DefaultWhitelistedHooks,
+		types.DefaultMintLimitsTimelock,
 	))
 	suite.Require().NoError(err)
 
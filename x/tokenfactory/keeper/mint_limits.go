@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+// GetMintWindow returns the amount of a denom minted in its current rate limit window
+func (k Keeper) GetMintWindow(ctx sdk.Context, denom string) (window types.MintWindow, found bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomMintWindowKey))
+	if bz == nil {
+		return window, false
+	}
+
+	if err := proto.Unmarshal(bz, &window); err != nil {
+		return window, false
+	}
+
+	return window, true
+}
+
+func (k Keeper) setMintWindow(ctx sdk.Context, denom string, window types.MintWindow) error {
+	bz, err := proto.Marshal(&window)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set([]byte(types.DenomMintWindowKey), bz)
+	return nil
+}
+
+// setMintLimits applies new mint limits for a denom. The first limits and any limits that are at least as tight as the
+// current ones apply immediately, looser limits only once the mint limits timelock has passed.
+// Returns the time at which the limits take effect.
+func (k Keeper) setMintLimits(ctx sdk.Context, denom string, limits types.MintLimits) (time.Time, error) {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	effectiveTime := ctx.BlockTime()
+	if metadata.MintLimits != nil && limits.IsLooserThan(*metadata.MintLimits) {
+		timelock := time.Duration(k.GetParams(ctx).MintLimitsTimelock) * time.Second
+		effectiveTime = effectiveTime.Add(timelock)
+	}
+
+	if effectiveTime.After(ctx.BlockTime()) {
+		metadata.PendingMintLimits = &limits
+		metadata.PendingMintLimitsEffectiveTime = effectiveTime.Unix()
+	} else {
+		metadata.MintLimits = &limits
+		metadata.PendingMintLimits = nil
+		metadata.PendingMintLimitsEffectiveTime = 0
+	}
+
+	return effectiveTime, k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// checkMintLimits returns an error if minting amount would exceed the max supply or the mint rate limit of its denom,
+// and otherwise counts amount against the current rate limit window
+func (k Keeper) checkMintLimits(ctx sdk.Context, amount sdk.Coin) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	limits := metadata.MintLimits
+	if limits == nil {
+		return nil
+	}
+
+	if limits.MaxSupply.IsPositive() {
+		supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount
+		if supply.Add(amount.Amount).GT(limits.MaxSupply) {
+			return types.ErrMaxSupplyExceeded.Wrapf("supply: %s, max supply: %s", supply, limits.MaxSupply)
+		}
+	}
+
+	if !limits.MintRateLimit.IsPositive() {
+		return nil
+	}
+
+	now := ctx.BlockTime().Unix()
+	window, found := k.GetMintWindow(ctx, amount.Denom)
+	if !found || now < window.WindowStart || uint64(now-window.WindowStart) >= limits.MintWindow { //nolint:gosec
+		window = types.MintWindow{WindowStart: now, Minted: math.ZeroInt()}
+	}
+
+	window.Minted = window.Minted.Add(amount.Amount)
+	if window.Minted.GT(limits.MintRateLimit) {
+		return types.ErrMintRateLimitExceeded.Wrapf(
+			"minted in window: %s, mint rate limit: %s", window.Minted, limits.MintRateLimit,
+		)
+	}
+
+	return k.setMintWindow(ctx, amount.Denom, window)
+}
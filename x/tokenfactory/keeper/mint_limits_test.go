@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) setMintLimits(ctx sdk.Context, maxSupply, mintRateLimit int64, mintWindow uint64) (*types.MsgSetMintLimitsResponse, error) {
+	return suite.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(suite.TestAccs[0].String(), suite.defaultDenom, types.MintLimits{
+		MaxSupply:     math.NewInt(maxSupply),
+		MintRateLimit: math.NewInt(mintRateLimit),
+		MintWindow:    mintWindow,
+	}))
+}
+
+func (suite *KeeperTestSuite) mintDefaultDenom(ctx sdk.Context, amount int64) error {
+	_, err := suite.msgServer.Mint(ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, amount)))
+	return err
+}
+
+func (suite *KeeperTestSuite) TestMintLimitsMaxSupply() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+
+	_, err := suite.setMintLimits(ctx, 100, 0, 0)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.mintDefaultDenom(ctx, 60))
+	suite.Require().ErrorIs(suite.mintDefaultDenom(ctx, 41), types.ErrMaxSupplyExceeded)
+	suite.Require().NoError(suite.mintDefaultDenom(ctx, 40))
+
+	// Burning frees up supply under the cap
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurn(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.mintDefaultDenom(ctx, 10))
+}
+
+func (suite *KeeperTestSuite) TestMintLimitsRateLimit() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+
+	_, err := suite.setMintLimits(ctx, 0, 50, 3600)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.mintDefaultDenom(ctx, 30))
+	suite.Require().ErrorIs(suite.mintDefaultDenom(ctx, 21), types.ErrMintRateLimitExceeded)
+	suite.Require().NoError(suite.mintDefaultDenom(ctx, 20))
+
+	// The limit resets once the window has passed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(suite.mintDefaultDenom(ctx, 50))
+
+	window, found := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper.GetMintWindow(ctx, suite.defaultDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(ctx.BlockTime().Unix(), window.WindowStart)
+	suite.Require().Equal(math.NewInt(50), window.Minted)
+}
+
+func (suite *KeeperTestSuite) TestMintLimitsLongestWindow() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+
+	_, err := suite.setMintLimits(ctx, 0, 50, types.MaxMintWindow)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.mintDefaultDenom(ctx, 50))
+
+	// The window doesn't reset until it has passed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(types.MaxMintWindow-1) * time.Second))
+	suite.Require().ErrorIs(suite.mintDefaultDenom(ctx, 1), types.ErrMintRateLimitExceeded)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	suite.Require().NoError(suite.mintDefaultDenom(ctx, 50))
+}
+
+func (suite *KeeperTestSuite) TestMintLimitsLooserLimitsAreTimelocked() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+
+	_, err := suite.setMintLimits(ctx, 100, 0, 0)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.mintDefaultDenom(ctx, 100))
+
+	// Raising the cap is only scheduled
+	resp, err := suite.setMintLimits(ctx, 200, 0, 0)
+	suite.Require().NoError(err)
+	timelock := time.Duration(types.DefaultMintLimitsTimelock) * time.Second
+	suite.Require().Equal(ctx.BlockTime().Add(timelock).Unix(), resp.EffectiveTime)
+	suite.Require().ErrorIs(suite.mintDefaultDenom(ctx, 1), types.ErrMaxSupplyExceeded)
+
+	denom := strings.Split(suite.defaultDenom, "/")
+	queryRes, err := suite.queryClient.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{
+		Creator:  denom[1],
+		Subdenom: denom[2],
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(100), queryRes.AuthorityMetadata.MintLimits.MaxSupply)
+	suite.Require().Equal(math.NewInt(200), queryRes.AuthorityMetadata.PendingMintLimits.MaxSupply)
+
+	// It applies once the timelock has passed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(timelock))
+	suite.Require().NoError(suite.mintDefaultDenom(ctx, 100))
+
+	metadata, err := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper.GetAuthorityMetadata(ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(200), metadata.MintLimits.MaxSupply)
+	suite.Require().Nil(metadata.PendingMintLimits)
+}
+
+func (suite *KeeperTestSuite) TestMintLimitsTighterLimitsApplyImmediately() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+
+	_, err := suite.setMintLimits(ctx, 100, 0, 0)
+	suite.Require().NoError(err)
+	_, err = suite.setMintLimits(ctx, 0, 0, 0)
+	suite.Require().NoError(err)
+
+	// Tightening replaces the current limits and drops the pending ones
+	resp, err := suite.setMintLimits(ctx, 50, 10, 60)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ctx.BlockTime().Unix(), resp.EffectiveTime)
+
+	metadata, err := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper.GetAuthorityMetadata(ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(50), metadata.MintLimits.MaxSupply)
+	suite.Require().Nil(metadata.PendingMintLimits)
+	suite.Require().ErrorIs(suite.mintDefaultDenom(ctx, 11), types.ErrMintRateLimitExceeded)
+}
+
+func (suite *KeeperTestSuite) TestMintLimitsOnlyAdmin() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+
+	_, err := suite.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(suite.TestAccs[1].String(), suite.defaultDenom, types.MintLimits{
+		MaxSupply:     math.NewInt(100),
+		MintRateLimit: math.ZeroInt(),
+	}))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// A rate limit needs a window
+	_, err = suite.setMintLimits(ctx, 0, 10, 0)
+	suite.Require().ErrorIs(err, types.ErrInvalidMintLimits)
+
+	// Of at most a year, longer windows would overflow the block time
+	_, err = suite.setMintLimits(ctx, 0, 10, types.MaxMintWindow+1)
+	suite.Require().ErrorIs(err, types.ErrInvalidMintLimits)
+	_, err = suite.setMintLimits(ctx, 0, 10, 1<<63)
+	suite.Require().ErrorIs(err, types.ErrInvalidMintLimits)
+}
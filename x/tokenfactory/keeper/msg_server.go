@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -65,14 +66,19 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
+	if err := assertHasRole(authorityMetadata, msg.Sender, types.ROLE_MINTER); err != nil {
+		return nil, err
 	}
 
 	if msg.MintToAddress == "" {
 		msg.MintToAddress = msg.Sender
 	}
 
+	err = server.Keeper.consumeMinterAllowance(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
 	err = server.Keeper.mintTo(ctx, msg.Amount, msg.MintToAddress)
 	if err != nil {
 		return nil, err
@@ -101,8 +107,8 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
+	if err := assertHasRole(authorityMetadata, msg.Sender, types.ROLE_BURNER); err != nil {
+		return nil, err
 	}
 
 	if msg.BurnFromAddress == "" {
@@ -143,8 +149,12 @@ func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForce
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
+	if authorityMetadata.ForceTransferRenounced {
+		return nil, types.ErrForceTransferRenounced
+	}
+
+	if err := assertHasRole(authorityMetadata, msg.Sender, types.ROLE_FORCE_TRANSFERRER); err != nil {
+		return nil, err
 	}
 
 	err = server.Keeper.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
@@ -180,7 +190,8 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeA
 		return nil, types.ErrUnauthorized.Wrapf("need: %s, received: %s, denom: %s", authorityMetadata.GetAdmin(), msg.Sender, msg.Denom)
 	}
 
-	err = server.Keeper.setAdmin(ctx, msg.Denom, msg.NewAdmin)
+	// The new admin still has to accept the denom, but can do so right away
+	pending, err := server.Keeper.proposeAdmin(ctx, msg.Denom, msg.NewAdmin, 0, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -189,12 +200,128 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeA
 			types.TypeMsgChangeAdmin,
 			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
 			sdk.NewAttribute(types.AttributeNewAdmin, msg.NewAdmin),
+			sdk.NewAttribute(types.AttributeAcceptTime, strconv.FormatInt(pending.AcceptTime, 10)),
 		),
 	})
 
 	return &types.MsgChangeAdminResponse{}, nil
 }
 
+func (server msgServer) ProposeAdmin(goCtx context.Context, msg *types.MsgProposeAdmin) (*types.MsgProposeAdminResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgProposeAdmin")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized.Wrapf("need: %s, received: %s, denom: %s", authorityMetadata.GetAdmin(), msg.Sender, msg.Denom)
+	}
+
+	pending, err := server.Keeper.proposeAdmin(ctx, msg.Denom, msg.NewAdmin, msg.Timelock, msg.Expiry)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgProposeAdmin,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeNewAdmin, msg.NewAdmin),
+			sdk.NewAttribute(types.AttributeAcceptTime, strconv.FormatInt(pending.AcceptTime, 10)),
+			sdk.NewAttribute(types.AttributeExpireTime, strconv.FormatInt(pending.ExpireTime, 10)),
+		),
+	})
+
+	return &types.MsgProposeAdminResponse{}, nil
+}
+
+func (server msgServer) AcceptAdmin(goCtx context.Context, msg *types.MsgAcceptAdmin) (*types.MsgAcceptAdminResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgAcceptAdmin")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.Keeper.acceptAdmin(ctx, msg.Denom, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgAcceptAdmin,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeNewAdmin, msg.Sender),
+		),
+	})
+
+	return &types.MsgAcceptAdminResponse{}, nil
+}
+
+func (server msgServer) CancelAdminTransfer(goCtx context.Context, msg *types.MsgCancelAdminTransfer) (*types.MsgCancelAdminTransferResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgCancelAdminTransfer")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized.Wrapf("need: %s, received: %s, denom: %s", authorityMetadata.GetAdmin(), msg.Sender, msg.Denom)
+	}
+
+	err = server.Keeper.cancelAdminTransfer(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgCancelAdminTransfer,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		),
+	})
+
+	return &types.MsgCancelAdminTransferResponse{}, nil
+}
+
+func (server msgServer) RenounceAdmin(goCtx context.Context, msg *types.MsgRenounceAdmin) (*types.MsgRenounceAdminResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRenounceAdmin")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized.Wrapf("need: %s, received: %s, denom: %s", authorityMetadata.GetAdmin(), msg.Sender, msg.Denom)
+	}
+
+	err = server.Keeper.renounceAdmin(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRenounceAdmin,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		),
+	})
+
+	return &types.MsgRenounceAdminResponse{}, nil
+}
+
 func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
 	if err := msg.Validate(); err != nil {
 		return nil, errors.Wrap(err, "failed to validate MsgSetDenomMetadata")
@@ -213,8 +340,8 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
+	if err := assertHasRole(authorityMetadata, msg.Sender, types.ROLE_METADATA_ADMIN); err != nil {
+		return nil, err
 	}
 
 	server.Keeper.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
@@ -246,16 +373,19 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 		return nil, types.ErrUnauthorized
 	}
 
-	// If we are not removing a hook make sure it has been already whitelisted
+	// If we are not removing the hooks make sure the new one has been already whitelisted
+	var hooks []types.BeforeSendHook
 	if msg.ContractAddr != "" {
 		// msg.ContractAddr has already been validated
 		cwAddr := sdk.MustAccAddressFromBech32(msg.ContractAddr)
 		if err := server.Keeper.AssertIsHookWhitelisted(ctx, msg.Denom, cwAddr); err != nil {
 			return nil, err
 		}
+
+		hooks = []types.BeforeSendHook{types.NewBlockBeforeSendHook(msg.ContractAddr)}
 	}
 
-	err = server.Keeper.setBeforeSendHook(ctx, msg.Denom, msg.ContractAddr)
+	err = server.Keeper.setBeforeSendHooks(ctx, msg.Denom, hooks)
 	if err != nil {
 		return nil, err
 	}
@@ -271,6 +401,437 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 	return &types.MsgSetBeforeSendHookResponse{}, nil
 }
 
+func (server msgServer) SetBeforeSendHooks(goCtx context.Context, msg *types.MsgSetBeforeSendHooks) (*types.MsgSetBeforeSendHooksResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetBeforeSendHooks")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	// Make sure every hook has been already whitelisted
+	attributes := []sdk.Attribute{sdk.NewAttribute(types.AttributeDenom, msg.GetDenom())}
+	for _, hook := range msg.Hooks {
+		// hook.ContractAddr has already been validated
+		cwAddr := sdk.MustAccAddressFromBech32(hook.ContractAddr)
+		if err := server.Keeper.AssertIsHookWhitelisted(ctx, msg.Denom, cwAddr); err != nil {
+			return nil, err
+		}
+
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeBeforeSendHookAddress, hook.ContractAddr),
+			sdk.NewAttribute(types.AttributeBeforeSendHookMode, hook.Mode.String()),
+		)
+	}
+
+	err = server.Keeper.setBeforeSendHooks(ctx, msg.Denom, msg.Hooks)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.TypeMsgSetBeforeSendHooks, attributes...),
+	})
+
+	return &types.MsgSetBeforeSendHooksResponse{}, nil
+}
+
+func (server msgServer) SetMintLimits(goCtx context.Context, msg *types.MsgSetMintLimits) (*types.MsgSetMintLimitsResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetMintLimits")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	effectiveTime, err := server.Keeper.setMintLimits(ctx, msg.Denom, msg.MintLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMintLimits,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMaxSupply, msg.MintLimits.MaxSupply.String()),
+			sdk.NewAttribute(types.AttributeMintRateLimit, msg.MintLimits.MintRateLimit.String()),
+			sdk.NewAttribute(types.AttributeMintWindow, strconv.FormatUint(msg.MintLimits.MintWindow, 10)),
+			sdk.NewAttribute(types.AttributeEffectiveTime, strconv.FormatInt(effectiveTime.Unix(), 10)),
+		),
+	})
+
+	return &types.MsgSetMintLimitsResponse{EffectiveTime: effectiveTime.Unix()}, nil
+}
+
+func (server msgServer) GrantRole(goCtx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgGrantRole")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.grantRole(ctx, msg.Denom, msg.Address, msg.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgGrantRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddress, msg.GetAddress()),
+			sdk.NewAttribute(types.AttributeRole, msg.Role.String()),
+		),
+	})
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (server msgServer) RevokeRole(goCtx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRevokeRole")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.revokeRole(ctx, msg.Denom, msg.Address, msg.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRevokeRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddress, msg.GetAddress()),
+			sdk.NewAttribute(types.AttributeRole, msg.Role.String()),
+		),
+	})
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+func (server msgServer) SetMinterAllowance(goCtx context.Context, msg *types.MsgSetMinterAllowance) (*types.MsgSetMinterAllowanceResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetMinterAllowance")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setMinterAllowance(ctx, msg.Denom, msg.Minter, msg.Allowance, msg.Unlimited)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance := msg.Allowance.String()
+	if msg.Unlimited {
+		allowance = "unlimited"
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMinterAllowance,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMinter, msg.GetMinter()),
+			sdk.NewAttribute(types.AttributeAllowance, allowance),
+		),
+	})
+
+	return &types.MsgSetMinterAllowanceResponse{}, nil
+}
+
+func (server msgServer) RenounceForceTransfer(goCtx context.Context, msg *types.MsgRenounceForceTransfer) (*types.MsgRenounceForceTransferResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRenounceForceTransfer")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.renounceForceTransfer(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRenounceForceTransfer,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		),
+	})
+
+	return &types.MsgRenounceForceTransferResponse{}, nil
+}
+
+func (server msgServer) SetDenomPaused(goCtx context.Context, msg *types.MsgSetDenomPaused) (*types.MsgSetDenomPausedResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetDenomPaused")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := assertHasRole(authorityMetadata, msg.Sender, types.ROLE_PAUSER); err != nil {
+		return nil, err
+	}
+
+	server.Keeper.setDenomPaused(ctx, msg.Denom, msg.Paused)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomPaused,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributePaused, strconv.FormatBool(msg.Paused)),
+		),
+	})
+
+	return &types.MsgSetDenomPausedResponse{}, nil
+}
+
+func (server msgServer) SetAddressFrozen(goCtx context.Context, msg *types.MsgSetAddressFrozen) (*types.MsgSetAddressFrozenResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetAddressFrozen")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := assertHasRole(authorityMetadata, msg.Sender, types.ROLE_PAUSER); err != nil {
+		return nil, err
+	}
+
+	// msg.Address has already been validated
+	server.Keeper.setAddressFrozen(ctx, msg.Denom, sdk.MustAccAddressFromBech32(msg.Address), msg.Frozen)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetAddressFrozen,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddress, msg.GetAddress()),
+			sdk.NewAttribute(types.AttributeFrozen, strconv.FormatBool(msg.Frozen)),
+		),
+	})
+
+	return &types.MsgSetAddressFrozenResponse{}, nil
+}
+
+func (server msgServer) MintVested(goCtx context.Context, msg *types.MsgMintVested) (*types.MsgMintVestedResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgMintVested")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// pay some extra gas cost to give a better error here.
+	_, denomExists := server.bankKeeper.GetDenomMetaData(ctx, msg.Amount.Denom)
+	if !denomExists {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Amount.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := assertHasRole(authorityMetadata, msg.Sender, types.ROLE_MINTER); err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.consumeMinterAllowance(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleID, err := server.Keeper.mintVested(ctx, msg.Amount, msg.Recipient, msg.StartTime, msg.EndTime, msg.Periods)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgMintVested,
+			sdk.NewAttribute(types.AttributeVestingScheduleID, strconv.FormatUint(scheduleID, 10)),
+			sdk.NewAttribute(types.AttributeRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgMintVestedResponse{ScheduleId: scheduleID}, nil
+}
+
+func (server msgServer) ClaimVested(goCtx context.Context, msg *types.MsgClaimVested) (*types.MsgClaimVestedResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgClaimVested")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, err := server.Keeper.claimVested(ctx, msg.Sender, msg.ScheduleId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgClaimVested,
+			sdk.NewAttribute(types.AttributeVestingScheduleID, strconv.FormatUint(msg.ScheduleId, 10)),
+			sdk.NewAttribute(types.AttributeRecipient, msg.Sender),
+			sdk.NewAttribute(types.AttributeAmount, amount.String()),
+		),
+	})
+
+	return &types.MsgClaimVestedResponse{Amount: amount}, nil
+}
+
+// ForceUnfreeze lets governance unfreeze addresses and resume transfers of a denom
+func (k Keeper) ForceUnfreeze(goCtx context.Context, req *types.MsgForceUnfreeze) (*types.MsgForceUnfreezeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgForceUnfreeze")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	events := sdk.Events{}
+	if req.Unpause {
+		k.setDenomPaused(ctx, req.Denom, false)
+		events = append(events, sdk.NewEvent(
+			types.TypeMsgSetDenomPaused,
+			sdk.NewAttribute(types.AttributeDenom, req.GetDenom()),
+			sdk.NewAttribute(types.AttributePaused, strconv.FormatBool(false)),
+		))
+	}
+
+	for _, address := range req.Addresses {
+		// addresses have already been validated
+		k.setAddressFrozen(ctx, req.Denom, sdk.MustAccAddressFromBech32(address), false)
+		events = append(events, sdk.NewEvent(
+			types.TypeMsgSetAddressFrozen,
+			sdk.NewAttribute(types.AttributeDenom, req.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddress, address),
+			sdk.NewAttribute(types.AttributeFrozen, strconv.FormatBool(false)),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgForceUnfreezeResponse{}, nil
+}
+
+// SetDenomRegistryEntry lets governance add or replace the registry entry of a denom
+func (k Keeper) SetDenomRegistryEntry(goCtx context.Context, req *types.MsgSetDenomRegistryEntry) (*types.MsgSetDenomRegistryEntryResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetDenomRegistryEntry")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.setDenomRegistryEntry(ctx, req.Entry)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomRegistryEntry,
+			sdk.NewAttribute(types.AttributeDenom, req.Entry.Denom),
+			sdk.NewAttribute(types.AttributeVerified, strconv.FormatBool(req.Entry.Verified)),
+		),
+	})
+
+	return &types.MsgSetDenomRegistryEntryResponse{}, nil
+}
+
+// RemoveDenomRegistryEntry lets governance remove the registry entry of a denom
+func (k Keeper) RemoveDenomRegistryEntry(goCtx context.Context, req *types.MsgRemoveDenomRegistryEntry) (*types.MsgRemoveDenomRegistryEntryResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRemoveDenomRegistryEntry")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetDenomRegistryEntry(ctx, req.Denom); !found {
+		return nil, types.ErrDenomRegistryEntryNotFound.Wrapf("denom: %s", req.Denom)
+	}
+	k.removeDenomRegistryEntry(ctx, req.Denom)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRemoveDenomRegistryEntry,
+			sdk.NewAttribute(types.AttributeDenom, req.Denom),
+		),
+	})
+
+	return &types.MsgRemoveDenomRegistryEntryResponse{}, nil
+}
+
 // UpdateParams updates the module parameters
 func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if err := req.Validate(); err != nil {
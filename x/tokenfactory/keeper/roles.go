@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+// assertHasRole returns an error unless sender holds role over the denom
+func assertHasRole(metadata types.DenomAuthorityMetadata, sender string, role types.Role) error {
+	if !metadata.HasRole(sender, role) {
+		return types.ErrUnauthorized.Wrapf("%s does not have role %s", sender, role)
+	}
+
+	return nil
+}
+
+func (k Keeper) grantRole(ctx sdk.Context, denom, address string, role types.Role) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if role == types.ROLE_FORCE_TRANSFERRER && metadata.ForceTransferRenounced {
+		return types.ErrForceTransferRenounced
+	}
+
+	metadata.GrantRole(address, role)
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+func (k Keeper) revokeRole(ctx sdk.Context, denom, address string, role types.Role) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.RevokeRole(address, role)
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+func (k Keeper) setMinterAllowance(ctx sdk.Context, denom, minter string, allowance math.Int, unlimited bool) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if err := assertHasRole(metadata, minter, types.ROLE_MINTER); err != nil {
+		return err
+	}
+
+	if unlimited {
+		metadata.RemoveMinterAllowance(minter)
+	} else {
+		metadata.SetMinterAllowance(minter, allowance)
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// renounceForceTransfer revokes the force transferrer role from every account and prevents granting it again
+func (k Keeper) renounceForceTransfer(ctx sdk.Context, denom string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	for _, assignment := range metadata.Roles {
+		if assignment.Role == types.ROLE_FORCE_TRANSFERRER {
+			metadata.RevokeRole(assignment.Address, types.ROLE_FORCE_TRANSFERRER)
+		}
+	}
+	metadata.ForceTransferRenounced = true
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// consumeMinterAllowance deducts a mint from the allowance of the minter, if it has one
+func (k Keeper) consumeMinterAllowance(ctx sdk.Context, minter string, amount sdk.Coin) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	allowance, found := metadata.GetMinterAllowance(minter)
+	if !found {
+		return nil
+	}
+
+	if amount.Amount.GT(allowance) {
+		return types.ErrMinterAllowanceExceeded.Wrapf("allowance: %s, amount: %s", allowance, amount.Amount)
+	}
+	metadata.SetMinterAllowance(minter, allowance.Sub(amount.Amount))
+
+	return k.setAuthorityMetadata(ctx, amount.Denom, metadata)
+}
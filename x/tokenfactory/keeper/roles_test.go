@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) grantRole(ctx sdk.Context, address sdk.AccAddress, role types.Role) {
+	_, err := suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(suite.TestAccs[0].String(), suite.defaultDenom, address.String(), role))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestCreatorHoldsAllRoles() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+
+	metadata, err := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper.GetAuthorityMetadata(ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	for _, role := range types.AllRoles {
+		suite.Require().True(metadata.HasRole(suite.TestAccs[0].String(), role))
+	}
+}
+
+func (suite *KeeperTestSuite) TestRolesGateMsgs() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	minter, burner := suite.TestAccs[1], suite.TestAccs[2]
+	coin := sdk.NewInt64Coin(suite.defaultDenom, 10)
+
+	// Without a role nothing is allowed
+	_, err := suite.msgServer.Mint(ctx, types.NewMsgMint(minter.String(), coin))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	suite.grantRole(ctx, minter, types.ROLE_MINTER)
+	suite.grantRole(ctx, burner, types.ROLE_BURNER)
+
+	// A minter can mint but not burn
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMintTo(minter.String(), coin, burner.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurnFrom(minter.String(), coin, burner.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// A burner can burn but not set metadata
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurnFrom(burner.String(), coin, burner.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(burner.String(), banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: suite.defaultDenom}},
+		Base:       suite.defaultDenom,
+		Display:    suite.defaultDenom,
+		Name:       "bitcoin",
+		Symbol:     "BTC",
+	}))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// Only the admin manages roles
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(minter.String(), suite.defaultDenom, minter.String(), types.ROLE_BURNER))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// A revoked minter can no longer mint
+	_, err = suite.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(suite.TestAccs[0].String(), suite.defaultDenom, minter.String(), types.ROLE_MINTER))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(minter.String(), coin))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestMinterAllowance() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	minter := suite.TestAccs[1]
+	suite.grantRole(ctx, minter, types.ROLE_MINTER)
+
+	_, err := suite.msgServer.SetMinterAllowance(ctx, types.NewMsgSetMinterAllowance(suite.TestAccs[0].String(), suite.defaultDenom, minter.String(), math.NewInt(15)))
+	suite.Require().NoError(err)
+
+	// The allowance is consumed by mints
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(minter.String(), sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(minter.String(), sdk.NewInt64Coin(suite.defaultDenom, 6)))
+	suite.Require().ErrorIs(err, types.ErrMinterAllowanceExceeded)
+
+	metadata, err := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper.GetAuthorityMetadata(ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	allowance, found := metadata.GetMinterAllowance(minter.String())
+	suite.Require().True(found)
+	suite.Require().Equal(math.NewInt(5), allowance)
+
+	// Removing the allowance lifts the cap
+	_, err = suite.msgServer.SetMinterAllowance(ctx, types.NewMsgRemoveMinterAllowance(suite.TestAccs[0].String(), suite.defaultDenom, minter.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(minter.String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	// Only minters can have an allowance
+	_, err = suite.msgServer.SetMinterAllowance(ctx, types.NewMsgSetMinterAllowance(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[2].String(), math.NewInt(15)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestRenounceForceTransfer() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	admin := suite.TestAccs[0]
+	coin := sdk.NewInt64Coin(suite.defaultDenom, 10)
+
+	_, err := suite.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), coin))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.RenounceForceTransfer(ctx, types.NewMsgRenounceForceTransfer(admin.String(), suite.defaultDenom))
+	suite.Require().NoError(err)
+
+	// Nobody can force transfer anymore
+	_, err = suite.msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(admin.String(), coin, admin.String(), suite.TestAccs[1].String()))
+	suite.Require().ErrorIs(err, types.ErrForceTransferRenounced)
+
+	// And the role cannot be granted again
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin.String(), suite.defaultDenom, admin.String(), types.ROLE_FORCE_TRANSFERRER))
+	suite.Require().ErrorIs(err, types.ErrForceTransferRenounced)
+}
+
+func (suite *KeeperTestSuite) TestChangeAdminTransfersRoles() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	minter := suite.TestAccs[2]
+	suite.grantRole(ctx, minter, types.ROLE_MINTER)
+
+	_, err := suite.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[1].String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(suite.TestAccs[1].String(), suite.defaultDenom))
+	suite.Require().NoError(err)
+
+	// The new admin takes over the roles of the previous one, other roles are kept
+	metadata, err := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper.GetAuthorityMetadata(ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	for _, role := range types.AllRoles {
+		suite.Require().False(metadata.HasRole(suite.TestAccs[0].String(), role))
+		suite.Require().True(metadata.HasRole(suite.TestAccs[1].String(), role))
+	}
+	suite.Require().True(metadata.HasRole(minter.String(), types.ROLE_MINTER))
+}
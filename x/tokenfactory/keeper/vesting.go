@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+// GetNextVestingScheduleID returns the ID assigned to the next vesting schedule
+func (k Keeper) GetNextVestingScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextVestingScheduleIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextVestingScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextVestingScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetVestingSchedulesPrefixStore returns the substore of all vesting schedules by ID
+func (k Keeper) GetVestingSchedulesPrefixStore(ctx context.Context) storetypes.KVStore {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+	return prefix.NewStore(store, types.GetVestingSchedulesPrefix())
+}
+
+// GetVestingRecipientPrefixStore returns the substore of the IDs of the vesting schedules of a recipient
+func (k Keeper) GetVestingRecipientPrefixStore(ctx context.Context, recipient string) storetypes.KVStore {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+	return prefix.NewStore(store, types.GetVestingRecipientPrefix(recipient))
+}
+
+// GetVestingSchedule returns a vesting schedule by ID
+func (k Keeper) GetVestingSchedule(ctx context.Context, id uint64) (schedule types.VestingSchedule, found bool) {
+	bz := k.GetVestingSchedulesPrefixStore(ctx).Get(sdk.Uint64ToBigEndian(id))
+	if bz == nil {
+		return schedule, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &schedule)
+
+	return schedule, true
+}
+
+func (k Keeper) setVestingSchedule(ctx sdk.Context, schedule types.VestingSchedule) {
+	key := sdk.Uint64ToBigEndian(schedule.Id)
+	k.GetVestingSchedulesPrefixStore(ctx).Set(key, k.cdc.MustMarshal(&schedule))
+	k.GetVestingRecipientPrefixStore(ctx, schedule.Recipient).Set(key, []byte{})
+}
+
+func (k Keeper) removeVestingSchedule(ctx sdk.Context, schedule types.VestingSchedule) {
+	key := sdk.Uint64ToBigEndian(schedule.Id)
+	k.GetVestingSchedulesPrefixStore(ctx).Delete(key)
+	k.GetVestingRecipientPrefixStore(ctx, schedule.Recipient).Delete(key)
+}
+
+// GetAllVestingSchedules returns all vesting schedules that have not been fully claimed
+func (k Keeper) GetAllVestingSchedules(ctx sdk.Context) (schedules []types.VestingSchedule) {
+	iterator := storetypes.KVStorePrefixIterator(k.GetVestingSchedulesPrefixStore(ctx), []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.VestingSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules
+}
+
+// GetVestingScheduleStatus returns a vesting schedule with the amounts vested and claimable at the current block time
+func (k Keeper) GetVestingScheduleStatus(ctx sdk.Context, schedule types.VestingSchedule) types.VestingScheduleStatus {
+	blockTime := ctx.BlockTime().Unix()
+
+	return types.VestingScheduleStatus{
+		Schedule:  schedule,
+		Vested:    schedule.VestedAmount(blockTime),
+		Claimable: schedule.ClaimableAmount(blockTime),
+	}
+}
+
+// mintVested mints tokens to the tokenfactory module account and holds them in a new vesting schedule of the recipient.
+// Returns the ID of the schedule.
+func (k Keeper) mintVested(
+	ctx sdk.Context,
+	amount sdk.Coin,
+	recipient string,
+	startTime, endTime int64,
+	periods []types.VestingPeriod,
+) (uint64, error) {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(amount.Denom)
+	if err != nil {
+		return 0, err
+	}
+
+	recipientAcc, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return 0, err
+	}
+
+	err = k.assertCanMintTo(ctx, recipientAcc)
+	if err != nil {
+		return 0, err
+	}
+
+	err = k.checkMintLimits(ctx, amount)
+	if err != nil {
+		return 0, err
+	}
+
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextVestingScheduleID(ctx)
+	k.setNextVestingScheduleID(ctx, id+1)
+	k.setVestingSchedule(ctx, types.VestingSchedule{
+		Id:        id,
+		Recipient: recipient,
+		Amount:    amount,
+		Claimed:   math.ZeroInt(),
+		StartTime: startTime,
+		EndTime:   endTime,
+		Periods:   periods,
+	})
+
+	return id, nil
+}
+
+// claimVested sends the tokens of a vesting schedule vested so far and not claimed yet to its recipient. The schedule
+// is removed once it has been fully claimed.
+func (k Keeper) claimVested(ctx sdk.Context, sender string, id uint64) (sdk.Coin, error) {
+	schedule, found := k.GetVestingSchedule(ctx, id)
+	if !found {
+		return sdk.Coin{}, types.ErrVestingScheduleNotFound.Wrapf("id: %d", id)
+	}
+
+	if schedule.Recipient != sender {
+		return sdk.Coin{}, types.ErrUnauthorized.Wrapf("%s is not the recipient of vesting schedule %d", sender, id)
+	}
+
+	claimable := schedule.ClaimableAmount(ctx.BlockTime().Unix())
+	if !claimable.IsPositive() {
+		return sdk.Coin{}, types.ErrNothingToClaim.Wrapf("id: %d", id)
+	}
+
+	amount := sdk.NewCoin(schedule.Amount.Denom, claimable)
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(schedule.Recipient), sdk.NewCoins(amount))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	schedule.Claimed = schedule.Claimed.Add(claimable)
+	if schedule.IsFullyClaimed() {
+		k.removeVestingSchedule(ctx, schedule)
+	} else {
+		k.setVestingSchedule(ctx, schedule)
+	}
+
+	return amount, nil
+}
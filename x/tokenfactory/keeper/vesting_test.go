@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestMintVestedContinuous() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	admin, minter, recipient := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	bankKeeper := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
+	tokenFactoryKeeper := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper
+	start := ctx.BlockTime().Unix()
+	msg := types.NewMsgMintVested(minter.String(), sdk.NewInt64Coin(suite.defaultDenom, 1000), recipient.String(), start, start+100, nil)
+
+	// Only a minter can mint into a vesting schedule
+	_, err := suite.msgServer.MintVested(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	suite.grantRole(ctx, minter, types.ROLE_MINTER)
+	res, err := suite.msgServer.MintVested(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.ScheduleId)
+	suite.Require().True(bankKeeper.GetBalance(ctx, recipient, suite.defaultDenom).IsZero())
+	suite.Require().Equal(int64(1000), bankKeeper.GetSupply(ctx, suite.defaultDenom).Amount.Int64())
+
+	// Only the recipient can claim and nothing is claimable before the schedule starts
+	_, err = suite.msgServer.ClaimVested(ctx, types.NewMsgClaimVested(admin.String(), res.ScheduleId))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.ClaimVested(ctx, types.NewMsgClaimVested(recipient.String(), res.ScheduleId))
+	suite.Require().ErrorIs(err, types.ErrNothingToClaim)
+
+	// A quarter of the amount is claimable after a quarter of the schedule
+	ctx = ctx.WithBlockTime(time.Unix(start+25, 0))
+	statusRes, err := tokenFactoryKeeper.VestingSchedule(ctx, &types.QueryVestingScheduleRequest{Id: res.ScheduleId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(250), statusRes.VestingSchedule.Claimable)
+
+	claimRes, err := suite.msgServer.ClaimVested(ctx, types.NewMsgClaimVested(recipient.String(), res.ScheduleId))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 250), claimRes.Amount)
+	suite.Require().Equal(int64(250), bankKeeper.GetBalance(ctx, recipient, suite.defaultDenom).Amount.Int64())
+
+	// The rest is claimable once the schedule ends, after which the schedule is removed
+	ctx = ctx.WithBlockTime(time.Unix(start+200, 0))
+	claimRes, err = suite.msgServer.ClaimVested(ctx, types.NewMsgClaimVested(recipient.String(), res.ScheduleId))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 750), claimRes.Amount)
+	suite.Require().Equal(int64(1000), bankKeeper.GetBalance(ctx, recipient, suite.defaultDenom).Amount.Int64())
+
+	_, found := tokenFactoryKeeper.GetVestingSchedule(ctx, res.ScheduleId)
+	suite.Require().False(found)
+	_, err = suite.msgServer.ClaimVested(ctx, types.NewMsgClaimVested(recipient.String(), res.ScheduleId))
+	suite.Require().ErrorIs(err, types.ErrVestingScheduleNotFound)
+}
+
+func (suite *KeeperTestSuite) TestMintVestedPeriodic() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	suite.CreateDefaultDenom(ctx)
+	admin, recipient := suite.TestAccs[0], suite.TestAccs[1]
+	bankKeeper := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
+	tokenFactoryKeeper := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper
+	start := ctx.BlockTime().Unix()
+	periods := []types.VestingPeriod{
+		{Length: 10, Amount: math.NewInt(100)},
+		{Length: 20, Amount: math.NewInt(200)},
+	}
+
+	// Period amounts must add up to the minted amount
+	_, err := suite.msgServer.MintVested(ctx, types.NewMsgMintVested(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 400), recipient.String(), start, 0, periods))
+	suite.Require().ErrorIs(err, types.ErrInvalidVestingSchedule)
+
+	// Schedules are bounded so that their end can't overflow
+	longPeriods := []types.VestingPeriod{
+		{Length: types.MaxVestingDuration, Amount: math.NewInt(100)},
+		{Length: 1<<63 - 1 - types.MaxVestingDuration, Amount: math.NewInt(200)},
+	}
+	_, err = suite.msgServer.MintVested(ctx, types.NewMsgMintVested(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 300), recipient.String(), start, 0, longPeriods))
+	suite.Require().ErrorIs(err, types.ErrInvalidVestingSchedule)
+	longPeriods[1].Length = 1
+	_, err = suite.msgServer.MintVested(ctx, types.NewMsgMintVested(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 300), recipient.String(), start, 0, longPeriods))
+	suite.Require().ErrorIs(err, types.ErrInvalidVestingSchedule)
+	_, err = suite.msgServer.MintVested(ctx, types.NewMsgMintVested(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 300), recipient.String(), types.MaxVestingStartTime+1, 0, periods))
+	suite.Require().ErrorIs(err, types.ErrInvalidVestingSchedule)
+
+	for range 2 {
+		_, err = suite.msgServer.MintVested(ctx, types.NewMsgMintVested(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 300), recipient.String(), start, 0, periods))
+		suite.Require().NoError(err)
+	}
+
+	res, err := tokenFactoryKeeper.VestingSchedules(ctx, &types.QueryVestingSchedulesRequest{Recipient: recipient.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.VestingSchedules, 2)
+	suite.Require().Equal(uint64(1), res.VestingSchedules[1].Schedule.Id)
+
+	// Nothing vests in the middle of the first period
+	ctx = ctx.WithBlockTime(time.Unix(start+9, 0))
+	_, err = suite.msgServer.ClaimVested(ctx, types.NewMsgClaimVested(recipient.String(), 0))
+	suite.Require().ErrorIs(err, types.ErrNothingToClaim)
+
+	// The first period vests at its end, the second one is still locked
+	ctx = ctx.WithBlockTime(time.Unix(start+29, 0))
+	claimRes, err := suite.msgServer.ClaimVested(ctx, types.NewMsgClaimVested(recipient.String(), 0))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 100), claimRes.Amount)
+
+	ctx = ctx.WithBlockTime(time.Unix(start+30, 0))
+	claimRes, err = suite.msgServer.ClaimVested(ctx, types.NewMsgClaimVested(recipient.String(), 0))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 200), claimRes.Amount)
+	suite.Require().Equal(int64(300), bankKeeper.GetBalance(ctx, recipient, suite.defaultDenom).Amount.Int64())
+
+	// The other schedule is left untouched
+	res, err = tokenFactoryKeeper.VestingSchedules(ctx, &types.QueryVestingSchedulesRequest{Recipient: recipient.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.VestingSchedules, 1)
+	suite.Require().Equal(math.NewInt(300), res.VestingSchedules[0].Claimable)
+}
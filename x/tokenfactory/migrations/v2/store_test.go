@@ -112,12 +112,12 @@ func (suite *V3DexMigrationTestSuite) TestHooksUpgrade() {
 	suite.NoError(v2.MigrateStore(ctx, cdc, storeKey, app.TokenFactoryKeeper))
 
 	// The whitelisted hook is still there
-	hook1 := app.TokenFactoryKeeper.GetBeforeSendHook(ctx, factoryDenom1)
+	hook1 := string(app.TokenFactoryKeeper.GetDenomPrefixStore(ctx, factoryDenom1).Get([]byte(types.BeforeSendHookAddressPrefixKey)))
 	suite.Assert().Equal(cwAddressStr, hook1)
 
 	// The non whitelisted hooks have been removed
-	hook2 := app.TokenFactoryKeeper.GetBeforeSendHook(ctx, factoryDenom2)
+	hook2 := string(app.TokenFactoryKeeper.GetDenomPrefixStore(ctx, factoryDenom2).Get([]byte(types.BeforeSendHookAddressPrefixKey)))
 	suite.Assert().Equal("", hook2)
-	hook3 := app.TokenFactoryKeeper.GetBeforeSendHook(ctx, factoryDenom3)
+	hook3 := string(app.TokenFactoryKeeper.GetDenomPrefixStore(ctx, factoryDenom3).Get([]byte(types.BeforeSendHookAddressPrefixKey)))
 	suite.Assert().Equal("", hook3)
 }
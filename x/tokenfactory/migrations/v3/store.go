@@ -0,0 +1,103 @@
+package v3
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+// MigrateStore performs in-place store migrations.
+// The migration grants every role to the admins of existing denoms, so that they keep the permissions they had
+// under the single admin model, and sets the new MintLimitsTimelock param.
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	if err := migrateParams(ctx, cdc, storeKey); err != nil {
+		return err
+	}
+
+	return migrateRoles(ctx, cdc, storeKey)
+}
+
+func migrateParams(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating tokenfactory params...")
+
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return errors.New("cannot fetch tokenfactory params from KV store")
+	}
+
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+	params.MintLimitsTimelock = types.DefaultMintLimitsTimelock
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	ctx.Logger().Info("Finished migrating tokenfactory params")
+
+	return nil
+}
+
+func migrateRoles(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating tokenfactory denom roles...")
+
+	store := prefix.NewStore(ctx.KVStore(storeKey), []byte(types.DenomsPrefixKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	keys := make([][]byte, 0)
+	values := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keyParts := strings.Split(string(iterator.Key()), types.KeySeparator)
+		if len(keyParts) != 3 {
+			return fmt.Errorf("cannot parse denom data key: %s", string(iterator.Key()))
+		}
+
+		// Hooks and authorityMetadata are in the same store, we only care about the authorityMetadata
+		if keyParts[2] != types.DenomAuthorityMetadataKey {
+			continue
+		}
+
+		var metadata types.DenomAuthorityMetadata
+		if err := cdc.Unmarshal(iterator.Value(), &metadata); err != nil {
+			return errorsmod.Wrapf(err, "cannot parse authority metadata of denom: %s", keyParts[1])
+		}
+
+		if metadata.Admin == "" || len(metadata.Roles) > 0 {
+			continue
+		}
+
+		for _, role := range types.AllRoles {
+			metadata.GrantRole(metadata.Admin, role)
+		}
+
+		bz, err := cdc.Marshal(&metadata)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		values = append(values, bz)
+	}
+
+	err := iterator.Close()
+	if err != nil {
+		return errorsmod.Wrap(err, "iterator failed to close after migration")
+	}
+
+	for i, key := range keys {
+		store.Set(key, values[i])
+	}
+
+	ctx.Logger().Info("Finished migrating tokenfactory denom roles")
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v5/testutil"
+	v3 "github.com/neutron-org/neutron/v5/x/tokenfactory/migrations/v3"
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+type V3TokenFactoryMigrationTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(V3TokenFactoryMigrationTestSuite))
+}
+
+func (suite *V3TokenFactoryMigrationTestSuite) TestRolesUpgrade() {
+	var (
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		storeKey = app.GetKey(types.StoreKey)
+		ctx      = suite.ChainA.GetContext()
+		cdc      = app.AppCodec()
+		addr1    = suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	)
+
+	// Write old state
+	factoryDenom, err := app.TokenFactoryKeeper.CreateDenom(ctx, addr1.String(), "test1")
+	suite.Require().NoError(err)
+	oldMetadata := types.DenomAuthorityMetadata{Admin: addr1.String()}
+	bz, err := cdc.Marshal(&oldMetadata)
+	suite.Require().NoError(err)
+	app.TokenFactoryKeeper.GetDenomPrefixStore(ctx, factoryDenom).Set([]byte(types.DenomAuthorityMetadataKey), bz)
+
+	params := app.TokenFactoryKeeper.GetParams(ctx)
+	params.MintLimitsTimelock = 0
+	suite.Require().NoError(app.TokenFactoryKeeper.SetParams(ctx, params))
+
+	// Run migration
+	suite.Require().NoError(v3.MigrateStore(ctx, cdc, storeKey))
+
+	// The admin holds every role
+	metadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, factoryDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewDenomAuthorityMetadata(addr1.String()), metadata)
+
+	// The mint limits timelock is set
+	suite.Require().Equal(types.DefaultMintLimitsTimelock, app.TokenFactoryKeeper.GetParams(ctx).MintLimitsTimelock)
+}
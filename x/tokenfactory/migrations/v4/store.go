@@ -0,0 +1,63 @@
+package v4
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+// MigrateStore performs in-place store migrations.
+// The migration moves the single before send hook contract address of each denom into a list of hooks holding it as a
+// blocking hook with the default gas limit, which keeps the hook behaving as before.
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating tokenfactory before send hooks...")
+
+	store := prefix.NewStore(ctx.KVStore(storeKey), []byte(types.DenomsPrefixKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	oldKeys := make([][]byte, 0)
+	newKeys := make([][]byte, 0)
+	values := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keyParts := strings.Split(string(iterator.Key()), types.KeySeparator)
+		if len(keyParts) != 3 {
+			return fmt.Errorf("cannot parse denom data key: %s", string(iterator.Key()))
+		}
+
+		// Hooks and authorityMetadata are in the same store, we only care about the hook
+		if keyParts[2] != types.BeforeSendHookAddressPrefixKey {
+			continue
+		}
+
+		bz, err := cdc.Marshal(&types.BeforeSendHooks{
+			Hooks: []types.BeforeSendHook{types.NewBlockBeforeSendHook(string(iterator.Value()))},
+		})
+		if err != nil {
+			return err
+		}
+
+		oldKeys = append(oldKeys, iterator.Key())
+		newKeys = append(newKeys, []byte(strings.Join([]string{keyParts[0], keyParts[1], types.BeforeSendHooksPrefixKey}, types.KeySeparator)))
+		values = append(values, bz)
+	}
+
+	err := iterator.Close()
+	if err != nil {
+		return errorsmod.Wrap(err, "iterator failed to close after migration")
+	}
+
+	for i, key := range oldKeys {
+		store.Delete(key)
+		store.Set(newKeys[i], values[i])
+	}
+
+	ctx.Logger().Info("Finished migrating tokenfactory before send hooks")
+
+	return nil
+}
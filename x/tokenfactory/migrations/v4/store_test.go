@@ -0,0 +1,51 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v5/testutil"
+	v4 "github.com/neutron-org/neutron/v5/x/tokenfactory/migrations/v4"
+	"github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+)
+
+type V4TokenFactoryMigrationTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(V4TokenFactoryMigrationTestSuite))
+}
+
+func (suite *V4TokenFactoryMigrationTestSuite) TestBeforeSendHooksUpgrade() {
+	var (
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		storeKey = app.GetKey(types.StoreKey)
+		ctx      = suite.ChainA.GetContext()
+		cdc      = app.AppCodec()
+		addr1    = suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+		cwAddr   = "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2"
+	)
+
+	// Write old state
+	factoryDenom, err := app.TokenFactoryKeeper.CreateDenom(ctx, addr1.String(), "test1")
+	suite.Require().NoError(err)
+	noHookDenom, err := app.TokenFactoryKeeper.CreateDenom(ctx, addr1.String(), "test2")
+	suite.Require().NoError(err)
+	store := app.TokenFactoryKeeper.GetDenomPrefixStore(ctx, factoryDenom)
+	store.Set([]byte(types.BeforeSendHookAddressPrefixKey), []byte(cwAddr))
+
+	// Run migration
+	suite.Require().NoError(v4.MigrateStore(ctx, cdc, storeKey))
+
+	// The hook is now the single blocking hook of the denom
+	suite.Require().Equal([]types.BeforeSendHook{types.NewBlockBeforeSendHook(cwAddr)}, app.TokenFactoryKeeper.GetBeforeSendHooks(ctx, factoryDenom))
+	suite.Require().False(store.Has([]byte(types.BeforeSendHookAddressPrefixKey)))
+	suite.Require().Empty(app.TokenFactoryKeeper.GetBeforeSendHooks(ctx, noHookDenom))
+
+	// Authority metadata is untouched
+	metadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, factoryDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr1.String(), metadata.Admin)
+}
@@ -1,9 +1,39 @@
 package types
 
 import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// MaxAdminTransferTimelock is the maximum timelock and expiry of an admin transfer in seconds
+	MaxAdminTransferTimelock = 365 * 24 * 60 * 60
+	// MaxMintWindow is the maximum length of a mint rate limit window in seconds
+	MaxMintWindow = 365 * 24 * 60 * 60
+)
+
+// AllRoles are the roles held by the admin of a newly created denom
+var AllRoles = []Role{
+	ROLE_MINTER,
+	ROLE_BURNER,
+	ROLE_PAUSER,
+	ROLE_METADATA_ADMIN,
+	ROLE_FORCE_TRANSFERRER,
+}
+
+// NewDenomAuthorityMetadata returns the authority metadata of a denom whose admin holds every role
+func NewDenomAuthorityMetadata(admin string) DenomAuthorityMetadata {
+	metadata := DenomAuthorityMetadata{Admin: admin}
+	for _, role := range AllRoles {
+		metadata.GrantRole(admin, role)
+	}
+
+	return metadata
+}
+
 func (metadata DenomAuthorityMetadata) Validate() error {
 	if metadata.Admin != "" {
 		_, err := sdk.AccAddressFromBech32(metadata.Admin)
@@ -11,5 +41,212 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+
+	if metadata.MintLimits != nil {
+		if err := metadata.MintLimits.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if metadata.PendingMintLimits != nil {
+		if err := metadata.PendingMintLimits.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if metadata.PendingAdminTransfer != nil {
+		if _, err := sdk.AccAddressFromBech32(metadata.PendingAdminTransfer.NewAdmin); err != nil {
+			return err
+		}
+	}
+
+	seenRoles := map[RoleAssignment]bool{}
+	for _, assignment := range metadata.Roles {
+		if _, err := sdk.AccAddressFromBech32(assignment.Address); err != nil {
+			return err
+		}
+
+		if err := assignment.Role.Validate(); err != nil {
+			return err
+		}
+
+		if seenRoles[assignment] {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "duplicate role %s for %s", assignment.Role, assignment.Address)
+		}
+		seenRoles[assignment] = true
+
+		if assignment.Role == ROLE_FORCE_TRANSFERRER && metadata.ForceTransferRenounced {
+			return ErrForceTransferRenounced
+		}
+	}
+
+	seenMinters := map[string]bool{}
+	for _, minterAllowance := range metadata.MinterAllowances {
+		if !metadata.HasRole(minterAllowance.Address, ROLE_MINTER) {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "allowance for %s who is not a minter", minterAllowance.Address)
+		}
+
+		if seenMinters[minterAllowance.Address] {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "duplicate allowance for %s", minterAllowance.Address)
+		}
+		seenMinters[minterAllowance.Address] = true
+
+		if minterAllowance.Allowance.IsNil() || minterAllowance.Allowance.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "invalid allowance for %s", minterAllowance.Address)
+		}
+	}
+
 	return nil
 }
+
+// HasRole returns true if address has been granted role
+func (metadata DenomAuthorityMetadata) HasRole(address string, role Role) bool {
+	for _, assignment := range metadata.Roles {
+		if assignment.Address == address && assignment.Role == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GrantRole grants role to address unless it already holds it
+func (metadata *DenomAuthorityMetadata) GrantRole(address string, role Role) {
+	if metadata.HasRole(address, role) {
+		return
+	}
+
+	metadata.Roles = append(metadata.Roles, RoleAssignment{Address: address, Role: role})
+}
+
+// RevokeRole revokes role from address. Revoking the minter role also removes the allowance of the minter.
+func (metadata *DenomAuthorityMetadata) RevokeRole(address string, role Role) {
+	roles := make([]RoleAssignment, 0, len(metadata.Roles))
+	for _, assignment := range metadata.Roles {
+		if assignment.Address != address || assignment.Role != role {
+			roles = append(roles, assignment)
+		}
+	}
+	metadata.Roles = roles
+
+	if role == ROLE_MINTER {
+		metadata.RemoveMinterAllowance(address)
+	}
+}
+
+// TransferRoles moves every role held by from, and its minter allowance, to to
+func (metadata *DenomAuthorityMetadata) TransferRoles(from, to string) {
+	for _, role := range AllRoles {
+		if !metadata.HasRole(from, role) {
+			continue
+		}
+
+		allowance, hasAllowance := metadata.GetMinterAllowance(from)
+		metadata.RevokeRole(from, role)
+		metadata.GrantRole(to, role)
+		if role == ROLE_MINTER && hasAllowance {
+			metadata.SetMinterAllowance(to, allowance)
+		}
+	}
+}
+
+// GetMinterAllowance returns the remaining allowance of a minter. Returns false if the minter can mint without limit.
+func (metadata DenomAuthorityMetadata) GetMinterAllowance(minter string) (math.Int, bool) {
+	for _, minterAllowance := range metadata.MinterAllowances {
+		if minterAllowance.Address == minter {
+			return minterAllowance.Allowance, true
+		}
+	}
+
+	return math.Int{}, false
+}
+
+// SetMinterAllowance caps the amount minter can still mint
+func (metadata *DenomAuthorityMetadata) SetMinterAllowance(minter string, allowance math.Int) {
+	for i, minterAllowance := range metadata.MinterAllowances {
+		if minterAllowance.Address == minter {
+			metadata.MinterAllowances[i].Allowance = allowance
+			return
+		}
+	}
+
+	metadata.MinterAllowances = append(metadata.MinterAllowances, MinterAllowance{Address: minter, Allowance: allowance})
+}
+
+// RemoveMinterAllowance lets minter mint without limit
+func (metadata *DenomAuthorityMetadata) RemoveMinterAllowance(minter string) {
+	allowances := make([]MinterAllowance, 0, len(metadata.MinterAllowances))
+	for _, minterAllowance := range metadata.MinterAllowances {
+		if minterAllowance.Address != minter {
+			allowances = append(allowances, minterAllowance)
+		}
+	}
+	metadata.MinterAllowances = allowances
+}
+
+func (role Role) Validate() error {
+	if _, ok := Role_name[int32(role)]; !ok || role == ROLE_UNSPECIFIED {
+		return errorsmod.Wrapf(ErrInvalidRole, "%d", role)
+	}
+
+	return nil
+}
+
+// PromotePendingMintLimits replaces the mint limits with the pending ones once their timelock has passed. Returns true
+// if the metadata was changed.
+func (metadata *DenomAuthorityMetadata) PromotePendingMintLimits(blockTime time.Time) bool {
+	if metadata.PendingMintLimits == nil || blockTime.Unix() < metadata.PendingMintLimitsEffectiveTime {
+		return false
+	}
+
+	metadata.MintLimits = metadata.PendingMintLimits
+	metadata.PendingMintLimits = nil
+	metadata.PendingMintLimitsEffectiveTime = 0
+
+	return true
+}
+
+func (limits MintLimits) Validate() error {
+	if limits.MaxSupply.IsNil() || limits.MaxSupply.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "invalid max supply (%s)", limits.MaxSupply)
+	}
+
+	if limits.MintRateLimit.IsNil() || limits.MintRateLimit.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "invalid mint rate limit (%s)", limits.MintRateLimit)
+	}
+
+	if limits.MintRateLimit.IsPositive() && limits.MintWindow == 0 {
+		return errorsmod.Wrap(ErrInvalidMintLimits, "mint window must be set with a mint rate limit")
+	}
+
+	if limits.MintWindow > MaxMintWindow {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "mint window must be at most %d seconds", MaxMintWindow)
+	}
+
+	return nil
+}
+
+// IsLooserThan returns true if the limits allow minting anything that other does not
+func (limits MintLimits) IsLooserThan(other MintLimits) bool {
+	if isLooserCap(limits.MaxSupply.IsZero(), other.MaxSupply.IsZero(), limits.MaxSupply.GT(other.MaxSupply)) {
+		return true
+	}
+
+	if isLooserCap(limits.MintRateLimit.IsZero(), other.MintRateLimit.IsZero(), limits.MintRateLimit.GT(other.MintRateLimit)) {
+		return true
+	}
+
+	return other.MintRateLimit.IsPositive() && limits.MintWindow < other.MintWindow
+}
+
+// isLooserCap compares two caps where zero stands for no cap
+func isLooserCap(uncapped, otherUncapped, greater bool) bool {
+	switch {
+	case otherUncapped:
+		return false
+	case uncapped:
+		return true
+	default:
+		return greater
+	}
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -24,12 +26,73 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Role is a permission over a token factory denom that the admin can grant
+type Role int32
+
+const (
+	ROLE_UNSPECIFIED Role = 0
+	// Can mint the denom, up to its allowance if it has one
+	ROLE_MINTER Role = 1
+	// Can burn the denom
+	ROLE_BURNER Role = 2
+	// Can freeze and pause transfers of the denom
+	ROLE_PAUSER Role = 3
+	// Can set the bank metadata of the denom
+	ROLE_METADATA_ADMIN Role = 4
+	// Can force transfer the denom between accounts
+	ROLE_FORCE_TRANSFERRER Role = 5
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_MINTER",
+	2: "ROLE_BURNER",
+	3: "ROLE_PAUSER",
+	4: "ROLE_METADATA_ADMIN",
+	5: "ROLE_FORCE_TRANSFERRER",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED":       0,
+	"ROLE_MINTER":            1,
+	"ROLE_BURNER":            2,
+	"ROLE_PAUSER":            3,
+	"ROLE_METADATA_ADMIN":    4,
+	"ROLE_FORCE_TRANSFERRER": 5,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{0}
+}
+
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
 // capabilities over a token factory denom. Right now there is only one Admin
 // permission, but is planned to be extended to the future.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid osmosis address
 	Admin string `protobuf:"bytes,1,opt,name=Admin,proto3" json:"Admin,omitempty" yaml:"admin"`
+	// Limits enforced on every mint of the denom. Empty if minting is only
+	// restricted to the admin.
+	MintLimits *MintLimits `protobuf:"bytes,2,opt,name=mint_limits,json=mintLimits,proto3" json:"mint_limits,omitempty" yaml:"mint_limits"`
+	// Looser limits that replace mint_limits once
+	// pending_mint_limits_effective_time (unix seconds) is reached.
+	PendingMintLimits              *MintLimits `protobuf:"bytes,3,opt,name=pending_mint_limits,json=pendingMintLimits,proto3" json:"pending_mint_limits,omitempty" yaml:"pending_mint_limits"`
+	PendingMintLimitsEffectiveTime int64       `protobuf:"varint,4,opt,name=pending_mint_limits_effective_time,json=pendingMintLimitsEffectiveTime,proto3" json:"pending_mint_limits_effective_time,omitempty" yaml:"pending_mint_limits_effective_time"`
+	// Roles granted by the admin to other accounts
+	Roles []RoleAssignment `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles" yaml:"roles"`
+	// Remaining amounts that minters can mint. Minters without an allowance can
+	// mint without limit.
+	MinterAllowances []MinterAllowance `protobuf:"bytes,6,rep,name=minter_allowances,json=minterAllowances,proto3" json:"minter_allowances" yaml:"minter_allowances"`
+	// Set once the admin has renounced force transfers. The force transferrer
+	// role can no longer be granted.
+	ForceTransferRenounced bool `protobuf:"varint,7,opt,name=force_transfer_renounced,json=forceTransferRenounced,proto3" json:"force_transfer_renounced,omitempty" yaml:"force_transfer_renounced"`
+	// Admin transfer proposed by the admin and waiting for the acceptance of the
+	// new admin
+	PendingAdminTransfer *PendingAdminTransfer `protobuf:"bytes,8,opt,name=pending_admin_transfer,json=pendingAdminTransfer,proto3" json:"pending_admin_transfer,omitempty" yaml:"pending_admin_transfer"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,8 +135,326 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetMintLimits() *MintLimits {
+	if m != nil {
+		return m.MintLimits
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetPendingMintLimits() *MintLimits {
+	if m != nil {
+		return m.PendingMintLimits
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetPendingMintLimitsEffectiveTime() int64 {
+	if m != nil {
+		return m.PendingMintLimitsEffectiveTime
+	}
+	return 0
+}
+
+func (m *DenomAuthorityMetadata) GetRoles() []RoleAssignment {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetMinterAllowances() []MinterAllowance {
+	if m != nil {
+		return m.MinterAllowances
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetForceTransferRenounced() bool {
+	if m != nil {
+		return m.ForceTransferRenounced
+	}
+	return false
+}
+
+func (m *DenomAuthorityMetadata) GetPendingAdminTransfer() *PendingAdminTransfer {
+	if m != nil {
+		return m.PendingAdminTransfer
+	}
+	return nil
+}
+
+// PendingAdminTransfer is a proposed handover of a denom to a new admin
+type PendingAdminTransfer struct {
+	NewAdmin string `protobuf:"bytes,1,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty" yaml:"new_admin"`
+	// Unix time in seconds from which the new admin can accept the transfer
+	AcceptTime int64 `protobuf:"varint,2,opt,name=accept_time,json=acceptTime,proto3" json:"accept_time,omitempty" yaml:"accept_time"`
+	// Unix time in seconds from which the transfer can no longer be accepted.
+	// Zero means the transfer does not expire.
+	ExpireTime int64 `protobuf:"varint,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty" yaml:"expire_time"`
+}
+
+func (m *PendingAdminTransfer) Reset()         { *m = PendingAdminTransfer{} }
+func (m *PendingAdminTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingAdminTransfer) ProtoMessage()    {}
+func (*PendingAdminTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{1}
+}
+func (m *PendingAdminTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAdminTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAdminTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingAdminTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAdminTransfer.Merge(m, src)
+}
+func (m *PendingAdminTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAdminTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAdminTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAdminTransfer proto.InternalMessageInfo
+
+func (m *PendingAdminTransfer) GetNewAdmin() string {
+	if m != nil {
+		return m.NewAdmin
+	}
+	return ""
+}
+
+func (m *PendingAdminTransfer) GetAcceptTime() int64 {
+	if m != nil {
+		return m.AcceptTime
+	}
+	return 0
+}
+
+func (m *PendingAdminTransfer) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+// RoleAssignment grants a role over a denom to an address
+type RoleAssignment struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Role    Role   `protobuf:"varint,2,opt,name=role,proto3,enum=osmosis.tokenfactory.v1beta1.Role" json:"role,omitempty" yaml:"role"`
+}
+
+func (m *RoleAssignment) Reset()         { *m = RoleAssignment{} }
+func (m *RoleAssignment) String() string { return proto.CompactTextString(m) }
+func (*RoleAssignment) ProtoMessage()    {}
+func (*RoleAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{2}
+}
+func (m *RoleAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleAssignment.Merge(m, src)
+}
+func (m *RoleAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleAssignment proto.InternalMessageInfo
+
+func (m *RoleAssignment) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RoleAssignment) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return ROLE_UNSPECIFIED
+}
+
+// MinterAllowance is the amount of a denom a minter can still mint
+type MinterAllowance struct {
+	Address   string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Allowance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance" yaml:"allowance"`
+}
+
+func (m *MinterAllowance) Reset()         { *m = MinterAllowance{} }
+func (m *MinterAllowance) String() string { return proto.CompactTextString(m) }
+func (*MinterAllowance) ProtoMessage()    {}
+func (*MinterAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{3}
+}
+func (m *MinterAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinterAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinterAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinterAllowance.Merge(m, src)
+}
+func (m *MinterAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinterAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinterAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinterAllowance proto.InternalMessageInfo
+
+func (m *MinterAllowance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MintLimits caps the supply of a token factory denom and the rate at which it
+// can be minted.
+type MintLimits struct {
+	// Hard cap on the total supply of the denom. Zero means no cap.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+	// Maximum amount that can be minted within a single mint_window. Zero means
+	// no rate limit.
+	MintRateLimit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=mint_rate_limit,json=mintRateLimit,proto3,customtype=cosmossdk.io/math.Int" json:"mint_rate_limit" yaml:"mint_rate_limit"`
+	// Length of the rate limit window in seconds
+	MintWindow uint64 `protobuf:"varint,3,opt,name=mint_window,json=mintWindow,proto3" json:"mint_window,omitempty" yaml:"mint_window"`
+}
+
+func (m *MintLimits) Reset()         { *m = MintLimits{} }
+func (m *MintLimits) String() string { return proto.CompactTextString(m) }
+func (*MintLimits) ProtoMessage()    {}
+func (*MintLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{4}
+}
+func (m *MintLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintLimits.Merge(m, src)
+}
+func (m *MintLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintLimits proto.InternalMessageInfo
+
+func (m *MintLimits) GetMintWindow() uint64 {
+	if m != nil {
+		return m.MintWindow
+	}
+	return 0
+}
+
+// MintWindow tracks the amount of a denom minted in the current rate limit
+// window.
+type MintWindow struct {
+	// Start of the window in unix seconds
+	WindowStart int64                 `protobuf:"varint,1,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty" yaml:"window_start"`
+	Minted      cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted" yaml:"minted"`
+}
+
+func (m *MintWindow) Reset()         { *m = MintWindow{} }
+func (m *MintWindow) String() string { return proto.CompactTextString(m) }
+func (*MintWindow) ProtoMessage()    {}
+func (*MintWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{5}
+}
+func (m *MintWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintWindow.Merge(m, src)
+}
+func (m *MintWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintWindow proto.InternalMessageInfo
+
+func (m *MintWindow) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.tokenfactory.v1beta1.Role", Role_name, Role_value)
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "osmosis.tokenfactory.v1beta1.DenomAuthorityMetadata")
+	proto.RegisterType((*PendingAdminTransfer)(nil), "osmosis.tokenfactory.v1beta1.PendingAdminTransfer")
+	proto.RegisterType((*RoleAssignment)(nil), "osmosis.tokenfactory.v1beta1.RoleAssignment")
+	proto.RegisterType((*MinterAllowance)(nil), "osmosis.tokenfactory.v1beta1.MinterAllowance")
+	proto.RegisterType((*MintLimits)(nil), "osmosis.tokenfactory.v1beta1.MintLimits")
+	proto.RegisterType((*MintWindow)(nil), "osmosis.tokenfactory.v1beta1.MintWindow")
 }
 
 func init() {
@@ -81,23 +462,70 @@ func init() {
 }
 
 var fileDescriptor_99435de88ae175f7 = []byte{
-	// 242 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca,
-	0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x81, 0xea, 0xd2, 0x43, 0xd6, 0xa5, 0x07, 0xd5, 0x25, 0x25, 0x97, 0x0c, 0x96, 0xd6,
-	0x4f, 0x4a, 0x2c, 0x4e, 0x85, 0x1b, 0x95, 0x9c, 0x9f, 0x99, 0x07, 0xd1, 0x2d, 0x25, 0x92, 0x9e,
-	0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x10, 0x51, 0x25, 0x37, 0x2e, 0x31, 0x97, 0xd4, 0xbc,
-	0xfc, 0x5c, 0x47, 0x74, 0x3b, 0x85, 0xd4, 0xb8, 0x58, 0x1d, 0x53, 0x72, 0x33, 0xf3, 0x24, 0x18,
-	0x15, 0x18, 0x35, 0x38, 0x9d, 0x04, 0x3e, 0xdd, 0x93, 0xe7, 0xa9, 0x4c, 0xcc, 0xcd, 0xb1, 0x52,
-	0x4a, 0x04, 0x09, 0x2b, 0x05, 0x41, 0xa4, 0xad, 0x58, 0x5e, 0x2c, 0x90, 0x67, 0x74, 0x0a, 0x38,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xb3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xbc, 0xd4, 0xd2, 0x92, 0xa2, 0xfc, 0x3c, 0xdd, 0xfc, 0xa2,
-	0x74, 0x18, 0x5b, 0xbf, 0xcc, 0x54, 0xbf, 0x02, 0x35, 0x1c, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93,
-	0xd8, 0xc0, 0x0e, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x39, 0xf1, 0xea, 0x2c, 0x01,
-	0x00, 0x00,
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6a, 0xe3, 0x46,
+	0x14, 0xb6, 0x62, 0x27, 0x9b, 0x8c, 0x77, 0x13, 0x67, 0xe2, 0x7a, 0xdd, 0xd0, 0x4a, 0xae, 0x0a,
+	0xc5, 0x2d, 0x1b, 0x9b, 0xa4, 0x3f, 0x0b, 0xa1, 0xd0, 0x4a, 0x1b, 0xa5, 0x18, 0x36, 0xd9, 0x30,
+	0x71, 0x68, 0x29, 0x2c, 0x62, 0x22, 0x4d, 0x1c, 0xb1, 0xd6, 0x8c, 0x91, 0x26, 0x3f, 0x86, 0x3e,
+	0x40, 0x69, 0x29, 0xf4, 0xbe, 0x37, 0x85, 0xf6, 0x11, 0xfa, 0x02, 0xbd, 0xdb, 0xcb, 0xa5, 0x57,
+	0xa5, 0x17, 0xa2, 0x24, 0x37, 0xbd, 0xd6, 0x13, 0x14, 0xcd, 0xc8, 0xb2, 0xec, 0x64, 0x63, 0xf6,
+	0xce, 0x73, 0xbe, 0xf3, 0x7d, 0xe7, 0x1c, 0xcd, 0x37, 0x07, 0x83, 0x4f, 0x58, 0xe8, 0xb3, 0xd0,
+	0x0b, 0xdb, 0x9c, 0xbd, 0x20, 0xf4, 0x04, 0x3b, 0x9c, 0x05, 0xc3, 0xf6, 0xf9, 0xe6, 0x31, 0xe1,
+	0x78, 0xb3, 0x8d, 0xcf, 0xf8, 0x29, 0x0b, 0x3c, 0x3e, 0xdc, 0x23, 0x1c, 0xbb, 0x98, 0xe3, 0xd6,
+	0x20, 0x60, 0x9c, 0xc1, 0x77, 0x52, 0x56, 0x2b, 0xcf, 0x6a, 0xa5, 0xac, 0x75, 0xd5, 0x11, 0x70,
+	0xfb, 0x18, 0x87, 0x24, 0x93, 0x72, 0x98, 0x47, 0x25, 0x7b, 0xfd, 0x6d, 0x89, 0xdb, 0xe2, 0xd4,
+	0x96, 0x87, 0x14, 0xaa, 0xf6, 0x58, 0x8f, 0xc9, 0x78, 0xf2, 0x4b, 0x46, 0xf5, 0xeb, 0x05, 0x50,
+	0xdb, 0x21, 0x94, 0xf9, 0xc6, 0x74, 0x3f, 0xf0, 0x03, 0x30, 0x6f, 0xb8, 0xbe, 0x47, 0xeb, 0x4a,
+	0x43, 0x69, 0x2e, 0x99, 0x95, 0x38, 0xd2, 0xee, 0x0f, 0xb1, 0xdf, 0xdf, 0xd6, 0x71, 0x12, 0xd6,
+	0x91, 0x84, 0x21, 0x06, 0x65, 0xdf, 0xa3, 0xdc, 0xee, 0x7b, 0xbe, 0xc7, 0xc3, 0xfa, 0x5c, 0x43,
+	0x69, 0x96, 0xb7, 0x9a, 0xad, 0xbb, 0xe6, 0x68, 0xed, 0x79, 0x94, 0x3f, 0x15, 0xf9, 0x66, 0x2d,
+	0x8e, 0x34, 0x28, 0x75, 0x73, 0x32, 0x3a, 0x02, 0x7e, 0x96, 0x03, 0x2f, 0xc1, 0xda, 0x80, 0x50,
+	0xd7, 0xa3, 0x3d, 0x3b, 0x5f, 0xaa, 0xf8, 0x86, 0xa5, 0xd4, 0x38, 0xd2, 0xd6, 0x65, 0xa9, 0x5b,
+	0xe4, 0x74, 0xb4, 0x9a, 0x46, 0xc7, 0x14, 0x38, 0x04, 0xb7, 0xa5, 0xda, 0xe4, 0xe4, 0x84, 0x38,
+	0xdc, 0x3b, 0x27, 0x36, 0xf7, 0x7c, 0x52, 0x2f, 0x35, 0x94, 0x66, 0xd1, 0xdc, 0x88, 0x23, 0xed,
+	0xc3, 0xd7, 0xca, 0x4f, 0x71, 0x74, 0xa4, 0xde, 0xa8, 0x66, 0x8d, 0x32, 0xba, 0x9e, 0x4f, 0xe0,
+	0x37, 0x60, 0x3e, 0x60, 0x7d, 0x12, 0xd6, 0xe7, 0x1b, 0xc5, 0x66, 0x79, 0xeb, 0xd1, 0xdd, 0x63,
+	0x22, 0xd6, 0x27, 0x46, 0x18, 0x7a, 0x3d, 0xea, 0x13, 0xca, 0xcd, 0xea, 0xcb, 0x48, 0x2b, 0x8c,
+	0x6f, 0x4c, 0x08, 0xe9, 0x48, 0x0a, 0xc2, 0xef, 0xc0, 0x6a, 0xd2, 0x18, 0x09, 0x6c, 0xdc, 0xef,
+	0xb3, 0x0b, 0x4c, 0x1d, 0x12, 0xd6, 0x17, 0x44, 0x95, 0x8d, 0xd9, 0x1f, 0x93, 0x04, 0xc6, 0x88,
+	0x65, 0x36, 0xd2, 0x32, 0xf5, 0xf1, 0x05, 0x4e, 0xa8, 0xea, 0xa8, 0xe2, 0x4f, 0x52, 0x42, 0xf8,
+	0x1c, 0xd4, 0x4f, 0x58, 0xe0, 0x10, 0x9b, 0x07, 0x98, 0x86, 0x27, 0x24, 0xb0, 0x03, 0x42, 0xd9,
+	0x19, 0x75, 0x88, 0x5b, 0xbf, 0xd7, 0x50, 0x9a, 0x8b, 0xe6, 0xfb, 0x71, 0xa4, 0x69, 0x52, 0xf1,
+	0x75, 0x99, 0x3a, 0xaa, 0x09, 0xa8, 0x9b, 0x22, 0x68, 0x04, 0xc0, 0x1f, 0x15, 0x50, 0x1b, 0x7d,
+	0x7e, 0x61, 0xd4, 0x8c, 0x5d, 0x5f, 0x14, 0x7e, 0xd9, 0xba, 0x7b, 0xc4, 0x03, 0xc9, 0x15, 0xde,
+	0x1e, 0xa9, 0x9b, 0xef, 0xc5, 0x91, 0xf6, 0xee, 0xe4, 0xd5, 0x4e, 0x6a, 0xeb, 0xa8, 0x3a, 0xb8,
+	0x85, 0xb8, 0x5d, 0xfa, 0xef, 0x57, 0x4d, 0xd1, 0xff, 0x54, 0x40, 0xf5, 0x36, 0x5d, 0xb8, 0x09,
+	0x96, 0x28, 0xb9, 0xb0, 0x71, 0xee, 0x9d, 0x55, 0xe3, 0x48, 0xab, 0xc8, 0x52, 0x19, 0xa4, 0xa3,
+	0x45, 0x4a, 0x2e, 0xe4, 0x73, 0x7b, 0x0c, 0xca, 0xd8, 0x71, 0xc8, 0x80, 0x4b, 0xeb, 0xcd, 0x09,
+	0xeb, 0xe5, 0x1e, 0x51, 0x0e, 0xd4, 0x11, 0x90, 0x27, 0xe1, 0xa7, 0xc7, 0xa0, 0x4c, 0x2e, 0x07,
+	0x5e, 0x90, 0x7a, 0xb6, 0x38, 0x4d, 0xcc, 0x81, 0x3a, 0x02, 0xf2, 0x94, 0x10, 0xd3, 0x19, 0x7e,
+	0x52, 0xc0, 0xf2, 0xa4, 0xc9, 0xe0, 0x23, 0x70, 0x0f, 0xbb, 0x6e, 0x40, 0xc2, 0x30, 0xed, 0x1d,
+	0xc6, 0x91, 0xb6, 0x3c, 0xda, 0x11, 0x02, 0xd0, 0xd1, 0x28, 0x05, 0x7e, 0x05, 0x4a, 0x89, 0xfd,
+	0x44, 0xc7, 0xcb, 0x5b, 0xfa, 0x6c, 0x3b, 0x9b, 0x2b, 0x71, 0xa4, 0x95, 0xc7, 0x06, 0xd6, 0x91,
+	0x10, 0x48, 0xfb, 0xf9, 0x5d, 0x01, 0x2b, 0x53, 0x76, 0x7c, 0xc3, 0x86, 0x9e, 0x83, 0xa5, 0xcc,
+	0xa9, 0xa2, 0xab, 0x25, 0xf3, 0x8b, 0xc4, 0xcf, 0xff, 0x44, 0xda, 0x5b, 0x72, 0x75, 0x86, 0xee,
+	0x8b, 0x96, 0xc7, 0xda, 0x3e, 0xe6, 0xa7, 0xad, 0x0e, 0xe5, 0xe3, 0x9b, 0xc9, 0x78, 0xfa, 0x5f,
+	0x7f, 0x6c, 0x80, 0x74, 0xcf, 0x76, 0x28, 0x47, 0x63, 0xc5, 0xb4, 0xcd, 0x5f, 0xe6, 0x00, 0xc8,
+	0xed, 0x13, 0x1b, 0x00, 0x1f, 0x5f, 0xda, 0xe1, 0xd9, 0x60, 0xd0, 0x1f, 0xa6, 0x4d, 0x7e, 0x39,
+	0xab, 0xe8, 0x6a, 0xfa, 0xba, 0x32, 0xe2, 0x8d, 0xaa, 0x3e, 0xbe, 0x3c, 0x14, 0x08, 0xf4, 0xc1,
+	0x8a, 0x58, 0x3a, 0x01, 0xe6, 0x44, 0x6e, 0x9e, 0x74, 0x34, 0x6b, 0x56, 0x95, 0x5a, 0x6e, 0x09,
+	0x8f, 0xd9, 0xd3, 0xa5, 0x1e, 0x24, 0x38, 0xc2, 0x9c, 0x88, 0x81, 0x12, 0x53, 0x09, 0xc2, 0x85,
+	0x47, 0x5d, 0x76, 0x21, 0x4c, 0x55, 0xba, 0xb1, 0xd2, 0x25, 0x98, 0xae, 0xf4, 0xaf, 0xc5, 0x61,
+	0x7c, 0x89, 0x60, 0x2f, 0x0b, 0xc2, 0x6d, 0x70, 0x5f, 0xe6, 0xda, 0x21, 0xc7, 0x01, 0x17, 0xdf,
+	0xa7, 0x68, 0x3e, 0x8c, 0x23, 0x6d, 0x4d, 0xca, 0xe5, 0x51, 0x1d, 0x95, 0xe5, 0xf1, 0x30, 0x39,
+	0xc1, 0x2e, 0x58, 0x48, 0xe4, 0x89, 0x9b, 0xce, 0xfb, 0xf9, 0xac, 0x79, 0x1f, 0x8c, 0x3b, 0x24,
+	0xee, 0xf4, 0x98, 0xa9, 0x96, 0x6c, 0xf3, 0xa3, 0x1f, 0x14, 0x50, 0x4a, 0x1c, 0x09, 0xab, 0xa0,
+	0x82, 0x9e, 0x3d, 0xb5, 0xec, 0xa3, 0xfd, 0xc3, 0x03, 0xeb, 0x49, 0x67, 0xb7, 0x63, 0xed, 0x54,
+	0x0a, 0x70, 0x05, 0x94, 0x45, 0x74, 0xaf, 0xb3, 0xdf, 0xb5, 0x50, 0x45, 0xc9, 0x02, 0xe6, 0x11,
+	0xda, 0xb7, 0x50, 0x65, 0x2e, 0x0b, 0x1c, 0x18, 0x47, 0x87, 0x16, 0xaa, 0x14, 0xe1, 0x43, 0xb0,
+	0x26, 0x29, 0x56, 0xd7, 0xd8, 0x31, 0xba, 0x86, 0x6d, 0xec, 0xec, 0x75, 0xf6, 0x2b, 0x25, 0xb8,
+	0x0e, 0x6a, 0x02, 0xd8, 0x7d, 0x86, 0x9e, 0x58, 0x76, 0x17, 0x19, 0xfb, 0x87, 0xbb, 0x16, 0x42,
+	0x16, 0xaa, 0xcc, 0xaf, 0x97, 0xbe, 0xff, 0x4d, 0x2d, 0x98, 0x07, 0x2f, 0xaf, 0x54, 0xe5, 0xd5,
+	0x95, 0xaa, 0xfc, 0x7b, 0xa5, 0x2a, 0x3f, 0x5f, 0xab, 0x85, 0x57, 0xd7, 0x6a, 0xe1, 0xef, 0x6b,
+	0xb5, 0xf0, 0xed, 0x67, 0x3d, 0x8f, 0x9f, 0x9e, 0x1d, 0xb7, 0x1c, 0xe6, 0xb7, 0x29, 0x39, 0xe3,
+	0x01, 0xa3, 0x1b, 0x2c, 0xe8, 0x8d, 0x7e, 0xb7, 0xcf, 0x3f, 0x6d, 0x5f, 0x4e, 0xfe, 0x1b, 0xe1,
+	0xc3, 0x01, 0x09, 0x8f, 0x17, 0xc4, 0x7f, 0x81, 0x8f, 0xff, 0x1f, 0x00, 0x2b, 0xbe, 0x8c, 0x05,
+	0xb2, 0x08, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -122,69 +550,1265 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
-	return true
-}
-func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.MintLimits.Equal(that1.MintLimits) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DenomAuthorityMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+	if !this.PendingMintLimits.Equal(that1.PendingMintLimits) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuthorityMetadata(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if this.PendingMintLimitsEffectiveTime != that1.PendingMintLimitsEffectiveTime {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *DenomAuthorityMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Roles) != len(that1.Roles) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	for i := range this.Roles {
+		if !this.Roles[i].Equal(&that1.Roles[i]) {
+			return false
+		}
 	}
-	return n
+	if len(this.MinterAllowances) != len(that1.MinterAllowances) {
+		return false
+	}
+	for i := range this.MinterAllowances {
+		if !this.MinterAllowances[i].Equal(&that1.MinterAllowances[i]) {
+			return false
+		}
+	}
+	if this.ForceTransferRenounced != that1.ForceTransferRenounced {
+		return false
+	}
+	if !this.PendingAdminTransfer.Equal(that1.PendingAdminTransfer) {
+		return false
+	}
+	return true
 }
+func (this *PendingAdminTransfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovAuthorityMetadata(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+	that1, ok := that.(*PendingAdminTransfer)
+	if !ok {
+		that2, ok := that.(PendingAdminTransfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NewAdmin != that1.NewAdmin {
+		return false
+	}
+	if this.AcceptTime != that1.AcceptTime {
+		return false
+	}
+	if this.ExpireTime != that1.ExpireTime {
+		return false
+	}
+	return true
+}
+func (this *RoleAssignment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoleAssignment)
+	if !ok {
+		that2, ok := that.(RoleAssignment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	return true
+}
+func (this *MinterAllowance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MinterAllowance)
+	if !ok {
+		that2, ok := that.(MinterAllowance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Allowance.Equal(that1.Allowance) {
+		return false
+	}
+	return true
+}
+func (this *MintLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintLimits)
+	if !ok {
+		that2, ok := that.(MintLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if !this.MintRateLimit.Equal(that1.MintRateLimit) {
+		return false
+	}
+	if this.MintWindow != that1.MintWindow {
+		return false
+	}
+	return true
+}
+func (this *MintWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintWindow)
+	if !ok {
+		that2, ok := that.(MintWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WindowStart != that1.WindowStart {
+		return false
+	}
+	if !this.Minted.Equal(that1.Minted) {
+		return false
+	}
+	return true
+}
+func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAuthorityMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingAdminTransfer != nil {
+		{
+			size, err := m.PendingAdminTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ForceTransferRenounced {
+		i--
+		if m.ForceTransferRenounced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MinterAllowances) > 0 {
+		for iNdEx := len(m.MinterAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PendingMintLimitsEffectiveTime != 0 {
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(m.PendingMintLimitsEffectiveTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PendingMintLimits != nil {
+		{
+			size, err := m.PendingMintLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MintLimits != nil {
+		{
+			size, err := m.MintLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAdminTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAdminTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAdminTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpireTime != 0 {
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(m.ExpireTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AcceptTime != 0 {
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(m.AcceptTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinterAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintWindow != 0 {
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(m.MintWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MintRateLimit.Size()
+		i -= size
+		if _, err := m.MintRateLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.WindowStart != 0 {
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthorityMetadata(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomAuthorityMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if m.MintLimits != nil {
+		l = m.MintLimits.Size()
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if m.PendingMintLimits != nil {
+		l = m.PendingMintLimits.Size()
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if m.PendingMintLimitsEffectiveTime != 0 {
+		n += 1 + sovAuthorityMetadata(uint64(m.PendingMintLimitsEffectiveTime))
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.MinterAllowances) > 0 {
+		for _, e := range m.MinterAllowances {
+			l = e.Size()
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if m.ForceTransferRenounced {
+		n += 2
+	}
+	if m.PendingAdminTransfer != nil {
+		l = m.PendingAdminTransfer.Size()
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingAdminTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if m.AcceptTime != 0 {
+		n += 1 + sovAuthorityMetadata(uint64(m.AcceptTime))
+	}
+	if m.ExpireTime != 0 {
+		n += 1 + sovAuthorityMetadata(uint64(m.ExpireTime))
+	}
+	return n
+}
+
+func (m *RoleAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovAuthorityMetadata(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MinterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
+	return n
+}
+
+func (m *MintLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
+	l = m.MintRateLimit.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
+	if m.MintWindow != 0 {
+		n += 1 + sovAuthorityMetadata(uint64(m.MintWindow))
+	}
+	return n
+}
+
+func (m *MintWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStart != 0 {
+		n += 1 + sovAuthorityMetadata(uint64(m.WindowStart))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
+	return n
+}
+
+func sovAuthorityMetadata(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozAuthorityMetadata(x uint64) (n int) {
 	return sovAuthorityMetadata(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
+func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintLimits == nil {
+				m.MintLimits = &MintLimits{}
+			}
+			if err := m.MintLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMintLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingMintLimits == nil {
+				m.PendingMintLimits = &MintLimits{}
+			}
+			if err := m.PendingMintLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMintLimitsEffectiveTime", wireType)
+			}
+			m.PendingMintLimitsEffectiveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingMintLimitsEffectiveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, RoleAssignment{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterAllowances = append(m.MinterAllowances, MinterAllowance{})
+			if err := m.MinterAllowances[len(m.MinterAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransferRenounced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceTransferRenounced = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingAdminTransfer == nil {
+				m.PendingAdminTransfer = &PendingAdminTransfer{}
+			}
+			if err := m.PendingAdminTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAdminTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAdminTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAdminTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptTime", wireType)
+			}
+			m.AcceptTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			m.ExpireTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinterAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinterAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -207,15 +1831,15 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomAuthorityMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomAuthorityMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -243,7 +1867,165 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintWindow", wireType)
+			}
+			m.MintWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
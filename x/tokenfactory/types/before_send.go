@@ -1,7 +1,11 @@
 package types
 
 import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type BlockBeforeSendSudoMsg struct {
@@ -13,13 +17,87 @@ type TrackBeforeSendSudoMsg struct {
 }
 
 type TrackBeforeSendMsg struct {
-	From   string           `json:"from"`
-	To     string           `json:"to"`
-	Amount wasmvmtypes.Coin `json:"amount"`
+	From        string           `json:"from"`
+	To          string           `json:"to"`
+	Amount      wasmvmtypes.Coin `json:"amount"`
+	IBCTransfer *IBCTransfer     `json:"ibc_transfer,omitempty"`
 }
 
 type BlockBeforeSendMsg struct {
-	From   string           `json:"from"`
-	To     string           `json:"to"`
-	Amount wasmvmtypes.Coin `json:"amount"`
+	From        string           `json:"from"`
+	To          string           `json:"to"`
+	Amount      wasmvmtypes.Coin `json:"amount"`
+	IBCTransfer *IBCTransfer     `json:"ibc_transfer,omitempty"`
+}
+
+// IBCTransfer is passed to before send hooks when tokens are sent to an IBC escrow address. The fields are empty if the
+// escrow was not made by an ICS-20 transfer.
+type IBCTransfer struct {
+	SourcePort    string `json:"source_port"`
+	SourceChannel string `json:"source_channel"`
+	Receiver      string `json:"receiver"`
+}
+
+type ibcTransferContextKey struct{}
+
+// WithIBCTransfer returns a context carrying the outbound ICS-20 transfer being executed, to be passed to the before send
+// hooks of the escrowed denoms
+func WithIBCTransfer(ctx sdk.Context, transfer IBCTransfer) sdk.Context {
+	return ctx.WithValue(ibcTransferContextKey{}, transfer)
+}
+
+// GetIBCTransfer returns the outbound ICS-20 transfer carried by the context, if any
+func GetIBCTransfer(ctx context.Context) (IBCTransfer, bool) {
+	transfer, ok := ctx.Value(ibcTransferContextKey{}).(IBCTransfer)
+	return transfer, ok
+}
+
+// MaxBeforeSendHooks is the maximum number of before send hooks a denom can have
+const MaxBeforeSendHooks = 5
+
+// NewBlockBeforeSendHook returns a blocking hook with the default gas limit, the behaviour of the single hook set with
+// MsgSetBeforeSendHook
+func NewBlockBeforeSendHook(contractAddr string) BeforeSendHook {
+	return BeforeSendHook{
+		ContractAddr: contractAddr,
+		Mode:         HOOK_MODE_BLOCK,
+	}
+}
+
+// Validate checks the contract address, mode and gas limit of a hook
+func (h BeforeSendHook) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(h.ContractAddr); err != nil {
+		return errorsmod.Wrapf(ErrInvalidHookContractAddress, "invalid hook contract address (%s)", err)
+	}
+
+	if _, ok := HookMode_name[int32(h.Mode)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidBeforeSendHooks, "unknown hook mode %d", h.Mode)
+	}
+
+	if h.GasLimit > TrackBeforeSendGasLimit {
+		return errorsmod.Wrapf(ErrInvalidBeforeSendHooks, "gas limit %d of hook %s exceeds the maximum of %d", h.GasLimit, h.ContractAddr, TrackBeforeSendGasLimit)
+	}
+
+	return nil
+}
+
+// ValidateBeforeSendHooks checks every hook of a denom and that no contract is called twice
+func ValidateBeforeSendHooks(hooks []BeforeSendHook) error {
+	if len(hooks) > MaxBeforeSendHooks {
+		return errorsmod.Wrapf(ErrInvalidBeforeSendHooks, "a denom can have at most %d hooks", MaxBeforeSendHooks)
+	}
+
+	seen := make(map[string]bool, len(hooks))
+	for _, hook := range hooks {
+		if err := hook.Validate(); err != nil {
+			return err
+		}
+
+		if seen[hook.ContractAddr] {
+			return errorsmod.Wrapf(ErrInvalidBeforeSendHooks, "duplicate hook %s", hook.ContractAddr)
+		}
+		seen[hook.ContractAddr] = true
+	}
+
+	return nil
 }
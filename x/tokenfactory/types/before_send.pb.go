@@ -0,0 +1,699 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/before_send.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HookMode defines how a before send hook is called on transfers of a denom
+type HookMode int32
+
+const (
+	// The hook is sent block_before_send messages and can reject transfers. It
+	// is also sent track_before_send messages, whose errors are ignored.
+	HOOK_MODE_BLOCK HookMode = 0
+	// The hook is only sent track_before_send messages and cannot reject
+	// transfers
+	HOOK_MODE_TRACK HookMode = 1
+)
+
+var HookMode_name = map[int32]string{
+	0: "HOOK_MODE_BLOCK",
+	1: "HOOK_MODE_TRACK",
+}
+
+var HookMode_value = map[string]int32{
+	"HOOK_MODE_BLOCK": 0,
+	"HOOK_MODE_TRACK": 1,
+}
+
+func (x HookMode) String() string {
+	return proto.EnumName(HookMode_name, int32(x))
+}
+
+func (HookMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ac0f7b6ca19a2c3, []int{0}
+}
+
+// BeforeSendHook is a whitelisted contract called before transfers of a denom
+type BeforeSendHook struct {
+	ContractAddr string   `protobuf:"bytes,1,opt,name=contract_addr,json=contractAddr,proto3" json:"contract_addr,omitempty" yaml:"contract_addr"`
+	Mode         HookMode `protobuf:"varint,2,opt,name=mode,proto3,enum=osmosis.tokenfactory.v1beta1.HookMode" json:"mode,omitempty" yaml:"mode"`
+	// Maximum gas a single call of the hook can consume. Zero uses the
+	// TrackBeforeSendGasLimit for track_before_send messages and leaves
+	// block_before_send messages limited by the transaction gas only.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+	// Whether messages about sends to IBC escrow addresses carry the outbound
+	// ICS-20 transfer in an ibc_transfer field. Off by default since contracts
+	// rejecting unknown fields would fail on it.
+	IbcTransferContext bool `protobuf:"varint,4,opt,name=ibc_transfer_context,json=ibcTransferContext,proto3" json:"ibc_transfer_context,omitempty" yaml:"ibc_transfer_context"`
+}
+
+func (m *BeforeSendHook) Reset()         { *m = BeforeSendHook{} }
+func (m *BeforeSendHook) String() string { return proto.CompactTextString(m) }
+func (*BeforeSendHook) ProtoMessage()    {}
+func (*BeforeSendHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ac0f7b6ca19a2c3, []int{0}
+}
+func (m *BeforeSendHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeforeSendHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeforeSendHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeforeSendHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeforeSendHook.Merge(m, src)
+}
+func (m *BeforeSendHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeforeSendHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeforeSendHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeforeSendHook proto.InternalMessageInfo
+
+func (m *BeforeSendHook) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *BeforeSendHook) GetMode() HookMode {
+	if m != nil {
+		return m.Mode
+	}
+	return HOOK_MODE_BLOCK
+}
+
+func (m *BeforeSendHook) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *BeforeSendHook) GetIbcTransferContext() bool {
+	if m != nil {
+		return m.IbcTransferContext
+	}
+	return false
+}
+
+// BeforeSendHooks is the ordered list of before send hooks of a denom
+type BeforeSendHooks struct {
+	Hooks []BeforeSendHook `protobuf:"bytes,1,rep,name=hooks,proto3" json:"hooks"`
+}
+
+func (m *BeforeSendHooks) Reset()         { *m = BeforeSendHooks{} }
+func (m *BeforeSendHooks) String() string { return proto.CompactTextString(m) }
+func (*BeforeSendHooks) ProtoMessage()    {}
+func (*BeforeSendHooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ac0f7b6ca19a2c3, []int{1}
+}
+func (m *BeforeSendHooks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeforeSendHooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeforeSendHooks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeforeSendHooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeforeSendHooks.Merge(m, src)
+}
+func (m *BeforeSendHooks) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeforeSendHooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeforeSendHooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeforeSendHooks proto.InternalMessageInfo
+
+func (m *BeforeSendHooks) GetHooks() []BeforeSendHook {
+	if m != nil {
+		return m.Hooks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.tokenfactory.v1beta1.HookMode", HookMode_name, HookMode_value)
+	proto.RegisterType((*BeforeSendHook)(nil), "osmosis.tokenfactory.v1beta1.BeforeSendHook")
+	proto.RegisterType((*BeforeSendHooks)(nil), "osmosis.tokenfactory.v1beta1.BeforeSendHooks")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/before_send.proto", fileDescriptor_9ac0f7b6ca19a2c3)
+}
+
+var fileDescriptor_9ac0f7b6ca19a2c3 = []byte{
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x33, 0x6d, 0x94, 0xed, 0x54, 0xbb, 0x4b, 0xdc, 0x43, 0xa8, 0x92, 0x84, 0x1c, 0x24,
+	0x88, 0x26, 0x6c, 0x45, 0x0f, 0x0b, 0x1e, 0x3a, 0xab, 0xb0, 0xb0, 0x2d, 0xab, 0xb1, 0x27, 0x3d,
+	0x84, 0x49, 0x66, 0x36, 0x0d, 0x6d, 0xf2, 0xca, 0xcc, 0xb4, 0x74, 0xbf, 0x81, 0x47, 0x3f, 0x82,
+	0xe0, 0xc5, 0x8f, 0xd2, 0x63, 0x8f, 0x9e, 0x82, 0xec, 0x5e, 0x3c, 0xef, 0x27, 0x90, 0x24, 0xbb,
+	0x62, 0x40, 0x7a, 0xfb, 0xe7, 0xbd, 0xdf, 0xff, 0x9f, 0x37, 0x8f, 0x87, 0x7d, 0x90, 0x39, 0xc8,
+	0x4c, 0x06, 0x0a, 0xce, 0x78, 0x31, 0xa3, 0x89, 0x02, 0x31, 0x0f, 0xae, 0x06, 0x31, 0x57, 0x74,
+	0x10, 0xc4, 0x7c, 0x06, 0x82, 0x47, 0x92, 0x17, 0xcc, 0xbf, 0x10, 0xa0, 0xc0, 0x78, 0xb2, 0xe6,
+	0xfd, 0x7f, 0x79, 0x7f, 0xcd, 0xef, 0xf7, 0x53, 0x48, 0xa1, 0x06, 0x83, 0x4a, 0x35, 0x1e, 0xf7,
+	0xc7, 0x16, 0xde, 0x23, 0x75, 0xd2, 0x47, 0x5e, 0xb0, 0x31, 0xc0, 0x99, 0xf1, 0x06, 0x3f, 0x4c,
+	0xa0, 0x50, 0x82, 0x26, 0x2a, 0xa2, 0x8c, 0x09, 0x13, 0x39, 0xc8, 0xdb, 0x21, 0xe6, 0xaa, 0xb4,
+	0xfb, 0x73, 0x9a, 0x9f, 0x0f, 0xdd, 0x56, 0xdb, 0x0d, 0x1f, 0x6c, 0xbe, 0x0f, 0x19, 0x13, 0xc6,
+	0x04, 0xeb, 0x39, 0x30, 0x6e, 0x6e, 0x39, 0xc8, 0xdb, 0x3b, 0x78, 0xea, 0xdf, 0x35, 0x94, 0x5f,
+	0xfd, 0xf0, 0x18, 0x18, 0x27, 0xdd, 0x55, 0x69, 0xef, 0x36, 0xe9, 0x95, 0xdb, 0x0d, 0xeb, 0x10,
+	0x63, 0x80, 0x77, 0x52, 0x2a, 0xa3, 0xf3, 0x2c, 0xcf, 0x94, 0xb9, 0xed, 0x20, 0x4f, 0x27, 0xfd,
+	0x55, 0x69, 0xf7, 0x1a, 0xf2, 0x6f, 0xcb, 0x0d, 0x3b, 0x29, 0x95, 0x47, 0x95, 0x34, 0x3e, 0xe0,
+	0x7e, 0x16, 0x27, 0x91, 0x12, 0xb4, 0x90, 0x33, 0x2e, 0xa2, 0x6a, 0x38, 0x7e, 0xad, 0x4c, 0xdd,
+	0x41, 0x5e, 0x87, 0xd8, 0xab, 0xd2, 0x7e, 0xdc, 0xb8, 0xff, 0x47, 0xb9, 0xa1, 0x91, 0xc5, 0xc9,
+	0xc9, 0xba, 0x3a, 0x6a, 0x8a, 0x43, 0xfd, 0xf7, 0x37, 0x1b, 0xb9, 0x9f, 0x71, 0xb7, 0xbd, 0x29,
+	0x69, 0x8c, 0xf1, 0xbd, 0xd3, 0x4a, 0x98, 0xc8, 0xd9, 0xf6, 0x76, 0x0f, 0x9e, 0xdf, 0xfd, 0xd8,
+	0xb6, 0x9b, 0xe8, 0x37, 0xa5, 0xad, 0x85, 0x4d, 0xc0, 0xb3, 0x21, 0xee, 0x6c, 0x76, 0x61, 0x3c,
+	0xc2, 0xdd, 0xf1, 0x74, 0x3a, 0x89, 0x8e, 0xa7, 0x6f, 0xdf, 0x45, 0xe4, 0x68, 0x3a, 0x9a, 0xf4,
+	0xb4, 0x76, 0xf1, 0x24, 0x3c, 0x1c, 0x4d, 0x7a, 0x68, 0x5f, 0xff, 0xf2, 0xdd, 0xd2, 0xc8, 0xfb,
+	0x9b, 0x85, 0x85, 0x6e, 0x17, 0x16, 0xfa, 0xb5, 0xb0, 0xd0, 0xd7, 0xa5, 0xa5, 0xdd, 0x2e, 0x2d,
+	0xed, 0xe7, 0xd2, 0xd2, 0x3e, 0xbd, 0x4e, 0x33, 0x75, 0x7a, 0x19, 0xfb, 0x09, 0xe4, 0x41, 0xc1,
+	0x2f, 0x95, 0x80, 0xe2, 0x05, 0x88, 0x74, 0xa3, 0x83, 0xab, 0x57, 0xc1, 0x75, 0xfb, 0xba, 0xd4,
+	0xfc, 0x82, 0xcb, 0xf8, 0x7e, 0x7d, 0x1c, 0x2f, 0xff, 0x0c, 0x00, 0x90, 0x80, 0xc1, 0x60, 0x82,
+	0x02, 0x00, 0x00,
+}
+
+func (this *BeforeSendHook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BeforeSendHook)
+	if !ok {
+		that2, ok := that.(BeforeSendHook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddr != that1.ContractAddr {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.IbcTransferContext != that1.IbcTransferContext {
+		return false
+	}
+	return true
+}
+func (m *BeforeSendHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeforeSendHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeforeSendHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IbcTransferContext {
+		i--
+		if m.IbcTransferContext {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintBeforeSend(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Mode != 0 {
+		i = encodeVarintBeforeSend(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintBeforeSend(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeforeSendHooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeforeSendHooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeforeSendHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeforeSend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBeforeSend(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBeforeSend(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BeforeSendHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovBeforeSend(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovBeforeSend(uint64(m.Mode))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovBeforeSend(uint64(m.GasLimit))
+	}
+	if m.IbcTransferContext {
+		n += 2
+	}
+	return n
+}
+
+func (m *BeforeSendHooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovBeforeSend(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBeforeSend(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBeforeSend(x uint64) (n int) {
+	return sovBeforeSend(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BeforeSendHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeforeSend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeforeSendHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeforeSendHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeforeSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeforeSend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeforeSend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeforeSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= HookMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeforeSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcTransferContext", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeforeSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IbcTransferContext = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeforeSend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeforeSend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeforeSendHooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeforeSend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeforeSendHooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeforeSendHooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeforeSend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeforeSend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeforeSend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, BeforeSendHook{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeforeSend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeforeSend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBeforeSend(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBeforeSend
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBeforeSend
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBeforeSend
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBeforeSend
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBeforeSend
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBeforeSend
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBeforeSend        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBeforeSend          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBeforeSend = fmt.Errorf("proto: unexpected end of group")
+)
@@ -14,7 +14,24 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	// cdc.RegisterConcrete(&MsgForceTransfer{}, "osmosis/tokenfactory/force-transfer", nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, "osmosis/tokenfactory/change-admin", nil)
 	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, "osmosis/tokenfactory/set-beforesend-hook", nil)
+	cdc.RegisterConcrete(&MsgSetBeforeSendHooks{}, "osmosis/tokenfactory/set-beforesend-hooks", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "osmosis/tokenfactory/update-params", nil)
+	cdc.RegisterConcrete(&MsgSetMintLimits{}, "osmosis/tokenfactory/set-mint-limits", nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, "osmosis/tokenfactory/grant-role", nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, "osmosis/tokenfactory/revoke-role", nil)
+	cdc.RegisterConcrete(&MsgSetMinterAllowance{}, "osmosis/tokenfactory/set-minter-allowance", nil)
+	cdc.RegisterConcrete(&MsgRenounceForceTransfer{}, "osmosis/tokenfactory/renounce-force-transfer", nil)
+	cdc.RegisterConcrete(&MsgSetDenomPaused{}, "osmosis/tokenfactory/set-denom-paused", nil)
+	cdc.RegisterConcrete(&MsgSetAddressFrozen{}, "osmosis/tokenfactory/set-address-frozen", nil)
+	cdc.RegisterConcrete(&MsgForceUnfreeze{}, "osmosis/tokenfactory/force-unfreeze", nil)
+	cdc.RegisterConcrete(&MsgMintVested{}, "osmosis/tokenfactory/mint-vested", nil)
+	cdc.RegisterConcrete(&MsgClaimVested{}, "osmosis/tokenfactory/claim-vested", nil)
+	cdc.RegisterConcrete(&MsgSetDenomRegistryEntry{}, "osmosis/tokenfactory/set-denom-registry-entry", nil)
+	cdc.RegisterConcrete(&MsgRemoveDenomRegistryEntry{}, "osmosis/tokenfactory/remove-denom-registry-entry", nil)
+	cdc.RegisterConcrete(&MsgProposeAdmin{}, "osmosis/tokenfactory/propose-admin", nil)
+	cdc.RegisterConcrete(&MsgAcceptAdmin{}, "osmosis/tokenfactory/accept-admin", nil)
+	cdc.RegisterConcrete(&MsgCancelAdminTransfer{}, "osmosis/tokenfactory/cancel-admin-transfer", nil)
+	cdc.RegisterConcrete(&MsgRenounceAdmin{}, "osmosis/tokenfactory/renounce-admin", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -26,7 +43,24 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		// &MsgForceTransfer{},
 		&MsgChangeAdmin{},
 		&MsgSetBeforeSendHook{},
+		&MsgSetBeforeSendHooks{},
 		&MsgUpdateParams{},
+		&MsgSetMintLimits{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgSetMinterAllowance{},
+		&MsgRenounceForceTransfer{},
+		&MsgSetDenomPaused{},
+		&MsgSetAddressFrozen{},
+		&MsgForceUnfreeze{},
+		&MsgMintVested{},
+		&MsgClaimVested{},
+		&MsgSetDenomRegistryEntry{},
+		&MsgRemoveDenomRegistryEntry{},
+		&MsgProposeAdmin{},
+		&MsgAcceptAdmin{},
+		&MsgCancelAdminTransfer{},
+		&MsgRenounceAdmin{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -1,6 +1,6 @@
 package types
 
-const ConsensusVersion = 2
+const ConsensusVersion = 4
 
 // TrackBeforeSendGasLimit value is increased to allow existing approved tokenfactory hooks to work properly.
 // In the next coordinated upgrade, this will become a chain parameter.
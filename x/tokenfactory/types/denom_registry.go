@@ -0,0 +1,50 @@
+package types
+
+import (
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxDenomRegistryDecimals is the maximum exponent of the display denom of a registry entry
+	MaxDenomRegistryDecimals = 18
+	// MaxDenomRegistryURILength is the maximum length of the URI of a registry entry
+	MaxDenomRegistryURILength = 512
+)
+
+// Validate checks the denom, display fields and URI hash of a registry entry
+func (e DenomRegistryEntry) Validate() error {
+	if err := sdk.ValidateDenom(e.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenomRegistryEntry, "invalid denom (%s)", err)
+	}
+
+	if e.Display == "" {
+		return errorsmod.Wrapf(ErrInvalidDenomRegistryEntry, "empty display denom of %s", e.Denom)
+	}
+
+	if e.Symbol == "" {
+		return errorsmod.Wrapf(ErrInvalidDenomRegistryEntry, "empty symbol of %s", e.Denom)
+	}
+
+	if e.Decimals > MaxDenomRegistryDecimals {
+		return errorsmod.Wrapf(ErrInvalidDenomRegistryEntry, "decimals %d of %s exceed the maximum of %d", e.Decimals, e.Denom, MaxDenomRegistryDecimals)
+	}
+
+	if len(e.URI) > MaxDenomRegistryURILength {
+		return errorsmod.Wrapf(ErrInvalidDenomRegistryEntry, "uri of %s is longer than %d", e.Denom, MaxDenomRegistryURILength)
+	}
+
+	if e.URIHash != "" {
+		if e.URI == "" {
+			return errorsmod.Wrapf(ErrInvalidDenomRegistryEntry, "uri hash of %s without uri", e.Denom)
+		}
+
+		if hash, err := hex.DecodeString(e.URIHash); err != nil || len(hash) != 32 {
+			return errorsmod.Wrapf(ErrInvalidDenomRegistryEntry, "uri hash of %s is not a hex encoded sha256 hash", e.Denom)
+		}
+	}
+
+	return nil
+}
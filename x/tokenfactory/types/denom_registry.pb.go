@@ -0,0 +1,662 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/denom_registry.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomRegistryEntry is the governance curated metadata of a factory, IBC or
+// native denom
+type DenomRegistryEntry struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// Denom to display to users, e.g. NTRN
+	Display string `protobuf:"bytes,2,opt,name=display,proto3" json:"display,omitempty" yaml:"display"`
+	// Exponent of the display denom relative to the base denom
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty" yaml:"decimals"`
+	Symbol   string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	// URI of a document with additional information, e.g. a logo
+	URI string `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty" yaml:"uri"`
+	// Hex encoded sha256 hash of the document the URI points to
+	URIHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty" yaml:"uri_hash"`
+	// Whether governance verified the entry as the canonical metadata of the
+	// denom, e.g. of the origin chain of an IBC denom
+	Verified bool `protobuf:"varint,7,opt,name=verified,proto3" json:"verified,omitempty" yaml:"verified"`
+}
+
+func (m *DenomRegistryEntry) Reset()         { *m = DenomRegistryEntry{} }
+func (m *DenomRegistryEntry) String() string { return proto.CompactTextString(m) }
+func (*DenomRegistryEntry) ProtoMessage()    {}
+func (*DenomRegistryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87f57e1d08976e29, []int{0}
+}
+func (m *DenomRegistryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRegistryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRegistryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRegistryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRegistryEntry.Merge(m, src)
+}
+func (m *DenomRegistryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRegistryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRegistryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRegistryEntry proto.InternalMessageInfo
+
+func (m *DenomRegistryEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomRegistryEntry) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
+}
+
+func (m *DenomRegistryEntry) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *DenomRegistryEntry) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *DenomRegistryEntry) GetURI() string {
+	if m != nil {
+		return m.URI
+	}
+	return ""
+}
+
+func (m *DenomRegistryEntry) GetURIHash() string {
+	if m != nil {
+		return m.URIHash
+	}
+	return ""
+}
+
+func (m *DenomRegistryEntry) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*DenomRegistryEntry)(nil), "osmosis.tokenfactory.v1beta1.DenomRegistryEntry")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/denom_registry.proto", fileDescriptor_87f57e1d08976e29)
+}
+
+var fileDescriptor_87f57e1d08976e29 = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x3b, 0xdb, 0xdd, 0xa6, 0x0e, 0xae, 0x7f, 0x46, 0x85, 0x20, 0x92, 0x29, 0x73, 0xd0,
+	0x0a, 0x9a, 0xa1, 0x88, 0x82, 0x7b, 0x2c, 0x0a, 0xee, 0x4d, 0x06, 0xf6, 0xe2, 0xa5, 0x24, 0xcd,
+	0x34, 0x19, 0x4c, 0x32, 0x65, 0x66, 0x52, 0xcc, 0xb7, 0xf0, 0x23, 0xf8, 0x71, 0x3c, 0xf6, 0xe8,
+	0x29, 0x68, 0x7a, 0xf1, 0x9c, 0x4f, 0x20, 0x99, 0x24, 0xb5, 0x7b, 0x7b, 0x79, 0x9f, 0xdf, 0xf3,
+	0xcc, 0x03, 0xf3, 0xc2, 0x85, 0xd4, 0x99, 0xd4, 0x42, 0x53, 0x23, 0xbf, 0xf2, 0x7c, 0x13, 0xac,
+	0x8d, 0x54, 0x25, 0xdd, 0x2d, 0x42, 0x6e, 0x82, 0x05, 0x8d, 0x78, 0x2e, 0xb3, 0x95, 0xe2, 0xb1,
+	0xd0, 0x46, 0x95, 0xfe, 0x56, 0x49, 0x23, 0xd1, 0xb3, 0xde, 0xe2, 0x9f, 0x5a, 0xfc, 0xde, 0xf2,
+	0xf4, 0x71, 0x2c, 0x63, 0x69, 0x41, 0xda, 0x4e, 0x9d, 0x87, 0xfc, 0x39, 0x83, 0xe8, 0x43, 0x1b,
+	0xc6, 0xfa, 0xac, 0x8f, 0xb9, 0x51, 0x25, 0x7a, 0x0e, 0x2f, 0xec, 0x13, 0x2e, 0x98, 0x81, 0xf9,
+	0x9d, 0xe5, 0x83, 0xa6, 0xc2, 0x77, 0xcb, 0x20, 0x4b, 0xaf, 0x88, 0x5d, 0x13, 0xd6, 0xc9, 0xe8,
+	0x15, 0x74, 0x22, 0xa1, 0xb7, 0x69, 0x50, 0xba, 0x67, 0x96, 0x44, 0x4d, 0x85, 0xef, 0xf5, 0x64,
+	0x27, 0x10, 0x36, 0x20, 0x88, 0xc2, 0x69, 0xc4, 0xd7, 0x22, 0x0b, 0x52, 0xed, 0x8e, 0x67, 0x60,
+	0x7e, 0xb9, 0x7c, 0xd4, 0x54, 0xf8, 0xfe, 0x10, 0xdc, 0x29, 0x84, 0x1d, 0x21, 0xf4, 0x12, 0x4e,
+	0x74, 0x99, 0x85, 0x32, 0x75, 0xcf, 0x6d, 0xfa, 0xc3, 0xa6, 0xc2, 0x97, 0x1d, 0xde, 0xed, 0x09,
+	0xeb, 0x01, 0xf4, 0x02, 0x8e, 0x0b, 0x25, 0xdc, 0x0b, 0xcb, 0x3d, 0xa9, 0x2b, 0x3c, 0xbe, 0x61,
+	0xd7, 0x4d, 0x85, 0x61, 0x87, 0x17, 0x4a, 0x10, 0xd6, 0x12, 0xe8, 0x3d, 0x9c, 0x16, 0x4a, 0xac,
+	0x92, 0x40, 0x27, 0xee, 0xc4, 0xd2, 0x5e, 0x5d, 0x61, 0xe7, 0x86, 0x5d, 0x7f, 0x0a, 0x74, 0xf2,
+	0xbf, 0xcf, 0x00, 0x11, 0xe6, 0x14, 0x4a, 0xb4, 0x5a, 0xdb, 0x7f, 0xc7, 0x95, 0xd8, 0x08, 0x1e,
+	0xb9, 0xce, 0x0c, 0xcc, 0xa7, 0xa7, 0xfd, 0x07, 0x85, 0xb0, 0x23, 0x74, 0x75, 0xfe, 0xf7, 0x07,
+	0x06, 0xcb, 0xcf, 0x3f, 0x6b, 0x0f, 0xec, 0x6b, 0x0f, 0xfc, 0xae, 0x3d, 0xf0, 0xfd, 0xe0, 0x8d,
+	0xf6, 0x07, 0x6f, 0xf4, 0xeb, 0xe0, 0x8d, 0xbe, 0xbc, 0x8b, 0x85, 0x49, 0x8a, 0xd0, 0x5f, 0xcb,
+	0x8c, 0xe6, 0xbc, 0x30, 0x4a, 0xe6, 0xaf, 0xa5, 0x8a, 0x87, 0x99, 0xee, 0xde, 0xd2, 0x6f, 0xb7,
+	0x0f, 0xc0, 0x94, 0x5b, 0xae, 0xc3, 0x89, 0xfd, 0xbc, 0x37, 0xff, 0x06, 0x00, 0xa4, 0x86, 0x42,
+	0x01, 0x25, 0x02, 0x00, 0x00,
+}
+
+func (this *DenomRegistryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomRegistryEntry)
+	if !ok {
+		that2, ok := that.(DenomRegistryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Display != that1.Display {
+		return false
+	}
+	if this.Decimals != that1.Decimals {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.URI != that1.URI {
+		return false
+	}
+	if this.URIHash != that1.URIHash {
+		return false
+	}
+	if this.Verified != that1.Verified {
+		return false
+	}
+	return true
+}
+func (m *DenomRegistryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRegistryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRegistryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintDenomRegistry(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintDenomRegistry(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintDenomRegistry(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintDenomRegistry(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintDenomRegistry(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDenomRegistry(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDenomRegistry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDenomRegistry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomRegistryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDenomRegistry(uint64(l))
+	}
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovDenomRegistry(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovDenomRegistry(uint64(m.Decimals))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovDenomRegistry(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovDenomRegistry(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovDenomRegistry(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func sovDenomRegistry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDenomRegistry(x uint64) (n int) {
+	return sovDenomRegistry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomRegistryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenomRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRegistryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRegistryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenomRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenomRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDenomRegistry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDenomRegistry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDenomRegistry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDenomRegistry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDenomRegistry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDenomRegistry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDenomRegistry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDenomRegistry = fmt.Errorf("proto: unexpected end of group")
+)
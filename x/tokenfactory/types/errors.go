@@ -21,4 +21,20 @@ var (
 	ErrTrackBeforeSendOutOfGas      = errorsmod.Register(ModuleName, 12, "gas meter hit maximum limit")
 	ErrInvalidHookContractAddress   = errorsmod.Register(ModuleName, 13, "invalid hook contract address")
 	ErrBeforeSendHookNotWhitelisted = errorsmod.Register(ModuleName, 14, "beforeSendHook is not whitelisted")
+	ErrInvalidMintLimits            = errorsmod.Register(ModuleName, 15, "invalid mint limits")
+	ErrMaxSupplyExceeded            = errorsmod.Register(ModuleName, 16, "mint would exceed the max supply of the denom")
+	ErrMintRateLimitExceeded        = errorsmod.Register(ModuleName, 17, "mint would exceed the mint rate limit of the denom")
+	ErrInvalidRole                  = errorsmod.Register(ModuleName, 18, "invalid role")
+	ErrForceTransferRenounced       = errorsmod.Register(ModuleName, 19, "force transfers of the denom have been renounced")
+	ErrMinterAllowanceExceeded      = errorsmod.Register(ModuleName, 20, "mint would exceed the allowance of the minter")
+	ErrDenomPaused                  = errorsmod.Register(ModuleName, 21, "transfers of the denom are paused")
+	ErrAddressFrozen                = errorsmod.Register(ModuleName, 22, "address is frozen for the denom")
+	ErrInvalidBeforeSendHooks       = errorsmod.Register(ModuleName, 23, "invalid before send hooks")
+	ErrInvalidVestingSchedule       = errorsmod.Register(ModuleName, 24, "invalid vesting schedule")
+	ErrVestingScheduleNotFound      = errorsmod.Register(ModuleName, 25, "vesting schedule not found")
+	ErrNothingToClaim               = errorsmod.Register(ModuleName, 26, "nothing vested to claim")
+	ErrInvalidDenomRegistryEntry    = errorsmod.Register(ModuleName, 27, "invalid denom registry entry")
+	ErrDenomRegistryEntryNotFound   = errorsmod.Register(ModuleName, 28, "denom registry entry not found")
+	ErrNoPendingAdminTransfer       = errorsmod.Register(ModuleName, 29, "no pending admin transfer")
+	ErrAdminTransferNotAcceptable   = errorsmod.Register(ModuleName, 30, "admin transfer cannot be accepted")
 )
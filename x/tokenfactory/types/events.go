@@ -14,4 +14,20 @@ const (
 	AttributeNewAdmin              = "new_admin"
 	AttributeDenomMetadata         = "denom_metadata"
 	AttributeBeforeSendHookAddress = "before_send_hook_address"
+	AttributeBeforeSendHookMode    = "before_send_hook_mode"
+	AttributeMaxSupply             = "max_supply"
+	AttributeMintRateLimit         = "mint_rate_limit"
+	AttributeMintWindow            = "mint_window"
+	AttributeEffectiveTime         = "effective_time"
+	AttributeAddress               = "address"
+	AttributeRole                  = "role"
+	AttributeMinter                = "minter"
+	AttributeAllowance             = "allowance"
+	AttributePaused                = "paused"
+	AttributeFrozen                = "frozen"
+	AttributeVestingScheduleID     = "vesting_schedule_id"
+	AttributeRecipient             = "recipient"
+	AttributeVerified              = "verified"
+	AttributeAcceptTime            = "accept_time"
+	AttributeExpireTime            = "expire_time"
 )
@@ -44,9 +44,59 @@ func (gs GenesisState) Validate() error {
 			}
 		}
 
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid authority metadata (%s)", err)
+		}
+
+		if denom.MintWindow != nil && (denom.MintWindow.Minted.IsNil() || denom.MintWindow.Minted.IsNegative()) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid mint window for denom: %s", denom.GetDenom())
+		}
+
+		for _, address := range denom.FrozenAddresses {
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "invalid frozen address (%s)", err)
+			}
+		}
+
 		if _, err := sdk.AccAddressFromBech32(denom.HookContractAddress); denom.HookContractAddress != "" && err != nil {
 			return errorsmod.Wrapf(ErrInvalidHookContractAddress, "Invalid hook contract address (%s)", err)
 		}
+
+		if err := ValidateBeforeSendHooks(denom.BeforeSendHooks); err != nil {
+			return err
+		}
+	}
+
+	seenSchedules := map[uint64]bool{}
+	for _, schedule := range gs.GetVestingSchedules() {
+		if seenSchedules[schedule.Id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate vesting schedule: %d", schedule.Id)
+		}
+		seenSchedules[schedule.Id] = true
+
+		if schedule.Id >= gs.NextVestingScheduleId {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "vesting schedule id %d is not below the next id %d", schedule.Id, gs.NextVestingScheduleId)
+		}
+
+		if !seenDenoms[schedule.Amount.Denom] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "vesting schedule %d of unknown denom: %s", schedule.Id, schedule.Amount.Denom)
+		}
+
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenRegistryDenoms := map[string]bool{}
+	for _, entry := range gs.GetDenomRegistry() {
+		if seenRegistryDenoms[entry.Denom] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate denom registry entry: %s", entry.Denom)
+		}
+		seenRegistryDenoms[entry.Denom] = true
+
+		if err := entry.Validate(); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -26,8 +26,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the tokenfactory module's genesis state.
 type GenesisState struct {
 	// params defines the parameters of the module.
-	Params        Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	FactoryDenoms []GenesisDenom `protobuf:"bytes,2,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms" yaml:"factory_denoms"`
+	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	FactoryDenoms    []GenesisDenom    `protobuf:"bytes,2,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms" yaml:"factory_denoms"`
+	VestingSchedules []VestingSchedule `protobuf:"bytes,3,rep,name=vesting_schedules,json=vestingSchedules,proto3" json:"vesting_schedules" yaml:"vesting_schedules"`
+	// ID assigned to the next vesting schedule
+	NextVestingScheduleId uint64               `protobuf:"varint,4,opt,name=next_vesting_schedule_id,json=nextVestingScheduleId,proto3" json:"next_vesting_schedule_id,omitempty" yaml:"next_vesting_schedule_id"`
+	DenomRegistry         []DenomRegistryEntry `protobuf:"bytes,5,rep,name=denom_registry,json=denomRegistry,proto3" json:"denom_registry" yaml:"denom_registry"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,13 +81,40 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 	return nil
 }
 
+func (m *GenesisState) GetVestingSchedules() []VestingSchedule {
+	if m != nil {
+		return m.VestingSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextVestingScheduleId() uint64 {
+	if m != nil {
+		return m.NextVestingScheduleId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDenomRegistry() []DenomRegistryEntry {
+	if m != nil {
+		return m.DenomRegistry
+	}
+	return nil
+}
+
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
 // denom's admin.
 type GenesisDenom struct {
-	Denom               string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
-	AuthorityMetadata   DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
-	HookContractAddress string                 `protobuf:"bytes,3,opt,name=hook_contract_address,json=hookContractAddress,proto3" json:"hook_contract_address,omitempty"`
+	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// Deprecated: use before_send_hooks. Imported as a single blocking hook if
+	// before_send_hooks is empty.
+	HookContractAddress string           `protobuf:"bytes,3,opt,name=hook_contract_address,json=hookContractAddress,proto3" json:"hook_contract_address,omitempty"`
+	MintWindow          *MintWindow      `protobuf:"bytes,4,opt,name=mint_window,json=mintWindow,proto3" json:"mint_window,omitempty" yaml:"mint_window"`
+	Paused              bool             `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	FrozenAddresses     []string         `protobuf:"bytes,6,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty" yaml:"frozen_addresses"`
+	BeforeSendHooks     []BeforeSendHook `protobuf:"bytes,7,rep,name=before_send_hooks,json=beforeSendHooks,proto3" json:"before_send_hooks" yaml:"before_send_hooks"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -140,6 +171,34 @@ func (m *GenesisDenom) GetHookContractAddress() string {
 	return ""
 }
 
+func (m *GenesisDenom) GetMintWindow() *MintWindow {
+	if m != nil {
+		return m.MintWindow
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetBeforeSendHooks() []BeforeSendHook {
+	if m != nil {
+		return m.BeforeSendHooks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "osmosis.tokenfactory.v1beta1.GenesisDenom")
@@ -150,33 +209,50 @@ func init() {
 }
 
 var fileDescriptor_5749c3f71850298b = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0xae, 0xd2, 0x40,
-	0x14, 0xc6, 0x3b, 0xf7, 0x5e, 0x6f, 0x62, 0x2f, 0x1a, 0xad, 0x92, 0x54, 0x82, 0x2d, 0x74, 0x61,
-	0x08, 0x89, 0x6d, 0x40, 0x34, 0x86, 0x1d, 0x95, 0xc4, 0x95, 0x09, 0xa9, 0x3b, 0x37, 0xcd, 0xd0,
-	0x8e, 0xa5, 0xc1, 0xf6, 0x34, 0x33, 0x03, 0xb1, 0x2f, 0xe0, 0xda, 0x47, 0xf0, 0x41, 0x7c, 0x00,
-	0x96, 0x2c, 0x5d, 0x11, 0x03, 0x1b, 0xd7, 0xbc, 0x80, 0xa6, 0x33, 0xe3, 0x1f, 0x84, 0xb0, 0x9b,
-	0x9e, 0xf9, 0x9d, 0xef, 0x7c, 0xe7, 0xeb, 0xe8, 0x5d, 0x60, 0x19, 0xb0, 0x94, 0x79, 0x1c, 0xe6,
-	0x24, 0x7f, 0x8f, 0x23, 0x0e, 0xb4, 0xf4, 0x96, 0xbd, 0x29, 0xe1, 0xb8, 0xe7, 0x25, 0x24, 0x27,
-	0x2c, 0x65, 0x6e, 0x41, 0x81, 0x83, 0xd1, 0x54, 0xac, 0xfb, 0x2f, 0xeb, 0x2a, 0xb6, 0xf1, 0x30,
-	0x81, 0x04, 0x04, 0xe8, 0x55, 0x27, 0xd9, 0xd3, 0x68, 0x9f, 0xd4, 0x2f, 0x30, 0xc5, 0x99, 0x92,
-	0x6d, 0x0c, 0xce, 0x5a, 0xc0, 0x0b, 0x3e, 0x03, 0x9a, 0xf2, 0xf2, 0x0d, 0xe1, 0x38, 0xc6, 0x1c,
-	0xcb, 0x2e, 0xe7, 0x2b, 0xd2, 0x6b, 0xaf, 0xa5, 0xbd, 0xb7, 0x1c, 0x73, 0x62, 0x0c, 0xf5, 0x6b,
-	0x29, 0x6b, 0xa2, 0x16, 0xea, 0xdc, 0xf4, 0x9b, 0xee, 0x49, 0xbb, 0x13, 0xc1, 0xf8, 0x57, 0xab,
-	0x8d, 0xad, 0x05, 0xaa, 0xc3, 0x28, 0xf4, 0xbb, 0xea, 0x3e, 0x8c, 0x49, 0x0e, 0x19, 0x33, 0x2f,
-	0x5a, 0x97, 0x9d, 0x9b, 0x7e, 0xd7, 0x3d, 0xb7, 0xb2, 0xab, 0xe6, 0x8f, 0xab, 0x16, 0xff, 0x71,
-	0xa5, 0xb8, 0xdf, 0xd8, 0xf5, 0x12, 0x67, 0x1f, 0x86, 0xce, 0xa1, 0x9e, 0x13, 0xdc, 0x51, 0x85,
-	0xb1, 0xfc, 0xfe, 0xf9, 0xd7, 0xbe, 0xa8, 0x18, 0x4f, 0xf4, 0x5b, 0x02, 0x15, 0xee, 0x6f, 0xfb,
-	0xf7, 0xf6, 0x1b, 0xbb, 0x26, 0x95, 0x44, 0xd9, 0x09, 0xe4, 0xb5, 0xf1, 0x09, 0xe9, 0xc6, 0x9f,
-	0x4c, 0xc2, 0x4c, 0x85, 0x62, 0x5e, 0x88, 0x9d, 0x07, 0xe7, 0xfd, 0x8a, 0x49, 0xa3, 0xff, 0x03,
-	0xf5, 0xdb, 0xca, 0xf9, 0x23, 0x39, 0xef, 0x58, 0xdd, 0x09, 0xee, 0x1f, 0xfd, 0x06, 0xe3, 0xa5,
-	0x5e, 0x9f, 0x01, 0xcc, 0xc3, 0x08, 0x72, 0x4e, 0x71, 0xc4, 0x43, 0x1c, 0xc7, 0x94, 0x30, 0x66,
-	0x5e, 0x8a, 0x05, 0xaa, 0x80, 0x51, 0xf0, 0xa0, 0x42, 0x5e, 0x29, 0x62, 0x24, 0x81, 0xe1, 0xd5,
-	0x8f, 0x2f, 0x36, 0xf2, 0x27, 0xab, 0xad, 0x85, 0xd6, 0x5b, 0x0b, 0x7d, 0xdf, 0x5a, 0xe8, 0xf3,
-	0xce, 0xd2, 0xd6, 0x3b, 0x4b, 0xfb, 0xb6, 0xb3, 0xb4, 0x77, 0x2f, 0x92, 0x94, 0xcf, 0x16, 0x53,
-	0x37, 0x82, 0xcc, 0xcb, 0xc9, 0x82, 0x53, 0xc8, 0x9f, 0x02, 0x4d, 0x7e, 0x9f, 0xbd, 0xe5, 0x73,
-	0xef, 0xe3, 0xe1, 0x63, 0xe1, 0x65, 0x41, 0xd8, 0xf4, 0x5a, 0xbc, 0x8c, 0x67, 0xbf, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xe6, 0x0c, 0x91, 0x02, 0xd4, 0x02, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4d, 0x4f, 0xd4, 0x40,
+	0x18, 0xc7, 0xb7, 0xb0, 0xa0, 0xcc, 0xf2, 0xb6, 0xa3, 0x68, 0x45, 0xdc, 0x2e, 0x35, 0x31, 0x2b,
+	0x91, 0x56, 0x10, 0x8d, 0xe1, 0x46, 0x7d, 0x3f, 0x90, 0x90, 0x92, 0x68, 0x62, 0x4c, 0x9a, 0xd9,
+	0xed, 0xd0, 0x6d, 0xa0, 0x33, 0x9b, 0x99, 0xd9, 0x85, 0x25, 0x9e, 0x3d, 0xf3, 0x11, 0xfc, 0x38,
+	0x1c, 0xf1, 0xe6, 0xa9, 0x31, 0x70, 0xf1, 0xbc, 0x9f, 0xc0, 0x74, 0x66, 0xc0, 0x7d, 0xc1, 0xc6,
+	0x5b, 0x33, 0xfd, 0xfd, 0xff, 0xcf, 0xcb, 0x3c, 0xcf, 0x80, 0x15, 0xca, 0x13, 0xca, 0x63, 0xee,
+	0x0a, 0xba, 0x8f, 0xc9, 0x1e, 0x6a, 0x08, 0xca, 0xba, 0x6e, 0x67, 0xad, 0x8e, 0x05, 0x5a, 0x73,
+	0x23, 0x4c, 0x30, 0x8f, 0xb9, 0xd3, 0x62, 0x54, 0x50, 0xb8, 0xa4, 0x59, 0xa7, 0x9f, 0x75, 0x34,
+	0xbb, 0x78, 0x3b, 0xa2, 0x11, 0x95, 0xa0, 0x9b, 0x7d, 0x29, 0xcd, 0xe2, 0xf2, 0xb5, 0xfe, 0x2d,
+	0xc4, 0x50, 0xa2, 0x6d, 0x17, 0x37, 0x72, 0x53, 0x40, 0x6d, 0xd1, 0xa4, 0x2c, 0x16, 0xdd, 0x6d,
+	0x2c, 0x50, 0x88, 0x04, 0xd2, 0x2a, 0x27, 0x57, 0x55, 0xc7, 0x7b, 0x94, 0xe1, 0x80, 0x63, 0x12,
+	0x6a, 0x7e, 0x2d, 0x97, 0x0f, 0x31, 0xa1, 0x49, 0xc0, 0x70, 0x14, 0x73, 0xc1, 0xba, 0x5a, 0x92,
+	0xdf, 0x9b, 0x0e, 0xe6, 0x22, 0x26, 0x91, 0x62, 0xed, 0x93, 0x22, 0x98, 0x7e, 0xa7, 0xba, 0xb5,
+	0x2b, 0x90, 0xc0, 0x70, 0x13, 0x4c, 0xaa, 0x2a, 0x4d, 0xa3, 0x6a, 0xd4, 0x4a, 0xeb, 0x4b, 0xce,
+	0xb5, 0xdd, 0xdb, 0x91, 0x8c, 0x57, 0x3c, 0x4d, 0xad, 0x82, 0xaf, 0x15, 0xb0, 0x05, 0x66, 0xf5,
+	0xff, 0x40, 0x26, 0xc6, 0xcd, 0xb1, 0xea, 0x78, 0xad, 0xb4, 0xbe, 0xe2, 0xe4, 0xdd, 0x80, 0xa3,
+	0xe3, 0xbf, 0xce, 0x24, 0xde, 0x83, 0xcc, 0xb1, 0x97, 0x5a, 0x0b, 0x5d, 0x94, 0x1c, 0x6c, 0xda,
+	0x83, 0x7e, 0xb6, 0x3f, 0xa3, 0x0f, 0x24, 0xcc, 0xe1, 0x57, 0x50, 0xd6, 0xf5, 0x04, 0xbc, 0xd1,
+	0xc4, 0x61, 0xfb, 0x00, 0x73, 0x73, 0x5c, 0x06, 0x5d, 0xcd, 0x0f, 0xfa, 0x51, 0xc9, 0x76, 0xb5,
+	0xca, 0xab, 0xea, 0xb8, 0xa6, 0x8a, 0x3b, 0xe2, 0x6a, 0xfb, 0xf3, 0x9d, 0x41, 0x09, 0x87, 0x5f,
+	0x80, 0x49, 0xf0, 0x91, 0x08, 0x86, 0xe1, 0x20, 0x0e, 0xcd, 0x62, 0xd5, 0xa8, 0x15, 0xbd, 0x87,
+	0xbd, 0xd4, 0xb2, 0x94, 0xe3, 0xbf, 0x48, 0xdb, 0x5f, 0xc8, 0x7e, 0x0d, 0xe5, 0xf3, 0x21, 0x84,
+	0x1d, 0x30, 0x3b, 0x78, 0xbd, 0xe6, 0x84, 0x2c, 0xec, 0x69, 0x7e, 0x61, 0xb2, 0x33, 0xbe, 0x96,
+	0xbc, 0x21, 0x82, 0x75, 0x87, 0x7b, 0x3a, 0xe8, 0x6a, 0xfb, 0x33, 0x61, 0xbf, 0xc4, 0xfe, 0xf1,
+	0x77, 0x24, 0xa4, 0x17, 0x7c, 0x04, 0x26, 0x24, 0x21, 0x27, 0x62, 0xca, 0x9b, 0xef, 0xa5, 0xd6,
+	0x74, 0x9f, 0x93, 0xed, 0xab, 0xdf, 0xf0, 0x9b, 0x01, 0xe0, 0xd5, 0xd8, 0x07, 0x89, 0x9e, 0x7b,
+	0x73, 0x4c, 0xce, 0xd1, 0xc6, 0x7f, 0x64, 0xbd, 0x35, 0xbc, 0x33, 0xde, 0xb2, 0xce, 0xfc, 0x9e,
+	0x8a, 0x37, 0xea, 0x6e, 0xfb, 0xe5, 0x91, 0x4d, 0x83, 0x2f, 0xc1, 0x42, 0x93, 0xd2, 0xfd, 0xa0,
+	0x41, 0x89, 0x60, 0xa8, 0x21, 0x02, 0x14, 0x86, 0x0c, 0xf3, 0x6c, 0x32, 0xb2, 0x02, 0xb2, 0xa1,
+	0x35, 0xfc, 0x5b, 0x19, 0xf2, 0x4a, 0x13, 0x5b, 0x0a, 0x80, 0x08, 0x94, 0x92, 0x98, 0x88, 0xe0,
+	0x30, 0x26, 0x21, 0x3d, 0x94, 0x97, 0x58, 0x5a, 0xaf, 0xe5, 0xa7, 0xbe, 0x1d, 0x13, 0xf1, 0x49,
+	0xf2, 0xde, 0x9d, 0x5e, 0x6a, 0x41, 0x95, 0x6a, 0x9f, 0x8d, 0xed, 0x83, 0xe4, 0x8a, 0x81, 0x8f,
+	0xb3, 0x05, 0x6b, 0x73, 0x1c, 0x9a, 0x13, 0x55, 0xa3, 0x76, 0xd3, 0x2b, 0xf7, 0x52, 0x6b, 0x46,
+	0x69, 0xd4, 0xb9, 0xed, 0x6b, 0x00, 0xbe, 0x05, 0xf3, 0x7b, 0x8c, 0x1e, 0x63, 0x72, 0x59, 0x00,
+	0xe6, 0xe6, 0x64, 0x75, 0xbc, 0x36, 0xe5, 0xdd, 0xef, 0xa5, 0xd6, 0x5d, 0xbd, 0x21, 0x43, 0x84,
+	0xed, 0xcf, 0xa9, 0xa3, 0xad, 0xcb, 0x13, 0x78, 0x0c, 0xca, 0x7d, 0x0f, 0x4b, 0x90, 0x15, 0xce,
+	0xcd, 0x1b, 0x72, 0x98, 0x9e, 0xe4, 0xd7, 0xe6, 0x49, 0xd9, 0x2e, 0x26, 0xe1, 0x7b, 0x4a, 0xf7,
+	0x87, 0x97, 0x64, 0xc4, 0xd4, 0xf6, 0xe7, 0xea, 0x03, 0x0a, 0xbe, 0x59, 0xfc, 0xfd, 0xdd, 0x32,
+	0xbc, 0x9d, 0xd3, 0xf3, 0x8a, 0x71, 0x76, 0x5e, 0x31, 0x7e, 0x9d, 0x57, 0x8c, 0x93, 0x8b, 0x4a,
+	0xe1, 0xec, 0xa2, 0x52, 0xf8, 0x79, 0x51, 0x29, 0x7c, 0x7e, 0x11, 0xc5, 0xa2, 0xd9, 0xae, 0x3b,
+	0x0d, 0x9a, 0xb8, 0x04, 0xb7, 0x05, 0xa3, 0x64, 0x95, 0xb2, 0xe8, 0xf2, 0xdb, 0xed, 0x3c, 0x77,
+	0x8f, 0x06, 0x1f, 0x32, 0xd1, 0x6d, 0x61, 0x5e, 0x9f, 0x94, 0xef, 0xd7, 0xb3, 0x3f, 0x03, 0x00,
+	0xae, 0xcf, 0x9f, 0xd7, 0x09, 0x06, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -207,6 +283,28 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if this.HookContractAddress != that1.HookContractAddress {
 		return false
 	}
+	if !this.MintWindow.Equal(that1.MintWindow) {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if len(this.FrozenAddresses) != len(that1.FrozenAddresses) {
+		return false
+	}
+	for i := range this.FrozenAddresses {
+		if this.FrozenAddresses[i] != that1.FrozenAddresses[i] {
+			return false
+		}
+	}
+	if len(this.BeforeSendHooks) != len(that1.BeforeSendHooks) {
+		return false
+	}
+	for i := range this.BeforeSendHooks {
+		if !this.BeforeSendHooks[i].Equal(&that1.BeforeSendHooks[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -229,6 +327,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomRegistry) > 0 {
+		for iNdEx := len(m.DenomRegistry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRegistry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NextVestingScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextVestingScheduleId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FactoryDenoms) > 0 {
 		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -276,6 +407,51 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BeforeSendHooks) > 0 {
+		for iNdEx := len(m.BeforeSendHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeforeSendHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MintWindow != nil {
+		{
+			size, err := m.MintWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.HookContractAddress) > 0 {
 		i -= len(m.HookContractAddress)
 		copy(dAtA[i:], m.HookContractAddress)
@@ -328,6 +504,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextVestingScheduleId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextVestingScheduleId))
+	}
+	if len(m.DenomRegistry) > 0 {
+		for _, e := range m.DenomRegistry {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -347,6 +538,25 @@ func (m *GenesisDenom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.MintWindow != nil {
+		l = m.MintWindow.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BeforeSendHooks) > 0 {
+		for _, e := range m.BeforeSendHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -452,6 +662,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVestingScheduleId", wireType)
+			}
+			m.NextVestingScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextVestingScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRegistry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRegistry = append(m.DenomRegistry, DenomRegistryEntry{})
+			if err := m.DenomRegistry[len(m.DenomRegistry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -599,6 +896,128 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 			}
 			m.HookContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintWindow == nil {
+				m.MintWindow = &MintWindow{}
+			}
+			if err := m.MintWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHooks = append(m.BeforeSendHooks, BeforeSendHook{})
+			if err := m.BeforeSendHooks[len(m.BeforeSendHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -160,6 +160,48 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid denom registry",
+			genState: &types.GenesisState{
+				DenomRegistry: []types.DenomRegistryEntry{
+					{
+						Denom:    "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+						Display:  "atom",
+						Decimals: 6,
+						Symbol:   "ATOM",
+						URI:      "https://example.com/atom.png",
+						URIHash:  "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+						Verified: true,
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid denom registry uri hash",
+			genState: &types.GenesisState{
+				DenomRegistry: []types.DenomRegistryEntry{
+					{
+						Denom:   "untrn",
+						Display: "ntrn",
+						Symbol:  "NTRN",
+						URI:     "https://example.com/ntrn.png",
+						URIHash: "not a hash",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate denom registry entry",
+			genState: &types.GenesisState{
+				DenomRegistry: []types.DenomRegistryEntry{
+					{Denom: "untrn", Display: "ntrn", Symbol: "NTRN"},
+					{Denom: "untrn", Display: "ntrn", Symbol: "NTRN"},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
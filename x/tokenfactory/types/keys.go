@@ -26,16 +26,26 @@ const (
 	KeySeparator    = "|"
 	prefixParamsKey = iota + 1
 	prefixEscrowAddressKey
+	prefixNextVestingScheduleIDKey
+	prefixDenomRegistryKey
 )
 
 var (
 	DenomAuthorityMetadataKey      = "authoritymetadata"
+	DenomMintWindowKey             = "mintwindow"
+	DenomPausedKey                 = "paused"
 	DenomsPrefixKey                = "denoms"
 	CreatorPrefixKey               = "creator"
 	AdminPrefixKey                 = "admin"
 	BeforeSendHookAddressPrefixKey = "beforesendhook"
+	BeforeSendHooksPrefixKey       = "beforesendhooks"
+	FrozenAddressPrefixKey         = "frozen"
+	VestingSchedulePrefixKey       = "vestingschedule"
+	VestingRecipientPrefixKey      = "vestingrecipient"
 	ParamsKey                      = []byte{prefixParamsKey}
 	EscrowAddressKey               = []byte{prefixEscrowAddressKey}
+	NextVestingScheduleIDKey       = []byte{prefixNextVestingScheduleIDKey}
+	DenomRegistryKey               = []byte{prefixDenomRegistryKey}
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -54,3 +64,19 @@ func GetCreatorPrefix(creator string) []byte {
 func GetCreatorsPrefix() []byte {
 	return []byte(strings.Join([]string{CreatorPrefixKey, ""}, KeySeparator))
 }
+
+// GetFrozenAddressesPrefix returns the store prefix where the addresses frozen for a specific denom are stored
+func GetFrozenAddressesPrefix(denom string) []byte {
+	return []byte(strings.Join([]string{FrozenAddressPrefixKey, denom, ""}, KeySeparator))
+}
+
+// GetVestingSchedulesPrefix returns the store prefix where vesting schedules are stored by ID
+func GetVestingSchedulesPrefix() []byte {
+	return []byte(strings.Join([]string{VestingSchedulePrefixKey, ""}, KeySeparator))
+}
+
+// GetVestingRecipientPrefix returns the store prefix where the IDs of the vesting schedules of a specific recipient
+// are stored
+func GetVestingRecipientPrefix(recipient string) []byte {
+	return []byte(strings.Join([]string{VestingRecipientPrefixKey, recipient, ""}, KeySeparator))
+}
@@ -10,13 +10,30 @@ import (
 
 // constants
 const (
-	TypeMsgCreateDenom       = "create_denom"
-	TypeMsgMint              = "tf_mint"
-	TypeMsgBurn              = "tf_burn"
-	TypeMsgForceTransfer     = "force_transfer"
-	TypeMsgChangeAdmin       = "change_admin"
-	TypeMsgSetDenomMetadata  = "set_denom_metadata"
-	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+	TypeMsgCreateDenom              = "create_denom"
+	TypeMsgMint                     = "tf_mint"
+	TypeMsgBurn                     = "tf_burn"
+	TypeMsgForceTransfer            = "force_transfer"
+	TypeMsgChangeAdmin              = "change_admin"
+	TypeMsgSetDenomMetadata         = "set_denom_metadata"
+	TypeMsgSetBeforeSendHook        = "set_before_send_hook"
+	TypeMsgSetBeforeSendHooks       = "set_before_send_hooks"
+	TypeMsgSetMintLimits            = "set_mint_limits"
+	TypeMsgGrantRole                = "grant_role"
+	TypeMsgRevokeRole               = "revoke_role"
+	TypeMsgSetMinterAllowance       = "set_minter_allowance"
+	TypeMsgRenounceForceTransfer    = "renounce_force_transfer"
+	TypeMsgSetDenomPaused           = "set_denom_paused"
+	TypeMsgSetAddressFrozen         = "set_address_frozen"
+	TypeMsgForceUnfreeze            = "force_unfreeze"
+	TypeMsgMintVested               = "mint_vested"
+	TypeMsgClaimVested              = "claim_vested"
+	TypeMsgSetDenomRegistryEntry    = "set_denom_registry_entry"
+	TypeMsgRemoveDenomRegistryEntry = "remove_denom_registry_entry"
+	TypeMsgProposeAdmin             = "propose_admin"
+	TypeMsgAcceptAdmin              = "accept_admin"
+	TypeMsgCancelAdminTransfer      = "cancel_admin_transfer"
+	TypeMsgRenounceAdmin            = "renounce_admin"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -317,3 +334,550 @@ func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetBeforeSendHooks{}
+
+// NewMsgSetBeforeSendHooks creates a message to replace the before send hooks of a denom
+func NewMsgSetBeforeSendHooks(sender, denom string, hooks []BeforeSendHook) *MsgSetBeforeSendHooks {
+	return &MsgSetBeforeSendHooks{
+		Sender: sender,
+		Denom:  denom,
+		Hooks:  hooks,
+	}
+}
+
+func (m MsgSetBeforeSendHooks) Route() string { return RouterKey }
+func (m MsgSetBeforeSendHooks) Type() string  { return TypeMsgSetBeforeSendHooks }
+func (m MsgSetBeforeSendHooks) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	return ValidateBeforeSendHooks(m.Hooks)
+}
+
+func (m MsgSetBeforeSendHooks) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgSetBeforeSendHooks) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetMintLimits{}
+
+// NewMsgSetMintLimits creates a message to set the mint limits of a denom
+func NewMsgSetMintLimits(sender, denom string, mintLimits MintLimits) *MsgSetMintLimits {
+	return &MsgSetMintLimits{
+		Sender:     sender,
+		Denom:      denom,
+		MintLimits: mintLimits,
+	}
+}
+
+func (m MsgSetMintLimits) Route() string { return RouterKey }
+func (m MsgSetMintLimits) Type() string  { return TypeMsgSetMintLimits }
+func (m MsgSetMintLimits) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return m.MintLimits.Validate()
+}
+
+func (m MsgSetMintLimits) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgSetMintLimits) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgGrantRole{}
+
+// NewMsgGrantRole creates a message to grant a role over a denom
+func NewMsgGrantRole(sender, denom, address string, role Role) *MsgGrantRole {
+	return &MsgGrantRole{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+		Role:    role,
+	}
+}
+
+func (m MsgGrantRole) Route() string { return RouterKey }
+func (m MsgGrantRole) Type() string  { return TypeMsgGrantRole }
+func (m MsgGrantRole) Validate() error {
+	return validateRoleMsg(m.Sender, m.Denom, m.Address, m.Role)
+}
+
+func (m MsgGrantRole) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgGrantRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRevokeRole{}
+
+// NewMsgRevokeRole creates a message to revoke a role over a denom
+func NewMsgRevokeRole(sender, denom, address string, role Role) *MsgRevokeRole {
+	return &MsgRevokeRole{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+		Role:    role,
+	}
+}
+
+func (m MsgRevokeRole) Route() string { return RouterKey }
+func (m MsgRevokeRole) Type() string  { return TypeMsgRevokeRole }
+func (m MsgRevokeRole) Validate() error {
+	return validateRoleMsg(m.Sender, m.Denom, m.Address, m.Role)
+}
+
+func (m MsgRevokeRole) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func validateRoleMsg(sender, denom, address string, role Role) error {
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	return role.Validate()
+}
+
+var _ sdk.Msg = &MsgSetMinterAllowance{}
+
+// NewMsgSetMinterAllowance creates a message to cap the amount a minter can still mint
+func NewMsgSetMinterAllowance(sender, denom, minter string, allowance math.Int) *MsgSetMinterAllowance {
+	return &MsgSetMinterAllowance{
+		Sender:    sender,
+		Denom:     denom,
+		Minter:    minter,
+		Allowance: allowance,
+	}
+}
+
+// NewMsgRemoveMinterAllowance creates a message to let a minter mint without limit
+func NewMsgRemoveMinterAllowance(sender, denom, minter string) *MsgSetMinterAllowance {
+	return &MsgSetMinterAllowance{
+		Sender:    sender,
+		Denom:     denom,
+		Minter:    minter,
+		Allowance: math.ZeroInt(),
+		Unlimited: true,
+	}
+}
+
+func (m MsgSetMinterAllowance) Route() string { return RouterKey }
+func (m MsgSetMinterAllowance) Type() string  { return TypeMsgSetMinterAllowance }
+func (m MsgSetMinterAllowance) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Minter)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if !m.Unlimited && (m.Allowance.IsNil() || m.Allowance.IsNegative()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid allowance (%s)", m.Allowance)
+	}
+
+	return nil
+}
+
+func (m MsgSetMinterAllowance) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgSetMinterAllowance) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRenounceForceTransfer{}
+
+// NewMsgRenounceForceTransfer creates a message to permanently disable force transfers of a denom
+func NewMsgRenounceForceTransfer(sender, denom string) *MsgRenounceForceTransfer {
+	return &MsgRenounceForceTransfer{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgRenounceForceTransfer) Route() string { return RouterKey }
+func (m MsgRenounceForceTransfer) Type() string  { return TypeMsgRenounceForceTransfer }
+func (m MsgRenounceForceTransfer) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgRenounceForceTransfer) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgRenounceForceTransfer) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetDenomPaused{}
+
+// NewMsgSetDenomPaused creates a message to pause or resume transfers of a denom
+func NewMsgSetDenomPaused(sender, denom string, paused bool) *MsgSetDenomPaused {
+	return &MsgSetDenomPaused{
+		Sender: sender,
+		Denom:  denom,
+		Paused: paused,
+	}
+}
+
+func (m MsgSetDenomPaused) Route() string { return RouterKey }
+func (m MsgSetDenomPaused) Type() string  { return TypeMsgSetDenomPaused }
+func (m MsgSetDenomPaused) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetDenomPaused) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgSetDenomPaused) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetAddressFrozen{}
+
+// NewMsgSetAddressFrozen creates a message to freeze or unfreeze transfers of a denom from and to an address
+func NewMsgSetAddressFrozen(sender, denom, address string, frozen bool) *MsgSetAddressFrozen {
+	return &MsgSetAddressFrozen{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+		Frozen:  frozen,
+	}
+}
+
+func (m MsgSetAddressFrozen) Route() string { return RouterKey }
+func (m MsgSetAddressFrozen) Type() string  { return TypeMsgSetAddressFrozen }
+func (m MsgSetAddressFrozen) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetAddressFrozen) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgSetAddressFrozen) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMintVested{}
+
+// NewMsgMintVested creates a message to mint tokens into a vesting schedule. The schedule is continuous between
+// startTime and endTime if periods is empty, and periodic otherwise.
+func NewMsgMintVested(sender string, amount sdk.Coin, recipient string, startTime, endTime int64, periods []VestingPeriod) *MsgMintVested {
+	return &MsgMintVested{
+		Sender:    sender,
+		Amount:    amount,
+		Recipient: recipient,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Periods:   periods,
+	}
+}
+
+func (m MsgMintVested) Route() string { return RouterKey }
+func (m MsgMintVested) Type() string  { return TypeMsgMintVested }
+func (m MsgMintVested) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	return ValidateVestingTerms(m.Amount.Amount, m.StartTime, m.EndTime, m.Periods)
+}
+
+func (m MsgMintVested) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgMintVested) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimVested{}
+
+// NewMsgClaimVested creates a message to claim the tokens vested so far in a vesting schedule
+func NewMsgClaimVested(sender string, scheduleID uint64) *MsgClaimVested {
+	return &MsgClaimVested{
+		Sender:     sender,
+		ScheduleId: scheduleID,
+	}
+}
+
+func (m MsgClaimVested) Route() string { return RouterKey }
+func (m MsgClaimVested) Type() string  { return TypeMsgClaimVested }
+func (m MsgClaimVested) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgClaimVested) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgClaimVested) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgProposeAdmin{}
+
+// NewMsgProposeAdmin creates a message to propose handing a denom over to a new admin. The new admin can accept after
+// timelock seconds, and until expiry more seconds have passed unless expiry is zero.
+func NewMsgProposeAdmin(sender, denom, newAdmin string, timelock, expiry uint64) *MsgProposeAdmin {
+	return &MsgProposeAdmin{
+		Sender:   sender,
+		Denom:    denom,
+		NewAdmin: newAdmin,
+		Timelock: timelock,
+		Expiry:   expiry,
+	}
+}
+
+func (m MsgProposeAdmin) Route() string { return RouterKey }
+func (m MsgProposeAdmin) Type() string  { return TypeMsgProposeAdmin }
+func (m MsgProposeAdmin) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.NewAdmin)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid new admin address (%s)", err)
+	}
+
+	if m.NewAdmin == m.Sender {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "new admin is already the admin")
+	}
+
+	if m.Timelock > MaxAdminTransferTimelock {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "timelock %d exceeds the maximum of %d", m.Timelock, MaxAdminTransferTimelock)
+	}
+
+	if m.Expiry > MaxAdminTransferTimelock {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry %d exceeds the maximum of %d", m.Expiry, MaxAdminTransferTimelock)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgProposeAdmin) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgProposeAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgAcceptAdmin{}
+
+// NewMsgAcceptAdmin creates a message to accept the admin transfer of a denom proposed to the sender
+func NewMsgAcceptAdmin(sender, denom string) *MsgAcceptAdmin {
+	return &MsgAcceptAdmin{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgAcceptAdmin) Route() string { return RouterKey }
+func (m MsgAcceptAdmin) Type() string  { return TypeMsgAcceptAdmin }
+func (m MsgAcceptAdmin) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgAcceptAdmin) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgAcceptAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelAdminTransfer{}
+
+// NewMsgCancelAdminTransfer creates a message to cancel the proposed admin transfer of a denom
+func NewMsgCancelAdminTransfer(sender, denom string) *MsgCancelAdminTransfer {
+	return &MsgCancelAdminTransfer{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgCancelAdminTransfer) Route() string { return RouterKey }
+func (m MsgCancelAdminTransfer) Type() string  { return TypeMsgCancelAdminTransfer }
+func (m MsgCancelAdminTransfer) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgCancelAdminTransfer) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgCancelAdminTransfer) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRenounceAdmin{}
+
+// NewMsgRenounceAdmin creates a message to permanently leave a denom without admin
+func NewMsgRenounceAdmin(sender, denom string) *MsgRenounceAdmin {
+	return &MsgRenounceAdmin{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgRenounceAdmin) Route() string { return RouterKey }
+func (m MsgRenounceAdmin) Type() string  { return TypeMsgRenounceAdmin }
+func (m MsgRenounceAdmin) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgRenounceAdmin) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgRenounceAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -13,11 +13,14 @@ var (
 	KeyDenomCreationGasConsume = []byte("DenomCreationGasConsume")
 	KeyFeeCollectorAddress     = []byte("FeeCollectorAddress")
 	KeyWhitelistedHooks        = []byte("WhitelistedHooks")
+	KeyMintLimitsTimelock      = []byte("MintLimitsTimelock")
 	// We don't want to charge users for denom creation
 	DefaultDenomCreationFee        sdk.Coins
 	DefaultDenomCreationGasConsume uint64
 	DefaultFeeCollectorAddress     = ""
 	DefaultWhitelistedHooks        = []*WhitelistedHook{}
+	// Looser mint limits take effect after a week
+	DefaultMintLimitsTimelock uint64 = 7 * 24 * 60 * 60
 )
 
 // ParamKeyTable the param key table for tokenfactory module.
@@ -25,18 +28,31 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(denomCreationFee sdk.Coins, denomCreationGasConsume uint64, feeCollectorAddress string, whitelistedHooks []*WhitelistedHook) Params {
+func NewParams(
+	denomCreationFee sdk.Coins,
+	denomCreationGasConsume uint64,
+	feeCollectorAddress string,
+	whitelistedHooks []*WhitelistedHook,
+	mintLimitsTimelock uint64,
+) Params {
 	return Params{
 		DenomCreationFee:        denomCreationFee,
 		DenomCreationGasConsume: denomCreationGasConsume,
 		FeeCollectorAddress:     feeCollectorAddress,
 		WhitelistedHooks:        whitelistedHooks,
+		MintLimitsTimelock:      mintLimitsTimelock,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultDenomCreationFee, DefaultDenomCreationGasConsume, DefaultFeeCollectorAddress, DefaultWhitelistedHooks)
+	return NewParams(
+		DefaultDenomCreationFee,
+		DefaultDenomCreationGasConsume,
+		DefaultFeeCollectorAddress,
+		DefaultWhitelistedHooks,
+		DefaultMintLimitsTimelock,
+	)
 }
 
 // Validate validates params
@@ -71,6 +87,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDenomCreationGasConsume, &p.DenomCreationGasConsume, validateDenomCreationGasConsume),
 		paramtypes.NewParamSetPair(KeyFeeCollectorAddress, &p.FeeCollectorAddress, validateFeeCollectorAddress),
 		paramtypes.NewParamSetPair(KeyWhitelistedHooks, &p.WhitelistedHooks, validateWhitelistedHooks),
+		paramtypes.NewParamSetPair(KeyMintLimitsTimelock, &p.MintLimitsTimelock, validateMintLimitsTimelock),
 	}
 }
 
@@ -96,6 +113,15 @@ func validateDenomCreationGasConsume(i interface{}) error {
 	return nil
 }
 
+func validateMintLimitsTimelock(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateFeeCollectorAddress(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
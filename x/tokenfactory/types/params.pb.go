@@ -97,6 +97,9 @@ type Params struct {
 	FeeCollectorAddress string `protobuf:"bytes,3,opt,name=fee_collector_address,json=feeCollectorAddress,proto3" json:"fee_collector_address,omitempty"`
 	// whitelisted_hooks is the list of hooks which are allowed to be added and executed
 	WhitelistedHooks []*WhitelistedHook `protobuf:"bytes,4,rep,name=whitelisted_hooks,json=whitelistedHooks,proto3" json:"whitelisted_hooks,omitempty"`
+	// mint_limits_timelock is the number of seconds before looser mint limits
+	// set by a denom admin take effect
+	MintLimitsTimelock uint64 `protobuf:"varint,5,opt,name=mint_limits_timelock,json=mintLimitsTimelock,proto3" json:"mint_limits_timelock,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -160,6 +163,13 @@ func (m *Params) GetWhitelistedHooks() []*WhitelistedHook {
 	return nil
 }
 
+func (m *Params) GetMintLimitsTimelock() uint64 {
+	if m != nil {
+		return m.MintLimitsTimelock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*WhitelistedHook)(nil), "osmosis.tokenfactory.WhitelistedHook")
 	proto.RegisterType((*Params)(nil), "osmosis.tokenfactory.Params")
@@ -168,36 +178,38 @@ func init() {
 func init() { proto.RegisterFile("osmosis/tokenfactory/params.proto", fileDescriptor_09c297db7c49d1cf) }
 
 var fileDescriptor_09c297db7c49d1cf = []byte{
-	// 457 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x49, 0x14, 0xc4, 0x02, 0xa2, 0x98, 0x22, 0x9c, 0x1c, 0xec, 0xd4, 0x15, 0x52, 0x38,
-	0xd4, 0xab, 0x16, 0xc1, 0x81, 0x1b, 0x31, 0x02, 0x7a, 0x40, 0xaa, 0x7c, 0x41, 0x82, 0x83, 0xb5,
-	0xf1, 0x8e, 0x9d, 0x55, 0xe2, 0x9d, 0xc8, 0xbb, 0x69, 0xc9, 0x5f, 0xc0, 0x85, 0x8f, 0xe0, 0x4b,
-	0x72, 0xec, 0x91, 0x93, 0x41, 0xc9, 0x1f, 0xf4, 0x0b, 0x50, 0xd6, 0xae, 0x9a, 0x94, 0x9c, 0xbc,
-	0xf3, 0xde, 0xcc, 0x78, 0xde, 0x9b, 0x21, 0x07, 0xa8, 0x72, 0x54, 0x42, 0x51, 0x8d, 0x63, 0x90,
-	0x29, 0x4b, 0x34, 0x16, 0x73, 0x3a, 0x65, 0x05, 0xcb, 0x55, 0x30, 0x2d, 0x50, 0xa3, 0xbd, 0x5f,
-	0xa7, 0x04, 0x9b, 0x29, 0x5d, 0x37, 0x31, 0x30, 0x1d, 0x32, 0x05, 0xf4, 0xfc, 0x78, 0x08, 0x9a,
-	0x1d, 0xd3, 0x04, 0x85, 0xac, 0xaa, 0xba, 0x9d, 0x8a, 0x8f, 0x4d, 0x44, 0xab, 0xa0, 0xa6, 0xf6,
-	0x33, 0xcc, 0xb0, 0xc2, 0xd7, 0xaf, 0x0a, 0xf5, 0xbf, 0x92, 0x47, 0x9f, 0x47, 0x42, 0xc3, 0x44,
-	0x28, 0x0d, 0xfc, 0x23, 0xe2, 0xd8, 0x3e, 0x24, 0x77, 0x13, 0xe4, 0x10, 0x0b, 0xee, 0x58, 0x3d,
-	0xab, 0xdf, 0x1a, 0x90, 0x65, 0xe9, 0xb5, 0x43, 0xe4, 0x70, 0xfa, 0x2e, 0x6a, 0xaf, 0xa9, 0x53,
-	0x6e, 0x1f, 0x92, 0x87, 0x1c, 0x24, 0xe6, 0x71, 0x52, 0x00, 0xd3, 0x58, 0x38, 0x77, 0x7a, 0x56,
-	0xff, 0x5e, 0xf4, 0xc0, 0x80, 0x61, 0x85, 0xf9, 0x3f, 0x9a, 0xa4, 0x7d, 0x66, 0x44, 0xd9, 0x3f,
-	0x2d, 0x62, 0x6f, 0x14, 0x08, 0x94, 0x71, 0x0a, 0xe0, 0x58, 0xbd, 0x66, 0xff, 0xfe, 0x49, 0x27,
-	0xa8, 0x27, 0x5d, 0xcb, 0x0a, 0x6a, 0x59, 0x41, 0x88, 0x42, 0x0e, 0x3e, 0x2d, 0x4a, 0xaf, 0x71,
-	0x55, 0x7a, 0x9d, 0x39, 0xcb, 0x27, 0x6f, 0xfc, 0xff, 0x5b, 0xf8, 0xbf, 0xfe, 0x78, 0xfd, 0x4c,
-	0xe8, 0xd1, 0x6c, 0x18, 0x24, 0x98, 0xd7, 0x9a, 0xeb, 0xcf, 0x91, 0xe2, 0x63, 0xaa, 0xe7, 0x53,
-	0x50, 0xa6, 0x9b, 0x8a, 0xf6, 0x6e, 0xe6, 0x13, 0x28, 0xdf, 0x03, 0xd8, 0x29, 0xe9, 0xde, 0x6a,
-	0x9a, 0x31, 0x15, 0x27, 0x28, 0xd5, 0x2c, 0x07, 0xa3, 0xaa, 0x35, 0x78, 0xb1, 0x28, 0x3d, 0xeb,
-	0xaa, 0xf4, 0x0e, 0x76, 0x0e, 0xb1, 0x91, 0xef, 0x47, 0xcf, 0xb6, 0x7e, 0xf0, 0x81, 0xa9, 0xb0,
-	0x62, 0xec, 0x13, 0xf2, 0x34, 0x05, 0x88, 0x13, 0x9c, 0x4c, 0x60, 0xbd, 0xcb, 0x98, 0x71, 0x5e,
-	0x80, 0x52, 0x4e, 0xd3, 0x18, 0xf7, 0x24, 0x05, 0x08, 0xaf, 0xb9, 0xb7, 0x15, 0x65, 0x47, 0xe4,
-	0xf1, 0xc5, 0xcd, 0x72, 0xe2, 0x11, 0xe2, 0x58, 0x39, 0x2d, 0x63, 0xd9, 0xf3, 0x60, 0xd7, 0x7d,
-	0x04, 0xb7, 0x76, 0x19, 0xed, 0x5d, 0x6c, 0x03, 0x6a, 0x70, 0xb6, 0x58, 0xba, 0xd6, 0xe5, 0xd2,
-	0xb5, 0xfe, 0x2e, 0x5d, 0xeb, 0xfb, 0xca, 0x6d, 0x5c, 0xae, 0xdc, 0xc6, 0xef, 0x95, 0xdb, 0xf8,
-	0xf2, 0x7a, 0xc3, 0x45, 0x09, 0x33, 0x5d, 0xa0, 0x3c, 0xc2, 0x22, 0xbb, 0x7e, 0xd3, 0xf3, 0x57,
-	0xf4, 0xdb, 0xf6, 0xc1, 0x1a, 0x67, 0x87, 0x6d, 0x73, 0x49, 0x2f, 0xff, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x06, 0xad, 0xec, 0x86, 0xd5, 0x02, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x12, 0x82, 0x58, 0x40, 0x94, 0x25, 0x08, 0x27, 0x07, 0x3b, 0x4d, 0x85, 0x14,
+	0x0e, 0xb5, 0x69, 0x11, 0x1c, 0xb8, 0x91, 0x20, 0xa0, 0x12, 0x48, 0x95, 0x85, 0x84, 0x04, 0x87,
+	0xd5, 0xc6, 0x9e, 0x24, 0x2b, 0xdb, 0x3b, 0x91, 0x77, 0xd3, 0x92, 0xb7, 0xe0, 0xc4, 0x43, 0xf0,
+	0x1a, 0x5c, 0x72, 0xec, 0x91, 0x93, 0x41, 0xc9, 0x1b, 0xf4, 0x09, 0x90, 0xd7, 0xae, 0x9a, 0x94,
+	0x9e, 0xbc, 0xfb, 0x7f, 0xb3, 0xe3, 0xf9, 0x67, 0x86, 0xec, 0xa2, 0x4a, 0x51, 0x09, 0xe5, 0x6b,
+	0x8c, 0x41, 0x8e, 0x79, 0xa8, 0x31, 0x5b, 0xf8, 0x33, 0x9e, 0xf1, 0x54, 0x79, 0xb3, 0x0c, 0x35,
+	0xd2, 0x56, 0x15, 0xe2, 0x6d, 0x86, 0x74, 0x9c, 0xd0, 0xc8, 0xfe, 0x88, 0x2b, 0xf0, 0x4f, 0x0e,
+	0x46, 0xa0, 0xf9, 0x81, 0x1f, 0xa2, 0x90, 0xe5, 0xab, 0x4e, 0xbb, 0xe4, 0xcc, 0xdc, 0xfc, 0xf2,
+	0x52, 0xa1, 0xd6, 0x04, 0x27, 0x58, 0xea, 0xc5, 0xa9, 0x54, 0x7b, 0x5f, 0xc9, 0xfd, 0xcf, 0x53,
+	0xa1, 0x21, 0x11, 0x4a, 0x43, 0xf4, 0x1e, 0x31, 0xa6, 0x7b, 0xe4, 0x56, 0x88, 0x11, 0x30, 0x11,
+	0xd9, 0x56, 0xd7, 0xea, 0x37, 0x06, 0x64, 0x95, 0xbb, 0xcd, 0x21, 0x46, 0x70, 0xf4, 0x26, 0x68,
+	0x16, 0xe8, 0x28, 0xa2, 0x7b, 0xe4, 0x5e, 0x04, 0x12, 0x53, 0x16, 0x66, 0xc0, 0x35, 0x66, 0xf6,
+	0x8d, 0xae, 0xd5, 0xbf, 0x1d, 0xdc, 0x35, 0xe2, 0xb0, 0xd4, 0x7a, 0xbf, 0xea, 0xa4, 0x79, 0x6c,
+	0x4c, 0xd1, 0x1f, 0x16, 0xa1, 0x1b, 0x0f, 0x04, 0x4a, 0x36, 0x06, 0xb0, 0xad, 0x6e, 0xbd, 0x7f,
+	0xe7, 0xb0, 0xed, 0x55, 0x95, 0x16, 0xb6, 0xbc, 0xca, 0x96, 0x37, 0x44, 0x21, 0x07, 0x1f, 0x97,
+	0xb9, 0x5b, 0x3b, 0xcf, 0xdd, 0xf6, 0x82, 0xa7, 0xc9, 0xab, 0xde, 0xff, 0x29, 0x7a, 0x3f, 0xff,
+	0xb8, 0xfd, 0x89, 0xd0, 0xd3, 0xf9, 0xc8, 0x0b, 0x31, 0xad, 0x3c, 0x57, 0x9f, 0x7d, 0x15, 0xc5,
+	0xbe, 0x5e, 0xcc, 0x40, 0x99, 0x6c, 0x2a, 0xd8, 0xb9, 0xac, 0x4f, 0xa0, 0x7c, 0x0b, 0x40, 0xc7,
+	0xa4, 0x73, 0x25, 0xe9, 0x84, 0x2b, 0x16, 0xa2, 0x54, 0xf3, 0x14, 0x8c, 0xab, 0xc6, 0xe0, 0xe9,
+	0x32, 0x77, 0xad, 0xf3, 0xdc, 0xdd, 0xbd, 0xb6, 0x88, 0x8d, 0xf8, 0x5e, 0xf0, 0x78, 0xeb, 0x07,
+	0xef, 0xb8, 0x1a, 0x96, 0x84, 0x1e, 0x92, 0x47, 0x63, 0x00, 0x16, 0x62, 0x92, 0x40, 0x31, 0x4b,
+	0xc6, 0xa3, 0x28, 0x03, 0xa5, 0xec, 0xba, 0x69, 0xdc, 0xc3, 0x31, 0xc0, 0xf0, 0x82, 0xbd, 0x2e,
+	0x11, 0x0d, 0xc8, 0x83, 0xd3, 0xcb, 0xe1, 0xb0, 0x29, 0x62, 0xac, 0xec, 0x86, 0x69, 0xd9, 0x13,
+	0xef, 0xba, 0xfd, 0xf0, 0xae, 0xcc, 0x32, 0xd8, 0x39, 0xdd, 0x16, 0x14, 0x7d, 0x46, 0x5a, 0xa9,
+	0x90, 0x9a, 0x25, 0x22, 0x15, 0x5a, 0x31, 0x2d, 0x52, 0x48, 0x30, 0x8c, 0xed, 0x9b, 0x85, 0xd3,
+	0x80, 0x16, 0xec, 0x83, 0x41, 0x9f, 0x2a, 0x32, 0x38, 0x5e, 0xae, 0x1c, 0xeb, 0x6c, 0xe5, 0x58,
+	0x7f, 0x57, 0x8e, 0xf5, 0x7d, 0xed, 0xd4, 0xce, 0xd6, 0x4e, 0xed, 0xf7, 0xda, 0xa9, 0x7d, 0x79,
+	0xb9, 0xd1, 0x77, 0x09, 0x73, 0x9d, 0xa1, 0xdc, 0xc7, 0x6c, 0x72, 0x71, 0xf6, 0x4f, 0x5e, 0xf8,
+	0xdf, 0xb6, 0x57, 0xdc, 0xcc, 0x62, 0xd4, 0x34, 0xbb, 0xf7, 0xfc, 0xdf, 0x00, 0xe5, 0xa1, 0x4a,
+	0x06, 0x07, 0x03, 0x00, 0x00,
 }
 
 func (m *WhitelistedHook) Marshal() (dAtA []byte, err error) {
@@ -255,6 +267,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MintLimitsTimelock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MintLimitsTimelock))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.WhitelistedHooks) > 0 {
 		for iNdEx := len(m.WhitelistedHooks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -350,6 +367,9 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.MintLimitsTimelock != 0 {
+		n += 1 + sovParams(uint64(m.MintLimitsTimelock))
+	}
 	return n
 }
 
@@ -608,6 +628,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimitsTimelock", wireType)
+			}
+			m.MintLimitsTimelock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintLimitsTimelock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
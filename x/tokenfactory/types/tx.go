@@ -3,6 +3,7 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ sdk.Msg = &MsgUpdateParams{}
@@ -34,3 +35,111 @@ func (msg *MsgUpdateParams) Validate() error {
 
 	return msg.Params.Validate()
 }
+
+var _ sdk.Msg = &MsgForceUnfreeze{}
+
+func (msg *MsgForceUnfreeze) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgForceUnfreeze) Type() string {
+	return "force-unfreeze"
+}
+
+func (msg *MsgForceUnfreeze) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgForceUnfreeze) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgForceUnfreeze) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	if len(msg.Addresses) == 0 && !msg.Unpause {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nothing to unfreeze")
+	}
+
+	for _, address := range msg.Addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+		}
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetDenomRegistryEntry{}
+
+func (msg *MsgSetDenomRegistryEntry) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetDenomRegistryEntry) Type() string {
+	return "set-denom-registry-entry"
+}
+
+func (msg *MsgSetDenomRegistryEntry) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetDenomRegistryEntry) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgSetDenomRegistryEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	return msg.Entry.Validate()
+}
+
+var _ sdk.Msg = &MsgRemoveDenomRegistryEntry{}
+
+func (msg *MsgRemoveDenomRegistryEntry) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveDenomRegistryEntry) Type() string {
+	return "remove-denom-registry-entry"
+}
+
+func (msg *MsgRemoveDenomRegistryEntry) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRemoveDenomRegistryEntry) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRemoveDenomRegistryEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenomRegistryEntry, "invalid denom (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxVestingPeriods is the maximum number of periods of a periodic vesting schedule
+	MaxVestingPeriods = 100
+	// MaxVestingStartTime is the latest start time of a vesting schedule in unix seconds (the end of year 9999)
+	MaxVestingStartTime = 253402300799
+	// MaxVestingDuration is the maximum length of a vesting schedule in seconds
+	MaxVestingDuration = 100 * 365 * 24 * 60 * 60
+)
+
+// ValidateVestingTerms checks that amount vests either continuously between startTime and endTime, or over periods
+// adding up to amount, starting by MaxVestingStartTime and over at most MaxVestingDuration
+func ValidateVestingTerms(amount math.Int, startTime, endTime int64, periods []VestingPeriod) error {
+	if startTime < 0 || startTime > MaxVestingStartTime {
+		return errorsmod.Wrapf(ErrInvalidVestingSchedule, "start time %d must be between 0 and %d", startTime, MaxVestingStartTime)
+	}
+
+	if len(periods) == 0 {
+		if endTime <= startTime {
+			return errorsmod.Wrapf(ErrInvalidVestingSchedule, "end time %d must be after start time %d", endTime, startTime)
+		}
+
+		if endTime-startTime > MaxVestingDuration {
+			return errorsmod.Wrapf(ErrInvalidVestingSchedule, "a schedule can last at most %d seconds", MaxVestingDuration)
+		}
+
+		return nil
+	}
+
+	if endTime != 0 {
+		return errorsmod.Wrap(ErrInvalidVestingSchedule, "periodic schedules cannot have an end time")
+	}
+
+	if len(periods) > MaxVestingPeriods {
+		return errorsmod.Wrapf(ErrInvalidVestingSchedule, "a schedule can have at most %d periods", MaxVestingPeriods)
+	}
+
+	total := math.ZeroInt()
+	var duration int64
+	for _, period := range periods {
+		if period.Length <= 0 || period.Length > MaxVestingDuration {
+			return errorsmod.Wrapf(ErrInvalidVestingSchedule, "period length %d must be between 1 and %d", period.Length, MaxVestingDuration)
+		}
+
+		// Periods are bounded so that the sum can't overflow
+		duration += period.Length
+		if duration > MaxVestingDuration {
+			return errorsmod.Wrapf(ErrInvalidVestingSchedule, "a schedule can last at most %d seconds", MaxVestingDuration)
+		}
+
+		if period.Amount.IsNil() || !period.Amount.IsPositive() {
+			return errorsmod.Wrap(ErrInvalidVestingSchedule, "period amount must be positive")
+		}
+
+		total = total.Add(period.Amount)
+	}
+
+	if !total.Equal(amount) {
+		return errorsmod.Wrapf(ErrInvalidVestingSchedule, "periods add up to %s instead of %s", total, amount)
+	}
+
+	return nil
+}
+
+// Validate checks the recipient, amounts and vesting terms of a schedule
+func (s VestingSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return errorsmod.Wrapf(ErrInvalidVestingSchedule, "invalid recipient address (%s)", err)
+	}
+
+	if !s.Amount.IsValid() || !s.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidVestingSchedule, "invalid amount %s", s.Amount)
+	}
+
+	if _, _, err := DeconstructDenom(s.Amount.Denom); err != nil {
+		return err
+	}
+
+	if s.Claimed.IsNil() || s.Claimed.IsNegative() || s.Claimed.GT(s.Amount.Amount) {
+		return errorsmod.Wrapf(ErrInvalidVestingSchedule, "invalid claimed amount %s", s.Claimed)
+	}
+
+	return ValidateVestingTerms(s.Amount.Amount, s.StartTime, s.EndTime, s.Periods)
+}
+
+// VestedAmount returns the amount of the schedule vested at blockTime (unix seconds)
+func (s VestingSchedule) VestedAmount(blockTime int64) math.Int {
+	if blockTime <= s.StartTime {
+		return math.ZeroInt()
+	}
+
+	if len(s.Periods) == 0 {
+		if blockTime >= s.EndTime {
+			return s.Amount.Amount
+		}
+
+		return s.Amount.Amount.MulRaw(blockTime - s.StartTime).QuoRaw(s.EndTime - s.StartTime)
+	}
+
+	vested := math.ZeroInt()
+	periodEnd := s.StartTime
+	for _, period := range s.Periods {
+		periodEnd += period.Length
+		if blockTime < periodEnd {
+			break
+		}
+		vested = vested.Add(period.Amount)
+	}
+
+	return vested
+}
+
+// ClaimableAmount returns the amount of the schedule vested at blockTime (unix seconds) and not claimed yet
+func (s VestingSchedule) ClaimableAmount(blockTime int64) math.Int {
+	return s.VestedAmount(blockTime).Sub(s.Claimed)
+}
+
+// IsFullyClaimed returns true once the whole amount of the schedule has been claimed
+func (s VestingSchedule) IsFullyClaimed() bool {
+	return s.Claimed.GTE(s.Amount.Amount)
+}
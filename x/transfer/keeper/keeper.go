@@ -16,6 +16,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 
 	feetypes "github.com/neutron-org/neutron/v5/x/feerefunder/types"
+	tokenfactorytypes "github.com/neutron-org/neutron/v5/x/tokenfactory/types"
 	wrappedtypes "github.com/neutron-org/neutron/v5/x/transfer/types"
 )
 
@@ -59,7 +60,7 @@ func (k KeeperTransferWrapper) Transfer(goCtx context.Context, msg *wrappedtypes
 	}
 
 	transferMsg := types.NewMsgTransfer(msg.SourcePort, msg.SourceChannel, msg.Token, msg.Sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo)
-	if _, err := k.Keeper.Transfer(goCtx, transferMsg); err != nil {
+	if _, err := k.ICS20Keeper().Transfer(ctx, transferMsg); err != nil {
 		return nil, err
 	}
 
@@ -69,6 +70,29 @@ func (k KeeperTransferWrapper) Transfer(goCtx context.Context, msg *wrappedtypes
 	}, nil
 }
 
+// ICS20Keeper returns the underlying transfer keeper wrapped to pass outbound transfers to tokenfactory hooks
+func (k KeeperTransferWrapper) ICS20Keeper() ICS20Keeper {
+	return ICS20Keeper{Keeper: k.Keeper}
+}
+
+// ICS20Keeper wraps the ibc-go transfer keeper so that every ICS-20 transfer, including the ones made by calling the
+// keeper directly such as packet forwarding, passes the outbound transfer to the before send hooks of the tokenfactory
+// denoms it escrows or burns
+type ICS20Keeper struct {
+	keeper.Keeper
+}
+
+// Transfer sets the outbound ICS-20 transfer on the context before the tokens are escrowed or burnt
+func (k ICS20Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	ctx := tokenfactorytypes.WithIBCTransfer(sdk.UnwrapSDKContext(goCtx), tokenfactorytypes.IBCTransfer{
+		SourcePort:    msg.SourcePort,
+		SourceChannel: msg.SourceChannel,
+		Receiver:      msg.Receiver,
+	})
+
+	return k.Keeper.Transfer(ctx, msg)
+}
+
 func (k KeeperTransferWrapper) UpdateParams(goCtx context.Context, msg *wrappedtypes.MsgUpdateParams) (*wrappedtypes.MsgUpdateParamsResponse, error) {
 	newMsg := &types.MsgUpdateParams{
 		Signer: msg.Signer,
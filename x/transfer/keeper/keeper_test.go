@@ -1,11 +1,13 @@
 package transfer_test
 
 import (
+	"context"
 	"testing"
 
 	"cosmossdk.io/math"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -20,6 +22,8 @@ import (
 	mock_types "github.com/neutron-org/neutron/v5/testutil/mocks/transfer/types"
 	"github.com/neutron-org/neutron/v5/testutil/transfer/keeper"
 	feetypes "github.com/neutron-org/neutron/v5/x/feerefunder/types"
+	tokenfactorytypes "github.com/neutron-org/neutron/v5/x/tokenfactory/types"
+	transferkeeper "github.com/neutron-org/neutron/v5/x/transfer/keeper"
 	"github.com/neutron-org/neutron/v5/x/transfer/types"
 )
 
@@ -164,6 +168,59 @@ func (suite KeeperTestSuite) TestTransfer() { //nolint:govet // it's a test so i
 	suite.NoError(err)
 }
 
+// ibcTransferRecorder records the outbound ICS-20 transfers carried by the contexts of the sends it makes
+type ibcTransferRecorder struct {
+	transfertypes.BankKeeper
+	transfers []tokenfactorytypes.IBCTransfer
+}
+
+func (r *ibcTransferRecorder) SendCoins(ctx context.Context, from, to sdktypes.AccAddress, amt sdktypes.Coins) error {
+	if transfer, ok := tokenfactorytypes.GetIBCTransfer(ctx); ok {
+		r.transfers = append(r.transfers, transfer)
+	}
+	return r.BankKeeper.SendCoins(ctx, from, to, amt)
+}
+
+func (suite *KeeperTestSuite) TestICS20KeeperPassesIBCTransfer() {
+	suite.ConfigureTransferChannel()
+
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	bankKeeper := &ibcTransferRecorder{BankKeeper: app.BankKeeper}
+	ics20Keeper := transferkeeper.ICS20Keeper{Keeper: ibctransferkeeper.NewKeeper(
+		app.AppCodec(),
+		app.GetKey(transfertypes.StoreKey),
+		app.GetSubspace(transfertypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.PortKeeper,
+		app.AccountKeeper,
+		bankKeeper,
+		app.ScopedTransferKeeper,
+		"authority",
+	)}
+
+	// a transfer made by calling the keeper directly, as the packet forward middleware does, carries the transfer
+	ctx := suite.ChainA.GetContext()
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, sdktypes.MustAccAddressFromBech32(testutil.TestOwnerAddress))
+	_, err := ics20Keeper.Transfer(ctx, transfertypes.NewMsgTransfer(
+		suite.TransferPath.EndpointA.ChannelConfig.PortID,
+		suite.TransferPath.EndpointA.ChannelID,
+		sdktypes.NewCoin(params.DefaultDenom, math.NewInt(1000)),
+		testutil.TestOwnerAddress,
+		TestAddress,
+		clienttypes.NewHeight(10, 10000),
+		0,
+		"",
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]tokenfactorytypes.IBCTransfer{{
+		SourcePort:    suite.TransferPath.EndpointA.ChannelConfig.PortID,
+		SourceChannel: suite.TransferPath.EndpointA.ChannelID,
+		Receiver:      TestAddress,
+	}}, bankKeeper.transfers)
+}
+
 func (suite *KeeperTestSuite) TopUpWallet(ctx sdktypes.Context, sender, contractAddress sdktypes.AccAddress) {
 	coinsAmnt := sdktypes.NewCoins(sdktypes.NewCoin(params.DefaultDenom, math.NewInt(int64(1_000_000))))
 	bankKeeper := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper